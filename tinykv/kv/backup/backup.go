@@ -0,0 +1,48 @@
+// Package backup holds what the backup and the restore of key ranges share: the format of the backup files, the
+// checksums of their pairs and the rewriting of the keys of a restore. The backup of the values visible at a timestamp
+// is in package mvcc.
+package backup
+
+import (
+	"bytes"
+	"hash/crc64"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+var crc64Table = crc64.MakeTable(crc64.ECMA)
+
+// Checksum sums up key/value pairs, see kvrpcpb.BackupFile.
+type Checksum struct {
+	Crc64Xor   uint64
+	TotalKvs   uint64
+	TotalBytes uint64
+}
+
+// Update adds a pair to the checksum.
+func (c *Checksum) Update(key, value []byte) {
+	digest := crc64.Update(0, crc64Table, key)
+	digest = crc64.Update(digest, crc64Table, value)
+	c.Crc64Xor ^= digest
+	c.TotalKvs++
+	c.TotalBytes += uint64(len(key) + len(value))
+}
+
+// Merge adds the pairs summed up by other to the checksum.
+func (c *Checksum) Merge(other *Checksum) {
+	c.Crc64Xor ^= other.Crc64Xor
+	c.TotalKvs += other.TotalKvs
+	c.TotalBytes += other.TotalBytes
+}
+
+// RewriteKey replaces the prefix of key by the first rule which matches it. A key which no rule matches is kept.
+func RewriteKey(key []byte, rules []*kvrpcpb.RewriteRule) []byte {
+	for _, rule := range rules {
+		if bytes.HasPrefix(key, rule.OldKeyPrefix) {
+			newKey := make([]byte, 0, len(rule.NewKeyPrefix)+len(key)-len(rule.OldKeyPrefix))
+			newKey = append(newKey, rule.NewKeyPrefix...)
+			return append(newKey, key[len(rule.OldKeyPrefix):]...)
+		}
+	}
+	return key
+}
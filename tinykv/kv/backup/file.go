@@ -0,0 +1,167 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"hash"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+
+	"github.com/pingcap/errors"
+)
+
+// A backup file holds key/value pairs sorted by key, it is laid out like an SST with a single block:
+//
+//	magic | entry ... | footer
+//
+// An entry is the uvarint length of the key, the key, the uvarint length of the value and the value. The footer is the
+// number of entries as a big endian uint64 and the CRC32 (Castagnoli) of everything before it as a big endian uint32.
+const fileMagic = "TKVBAK01"
+
+const footerSize = 8 + 4
+
+var crc32Table = crc32.MakeTable(crc32.Castagnoli)
+
+// FileWriter writes a backup file. The file is written under a temporary name and renamed once it is finished, so a
+// file which exists is always complete.
+type FileWriter struct {
+	path    string
+	file    *os.File
+	w       *bufio.Writer
+	crc     hash.Hash32
+	count   uint64
+	lastKey []byte
+	buf     [binary.MaxVarintLen64]byte
+}
+
+// CreateFile creates a backup file at path.
+func CreateFile(path string) (*FileWriter, error) {
+	file, err := os.Create(path + ".tmp")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	w := &FileWriter{
+		path: path,
+		file: file,
+		w:    bufio.NewWriter(file),
+		crc:  crc32.New(crc32Table),
+	}
+	if err := w.write([]byte(fileMagic)); err != nil {
+		w.Abort()
+		return nil, err
+	}
+	return w, nil
+}
+
+// Add appends a pair to the file, pairs must be added in ascending order of their keys.
+func (w *FileWriter) Add(key, value []byte) error {
+	if w.count > 0 && bytes.Compare(key, w.lastKey) <= 0 {
+		return errors.Errorf("backup file %s: key %q added after %q", w.path, key, w.lastKey)
+	}
+	for _, b := range [][]byte{key, value} {
+		n := binary.PutUvarint(w.buf[:], uint64(len(b)))
+		if err := w.write(w.buf[:n]); err != nil {
+			return err
+		}
+		if err := w.write(b); err != nil {
+			return err
+		}
+	}
+	w.count++
+	w.lastKey = append(w.lastKey[:0], key...)
+	return nil
+}
+
+// Finish writes the footer and moves the file to its path.
+func (w *FileWriter) Finish() error {
+	var footer [footerSize]byte
+	binary.BigEndian.PutUint64(footer[:8], w.count)
+	if err := w.write(footer[:8]); err != nil {
+		return err
+	}
+	binary.BigEndian.PutUint32(footer[8:], w.crc.Sum32())
+	if _, err := w.w.Write(footer[8:]); err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.w.Flush(); err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.file.Sync(); err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.file.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(os.Rename(w.file.Name(), w.path))
+}
+
+// Abort removes the unfinished file.
+func (w *FileWriter) Abort() {
+	w.file.Close()
+	os.Remove(w.file.Name())
+}
+
+func (w *FileWriter) write(b []byte) error {
+	w.crc.Write(b)
+	_, err := w.w.Write(b)
+	return errors.Trace(err)
+}
+
+// FileReader reads the pairs of a backup file in order.
+type FileReader struct {
+	data []byte
+	// count is the number of pairs left.
+	count uint64
+}
+
+// OpenFile reads the backup file at path and checks it isn't corrupted.
+func OpenFile(path string) (*FileReader, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(data) < len(fileMagic)+footerSize || string(data[:len(fileMagic)]) != fileMagic {
+		return nil, errors.Errorf("backup file %s: not a backup file", path)
+	}
+	footer := data[len(data)-footerSize:]
+	data = data[:len(data)-4]
+	if crc32.Checksum(data, crc32Table) != binary.BigEndian.Uint32(footer[8:]) {
+		return nil, errors.Errorf("backup file %s: checksum mismatch", path)
+	}
+	return &FileReader{
+		data:  data[len(fileMagic) : len(data)-8],
+		count: binary.BigEndian.Uint64(footer[:8]),
+	}, nil
+}
+
+// Next returns the next pair of the file, or nil keys once all the pairs are read.
+func (r *FileReader) Next() ([]byte, []byte, error) {
+	if r.count == 0 {
+		if len(r.data) > 0 {
+			return nil, nil, errors.New("backup file: data after the last entry")
+		}
+		return nil, nil, nil
+	}
+	key, err := r.readBytes()
+	if err != nil {
+		return nil, nil, err
+	}
+	value, err := r.readBytes()
+	if err != nil {
+		return nil, nil, err
+	}
+	r.count--
+	return key, value, nil
+}
+
+func (r *FileReader) readBytes() ([]byte, error) {
+	l, n := binary.Uvarint(r.data)
+	if n <= 0 || uint64(len(r.data)-n) < l {
+		return nil, errors.New("backup file: truncated entry")
+	}
+	b := r.data[n : n+int(l)]
+	r.data = r.data[n+int(l):]
+	return b, nil
+}
@@ -0,0 +1,512 @@
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/storage"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/concurrency"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// Change data capture streams the changes of a key range to a subscriber, see kvrpcpb.ChangeDataRequest.
+//
+// The hub sits between the transactional commands and the storage. Commands write while they hold the latches of their
+// keys, so the changes of a key reach the hub in the order they are written. The hub turns them into entries and queues
+// them for the subscribers of the key before the command returns.
+//
+// A subscriber first gets the changes committed after its start ts and the locks of its range from a scan of the
+// storage. The writes which happen during the scan are queued once the scan is done, they may be sent twice.
+//
+// The hub keeps the locks of the range of each subscriber, and sends the subscriber a resolved ts periodically: a
+// timestamp got from the scheduler, or right below the start ts of the oldest lock. A transaction which hasn't
+// prewritten gets its commit ts after that, and async commit and one-phase commit transactions commit after the max ts
+// (see package concurrency), which is bumped to the timestamp first. The resolved ts is queued after the changes
+// already written, so all the changes committed at or below it have been sent when it is.
+
+// TsSource allocates timestamps, it is the scheduler on a TinyKV server.
+type TsSource interface {
+	GetTS(ctx context.Context) (uint64, error)
+}
+
+const (
+	// eventChanSize is the number of events queued for a subscriber, a subscriber which falls further behind is dropped.
+	eventChanSize = 1024
+	// scanBatchSize is the number of entries sent in one event by the initial scan.
+	scanBatchSize = 128
+	// defaultResolveInterval is the interval to check the region of a subscriber and to send its resolved ts.
+	defaultResolveInterval = time.Second
+)
+
+var errLagging = errors.New("change data subscriber falls behind")
+
+// Hub passes the writes of the transactional commands to the storage and sends the changes they make to the
+// subscribers of the keys.
+type Hub struct {
+	storage.Storage
+	cm       *concurrency.Manager
+	tsSource TsSource
+	interval time.Duration
+
+	// Mutex to guard the subscribers and their state, it orders the events of a subscriber.
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+// NewHub creates a hub writing to inner. It doesn't send resolved ts until SetTsSource is called.
+func NewHub(inner storage.Storage, cm *concurrency.Manager) *Hub {
+	return &Hub{
+		Storage:     inner,
+		cm:          cm,
+		interval:    defaultResolveInterval,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// SetTsSource makes the hub send resolved ts every interval, with timestamps got from src. It must be called before the
+// hub is used.
+func (h *Hub) SetTsSource(src TsSource, interval time.Duration) {
+	h.tsSource = src
+	h.interval = interval
+}
+
+type subscriber struct {
+	startKey []byte
+	endKey   []byte
+	// locks maps the keys of the range to their prewrites which haven't been committed or rolled back.
+	locks      map[string]*kvrpcpb.ChangeDataEntry
+	resolvedTs uint64
+
+	// Until the initial scan is done, the entries of the writes are kept in pending, and the start ts of the locks they
+	// remove in removed, so that the locks found by the scan aren't kept for ever. The commits of the locks written before
+	// the subscription are in unmatched, they get their values from the scan.
+	initialized bool
+	pending     []*kvrpcpb.ChangeDataEntry
+	removed     map[string]uint64
+	unmatched   map[*kvrpcpb.ChangeDataEntry]struct{}
+
+	// events is closed when the subscriber is dropped, err is the error which ends the subscription then.
+	events  chan *kvrpcpb.ChangeDataEvent
+	dropped bool
+	err     error
+}
+
+func (sub *subscriber) contains(key []byte) bool {
+	return bytes.Compare(key, sub.startKey) >= 0 && (len(sub.endKey) == 0 || bytes.Compare(key, sub.endKey) < 0)
+}
+
+// Write writes batch to the storage, then queues the changes for the subscribers.
+func (h *Hub) Write(ctx *kvrpcpb.Context, batch []storage.Modify) error {
+	if err := h.Storage.Write(ctx, batch); err != nil {
+		return err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.subscribers) == 0 {
+		return nil
+	}
+	changes, err := parseChanges(batch)
+	if err != nil {
+		log.Warn("failed to parse the changes of a write", zap.Error(err))
+		for sub := range h.subscribers {
+			h.drop(sub, err)
+		}
+		return nil
+	}
+	for sub := range h.subscribers {
+		entries := sub.apply(changes)
+		if !sub.initialized {
+			sub.pending = append(sub.pending, entries...)
+		} else if len(entries) > 0 {
+			h.send(sub, &kvrpcpb.ChangeDataEvent{Entries: entries})
+		}
+	}
+	return nil
+}
+
+// changes are the changes of the mvcc data made by a write.
+type changes struct {
+	// values maps the encoded keys of the default CF to the values written.
+	values      map[string][]byte
+	writes      []writeChange
+	lockPuts    []lockChange
+	lockDeletes [][]byte
+}
+
+type writeChange struct {
+	key      []byte
+	commitTs uint64
+	write    *mvcc.Write
+}
+
+type lockChange struct {
+	key  []byte
+	lock *mvcc.Lock
+}
+
+func parseChanges(batch []storage.Modify) (*changes, error) {
+	c := &changes{values: make(map[string][]byte)}
+	for _, m := range batch {
+		switch data := m.Data.(type) {
+		case storage.Put:
+			switch data.Cf {
+			case engine_util.CfDefault:
+				c.values[string(data.Key)] = data.Value
+			case engine_util.CfWrite:
+				write, err := mvcc.ParseWrite(data.Value)
+				if err != nil {
+					return nil, err
+				}
+				c.writes = append(c.writes, writeChange{
+					key:      mvcc.DecodeUserKey(data.Key),
+					commitTs: mvcc.DecodeTimestamp(data.Key),
+					write:    write,
+				})
+			case engine_util.CfLock:
+				lock, err := mvcc.ParseLock(data.Value)
+				if err != nil {
+					return nil, err
+				}
+				c.lockPuts = append(c.lockPuts, lockChange{key: data.Key, lock: lock})
+			}
+		case storage.Delete:
+			if data.Cf == engine_util.CfLock {
+				c.lockDeletes = append(c.lockDeletes, data.Key)
+			}
+		}
+	}
+	return c, nil
+}
+
+// apply updates the locks of the subscriber with c and returns the entries to send it. Pessimistic locks are left out,
+// they don't commit before they are prewritten. The locks and the commits of Lock mutations are kept but not sent.
+func (sub *subscriber) apply(c *changes) []*kvrpcpb.ChangeDataEntry {
+	var entries []*kvrpcpb.ChangeDataEntry
+	for _, w := range c.writes {
+		if !sub.contains(w.key) {
+			continue
+		}
+		lock := sub.locks[string(w.key)]
+		if lock != nil && lock.StartTs == w.write.StartTS {
+			delete(sub.locks, string(w.key))
+		} else {
+			lock = nil
+		}
+		if !sub.initialized {
+			sub.removed[string(w.key)] = w.write.StartTS
+		}
+		switch w.write.Kind {
+		case mvcc.WriteKindPut, mvcc.WriteKindDelete:
+			entry := &kvrpcpb.ChangeDataEntry{
+				Type:     kvrpcpb.ChangeDataEntry_Commit,
+				Key:      w.key,
+				Op:       w.write.Kind.ToProto(),
+				StartTs:  w.write.StartTS,
+				CommitTs: w.commitTs,
+			}
+			if w.write.Kind == mvcc.WriteKindPut {
+				// A one-phase commit writes the value with the commit.
+				if value, ok := c.values[string(mvcc.EncodeKey(w.key, w.write.StartTS))]; ok {
+					entry.Value = value
+				} else if lock != nil {
+					entry.Value = lock.Value
+				} else if !sub.initialized {
+					sub.unmatched[entry] = struct{}{}
+				}
+			}
+			entries = append(entries, entry)
+		case mvcc.WriteKindRollback:
+			entries = append(entries, &kvrpcpb.ChangeDataEntry{
+				Type:    kvrpcpb.ChangeDataEntry_Rollback,
+				Key:     w.key,
+				Op:      kvrpcpb.Op_Rollback,
+				StartTs: w.write.StartTS,
+			})
+		}
+	}
+	for _, key := range c.lockDeletes {
+		delete(sub.locks, string(key))
+	}
+	for _, l := range c.lockPuts {
+		if !sub.contains(l.key) || l.lock.IsPessimistic() {
+			continue
+		}
+		// The lock of a prewrite is written again when its ttl or min commit ts is updated.
+		if old := sub.locks[string(l.key)]; old != nil && old.StartTs == l.lock.Ts {
+			continue
+		}
+		entry := &kvrpcpb.ChangeDataEntry{
+			Type:    kvrpcpb.ChangeDataEntry_Prewrite,
+			Key:     l.key,
+			Op:      l.lock.Kind.ToProto(),
+			StartTs: l.lock.Ts,
+		}
+		if l.lock.Kind == mvcc.WriteKindPut {
+			entry.Value = c.values[string(mvcc.EncodeKey(l.key, l.lock.Ts))]
+		}
+		sub.locks[string(l.key)] = entry
+		if l.lock.Kind != mvcc.WriteKindLock {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// send queues event for sub, sub is dropped if it falls too far behind. h.mu must be held.
+func (h *Hub) send(sub *subscriber, event *kvrpcpb.ChangeDataEvent) {
+	if sub.dropped {
+		return
+	}
+	select {
+	case sub.events <- event:
+	default:
+		h.drop(sub, errLagging)
+	}
+}
+
+// drop stops queuing events for sub, the subscription ends with err once the queued events are sent. h.mu must be held.
+func (h *Hub) drop(sub *subscriber, err error) {
+	if sub.dropped {
+		return
+	}
+	sub.dropped, sub.err = true, err
+	close(sub.events)
+	delete(h.subscribers, sub)
+}
+
+// Subscribe streams the changes of the range of req to stream until the stream is closed or an error ends it.
+func (h *Hub) Subscribe(req *kvrpcpb.ChangeDataRequest, stream tinykvpb.TinyKv_ChangeDataServer) error {
+	sub := &subscriber{
+		startKey:   req.StartKey,
+		endKey:     req.EndKey,
+		locks:      make(map[string]*kvrpcpb.ChangeDataEntry),
+		resolvedTs: req.StartTs,
+		removed:    make(map[string]uint64),
+		unmatched:  make(map[*kvrpcpb.ChangeDataEntry]struct{}),
+		events:     make(chan *kvrpcpb.ChangeDataEvent, eventChanSize),
+	}
+	h.mu.Lock()
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+	defer func() {
+		h.mu.Lock()
+		h.drop(sub, nil)
+		h.mu.Unlock()
+	}()
+
+	if err := h.initialize(req, sub, stream); err != nil {
+		return sendError(stream, err)
+	}
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case event, ok := <-sub.events:
+			if !ok {
+				return sendError(stream, sub.err)
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := h.resolve(stream.Context(), req, sub); err != nil {
+				return sendError(stream, err)
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// initialize sends sub the changes committed after the start ts and the locks of its range, followed by the changes
+// written meanwhile.
+func (h *Hub) initialize(req *kvrpcpb.ChangeDataRequest, sub *subscriber, stream tinykvpb.TinyKv_ChangeDataServer) error {
+	reader, err := h.Storage.Reader(req.Context)
+	if err != nil {
+		return err
+	}
+	entries, locks, err := scan(reader, req)
+	reader.Close()
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	for key, lock := range locks {
+		if startTs, ok := sub.removed[key]; ok && startTs == lock.StartTs {
+			continue
+		}
+		if _, ok := sub.locks[key]; !ok {
+			sub.locks[key] = lock
+		}
+	}
+	for _, entry := range sub.pending {
+		if _, ok := sub.unmatched[entry]; ok {
+			lock := locks[string(entry.Key)]
+			if lock == nil || lock.StartTs != entry.StartTs {
+				// The scan has read the commit.
+				continue
+			}
+			entry.Value = lock.Value
+		}
+		entries = append(entries, entry)
+	}
+	sub.pending, sub.removed, sub.unmatched = nil, nil, nil
+	sub.initialized = true
+	h.mu.Unlock()
+
+	entries = append(entries, &kvrpcpb.ChangeDataEntry{Type: kvrpcpb.ChangeDataEntry_Initialized})
+	for len(entries) > 0 {
+		n := scanBatchSize
+		if n > len(entries) {
+			n = len(entries)
+		}
+		if err := stream.Send(&kvrpcpb.ChangeDataEvent{Entries: entries[:n]}); err != nil {
+			return err
+		}
+		entries = entries[n:]
+	}
+	return nil
+}
+
+// scan reads the changes committed in the range of req after its start ts, and the locks of the range. The prewrites
+// of the locks are returned with the changes, except those of Lock mutations.
+func scan(reader storage.StorageReader, req *kvrpcpb.ChangeDataRequest) ([]*kvrpcpb.ChangeDataEntry, map[string]*kvrpcpb.ChangeDataEntry, error) {
+	var entries []*kvrpcpb.ChangeDataEntry
+	inRange := func(key []byte) bool {
+		return len(req.EndKey) == 0 || bytes.Compare(key, req.EndKey) < 0
+	}
+
+	writeIter := reader.IterCF(engine_util.CfWrite)
+	defer writeIter.Close()
+	for writeIter.Seek(mvcc.EncodeKey(req.StartKey, mvcc.TsMax)); writeIter.Valid(); writeIter.Next() {
+		item := writeIter.Item()
+		key := mvcc.DecodeUserKey(item.Key())
+		if !inRange(key) {
+			break
+		}
+		commitTs := mvcc.DecodeTimestamp(item.Key())
+		if commitTs <= req.StartTs {
+			continue
+		}
+		value, err := item.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+		write, err := mvcc.ParseWrite(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		if write.Kind != mvcc.WriteKindPut && write.Kind != mvcc.WriteKindDelete {
+			continue
+		}
+		entry := &kvrpcpb.ChangeDataEntry{
+			Type:     kvrpcpb.ChangeDataEntry_Commit,
+			Key:      key,
+			Op:       write.Kind.ToProto(),
+			StartTs:  write.StartTS,
+			CommitTs: commitTs,
+		}
+		if write.Kind == mvcc.WriteKindPut {
+			if entry.Value, err = reader.GetCF(engine_util.CfDefault, mvcc.EncodeKey(key, write.StartTS)); err != nil {
+				return nil, nil, err
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	locks := make(map[string]*kvrpcpb.ChangeDataEntry)
+	lockIter := reader.IterCF(engine_util.CfLock)
+	defer lockIter.Close()
+	for lockIter.Seek(req.StartKey); lockIter.Valid(); lockIter.Next() {
+		item := lockIter.Item()
+		key := item.KeyCopy(nil)
+		if !inRange(key) {
+			break
+		}
+		value, err := item.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+		lock, err := mvcc.ParseLock(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		if lock.IsPessimistic() {
+			continue
+		}
+		entry := &kvrpcpb.ChangeDataEntry{
+			Type:    kvrpcpb.ChangeDataEntry_Prewrite,
+			Key:     key,
+			Op:      lock.Kind.ToProto(),
+			StartTs: lock.Ts,
+		}
+		if lock.Kind == mvcc.WriteKindPut {
+			if entry.Value, err = reader.GetCF(engine_util.CfDefault, mvcc.EncodeKey(key, lock.Ts)); err != nil {
+				return nil, nil, err
+			}
+		}
+		locks[string(key)] = entry
+		if lock.Kind != mvcc.WriteKindLock {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, locks, nil
+}
+
+// resolve checks that the region of sub is still served here and queues the resolved ts of its range.
+func (h *Hub) resolve(ctx context.Context, req *kvrpcpb.ChangeDataRequest, sub *subscriber) error {
+	reader, err := h.Storage.Reader(req.Context)
+	if err != nil {
+		return err
+	}
+	reader.Close()
+	if h.tsSource == nil {
+		return nil
+	}
+	ts, err := h.tsSource.GetTS(ctx)
+	if err != nil {
+		log.Warn("failed to get a ts to resolve", zap.Error(err))
+		return nil
+	}
+	// Async commit and one-phase commit transactions which haven't read the max ts commit after ts, the others keep
+	// their locks in memory until their changes are queued.
+	h.cm.UpdateMaxTs(ts)
+	memLockTs := h.cm.MinLockTs()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	resolvedTs := ts
+	if memLockTs != 0 && memLockTs-1 < resolvedTs {
+		resolvedTs = memLockTs - 1
+	}
+	for _, lock := range sub.locks {
+		if lock.StartTs-1 < resolvedTs {
+			resolvedTs = lock.StartTs - 1
+		}
+	}
+	if resolvedTs > sub.resolvedTs {
+		sub.resolvedTs = resolvedTs
+		h.send(sub, &kvrpcpb.ChangeDataEvent{ResolvedTs: resolvedTs})
+	}
+	return nil
+}
+
+// sendError ends the subscription with an event carrying err.
+func sendError(stream tinykvpb.TinyKv_ChangeDataServer, err error) error {
+	event := new(kvrpcpb.ChangeDataEvent)
+	if regionErr, ok := errors.Cause(err).(storage.RegionError); ok {
+		event.RegionError = regionErr.RegionErr()
+	} else {
+		event.Error = err.Error()
+	}
+	return stream.Send(event)
+}
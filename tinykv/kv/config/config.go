@@ -20,23 +20,39 @@ type Config struct {
 	RaftBaseTickInterval     time.Duration
 	RaftHeartbeatTicks       int
 	RaftElectionTimeoutTicks int
+	// Serve reads with the leader lease instead of confirming the leadership with a round of
+	// heartbeats first. It saves a round trip per read but relies on a bounded clock drift.
+	RaftLeaseRead bool
+	// Max byte size of the entries carried by one append message.
+	RaftMaxSizePerMsg uint64
+	// Max number of append messages in flight to a follower which is catching up.
+	RaftMaxInflightMsgs int
 
 	// Interval to gc unnecessary raft log (ms).
 	RaftLogGCTickInterval time.Duration
 	// When entry count exceed this value, gc will be forced trigger.
 	RaftLogGcCountLimit uint64
+	// A threshold to gc stale raft log, must >= 1.
+	RaftLogGcThreshold uint64
 
 	// Interval (ms) to check region whether need to be split or not.
 	SplitRegionCheckTickInterval time.Duration
 	// delay time before deleting a stale peer
 	SchedulerHeartbeatTickInterval      time.Duration
 	SchedulerStoreHeartbeatTickInterval time.Duration
+	// Interval to advance the resolved ts of the regions, followers serve stale reads below it. The change data
+	// streams get their resolved ts at the same interval.
+	ResolvedTsTickInterval time.Duration
 
 	// When region [a,e) size meets regionMaxSize, it will be split into
 	// several regions [a,b), [b,c), [c,d), [d,e). And the size of [a,b),
 	// [b,c), [c,d) will be regionSplitSize (maybe a little larger).
 	RegionMaxSize   uint64
 	RegionSplitSize uint64
+
+	// Store a time to live with each raw value, so that raw writes may set one. It changes how raw values are
+	// stored, so it must not change once a store holds raw data.
+	RawTTL bool
 }
 
 func (c *Config) Validate() error {
@@ -49,10 +65,18 @@ func (c *Config) Validate() error {
 			"otherwise it may lead to inconsistency."))
 	}
 
+	if c.RaftLogGcThreshold < 1 {
+		return fmt.Errorf("raft log gc threshold must be greater than 0")
+	}
+
 	if c.RaftElectionTimeoutTicks <= c.RaftHeartbeatTicks {
 		return fmt.Errorf("election tick must be greater than heartbeat tick.")
 	}
 
+	if c.RaftMaxInflightMsgs <= 0 {
+		return fmt.Errorf("raft max inflight msgs must be greater than 0")
+	}
+
 	return nil
 }
 
@@ -70,12 +94,16 @@ func NewDefaultConfig() *Config {
 		RaftBaseTickInterval:     1 * time.Second,
 		RaftHeartbeatTicks:       2,
 		RaftElectionTimeoutTicks: 10,
+		RaftMaxSizePerMsg:        1 * MB,
+		RaftMaxInflightMsgs:      256,
 		RaftLogGCTickInterval:    10 * time.Second,
 		// Assume the average size of entries is 1k.
 		RaftLogGcCountLimit:                 128000,
+		RaftLogGcThreshold:                  50,
 		SplitRegionCheckTickInterval:        10 * time.Second,
 		SchedulerHeartbeatTickInterval:      100 * time.Millisecond,
 		SchedulerStoreHeartbeatTickInterval: 10 * time.Second,
+		ResolvedTsTickInterval:              1 * time.Second,
 		RegionMaxSize:                       144 * MB,
 		RegionSplitSize:                     96 * MB,
 		DBPath:                              "/tmp/badger",
@@ -89,12 +117,16 @@ func NewTestConfig() *Config {
 		RaftBaseTickInterval:     50 * time.Millisecond,
 		RaftHeartbeatTicks:       2,
 		RaftElectionTimeoutTicks: 10,
+		RaftMaxSizePerMsg:        1 * MB,
+		RaftMaxInflightMsgs:      256,
 		RaftLogGCTickInterval:    50 * time.Millisecond,
 		// Assume the average size of entries is 1k.
 		RaftLogGcCountLimit:                 128000,
+		RaftLogGcThreshold:                  50,
 		SplitRegionCheckTickInterval:        100 * time.Millisecond,
 		SchedulerHeartbeatTickInterval:      100 * time.Millisecond,
 		SchedulerStoreHeartbeatTickInterval: 500 * time.Millisecond,
+		ResolvedTsTickInterval:              100 * time.Millisecond,
 		RegionMaxSize:                       144 * MB,
 		RegionSplitSize:                     96 * MB,
 		DBPath:                              "/tmp/badger",
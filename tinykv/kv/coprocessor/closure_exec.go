@@ -11,6 +11,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/kv/coprocessor/rowcodec"
 	"github.com/pingcap-incubator/tinykv/kv/storage"
 	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/expression/aggregation"
 	"github.com/pingcap/tidb/kv"
@@ -36,13 +37,23 @@ const (
 
 // buildClosureExecutor build a closureExecutor for the DAGRequest.
 // Currently the composition of executors are:
-// 	tableScan|indexScan [selection] [topN | limit | agg]
+//
+//	tableScan|indexScan [selection] [topN | limit | agg] [projection]
+//
+// A projection can't follow an agg.
 func (svr *CopHandler) buildClosureExecutor(dagCtx *dagContext, dagReq *tipb.DAGRequest) (*closureExecutor, error) {
 	ce, err := svr.newClosureExecutor(dagCtx, dagReq)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	executors := dagReq.Executors
+	if lastExec := executors[len(executors)-1]; lastExec.Tp == tipb.ExecType_TypeProjection {
+		ce.projectionCtx.exprs, err = convertToExprs(ce.sc, ce.fieldTps, lastExec.Projection.Exprs)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		executors = executors[:len(executors)-1]
+	}
 	scanExec := executors[0]
 	if scanExec.Tp == tipb.ExecType_TypeTableScan {
 		ce.processor = &tableScanProcessor{closureExecutor: ce}
@@ -65,10 +76,15 @@ func (svr *CopHandler) buildClosureExecutor(dagCtx *dagContext, dagReq *tipb.DAG
 		ce.limit = int(lastExecutor.Limit.Limit)
 	case tipb.ExecType_TypeTopN:
 		err = svr.buildTopNProcessor(ce, lastExecutor.TopN)
-	case tipb.ExecType_TypeAggregation:
-		err = svr.buildHashAggProcessor(ce, dagCtx, lastExecutor.Aggregation)
-	case tipb.ExecType_TypeStreamAgg:
-		err = svr.buildStreamAggProcessor(ce, dagCtx, executors)
+	case tipb.ExecType_TypeAggregation, tipb.ExecType_TypeStreamAgg:
+		if ce.hasProjection() {
+			return nil, errors.New("projection after aggregation is not supported")
+		}
+		if lastExecutor.Tp == tipb.ExecType_TypeAggregation {
+			err = svr.buildHashAggProcessor(ce, dagCtx, lastExecutor.Aggregation)
+		} else {
+			err = svr.buildStreamAggProcessor(ce, dagCtx, executors)
+		}
 	case tipb.ExecType_TypeSelection:
 		ce.processor = &selectionProcessor{closureExecutor: ce}
 	default:
@@ -238,26 +254,39 @@ func (svr *CopHandler) buildStreamAggProcessor(e *closureExecutor, ctx *dagConte
 type closureExecutor struct {
 	*evalContext
 
-	reader       storage.StorageReader
-	outputOff    []uint32
-	seCtx        sessionctx.Context
-	kvRanges     []kv.KeyRange
-	startTS      uint64
-	ignoreLock   bool
-	lockChecked  bool
-	scanCtx      scanCtx
-	idxScanCtx   *idxScanCtx
-	selectionCtx selectionCtx
-	aggCtx       aggCtx
-	topNCtx      *topNCtx
+	reader        storage.StorageReader
+	outputOff     []uint32
+	seCtx         sessionctx.Context
+	kvRanges      []kv.KeyRange
+	startTS       uint64
+	ignoreLock    bool
+	lockChecked   bool
+	scanCtx       scanCtx
+	idxScanCtx    *idxScanCtx
+	selectionCtx  selectionCtx
+	projectionCtx projectionCtx
+	aggCtx        aggCtx
+	topNCtx       *topNCtx
 
 	rowCount int
 	unique   bool
 	limit    int
 
-	oldChunks []tipb.Chunk
-	oldRowBuf []byte
-	processor closureProcessor
+	// pagingSize and pagingBytes are the budget of a paged scan, scannedRange is set if the scan stops at it. See
+	// coprocessor.Request.PagingSize.
+	pagingSize   int
+	pagingBytes  int
+	scannedRange *coprocessor.KeyRange
+
+	// cacheEnabled is set if the response may be cached, metNewerData is set if it can't be since the ranges have
+	// data newer than startTS. See coprocessor.Response.CanBeCached.
+	cacheEnabled bool
+	metNewerData bool
+
+	oldChunks     []tipb.Chunk
+	oldChunksSize int
+	oldRowBuf     []byte
+	processor     closureProcessor
 }
 
 type closureProcessor interface {
@@ -290,16 +319,57 @@ type selectionCtx struct {
 	conditions []expression.Expression
 }
 
+// projectionCtx holds the expressions of a projection, which are evaluated on the rows as they are output.
+type projectionCtx struct {
+	exprs []expression.Expression
+}
+
 type topNCtx struct {
 	heap         *topNHeap
 	orderByExprs []expression.Expression
 	sortRow      *sortRow
 }
 
+// initPaging sets the budget of a paged request. Only the scans which output their rows as they read them are paged,
+// the other processors need all the rows of the ranges.
+func (e *closureExecutor) initPaging(req *coprocessor.Request) {
+	switch e.processor.(type) {
+	case *tableScanProcessor, *indexScanProcessor, *selectionProcessor:
+		e.pagingSize, e.pagingBytes = int(req.PagingSize), int(req.PagingBytes)
+	}
+}
+
+// pageFull returns if a paged scan has output its budget of rows or bytes. A scan which has reached its limit is done
+// rather than full.
+func (e *closureExecutor) pageFull() bool {
+	if e.pagingSize == 0 || e.rowCount == e.limit {
+		return false
+	}
+	if e.rowCount >= e.pagingSize {
+		return true
+	}
+	return e.pagingBytes > 0 && int64(e.oldChunksSize)+e.scanCtx.chk.MemoryUsage() >= int64(e.pagingBytes)
+}
+
+// endPage sets the range scanned by a page whose last key is lastKey.
+func (e *closureExecutor) endPage(lastKey []byte) {
+	first := e.kvRanges[0]
+	if e.scanCtx.desc {
+		e.scannedRange = &coprocessor.KeyRange{Start: lastKey, End: first.EndKey}
+	} else {
+		e.scannedRange = &coprocessor.KeyRange{Start: first.StartKey, End: kv.Key(lastKey).Next()}
+	}
+}
+
 func (e *closureExecutor) execute() ([]tipb.Chunk, error) {
 	txn := mvcc.RoTxn{Reader: e.reader, StartTS: e.startTS}
 	for _, ran := range e.kvRanges {
 		if e.unique && ran.IsPoint() {
+			if e.cacheEnabled {
+				if err := txn.CheckNewerData(ran.StartKey); err != nil {
+					return nil, errors.Trace(err)
+				}
+			}
 			val, err := txn.GetValue(ran.StartKey)
 			if err != nil {
 				return nil, errors.Trace(err)
@@ -311,45 +381,61 @@ func (e *closureExecutor) execute() ([]tipb.Chunk, error) {
 			if err != nil {
 				return nil, errors.Trace(err)
 			}
+			if e.pageFull() {
+				e.endPage(ran.StartKey)
+			}
 		} else {
-			if e.scanCtx.desc {
-				panic("do not support desc scan")
-			} else {
-				scanner := mvcc.NewScanner(ran.StartKey, &txn)
-				for {
-					key, val, err := scanner.Next()
-					if err != nil {
-						scanner.Close()
-						return nil, err
-					}
-					if key == nil && val == nil {
-						break
-					}
-					if bytes.Compare(key, ran.EndKey) >= 0 {
-						break
-					}
-
-					err = e.processor.Process(key, val)
-					if err != nil {
-						if err == ScanBreak {
-							break
-						}
-						scanner.Close()
-						return nil, err
-					}
-
-				}
-				scanner.Close()
+			if err := e.scanRange(&txn, ran); err != nil {
+				return nil, err
 			}
 		}
-		if e.rowCount == e.limit {
+		if e.rowCount == e.limit || e.scannedRange != nil {
 			break
 		}
 	}
+	e.metNewerData = txn.MetNewerData
 	err := e.processor.Finish()
 	return e.oldChunks, err
 }
 
+// scanRange feeds the pairs in the key range to the processor, from the last key to the first for a desc scan.
+func (e *closureExecutor) scanRange(txn *mvcc.RoTxn, ran kv.KeyRange) error {
+	var scanner mvcc.PairScanner
+	if e.scanCtx.desc {
+		scanner = mvcc.NewReverseScanner(ran.EndKey, txn)
+	} else {
+		scanner = mvcc.NewScanner(ran.StartKey, txn)
+	}
+	defer scanner.Close()
+	for {
+		key, val, err := scanner.Next()
+		if err != nil {
+			return err
+		}
+		if key == nil && val == nil {
+			return nil
+		}
+		if e.scanCtx.desc && bytes.Compare(key, ran.StartKey) < 0 {
+			return nil
+		}
+		if !e.scanCtx.desc && bytes.Compare(key, ran.EndKey) >= 0 {
+			return nil
+		}
+
+		err = e.processor.Process(key, val)
+		if err != nil {
+			if err == ScanBreak {
+				return nil
+			}
+			return err
+		}
+		if e.pageFull() {
+			e.endPage(key)
+			return nil
+		}
+	}
+}
+
 type countStarProcessor struct {
 	skipVal
 	*closureExecutor
@@ -449,6 +535,10 @@ func (e *closureExecutor) hasSelection() bool {
 	return len(e.selectionCtx.conditions) > 0
 }
 
+func (e *closureExecutor) hasProjection() bool {
+	return len(e.projectionCtx.exprs) > 0
+}
+
 func (e *closureExecutor) processSelection() (gotRow bool, err error) {
 	chk := e.scanCtx.chk
 	row := chk.GetRow(chk.NumRows() - 1)
@@ -544,8 +634,19 @@ func (e *closureExecutor) chunkToOldChunk(chk *chunk.Chunk) error {
 	var oldRow []types.Datum
 	for i := 0; i < chk.NumRows(); i++ {
 		oldRow = oldRow[:0]
+		row := chk.GetRow(i)
+		// The output offsets of a projection are the offsets of its expressions.
 		for _, outputOff := range e.outputOff {
-			d := chk.GetRow(i).GetDatum(int(outputOff), e.fieldTps[outputOff])
+			var d types.Datum
+			if e.hasProjection() {
+				var err error
+				d, err = e.projectionCtx.exprs[outputOff].Eval(row)
+				if err != nil {
+					return errors.Trace(err)
+				}
+			} else {
+				d = row.GetDatum(int(outputOff), e.fieldTps[outputOff])
+			}
 			oldRow = append(oldRow, d)
 		}
 		var err error
@@ -554,6 +655,7 @@ func (e *closureExecutor) chunkToOldChunk(chk *chunk.Chunk) error {
 			return errors.Trace(err)
 		}
 		e.oldChunks = appendRow(e.oldChunks, e.oldRowBuf, i)
+		e.oldChunksSize += len(e.oldRowBuf)
 	}
 	chk.Reset()
 	return nil
@@ -49,6 +49,15 @@ type CopHandler struct{}
 func (svr *CopHandler) HandleCopDAGRequest(reader storage.StorageReader, req *coprocessor.Request) *coprocessor.Response {
 	startTime := time.Now()
 	resp := &coprocessor.Response{}
+	dataVersion, err := cacheDataVersion(reader, req)
+	if err != nil {
+		resp.OtherError = err.Error()
+		return resp
+	}
+	if dataVersion != 0 && dataVersion == req.CacheIfMatchVersion {
+		resp.IsCacheHit, resp.CacheLastVersion = true, dataVersion
+		return resp
+	}
 	dagCtx, dagReq, err := svr.buildDAG(reader, req)
 	if err != nil {
 		resp.OtherError = err.Error()
@@ -58,8 +67,30 @@ func (svr *CopHandler) HandleCopDAGRequest(reader storage.StorageReader, req *co
 	if err != nil {
 		return buildResp(nil, nil, err, dagCtx.evalCtx.sc.GetWarnings(), time.Since(startTime))
 	}
+	closureExec.initPaging(req)
+	closureExec.cacheEnabled = dataVersion != 0
 	chunks, err := closureExec.execute()
-	return buildResp(chunks, nil, err, dagCtx.evalCtx.sc.GetWarnings(), time.Since(startTime))
+	resp = buildResp(chunks, nil, err, dagCtx.evalCtx.sc.GetWarnings(), time.Since(startTime))
+	if err == nil {
+		resp.Range = closureExec.scannedRange
+		resp.CacheLastVersion = dataVersion
+		resp.CanBeCached = dataVersion != 0 && !closureExec.metNewerData
+	}
+	return resp
+}
+
+// cacheDataVersion returns the data version of the region read by a request which enables the cache, or 0 if the
+// response can't be cached.
+func cacheDataVersion(reader storage.StorageReader, req *coprocessor.Request) (uint64, error) {
+	if !req.IsCacheEnabled {
+		return 0, nil
+	}
+	versionReader, ok := reader.(storage.DataVersionReader)
+	if !ok {
+		return 0, nil
+	}
+	version, err := versionReader.DataVersion()
+	return version, errors.Trace(err)
 }
 
 func (svr *CopHandler) buildDAG(reader storage.StorageReader, req *coprocessor.Request) (*dagContext, *tipb.DAGRequest, error) {
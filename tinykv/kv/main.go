@@ -13,6 +13,8 @@ import (
 
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/server"
+	"github.com/pingcap-incubator/tinykv/kv/storage"
+	"github.com/pingcap-incubator/tinykv/kv/storage/raft_storage"
 	"github.com/pingcap-incubator/tinykv/kv/storage/standalone_storage"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/logutil"
@@ -27,6 +29,7 @@ var (
 	storeAddr     = flag.String("addr", "", "store address")
 	dbPath        = flag.String("path", "", "directory path of db")
 	logLevel      = flag.String("loglevel", "info", "the level of log")
+	rawTTL        = flag.Bool("raw-ttl", false, "store a time to live with each raw value")
 )
 
 func main() {
@@ -44,13 +47,28 @@ func main() {
 	if *logLevel != "" {
 		conf.LogLevel = *logLevel
 	}
+	conf.RawTTL = *rawTTL
 
 	log.SetLevel(logutil.StringToZapLogLevel(conf.LogLevel))
 	log.Info(fmt.Sprintf("Server started with conf %+v", conf))
 
-	storage := standalone_storage.NewStandAloneStorage(conf)
+	var storage storage.Storage
+	var raftStorage *raft_storage.RaftStorage
+	if conf.Raft {
+		raftStorage = raft_storage.NewRaftStorage(conf)
+		storage = raftStorage
+	} else {
+		storage = standalone_storage.NewStandAloneStorage(conf)
+	}
 
 	server := server.NewServer(storage)
+	server.RawTTL = conf.RawTTL
+	if raftStorage != nil {
+		if err := raftStorage.Start(server.ConcurrencyManager); err != nil {
+			log.Fatal("start raft storage failed", zap.Error(err))
+		}
+		server.CDC.SetTsSource(raftStorage, conf.ResolvedTsTickInterval)
+	}
 
 	var alivePolicy = keepalive.EnforcementPolicy{
 		MinTime:             2 * time.Second, // If a client pings more than once every 2 seconds, terminate the connection
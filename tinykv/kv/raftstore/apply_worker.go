@@ -0,0 +1,575 @@
+package raftstore
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// registerTask creates the applier of a region, it is sent when a peer is created and after a
+// snapshot has been applied.
+type registerTask struct {
+	regionID   uint64
+	region     *metapb.Region
+	applyState *rspb.RaftApplyState
+}
+
+// applyTask carries the committed entries of one ready, and the proposals waiting for them.
+type applyTask struct {
+	regionID  uint64
+	term      uint64
+	entries   []eraftpb.Entry
+	proposals []*proposal
+}
+
+// applySnapTask asks the apply worker to replace the data of a region with the data of a
+// snapshot. The result is sent back on done, so the raft worker can persist the raft state
+// only after the data is in the kv engine.
+type applySnapTask struct {
+	regionID uint64
+	snapshot *eraftpb.Snapshot
+	done     chan<- *applySnapResult
+}
+
+type applySnapResult struct {
+	region     *metapb.Region
+	applyState *rspb.RaftApplyState
+	err        error
+}
+
+// destroyTask drops the applier of a region and marks the region as tombstone. The data of the
+// region is cleared unless keepData is set.
+type destroyTask struct {
+	regionID uint64
+	region   *metapb.Region
+	keepData bool
+}
+
+// applyResult is sent back to the peer after a batch of entries has been applied.
+type applyResult struct {
+	applyState  *rspb.RaftApplyState
+	execResults []interface{}
+	// the approximate size of the data written by the applied entries
+	sizeDiffHint uint64
+}
+
+// execResultChangePeer is the result of applying a conf change entry.
+type execResultChangePeer struct {
+	confChange *eraftpb.ConfChange
+	peer       *metapb.Peer
+	region     *metapb.Region
+}
+
+// execResultSplitRegion is the result of applying a split admin command, derived is the origin
+// region with its new range, and regions has both the derived region and the new region.
+type execResultSplitRegion struct {
+	regions []*metapb.Region
+	derived *metapb.Region
+}
+
+// execResultCompactLog is the result of applying a compact log admin command.
+type execResultCompactLog struct {
+	truncatedIndex uint64
+}
+
+// execResultResolvedTs is the result of applying a resolved ts admin command.
+type execResultResolvedTs struct {
+	resolvedTs uint64
+}
+
+// applier keeps the state the apply worker needs to apply the entries of one region.
+type applier struct {
+	tag        string
+	region     *metapb.Region
+	applyState *rspb.RaftApplyState
+	// removed is set once a conf change removing the peer has been applied, entries after it
+	// must not be applied any more.
+	removed bool
+}
+
+// applyWorker applies committed raft entries to the kv engine. All the regions of the store
+// share one apply worker, so the tasks of a region are applied in the order they were sent.
+type applyWorker struct {
+	storeID  uint64
+	engines  *engine_util.Engines
+	router   *router
+	appliers map[uint64]*applier
+}
+
+func newApplyWorker(storeID uint64, engines *engine_util.Engines, router *router) *applyWorker {
+	return &applyWorker{
+		storeID:  storeID,
+		engines:  engines,
+		router:   router,
+		appliers: make(map[uint64]*applier),
+	}
+}
+
+func (aw *applyWorker) Handle(t worker.Task) {
+	switch task := t.(type) {
+	case *registerTask:
+		aw.appliers[task.regionID] = &applier{
+			tag:        fmt.Sprintf("[region %d] apply", task.regionID),
+			region:     task.region,
+			applyState: task.applyState,
+		}
+	case *applyTask:
+		aw.handleApply(task)
+	case *applySnapTask:
+		task.done <- aw.handleApplySnap(task)
+	case *destroyTask:
+		aw.handleDestroy(task)
+	}
+}
+
+func (aw *applyWorker) handleApply(task *applyTask) {
+	a, ok := aw.appliers[task.regionID]
+	if !ok || a.removed {
+		for _, p := range task.proposals {
+			NotifyReqRegionRemoved(task.regionID, p.cb)
+		}
+		return
+	}
+	ctx := &applyContext{
+		applier:   a,
+		engines:   aw.engines,
+		storeID:   aw.storeID,
+		term:      task.term,
+		proposals: task.proposals,
+		kvWB:      new(engine_util.WriteBatch),
+	}
+	for i := range task.entries {
+		if a.removed {
+			break
+		}
+		ctx.applyEntry(&task.entries[i])
+	}
+	ctx.flush()
+	// Anything left was proposed after the peer removed itself.
+	for _, p := range ctx.proposals {
+		NotifyReqRegionRemoved(task.regionID, p.cb)
+	}
+	_ = aw.router.send(task.regionID, message.NewPeerMsg(message.MsgTypeApplyRes, task.regionID, &applyResult{
+		applyState:   cloneApplyState(a.applyState),
+		execResults:  ctx.execResults,
+		sizeDiffHint: ctx.sizeDiffHint,
+	}))
+}
+
+func (aw *applyWorker) handleApplySnap(task *applySnapTask) *applySnapResult {
+	data, err := decodeSnapshotData(task.snapshot)
+	if err != nil {
+		return &applySnapResult{err: err}
+	}
+	region := data.Region
+	kvWB := new(engine_util.WriteBatch)
+	if a, ok := aw.appliers[task.regionID]; ok && util.RegionInitialized(a.region) {
+		// Clear the stale data of the region before ingesting the snapshot.
+		if err := engine_util.DeleteRange(aw.engines.Kv, a.region.StartKey, a.region.EndKey); err != nil {
+			return &applySnapResult{err: err}
+		}
+	}
+	for _, kv := range data.Data {
+		cf, key := splitCFKey(kv.Key)
+		kvWB.SetCF(cf, key, kv.Value)
+	}
+	applyState := &rspb.RaftApplyState{
+		AppliedIndex: task.snapshot.Metadata.Index,
+		TruncatedState: &rspb.RaftTruncatedState{
+			Index: task.snapshot.Metadata.Index,
+			Term:  task.snapshot.Metadata.Term,
+		},
+		DataVersion: task.snapshot.Metadata.Index,
+	}
+	if err := kvWB.SetMeta(meta.ApplyStateKey(task.regionID), applyState); err != nil {
+		return &applySnapResult{err: err}
+	}
+	meta.WriteRegionState(kvWB, region, rspb.PeerState_Normal)
+	if err := kvWB.WriteToDB(aw.engines.Kv); err != nil {
+		return &applySnapResult{err: err}
+	}
+	aw.appliers[task.regionID] = &applier{
+		tag:        fmt.Sprintf("[region %d] apply", task.regionID),
+		region:     region,
+		applyState: applyState,
+	}
+	log.Info(fmt.Sprintf("[region %d] applied snapshot with %d keys at index %d",
+		task.regionID, len(data.Data), applyState.AppliedIndex))
+	return &applySnapResult{region: region, applyState: cloneApplyState(applyState)}
+}
+
+func (aw *applyWorker) handleDestroy(task *destroyTask) {
+	delete(aw.appliers, task.regionID)
+	kvWB := new(engine_util.WriteBatch)
+	kvWB.DeleteMeta(meta.ApplyStateKey(task.regionID))
+	meta.WriteRegionState(kvWB, task.region, rspb.PeerState_Tombstone)
+	if err := kvWB.WriteToDB(aw.engines.Kv); err != nil {
+		log.Panic("failed to write tombstone state", zap.Uint64("region", task.regionID), zap.Error(err))
+	}
+	if task.keepData || !util.RegionInitialized(task.region) {
+		return
+	}
+	if err := engine_util.DeleteRange(aw.engines.Kv, task.region.StartKey, task.region.EndKey); err != nil {
+		log.Error("failed to clear region data", zap.Uint64("region", task.regionID), zap.Error(err))
+	}
+}
+
+// applyContext holds the state of applying the entries of one applyTask.
+type applyContext struct {
+	*applier
+	engines      *engine_util.Engines
+	storeID      uint64
+	term         uint64
+	proposals    []*proposal
+	kvWB         *engine_util.WriteBatch
+	execResults  []interface{}
+	sizeDiffHint uint64
+	// callbacks to invoke once the write batch is persisted
+	pending []pendingResp
+}
+
+type pendingResp struct {
+	cb   *message.Callback
+	resp *raft_cmdpb.RaftCmdResponse
+	// snap is set if the callback needs a badger transaction opened after the write
+	snap bool
+}
+
+// flush persists the write batch together with the apply state, then answers the callbacks of
+// the applied commands.
+func (ctx *applyContext) flush() {
+	if err := ctx.kvWB.SetMeta(meta.ApplyStateKey(ctx.region.Id), ctx.applyState); err != nil {
+		log.Panic("failed to set apply state", zap.Error(err))
+	}
+	ctx.kvWB.MustWriteToDB(ctx.engines.Kv)
+	ctx.kvWB.Reset()
+	for _, p := range ctx.pending {
+		if p.snap {
+			p.cb.Txn = ctx.engines.Kv.NewTransaction(false)
+		}
+		p.cb.Done(p.resp)
+	}
+	ctx.pending = ctx.pending[:0]
+}
+
+// takeCallback pops the callback proposed for the entry, answering the ones which were
+// superseded by another entry as stale.
+func (ctx *applyContext) takeCallback(entry *eraftpb.Entry) *message.Callback {
+	for len(ctx.proposals) > 0 {
+		p := ctx.proposals[0]
+		if p.index > entry.Index {
+			return nil
+		}
+		ctx.proposals = ctx.proposals[1:]
+		if p.index == entry.Index && p.term == entry.Term {
+			return p.cb
+		}
+		NotifyStaleReq(entry.Term, p.cb)
+	}
+	return nil
+}
+
+func (ctx *applyContext) respond(cb *message.Callback, resp *raft_cmdpb.RaftCmdResponse, snap bool) {
+	if cb == nil {
+		return
+	}
+	util.BindRespTerm(resp, ctx.term)
+	ctx.pending = append(ctx.pending, pendingResp{cb: cb, resp: resp, snap: snap})
+}
+
+func (ctx *applyContext) applyEntry(entry *eraftpb.Entry) {
+	cb := ctx.takeCallback(entry)
+	switch entry.EntryType {
+	case eraftpb.EntryType_EntryNormal:
+		ctx.applyNormalEntry(entry, cb)
+	case eraftpb.EntryType_EntryConfChange:
+		ctx.applyConfChangeEntry(entry, cb)
+	}
+	ctx.applyState.AppliedIndex = entry.Index
+}
+
+func (ctx *applyContext) applyNormalEntry(entry *eraftpb.Entry, cb *message.Callback) {
+	if len(entry.Data) == 0 {
+		// The empty entry proposed by a new leader.
+		return
+	}
+	req := new(raft_cmdpb.RaftCmdRequest)
+	if err := req.Unmarshal(entry.Data); err != nil {
+		log.Panic("failed to unmarshal raft command", zap.Error(err))
+	}
+	if err := util.CheckRegionEpoch(req, ctx.region, true); err != nil {
+		ctx.respond(cb, util.ErrResp(err), false)
+		return
+	}
+	if req.AdminRequest != nil {
+		adminResp, err := ctx.execAdminCmd(req.AdminRequest)
+		if err != nil {
+			ctx.respond(cb, util.ErrResp(err), false)
+			return
+		}
+		resp := util.NewRaftCmdResponse()
+		resp.AdminResponse = adminResp
+		ctx.respond(cb, resp, false)
+		return
+	}
+	if hasWrites(req) {
+		// Set before the writes are executed, since a read in the same command flushes them.
+		ctx.applyState.DataVersion = entry.Index
+	}
+	resp, snap, err := ctx.execWriteAndRead(req)
+	if err != nil {
+		ctx.respond(cb, util.ErrResp(err), false)
+		return
+	}
+	ctx.respond(cb, resp, snap)
+}
+
+func hasWrites(req *raft_cmdpb.RaftCmdRequest) bool {
+	for _, r := range req.Requests {
+		if r.CmdType == raft_cmdpb.CmdType_Put || r.CmdType == raft_cmdpb.CmdType_Delete {
+			return true
+		}
+	}
+	return false
+}
+
+// execWriteAndRead executes the normal requests of a command. Reads go to the kv engine, so
+// pending writes are flushed first to make them visible.
+func (ctx *applyContext) execWriteAndRead(req *raft_cmdpb.RaftCmdRequest) (*raft_cmdpb.RaftCmdResponse, bool, error) {
+	for _, r := range req.Requests {
+		var key []byte
+		switch r.CmdType {
+		case raft_cmdpb.CmdType_Get:
+			key = r.Get.Key
+		case raft_cmdpb.CmdType_Put:
+			key = r.Put.Key
+		case raft_cmdpb.CmdType_Delete:
+			key = r.Delete.Key
+		}
+		if key != nil {
+			if err := util.CheckKeyInRegion(key, ctx.region); err != nil {
+				return nil, false, err
+			}
+		}
+	}
+
+	resp := util.NewRaftCmdResponse()
+	snap := false
+	for _, r := range req.Requests {
+		switch r.CmdType {
+		case raft_cmdpb.CmdType_Put:
+			ctx.kvWB.SetCF(r.Put.Cf, r.Put.Key, r.Put.Value)
+			ctx.sizeDiffHint += uint64(len(r.Put.Key) + len(r.Put.Value))
+			resp.Responses = append(resp.Responses, &raft_cmdpb.Response{
+				CmdType: raft_cmdpb.CmdType_Put, Put: &raft_cmdpb.PutResponse{}})
+		case raft_cmdpb.CmdType_Delete:
+			ctx.kvWB.DeleteCF(r.Delete.Cf, r.Delete.Key)
+			ctx.sizeDiffHint += uint64(len(r.Delete.Key))
+			resp.Responses = append(resp.Responses, &raft_cmdpb.Response{
+				CmdType: raft_cmdpb.CmdType_Delete, Delete: &raft_cmdpb.DeleteResponse{}})
+		case raft_cmdpb.CmdType_Get:
+			ctx.flush()
+			val, err := engine_util.GetCF(ctx.engines.Kv, r.Get.Cf, r.Get.Key)
+			if err != nil && err != badger.ErrKeyNotFound {
+				return nil, false, err
+			}
+			resp.Responses = append(resp.Responses, &raft_cmdpb.Response{
+				CmdType: raft_cmdpb.CmdType_Get, Get: &raft_cmdpb.GetResponse{Value: val}})
+		case raft_cmdpb.CmdType_Snap:
+			region := new(metapb.Region)
+			if err := util.CloneMsg(ctx.region, region); err != nil {
+				return nil, false, err
+			}
+			resp.Responses = append(resp.Responses, &raft_cmdpb.Response{
+				CmdType: raft_cmdpb.CmdType_Snap, Snap: &raft_cmdpb.SnapResponse{Region: region}})
+			snap = true
+		}
+	}
+	return resp, snap, nil
+}
+
+func (ctx *applyContext) execAdminCmd(req *raft_cmdpb.AdminRequest) (*raft_cmdpb.AdminResponse, error) {
+	switch req.CmdType {
+	case raft_cmdpb.AdminCmdType_Split:
+		return ctx.execSplit(req.Split)
+	case raft_cmdpb.AdminCmdType_CompactLog:
+		return ctx.execCompactLog(req.CompactLog)
+	case raft_cmdpb.AdminCmdType_ResolvedTs:
+		ctx.execResults = append(ctx.execResults, &execResultResolvedTs{resolvedTs: req.ResolvedTs.ResolvedTs})
+		return &raft_cmdpb.AdminResponse{
+			CmdType:    raft_cmdpb.AdminCmdType_ResolvedTs,
+			ResolvedTs: &raft_cmdpb.ResolvedTsResponse{},
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported admin command %v", req.CmdType)
+	}
+}
+
+// execSplit splits the region at the split key. The origin region keeps the left part and the
+// new region takes the right part, both get a new version so stale requests are rejected.
+func (ctx *applyContext) execSplit(split *raft_cmdpb.SplitRequest) (*raft_cmdpb.AdminResponse, error) {
+	if err := util.CheckKeyInRegionExclusive(split.SplitKey, ctx.region); err != nil {
+		return nil, err
+	}
+	if len(split.NewPeerIds) != len(ctx.region.Peers) {
+		return nil, errors.Errorf("invalid new peer id count, need %d, but got %d",
+			len(ctx.region.Peers), len(split.NewPeerIds))
+	}
+	derived := new(metapb.Region)
+	if err := util.CloneMsg(ctx.region, derived); err != nil {
+		log.Panic("failed to clone region", zap.Error(err))
+	}
+	derived.RegionEpoch.Version++
+	newRegion := &metapb.Region{
+		Id:       split.NewRegionId,
+		StartKey: split.SplitKey,
+		EndKey:   derived.EndKey,
+		RegionEpoch: &metapb.RegionEpoch{
+			ConfVer: derived.RegionEpoch.ConfVer,
+			Version: derived.RegionEpoch.Version,
+		},
+	}
+	for i, peer := range derived.Peers {
+		newRegion.Peers = append(newRegion.Peers, &metapb.Peer{Id: split.NewPeerIds[i], StoreId: peer.StoreId})
+	}
+	derived.EndKey = split.SplitKey
+	log.Info(fmt.Sprintf("%s split region %v into %v and %v", ctx.tag, ctx.region, derived, newRegion))
+
+	meta.WriteRegionState(ctx.kvWB, derived, rspb.PeerState_Normal)
+	meta.WriteRegionState(ctx.kvWB, newRegion, rspb.PeerState_Normal)
+	ctx.region = derived
+
+	regions := []*metapb.Region{derived, newRegion}
+	ctx.execResults = append(ctx.execResults, &execResultSplitRegion{
+		regions: regions,
+		derived: derived,
+	})
+	return &raft_cmdpb.AdminResponse{
+		CmdType: raft_cmdpb.AdminCmdType_Split,
+		Split:   &raft_cmdpb.SplitResponse{Regions: regions},
+	}, nil
+}
+
+// execCompactLog moves the truncated state forward, the raft worker deletes the compacted entries
+// from the raft engine once the new apply state is persisted.
+func (ctx *applyContext) execCompactLog(req *raft_cmdpb.CompactLogRequest) (*raft_cmdpb.AdminResponse, error) {
+	resp := &raft_cmdpb.AdminResponse{
+		CmdType:    raft_cmdpb.AdminCmdType_CompactLog,
+		CompactLog: &raft_cmdpb.CompactLogResponse{},
+	}
+	truncatedState := ctx.applyState.TruncatedState
+	if req.CompactIndex <= truncatedState.Index {
+		// The log has been compacted further by a snapshot or a former compaction.
+		return resp, nil
+	}
+	if req.CompactIndex > ctx.applyState.AppliedIndex {
+		return nil, errors.Errorf("compact index %d is greater than applied index %d",
+			req.CompactIndex, ctx.applyState.AppliedIndex)
+	}
+	truncatedState.Index = req.CompactIndex
+	truncatedState.Term = req.CompactTerm
+	ctx.execResults = append(ctx.execResults, &execResultCompactLog{truncatedIndex: req.CompactIndex})
+	return resp, nil
+}
+
+func (ctx *applyContext) applyConfChangeEntry(entry *eraftpb.Entry, cb *message.Callback) {
+	cc := new(eraftpb.ConfChange)
+	if err := cc.Unmarshal(entry.Data); err != nil {
+		log.Panic("failed to unmarshal conf change", zap.Error(err))
+	}
+	req := new(raft_cmdpb.RaftCmdRequest)
+	if err := req.Unmarshal(cc.Context); err != nil {
+		log.Panic("failed to unmarshal conf change context", zap.Error(err))
+	}
+	if err := util.CheckRegionEpoch(req, ctx.region, true); err != nil {
+		ctx.respond(cb, util.ErrResp(err), false)
+		return
+	}
+
+	changePeer := req.AdminRequest.ChangePeer
+	peer := changePeer.Peer
+	region := new(metapb.Region)
+	if err := util.CloneMsg(ctx.region, region); err != nil {
+		log.Panic("failed to clone region", zap.Error(err))
+	}
+	switch cc.ChangeType {
+	case eraftpb.ConfChangeType_AddNode:
+		if p := util.FindPeer(region, peer.StoreId); p != nil {
+			if p.Id != peer.Id || !p.IsLearner {
+				log.Warn(fmt.Sprintf("%s can't add duplicated peer %v", ctx.tag, peer))
+				cc.NodeId = 0
+				break
+			}
+			// Promote the learner to a voter.
+			p.IsLearner = false
+			region.RegionEpoch.ConfVer++
+			break
+		}
+		region.Peers = append(region.Peers, peer)
+		region.RegionEpoch.ConfVer++
+	case eraftpb.ConfChangeType_AddLearnerNode:
+		if util.FindPeer(region, peer.StoreId) != nil {
+			log.Warn(fmt.Sprintf("%s can't add duplicated learner %v", ctx.tag, peer))
+			cc.NodeId = 0
+			break
+		}
+		peer.IsLearner = true
+		region.Peers = append(region.Peers, peer)
+		region.RegionEpoch.ConfVer++
+	case eraftpb.ConfChangeType_RemoveNode:
+		if p := util.FindPeer(region, peer.StoreId); p == nil || p.Id != peer.Id {
+			log.Warn(fmt.Sprintf("%s can't remove peer %v which is not in the region", ctx.tag, peer))
+			cc.NodeId = 0
+			break
+		}
+		util.RemovePeer(region, peer.StoreId)
+		region.RegionEpoch.ConfVer++
+	}
+	log.Info(fmt.Sprintf("%s applied conf change %v %v, region %v", ctx.tag, cc.ChangeType, peer, region))
+
+	state := rspb.PeerState_Normal
+	if cc.ChangeType == eraftpb.ConfChangeType_RemoveNode && peer.StoreId == ctx.storeID && cc.NodeId != 0 {
+		// The peer itself is removed, no more entry of this region should be applied.
+		ctx.removed = true
+		state = rspb.PeerState_Tombstone
+	}
+	meta.WriteRegionState(ctx.kvWB, region, state)
+	ctx.region = region
+
+	ctx.execResults = append(ctx.execResults, &execResultChangePeer{
+		confChange: cc,
+		peer:       peer,
+		region:     region,
+	})
+	resp := util.NewRaftCmdResponse()
+	resp.AdminResponse = &raft_cmdpb.AdminResponse{
+		CmdType:    raft_cmdpb.AdminCmdType_ChangePeer,
+		ChangePeer: &raft_cmdpb.ChangePeerResponse{Region: region},
+	}
+	ctx.respond(cb, resp, false)
+}
+
+// splitCFKey splits a key of RaftSnapshotData into its column family and the user key.
+func splitCFKey(cfKey []byte) (string, []byte) {
+	for _, cf := range engine_util.CFs {
+		prefix := cf + "_"
+		if bytes.HasPrefix(cfKey, []byte(prefix)) {
+			return cf, cfKey[len(prefix):]
+		}
+	}
+	log.Panic("unknown column family in snapshot key", zap.Binary("key", cfKey))
+	return "", nil
+}
@@ -0,0 +1,60 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDataVersion tests that the data version of a region is moved by the entries which write data only.
+func TestDataVersion(t *testing.T) {
+	engines := newTestEngines(t)
+	defer engines.Destroy()
+	region, err := PrepareBootstrap(engines, 1, 1, 1)
+	require.Nil(t, err)
+	applyState, err := meta.GetApplyState(engines.Kv, region.Id)
+	require.Nil(t, err)
+	require.Equal(t, uint64(meta.RaftInitLogIndex), applyState.DataVersion)
+
+	ctx := &applyContext{
+		applier: &applier{region: region, applyState: applyState},
+		engines: engines,
+		kvWB:    new(engine_util.WriteBatch),
+	}
+	apply := func(index uint64, req *raft_cmdpb.RaftCmdRequest) uint64 {
+		req.Header = &raft_cmdpb.RaftRequestHeader{RegionId: region.Id, RegionEpoch: region.RegionEpoch}
+		data, err := req.Marshal()
+		require.Nil(t, err)
+		ctx.applyEntry(&eraftpb.Entry{EntryType: eraftpb.EntryType_EntryNormal, Index: index, Term: 1, Data: data})
+		ctx.flush()
+		applyState, err := meta.GetApplyState(engines.Kv, region.Id)
+		require.Nil(t, err)
+		require.Equal(t, index, applyState.AppliedIndex)
+		return applyState.DataVersion
+	}
+
+	put := &raft_cmdpb.RaftCmdRequest{Requests: []*raft_cmdpb.Request{{
+		CmdType: raft_cmdpb.CmdType_Put,
+		Put:     &raft_cmdpb.PutRequest{Cf: engine_util.CfDefault, Key: []byte("a"), Value: []byte("1")},
+	}}}
+	require.Equal(t, uint64(6), apply(6, put))
+	resolvedTs := &raft_cmdpb.RaftCmdRequest{AdminRequest: &raft_cmdpb.AdminRequest{
+		CmdType:    raft_cmdpb.AdminCmdType_ResolvedTs,
+		ResolvedTs: &raft_cmdpb.ResolvedTsRequest{ResolvedTs: 10},
+	}}
+	require.Equal(t, uint64(6), apply(7, resolvedTs))
+	snap := &raft_cmdpb.RaftCmdRequest{Requests: []*raft_cmdpb.Request{{
+		CmdType: raft_cmdpb.CmdType_Snap,
+		Snap:    &raft_cmdpb.SnapRequest{},
+	}}}
+	require.Equal(t, uint64(6), apply(8, snap))
+	del := &raft_cmdpb.RaftCmdRequest{Requests: []*raft_cmdpb.Request{{
+		CmdType: raft_cmdpb.CmdType_Delete,
+		Delete:  &raft_cmdpb.DeleteRequest{Cf: engine_util.CfDefault, Key: []byte("a")},
+	}}}
+	require.Equal(t, uint64(9), apply(9, del))
+}
@@ -0,0 +1,158 @@
+package raftstore
+
+import (
+	"bytes"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap/errors"
+)
+
+const (
+	InitEpochVer     uint64 = 1
+	InitEpochConfVer uint64 = 1
+)
+
+func isRangeEmpty(engine *badger.DB, startKey, endKey []byte) (bool, error) {
+	var hasData bool
+	err := engine.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		it.Seek(startKey)
+		if it.Valid() {
+			item := it.Item()
+			if bytes.Compare(item.Key(), endKey) < 0 {
+				hasData = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return !hasData, err
+}
+
+// BootstrapStore writes the store ident into an empty store.
+func BootstrapStore(engines *engine_util.Engines, clusterID, storeID uint64) error {
+	ident := new(rspb.StoreIdent)
+	empty, err := isRangeEmpty(engines.Kv, meta.MinKey, meta.MaxKey)
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return errors.New("kv store is not empty and has already had data.")
+	}
+	empty, err = isRangeEmpty(engines.Raft, meta.MinKey, meta.MaxKey)
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return errors.New("raft store is not empty and has already had data.")
+	}
+	ident.ClusterId = clusterID
+	ident.StoreId = storeID
+	return engine_util.PutMeta(engines.Kv, meta.StoreIdentKey, ident)
+}
+
+// PrepareBootstrap writes the first region of the cluster, which covers the whole key space and
+// has a single peer on this store.
+func PrepareBootstrap(engines *engine_util.Engines, storeID, regionID, peerID uint64) (*metapb.Region, error) {
+	region := &metapb.Region{
+		Id:       regionID,
+		StartKey: []byte{},
+		EndKey:   []byte{},
+		RegionEpoch: &metapb.RegionEpoch{
+			Version: InitEpochVer,
+			ConfVer: InitEpochConfVer,
+		},
+		Peers: []*metapb.Peer{
+			{
+				Id:      peerID,
+				StoreId: storeID,
+			},
+		},
+	}
+	err := PrepareBootstrapCluster(engines, region)
+	if err != nil {
+		return nil, err
+	}
+	return region, nil
+}
+
+func PrepareBootstrapCluster(engines *engine_util.Engines, region *metapb.Region) error {
+	state := new(rspb.RegionLocalState)
+	state.Region = region
+	kvWB := new(engine_util.WriteBatch)
+	if err := kvWB.SetMeta(meta.PrepareBootstrapKey, state); err != nil {
+		return err
+	}
+	if err := kvWB.SetMeta(meta.RegionStateKey(region.Id), state); err != nil {
+		return err
+	}
+	if err := writeInitialApplyState(kvWB, region.Id); err != nil {
+		return err
+	}
+	if err := engines.WriteKV(kvWB); err != nil {
+		return err
+	}
+	raftWB := new(engine_util.WriteBatch)
+	if err := writeInitialRaftState(raftWB, region.Id); err != nil {
+		return err
+	}
+	return engines.WriteRaft(raftWB)
+}
+
+func writeInitialApplyState(kvWB *engine_util.WriteBatch, regionID uint64) error {
+	applyState := &rspb.RaftApplyState{
+		AppliedIndex: meta.RaftInitLogIndex,
+		TruncatedState: &rspb.RaftTruncatedState{
+			Index: meta.RaftInitLogIndex,
+			Term:  meta.RaftInitLogTerm,
+		},
+		DataVersion: meta.RaftInitLogIndex,
+	}
+	return kvWB.SetMeta(meta.ApplyStateKey(regionID), applyState)
+}
+
+func writeInitialRaftState(raftWB *engine_util.WriteBatch, regionID uint64) error {
+	raftState := &rspb.RaftLocalState{
+		HardState: &eraftpb.HardState{
+			Term:   meta.RaftInitLogTerm,
+			Commit: meta.RaftInitLogIndex,
+		},
+		LastIndex: meta.RaftInitLogIndex,
+		LastTerm:  meta.RaftInitLogTerm,
+	}
+	return raftWB.SetMeta(meta.RaftStateKey(regionID), raftState)
+}
+
+// ClearPrepareBootstrap removes the first region prepared by PrepareBootstrap, it is used when
+// another store has bootstrapped the cluster first.
+func ClearPrepareBootstrap(engines *engine_util.Engines, regionID uint64) error {
+	err := engines.Raft.Update(func(txn *badger.Txn) error {
+		return txn.Delete(meta.RaftStateKey(regionID))
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	wb := new(engine_util.WriteBatch)
+	wb.DeleteMeta(meta.PrepareBootstrapKey)
+	// should clear raft initial state too.
+	wb.DeleteMeta(meta.RegionStateKey(regionID))
+	wb.DeleteMeta(meta.ApplyStateKey(regionID))
+	return engines.WriteKV(wb)
+}
+
+// ClearPrepareBootstrapState removes the bootstrap mark once the cluster is bootstrapped, the
+// first region is kept.
+func ClearPrepareBootstrapState(engines *engine_util.Engines) error {
+	err := engines.Kv.Update(func(txn *badger.Txn) error {
+		return txn.Delete(meta.PrepareBootstrapKey)
+	})
+	return errors.WithStack(err)
+}
@@ -0,0 +1,107 @@
+package meta
+
+import (
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap/errors"
+)
+
+// The initial log index and term of a region which is created by bootstrap or split. The log entries
+// before RaftInitLogIndex are treated as already truncated.
+const (
+	RaftInitLogTerm  = 5
+	RaftInitLogIndex = 5
+)
+
+func GetRegionLocalState(db *badger.DB, regionId uint64) (*rspb.RegionLocalState, error) {
+	regionLocalState := new(rspb.RegionLocalState)
+	if err := engine_util.GetMeta(db, RegionStateKey(regionId), regionLocalState); err != nil {
+		return regionLocalState, err
+	}
+	return regionLocalState, nil
+}
+
+func GetRaftLocalState(db *badger.DB, regionId uint64) (*rspb.RaftLocalState, error) {
+	raftLocalState := new(rspb.RaftLocalState)
+	if err := engine_util.GetMeta(db, RaftStateKey(regionId), raftLocalState); err != nil {
+		return raftLocalState, err
+	}
+	return raftLocalState, nil
+}
+
+func GetApplyState(db *badger.DB, regionId uint64) (*rspb.RaftApplyState, error) {
+	applyState := new(rspb.RaftApplyState)
+	if err := engine_util.GetMeta(db, ApplyStateKey(regionId), applyState); err != nil {
+		return nil, err
+	}
+	return applyState, nil
+}
+
+func GetRaftEntry(db *badger.DB, regionId, idx uint64) (*eraftpb.Entry, error) {
+	entry := new(eraftpb.Entry)
+	if err := engine_util.GetMeta(db, RaftLogKey(regionId, idx), entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// InitRaftLocalState loads the raft local state of the region from the raft engine, or builds
+// the initial one if the region has never been persisted on this store.
+func InitRaftLocalState(raftEngine *badger.DB, region *metapb.Region) (*rspb.RaftLocalState, error) {
+	raftState, err := GetRaftLocalState(raftEngine, region.Id)
+	if err != nil && err != badger.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == badger.ErrKeyNotFound {
+		raftState = new(rspb.RaftLocalState)
+		raftState.HardState = new(eraftpb.HardState)
+		if len(region.Peers) > 0 {
+			// new split region
+			raftState.LastIndex = RaftInitLogIndex
+			raftState.LastTerm = RaftInitLogTerm
+			raftState.HardState.Term = RaftInitLogTerm
+			raftState.HardState.Commit = RaftInitLogIndex
+			err = engine_util.PutMeta(raftEngine, RaftStateKey(region.Id), raftState)
+			if err != nil {
+				return raftState, err
+			}
+		}
+	}
+	return raftState, nil
+}
+
+// InitApplyState loads the apply state of the region from the kv engine, or builds the initial
+// one if the region has never been persisted on this store.
+func InitApplyState(kvEngine *badger.DB, region *metapb.Region) (*rspb.RaftApplyState, error) {
+	applyState, err := GetApplyState(kvEngine, region.Id)
+	if err != nil && err != badger.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == badger.ErrKeyNotFound {
+		applyState = new(rspb.RaftApplyState)
+		applyState.TruncatedState = new(rspb.RaftTruncatedState)
+		if len(region.Peers) > 0 {
+			applyState.AppliedIndex = RaftInitLogIndex
+			applyState.TruncatedState.Index = RaftInitLogIndex
+			applyState.TruncatedState.Term = RaftInitLogTerm
+			applyState.DataVersion = RaftInitLogIndex
+		}
+		err = engine_util.PutMeta(kvEngine, ApplyStateKey(region.Id), applyState)
+		if err != nil {
+			return applyState, err
+		}
+	}
+	return applyState, nil
+}
+
+func WriteRegionState(kvWB *engine_util.WriteBatch, region *metapb.Region, state rspb.PeerState) {
+	regionState := new(rspb.RegionLocalState)
+	regionState.State = state
+	regionState.Region = region
+	if err := kvWB.SetMeta(RegionStateKey(region.Id), regionState); err != nil {
+		panic(errors.ErrorStack(err))
+	}
+}
@@ -0,0 +1,426 @@
+package raftstore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/runner"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/raft"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+func NotifyStaleReq(term uint64, cb *message.Callback) {
+	cb.Done(util.ErrRespStaleCommand(term))
+}
+
+func NotifyReqRegionRemoved(regionId uint64, cb *message.Callback) {
+	regionNotFound := &util.ErrRegionNotFound{RegionId: regionId}
+	resp := util.ErrResp(regionNotFound)
+	cb.Done(resp)
+}
+
+// If we create the peer actively, like bootstrap/split/merge region, we should
+// use this function to create the peer. The region must contain the peer info
+// for this store.
+func createPeer(storeID uint64, cfg *config.Config, applySender, snapSender chan<- worker.Task,
+	engines *engine_util.Engines, region *metapb.Region) (*peer, error) {
+	metaPeer := util.FindPeer(region, storeID)
+	if metaPeer == nil {
+		return nil, errors.Errorf("find no peer for store %d in region %v", storeID, region)
+	}
+	log.Info(fmt.Sprintf("region %v create peer with ID %d", region, metaPeer.Id))
+	return NewPeer(storeID, cfg, engines, region, applySender, snapSender, metaPeer)
+}
+
+// The peer can be created from another node with raft membership changes, and we only
+// know the region_id and peer_id when creating this replicated peer, the region info
+// will be retrieved later after applying snapshot.
+func replicatePeer(storeID uint64, cfg *config.Config, applySender, snapSender chan<- worker.Task,
+	engines *engine_util.Engines, regionID uint64, metaPeer *metapb.Peer) (*peer, error) {
+	// We will remove tombstone key when apply snapshot
+	log.Info(fmt.Sprintf("[region %v] replicates peer with ID %d", regionID, metaPeer.GetId()))
+	region := &metapb.Region{
+		Id:          regionID,
+		RegionEpoch: &metapb.RegionEpoch{},
+	}
+	return NewPeer(storeID, cfg, engines, region, applySender, snapSender, metaPeer)
+}
+
+// proposal is a raft command waiting to be applied, it is answered by the apply worker once
+// the entry at index is applied, or as stale if the entry has been replaced by another term.
+type proposal struct {
+	// index + term for unique identification
+	index uint64
+	term  uint64
+	cb    *message.Callback
+}
+
+// readIndexRequest is a read only command served without going through the raft log, it is
+// answered once the read index confirmed by raft has been applied.
+type readIndexRequest struct {
+	// id is the context of the read index request
+	id   uint64
+	term uint64
+	cmd  *raft_cmdpb.RaftCmdRequest
+	cb   *message.Callback
+	// readIndex is 0 until raft returns the read state of the request
+	readIndex uint64
+	// replica is set if the read is served by a follower, the leader confirms the read index then
+	replica bool
+	// expireTick is the tick of the peer after which the read is given up if it's still not confirmed
+	expireTick int64
+}
+
+type peer struct {
+	// The ticker of the peer, used to trigger
+	// * raft tick
+	// * raft log gc
+	// * region heartbeat
+	// * split check
+	ticker *ticker
+	// Instance of the Raft module
+	RaftGroup *raft.RawNode
+	// The peer storage for the Raft module
+	peerStorage *PeerStorage
+
+	// Record the meta information of the peer
+	Meta     *metapb.Peer
+	regionId uint64
+	// Tag which is useful for printing log
+	Tag string
+
+	// Record the callback of the proposals
+	proposals []*proposal
+	// The read only commands waiting for their read index
+	pendingReads []*readIndexRequest
+	// The id of the next read index request
+	nextReadID uint64
+	// All the transactions committed at or below safeTs have been applied, it's the largest resolved ts
+	// applied, stale reads at or below it are served by any peer
+	safeTs uint64
+	// The largest resolved ts proposed by the peer as leader
+	proposedResolvedTs uint64
+
+	// Cache the peers information from other stores
+	// when sending raft messages to other peers, it's used to get the store id of target peer
+	peerCache map[uint64]*metapb.Peer
+	// Record the instants of peers being added into the configuration.
+	// Remove them after they are not pending any more.
+	PeersStartPendingTime map[uint64]time.Time
+	// Mark the peer as stopped, set when peer is destroyed
+	stopped bool
+
+	// An inaccurate difference in region size since last reset.
+	// split checker is triggered when it exceeds the threshold, it makes split checker not scan the data very often
+	SizeDiffHint uint64
+	// Approximate size of the region.
+	// It's updated everytime the split checker scan the data
+	ApproximateSize *uint64
+
+	// Index of the first raft log entry not deleted yet, 0 if unknown.
+	LastCompactedIdx uint64
+
+	// The apply worker the committed entries of this peer are sent to
+	applySender chan<- worker.Task
+}
+
+func NewPeer(storeId uint64, cfg *config.Config, engines *engine_util.Engines, region *metapb.Region,
+	applySender, snapSender chan<- worker.Task, meta *metapb.Peer) (*peer, error) {
+	if meta.GetId() == util.InvalidID {
+		return nil, fmt.Errorf("invalid peer id")
+	}
+	tag := fmt.Sprintf("[region %v] %v", region.GetId(), meta.GetId())
+
+	ps, err := NewPeerStorage(engines, region, snapSender, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	appliedIndex := ps.AppliedIndex()
+
+	raftCfg := &raft.Config{
+		ID:              meta.GetId(),
+		ElectionTick:    cfg.RaftElectionTimeoutTicks,
+		HeartbeatTick:   cfg.RaftHeartbeatTicks,
+		Applied:         appliedIndex,
+		Storage:         ps,
+		MaxSizePerMsg:   cfg.RaftMaxSizePerMsg,
+		MaxInflightMsgs: cfg.RaftMaxInflightMsgs,
+		PreVote:         true,
+		CheckQuorum:     true,
+	}
+	if cfg.RaftLeaseRead {
+		raftCfg.ReadOnlyOption = raft.ReadOnlyLeaseBased
+	}
+
+	raftGroup, err := raft.NewRawNode(raftCfg)
+	if err != nil {
+		return nil, err
+	}
+	p := &peer{
+		Meta:                  meta,
+		regionId:              region.GetId(),
+		RaftGroup:             raftGroup,
+		peerStorage:           ps,
+		peerCache:             make(map[uint64]*metapb.Peer),
+		PeersStartPendingTime: make(map[uint64]time.Time),
+		Tag:                   tag,
+		ticker:                newTicker(region.GetId(), cfg),
+		applySender:           applySender,
+	}
+
+	// If this region has only one peer and I am the one, campaign directly.
+	if len(region.GetPeers()) == 1 && region.GetPeers()[0].GetStoreId() == storeId {
+		err = p.RaftGroup.Campaign()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+func (p *peer) insertPeerCache(peer *metapb.Peer) {
+	p.peerCache[peer.GetId()] = peer
+}
+
+func (p *peer) removePeerCache(peerID uint64) {
+	delete(p.peerCache, peerID)
+}
+
+func (p *peer) getPeerFromCache(peerID uint64) *metapb.Peer {
+	if peer, ok := p.peerCache[peerID]; ok {
+		return peer
+	}
+	for _, peer := range p.peerStorage.Region().GetPeers() {
+		if peer.GetId() == peerID {
+			p.insertPeerCache(peer)
+			return peer
+		}
+	}
+	return nil
+}
+
+func (p *peer) nextProposalIndex() uint64 {
+	return p.RaftGroup.Raft.RaftLog.LastIndex() + 1
+}
+
+// registerApplier tells the apply worker about the region and the apply state it starts from.
+// It must be called before any committed entry of the peer is sent to the apply worker.
+func (p *peer) registerApplier() {
+	p.applySender <- &registerTask{
+		regionID:   p.regionId,
+		region:     p.Region(),
+		applyState: cloneApplyState(p.peerStorage.applyState),
+	}
+}
+
+// MaybeDestroy check whether we need to destroy the peer, returns false if the peer is already stopped.
+func (p *peer) MaybeDestroy() bool {
+	if p.stopped {
+		log.Info(fmt.Sprintf("%v is being destroyed, skip", p.Tag))
+		return false
+	}
+	return true
+}
+
+// Destroy does the real destroy task which includes:
+// 1. Set the region to tombstone;
+// 2. Clear data;
+// 3. Notify all pending requests.
+func (p *peer) Destroy(engine *engine_util.Engines, keepData bool) error {
+	start := time.Now()
+	region := p.Region()
+	log.Info(fmt.Sprintf("%v begin to destroy", p.Tag))
+
+	// The raft engine belongs to the raft worker, clear the raft log and the raft state here.
+	raftWB := new(engine_util.WriteBatch)
+	if err := p.peerStorage.clearRaftMeta(raftWB); err != nil {
+		return err
+	}
+	if err := raftWB.WriteToDB(engine.Raft); err != nil {
+		return err
+	}
+
+	// The kv engine belongs to the apply worker. It sets the tombstone state, drops the apply
+	// state and clears the data once everything sent to it before has been applied.
+	p.applySender <- &destroyTask{regionID: region.GetId(), region: region, keepData: keepData}
+
+	for _, proposal := range p.proposals {
+		NotifyReqRegionRemoved(region.Id, proposal.cb)
+	}
+	p.proposals = nil
+	for _, read := range p.pendingReads {
+		NotifyReqRegionRemoved(region.Id, read.cb)
+	}
+	p.pendingReads = nil
+
+	log.Info(fmt.Sprintf("%v destroy itself, takes %v", p.Tag, time.Now().Sub(start)))
+	return nil
+}
+
+func (p *peer) isInitialized() bool {
+	return p.peerStorage.isInitialized()
+}
+
+func (p *peer) storeID() uint64 {
+	return p.Meta.StoreId
+}
+
+func (p *peer) Region() *metapb.Region {
+	return p.peerStorage.Region()
+}
+
+// Set the region of a peer.
+//
+// This will update the region of the peer, caller must ensure the region
+// has been preserved in a durable device.
+func (p *peer) SetRegion(region *metapb.Region) {
+	p.peerStorage.SetRegion(region)
+}
+
+func (p *peer) PeerId() uint64 {
+	return p.Meta.GetId()
+}
+
+func (p *peer) LeaderId() uint64 {
+	return p.RaftGroup.Raft.Lead
+}
+
+func (p *peer) IsLeader() bool {
+	return p.RaftGroup.Raft.State == raft.StateLeader
+}
+
+func (p *peer) Send(trans Transport, msgs []eraftpb.Message) {
+	for _, msg := range msgs {
+		err := p.sendRaftMessage(msg, trans)
+		if err != nil {
+			log.Debug(fmt.Sprintf("%v send message err: %v", p.Tag, err))
+			if msg.MsgType == eraftpb.MessageType_MsgSnapshot {
+				p.RaftGroup.ReportSnapshot(msg.To, raft.SnapshotFailure)
+			}
+			p.RaftGroup.ReportUnreachable(msg.To)
+		}
+	}
+}
+
+// Collects all pending peers and update `peers_start_pending_time`.
+func (p *peer) CollectPendingPeers() []*metapb.Peer {
+	pendingPeers := make([]*metapb.Peer, 0, len(p.Region().GetPeers()))
+	truncatedIdx := p.peerStorage.truncatedIndex()
+	for id, progress := range p.RaftGroup.GetProgress() {
+		if id == p.Meta.GetId() {
+			continue
+		}
+		if progress.Match < truncatedIdx {
+			if peer := p.getPeerFromCache(id); peer != nil {
+				pendingPeers = append(pendingPeers, peer)
+				if _, ok := p.PeersStartPendingTime[id]; !ok {
+					now := time.Now()
+					p.PeersStartPendingTime[id] = now
+					log.Debug(fmt.Sprintf("%v peer %v start pending at %v", p.Tag, id, now))
+				}
+			}
+		}
+	}
+	return pendingPeers
+}
+
+func (p *peer) clearPeersStartPendingTime() {
+	for id := range p.PeersStartPendingTime {
+		delete(p.PeersStartPendingTime, id)
+	}
+}
+
+// Returns `true` if any new peer catches up with the leader in replicating logs.
+// And updates `PeersStartPendingTime` if needed.
+func (p *peer) AnyNewPeerCatchUp(peerId uint64) bool {
+	if len(p.PeersStartPendingTime) == 0 {
+		return false
+	}
+	if !p.IsLeader() {
+		p.clearPeersStartPendingTime()
+		return false
+	}
+	if startPendingTime, ok := p.PeersStartPendingTime[peerId]; ok {
+		truncatedIdx := p.peerStorage.truncatedIndex()
+		progress, ok := p.RaftGroup.Raft.Prs[peerId]
+		if ok {
+			if progress.Match >= truncatedIdx {
+				delete(p.PeersStartPendingTime, peerId)
+				elapsed := time.Since(startPendingTime)
+				log.Debug(fmt.Sprintf("%v peer %v has caught up logs, elapsed: %v", p.Tag, peerId, elapsed))
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *peer) HeartbeatScheduler(ch chan<- worker.Task) {
+	clonedRegion := new(metapb.Region)
+	err := util.CloneMsg(p.Region(), clonedRegion)
+	if err != nil {
+		return
+	}
+	ch <- &runner.SchedulerRegionHeartbeatTask{
+		Region:          clonedRegion,
+		Peer:            p.Meta,
+		PendingPeers:    p.CollectPendingPeers(),
+		ApproximateSize: p.ApproximateSize,
+	}
+}
+
+func (p *peer) sendRaftMessage(msg eraftpb.Message, trans Transport) error {
+	sendMsg := new(rspb.RaftMessage)
+	sendMsg.RegionId = p.regionId
+	// set current epoch
+	sendMsg.RegionEpoch = &metapb.RegionEpoch{
+		ConfVer: p.Region().RegionEpoch.ConfVer,
+		Version: p.Region().RegionEpoch.Version,
+	}
+
+	fromPeer := *p.Meta
+	toPeer := p.getPeerFromCache(msg.To)
+	if toPeer == nil {
+		return fmt.Errorf("failed to lookup recipient peer %v in region %v", msg.To, p.regionId)
+	}
+	log.Debug(fmt.Sprintf("%v, send raft msg %v from %v to %v", p.Tag, msg.MsgType, fromPeer, toPeer))
+
+	sendMsg.FromPeer = &fromPeer
+	sendMsg.ToPeer = toPeer
+
+	// There could be two cases:
+	// 1. Target peer already exists but has not established communication with leader yet
+	// 2. Target peer is added newly due to member change or region split, but it's not
+	//    created yet
+	// For both cases the region start key and end key are attached in RequestVote and
+	// Heartbeat message for the store of that peer to check whether to create a new peer
+	// when receiving these messages, or just to wait for a pending region split to perform
+	// later.
+	if p.peerStorage.isInitialized() && util.IsInitialMsg(&msg) {
+		sendMsg.StartKey = append([]byte{}, p.Region().StartKey...)
+		sendMsg.EndKey = append([]byte{}, p.Region().EndKey...)
+	}
+	sendMsg.Message = &msg
+	return trans.Send(sendMsg)
+}
+
+func mustMarshal(msg interface{ Marshal() ([]byte, error) }) []byte {
+	data, err := msg.Marshal()
+	if err != nil {
+		log.Panic("marshal failed", zap.Error(err))
+	}
+	return data
+}
@@ -0,0 +1,880 @@
+package raftstore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/Connor1996/badger"
+	"github.com/google/btree"
+
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/runner"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/raft"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+type peerMsgHandler struct {
+	*peer
+	ctx *GlobalContext
+}
+
+func newPeerMsgHandler(peer *peer, ctx *GlobalContext) *peerMsgHandler {
+	return &peerMsgHandler{
+		peer: peer,
+		ctx:  ctx,
+	}
+}
+
+// HandleRaftReady persists the ready of the raft group, sends the raft messages out and hands
+// the committed entries over to the apply worker.
+func (d *peerMsgHandler) HandleRaftReady() {
+	if d.stopped {
+		return
+	}
+	if !d.RaftGroup.HasReady() {
+		return
+	}
+	rd := d.RaftGroup.Ready()
+	if !raft.IsEmptySnap(&rd.Snapshot) {
+		d.applySnapshot(&rd.Snapshot)
+	}
+	if err := d.peerStorage.SaveReadyState(&rd); err != nil {
+		log.Panic(fmt.Sprintf("%s failed to save ready state", d.Tag), zap.Error(err))
+	}
+	d.Send(d.ctx.trans, rd.Messages)
+	if len(rd.CommittedEntries) > 0 {
+		d.sendApplyTask(rd.CommittedEntries)
+	}
+	d.onReadStates(rd.ReadStates)
+	d.RaftGroup.Advance(rd)
+}
+
+// applySnapshot lets the apply worker ingest the data of the snapshot and waits for it, the raft
+// state is only reset to the snapshot once its data is in the kv engine.
+func (d *peerMsgHandler) applySnapshot(snapshot *eraftpb.Snapshot) {
+	done := make(chan *applySnapResult, 1)
+	d.applySender <- &applySnapTask{regionID: d.regionId, snapshot: snapshot, done: done}
+	res := <-done
+	if res.err != nil {
+		log.Panic(fmt.Sprintf("%s failed to apply snapshot", d.Tag), zap.Error(res.err))
+	}
+	prevRegion := d.Region()
+	if err := d.peerStorage.restoreSnapshot(snapshot, res.applyState, res.region); err != nil {
+		log.Panic(fmt.Sprintf("%s failed to restore snapshot", d.Tag), zap.Error(err))
+	}
+	for _, peer := range res.region.Peers {
+		d.insertPeerCache(peer)
+	}
+
+	meta := d.ctx.storeMeta
+	meta.Lock()
+	defer meta.Unlock()
+	if util.RegionInitialized(prevRegion) {
+		meta.regionRanges.Delete(&regionItem{region: prevRegion})
+	}
+	meta.regionRanges.ReplaceOrInsert(&regionItem{region: res.region})
+	meta.regions[d.regionId] = res.region
+}
+
+// sendApplyTask sends the committed entries to the apply worker together with the proposals
+// they may answer.
+func (d *peerMsgHandler) sendApplyTask(entries []eraftpb.Entry) {
+	last := entries[len(entries)-1].Index
+	i := 0
+	for i < len(d.proposals) && d.proposals[i].index <= last {
+		i++
+	}
+	proposals := append([]*proposal(nil), d.proposals[:i]...)
+	d.proposals = d.proposals[i:]
+	d.applySender <- &applyTask{
+		regionID:  d.regionId,
+		term:      d.Term(),
+		entries:   append([]eraftpb.Entry(nil), entries...),
+		proposals: proposals,
+	}
+}
+
+func (d *peerMsgHandler) HandleMsg(msg message.Msg) {
+	switch msg.Type {
+	case message.MsgTypeRaftMessage:
+		raftMsg := msg.Data.(*rspb.RaftMessage)
+		if err := d.onRaftMsg(raftMsg); err != nil {
+			log.Error(fmt.Sprintf("%s handle raft message error %v", d.Tag, err))
+		}
+	case message.MsgTypeRaftCmd:
+		raftCMD := msg.Data.(*message.MsgRaftCmd)
+		d.proposeRaftCommand(raftCMD.Request, raftCMD.Callback)
+	case message.MsgTypeTick:
+		d.onTick()
+	case message.MsgTypeApplyRes:
+		d.onApplyResult(msg.Data.(*applyResult))
+	case message.MsgTypeSplitRegion:
+		split := msg.Data.(*message.MsgSplitRegion)
+		log.Info(fmt.Sprintf("%s on split with %v", d.Tag, split.SplitKey))
+		d.onPrepareSplitRegion(split.RegionEpoch, split.SplitKey, split.Callback)
+	case message.MsgTypeRegionApproximateSize:
+		d.onApproximateRegionSize(msg.Data.(uint64))
+	case message.MsgTypeResolvedTs:
+		d.onResolvedTs(msg.Data.(uint64))
+	case message.MsgTypeStart:
+		d.startTicker()
+	case message.MsgTypeSnapStatus:
+		status := msg.Data.(*message.MsgSnapStatus)
+		d.RaftGroup.ReportSnapshot(status.ToPeerID, status.Status)
+	case message.MsgTypePeerUnreachable:
+		d.RaftGroup.ReportUnreachable(msg.Data.(uint64))
+	}
+}
+
+func (d *peerMsgHandler) Term() uint64 {
+	return d.RaftGroup.Raft.Term
+}
+
+func (d *peerMsgHandler) preProposeRaftCommand(req *raft_cmdpb.RaftCmdRequest) error {
+	// Check store_id, make sure that the msg is dispatched to the right place.
+	if err := util.CheckStoreID(req, d.storeID()); err != nil {
+		return err
+	}
+
+	// Check whether the store has the right peer to handle the request.
+	regionID := d.regionId
+	leaderID := d.LeaderId()
+	if !d.IsLeader() && !isFollowerRead(req) {
+		leader := d.getPeerFromCache(leaderID)
+		return &util.ErrNotLeader{RegionId: regionID, Leader: leader}
+	}
+	// peer_id must be the same as peer's.
+	if err := util.CheckPeerID(req, d.PeerId()); err != nil {
+		return err
+	}
+	// Check whether the term is stale.
+	if err := util.CheckTerm(req, d.Term()); err != nil {
+		return err
+	}
+	if err := util.CheckRegionEpoch(req, d.Region(), true); err != nil {
+		if errEpochNotMatch, ok := err.(*util.ErrEpochNotMatch); ok {
+			// Attach the region split off from this one, so the client can update its region
+			// cache without another round trip.
+			if sibling := d.findSiblingRegion(); sibling != nil {
+				errEpochNotMatch.Regions = append(errEpochNotMatch.Regions, sibling)
+			}
+			return errEpochNotMatch
+		}
+		return err
+	}
+	return nil
+}
+
+// findSiblingRegion returns the region right after this one on the store, if it starts where
+// this one ends.
+func (d *peerMsgHandler) findSiblingRegion() *metapb.Region {
+	endKey := d.Region().EndKey
+	if len(endKey) == 0 {
+		return nil
+	}
+	meta := d.ctx.storeMeta
+	meta.RLock()
+	defer meta.RUnlock()
+	var sibling *metapb.Region
+	meta.regionRanges.AscendGreaterOrEqual(&regionItem{region: &metapb.Region{StartKey: endKey}}, func(i btree.Item) bool {
+		if region := i.(*regionItem).region; bytes.Equal(region.StartKey, endKey) {
+			sibling = region
+		}
+		return false
+	})
+	return sibling
+}
+
+func (d *peerMsgHandler) proposeRaftCommand(msg *raft_cmdpb.RaftCmdRequest, cb *message.Callback) {
+	if err := d.preProposeRaftCommand(msg); err != nil {
+		cb.Done(util.ErrResp(err))
+		return
+	}
+	if d.stopped {
+		NotifyReqRegionRemoved(d.regionId, cb)
+		return
+	}
+
+	if msg.AdminRequest != nil {
+		switch msg.AdminRequest.CmdType {
+		case raft_cmdpb.AdminCmdType_ChangePeer:
+			d.proposeConfChange(msg, cb)
+		case raft_cmdpb.AdminCmdType_Split:
+			if err := util.CheckKeyInRegionExclusive(msg.AdminRequest.Split.SplitKey, d.Region()); err != nil {
+				cb.Done(util.ErrResp(err))
+				return
+			}
+			d.proposeNormal(msg, cb)
+		case raft_cmdpb.AdminCmdType_CompactLog, raft_cmdpb.AdminCmdType_ResolvedTs:
+			d.proposeNormal(msg, cb)
+		case raft_cmdpb.AdminCmdType_TransferLeader:
+			// Transferring leader is not replicated, the leader just steps down.
+			d.RaftGroup.TransferLeader(msg.AdminRequest.TransferLeader.Peer.Id)
+			resp := util.NewRaftCmdResponse()
+			resp.AdminResponse = &raft_cmdpb.AdminResponse{
+				CmdType:        raft_cmdpb.AdminCmdType_TransferLeader,
+				TransferLeader: &raft_cmdpb.TransferLeaderResponse{},
+			}
+			util.BindRespTerm(resp, d.Term())
+			cb.Done(resp)
+		default:
+			cb.Done(util.ErrResp(errors.Errorf("unsupported admin command %v", msg.AdminRequest.CmdType)))
+		}
+		return
+	}
+	if isReadOnly(msg) {
+		switch {
+		case msg.Header.StaleRead && msg.Header.ReadTs <= d.safeTs:
+			// Everything the read may see has been applied.
+			d.execReadOnly(msg, cb)
+		case msg.Header.StaleRead && !d.IsLeader():
+			cb.Done(util.ErrResp(&util.ErrDataIsNotReady{RegionId: d.regionId, PeerId: d.PeerId(), SafeTs: d.safeTs}))
+		default:
+			d.proposeReadIndex(msg, cb)
+		}
+		return
+	}
+	d.proposeNormal(msg, cb)
+}
+
+// isFollowerRead returns true if the command is a read which a follower may serve, see kvrpcpb.Context.
+func isFollowerRead(msg *raft_cmdpb.RaftCmdRequest) bool {
+	return (msg.Header.ReplicaRead || msg.Header.StaleRead) && isReadOnly(msg)
+}
+
+// isReadOnly returns true if the command only reads, it can be served without being appended to
+// the raft log then.
+func isReadOnly(msg *raft_cmdpb.RaftCmdRequest) bool {
+	if len(msg.Requests) == 0 {
+		return false
+	}
+	for _, req := range msg.Requests {
+		if req.CmdType != raft_cmdpb.CmdType_Get && req.CmdType != raft_cmdpb.CmdType_Snap {
+			return false
+		}
+	}
+	return true
+}
+
+// proposeReadIndex asks raft for the read index of a read only command instead of proposing
+// it, the command is served once the read index has been applied. On a follower raft forwards
+// the request to the leader, which confirms the read index for it.
+func (d *peerMsgHandler) proposeReadIndex(msg *raft_cmdpb.RaftCmdRequest, cb *message.Callback) {
+	replica := !d.IsLeader()
+	if !replica && !d.RaftGroup.Raft.CommittedEntryInCurrentTerm() {
+		// Raft drops read index requests until the new leader has committed an entry, which
+		// happens shortly after the election. Go through the log meanwhile.
+		d.proposeNormal(msg, cb)
+		return
+	}
+	d.nextReadID++
+	read := &readIndexRequest{
+		id:      d.nextReadID,
+		term:    d.Term(),
+		cmd:     msg,
+		cb:      cb,
+		replica: replica,
+		// The request or its response may be lost on the way between the follower and the leader.
+		expireTick: d.ticker.tick + 2*int64(d.ctx.cfg.RaftElectionTimeoutTicks),
+	}
+	d.pendingReads = append(d.pendingReads, read)
+	// The read ts is recorded by the leader, see onReplicaReadIndex.
+	ctx := make([]byte, 16)
+	binary.BigEndian.PutUint64(ctx, read.id)
+	binary.BigEndian.PutUint64(ctx[8:], msg.Header.ReadTs)
+	d.RaftGroup.ReadIndex(ctx)
+}
+
+// onReplicaReadIndex records the read ts of a read index request forwarded by a follower, so that
+// async commit transactions commit after it. It returns false if a transaction being written may
+// still commit at or below the read ts, the request is dropped then and the follower's read
+// expires, the client retries it.
+func (d *peerMsgHandler) onReplicaReadIndex(m *eraftpb.Message) bool {
+	if len(m.Entries) != 1 || len(m.Entries[0].Data) != 16 {
+		return true
+	}
+	readTs := binary.BigEndian.Uint64(m.Entries[0].Data[8:])
+	d.ctx.tsTracker.UpdateMaxTs(readTs)
+	minTs := d.ctx.tsTracker.MinLockTs()
+	return minTs == 0 || minTs > readTs
+}
+
+// expireReads answers the reads whose read index hasn't been confirmed in time.
+func (d *peerMsgHandler) expireReads() {
+	reads := d.pendingReads[:0]
+	for _, read := range d.pendingReads {
+		if read.readIndex == 0 && d.ticker.tick >= read.expireTick {
+			NotifyStaleReq(d.Term(), read.cb)
+			continue
+		}
+		reads = append(reads, read)
+	}
+	d.pendingReads = reads
+}
+
+// onReadStates records the read indexes confirmed by raft, and answers the reads which will
+// never be confirmed since the peer is not the leader of their term any more. The reads of a
+// follower are confirmed by the leader, they only expire.
+func (d *peerMsgHandler) onReadStates(readStates []raft.ReadState) {
+	for _, rs := range readStates {
+		id := binary.BigEndian.Uint64(rs.RequestCtx)
+		for _, read := range d.pendingReads {
+			if read.id == id {
+				read.readIndex = rs.Index
+				break
+			}
+		}
+	}
+	leader, term := d.IsLeader(), d.Term()
+	reads := d.pendingReads[:0]
+	for _, read := range d.pendingReads {
+		if read.readIndex == 0 && !read.replica && (!leader || read.term != term) {
+			NotifyStaleReq(term, read.cb)
+			continue
+		}
+		reads = append(reads, read)
+	}
+	d.pendingReads = reads
+	d.serveReads()
+}
+
+// serveReads answers the pending reads whose read index has been applied.
+func (d *peerMsgHandler) serveReads() {
+	appliedIndex := d.peerStorage.AppliedIndex()
+	reads := d.pendingReads[:0]
+	for _, read := range d.pendingReads {
+		if read.readIndex == 0 || read.readIndex > appliedIndex {
+			reads = append(reads, read)
+			continue
+		}
+		d.execReadOnly(read.cmd, read.cb)
+	}
+	d.pendingReads = reads
+}
+
+// execReadOnly serves a read only command from the kv engine, the region may have changed since
+// the command was checked so the epoch is checked again.
+func (d *peerMsgHandler) execReadOnly(msg *raft_cmdpb.RaftCmdRequest, cb *message.Callback) {
+	region := d.Region()
+	if err := util.CheckRegionEpoch(msg, region, true); err != nil {
+		cb.Done(util.ErrResp(err))
+		return
+	}
+	resp := util.NewRaftCmdResponse()
+	for _, req := range msg.Requests {
+		switch req.CmdType {
+		case raft_cmdpb.CmdType_Get:
+			if err := util.CheckKeyInRegion(req.Get.Key, region); err != nil {
+				cb.Done(util.ErrResp(err))
+				return
+			}
+			val, err := engine_util.GetCF(d.ctx.engine.Kv, req.Get.Cf, req.Get.Key)
+			if err != nil && err != badger.ErrKeyNotFound {
+				cb.Done(util.ErrResp(err))
+				return
+			}
+			resp.Responses = append(resp.Responses, &raft_cmdpb.Response{
+				CmdType: raft_cmdpb.CmdType_Get, Get: &raft_cmdpb.GetResponse{Value: val}})
+		case raft_cmdpb.CmdType_Snap:
+			snapRegion := new(metapb.Region)
+			if err := util.CloneMsg(region, snapRegion); err != nil {
+				cb.Done(util.ErrResp(err))
+				return
+			}
+			resp.Responses = append(resp.Responses, &raft_cmdpb.Response{
+				CmdType: raft_cmdpb.CmdType_Snap, Snap: &raft_cmdpb.SnapResponse{Region: snapRegion}})
+			cb.Txn = d.ctx.engine.Kv.NewTransaction(false)
+		}
+	}
+	util.BindRespTerm(resp, d.Term())
+	cb.Done(resp)
+}
+
+// proposeNormal proposes the command as a normal entry, it is executed by the apply worker once
+// committed.
+func (d *peerMsgHandler) proposeNormal(msg *raft_cmdpb.RaftCmdRequest, cb *message.Callback) {
+	data, err := msg.Marshal()
+	if err != nil {
+		cb.Done(util.ErrResp(err))
+		return
+	}
+	index, term := d.nextProposalIndex(), d.Term()
+	if err := d.RaftGroup.Propose(data); err != nil {
+		cb.Done(util.ErrResp(err))
+		return
+	}
+	d.appendProposal(index, term, cb)
+}
+
+// proposeConfChange proposes a conf change entry, the raft command is carried in the context of
+// the entry so the apply worker can check the region epoch.
+func (d *peerMsgHandler) proposeConfChange(msg *raft_cmdpb.RaftCmdRequest, cb *message.Callback) {
+	changePeer := msg.AdminRequest.ChangePeer
+	if changePeer.ChangeType == eraftpb.ConfChangeType_RemoveNode && changePeer.Peer.Id == d.PeerId() {
+		// The leader can't remove itself, otherwise the region is left without leader until the
+		// election timeout. The scheduler transfers the leader away first.
+		cb.Done(util.ErrResp(errors.Errorf("%s can't remove the leader itself", d.Tag)))
+		return
+	}
+	context, err := msg.Marshal()
+	if err != nil {
+		cb.Done(util.ErrResp(err))
+		return
+	}
+	cc := eraftpb.ConfChange{
+		ChangeType: changePeer.ChangeType,
+		NodeId:     changePeer.Peer.Id,
+		Context:    context,
+	}
+	index, term := d.nextProposalIndex(), d.Term()
+	if err := d.RaftGroup.ProposeConfChange(cc); err != nil {
+		cb.Done(util.ErrResp(err))
+		return
+	}
+	d.appendProposal(index, term, cb)
+}
+
+// appendProposal records the callback of a proposal which has been appended at index, raft may
+// drop a proposal silently, for example while transferring leader.
+func (d *peerMsgHandler) appendProposal(index, term uint64, cb *message.Callback) {
+	if d.nextProposalIndex() == index {
+		cb.Done(util.ErrResp(&util.ErrNotLeader{RegionId: d.regionId, Leader: d.getPeerFromCache(d.LeaderId())}))
+		return
+	}
+	d.proposals = append(d.proposals, &proposal{index: index, term: term, cb: cb})
+}
+
+func (d *peerMsgHandler) onApplyResult(res *applyResult) {
+	if d.stopped {
+		return
+	}
+	if res.applyState.AppliedIndex < d.peerStorage.AppliedIndex() {
+		// The entries were applied before a snapshot which has replaced them.
+		return
+	}
+	d.SizeDiffHint += res.sizeDiffHint
+	var compactedIdx uint64
+	for _, result := range res.execResults {
+		switch r := result.(type) {
+		case *execResultChangePeer:
+			d.onReadyChangePeer(r)
+		case *execResultSplitRegion:
+			d.onReadySplitRegion(r.derived, r.regions)
+		case *execResultCompactLog:
+			compactedIdx = r.truncatedIndex
+		case *execResultResolvedTs:
+			if r.resolvedTs > d.safeTs {
+				d.safeTs = r.resolvedTs
+			}
+		}
+		if d.stopped {
+			return
+		}
+	}
+	d.peerStorage.setApplyState(res.applyState)
+	if compactedIdx != 0 {
+		d.onReadyCompactLog(compactedIdx)
+	}
+	d.serveReads()
+}
+
+// onReadyCompactLog deletes the compacted entries from the raft engine, the apply state
+// has been updated already so the peer storage won't read them anymore.
+func (d *peerMsgHandler) onReadyCompactLog(truncatedIndex uint64) {
+	d.ctx.raftLogGCTaskSender <- &runner.RaftLogGCTask{
+		RaftEngine: d.ctx.engine.Raft,
+		RegionID:   d.regionId,
+		StartIdx:   d.LastCompactedIdx,
+		EndIdx:     truncatedIndex + 1,
+	}
+	d.LastCompactedIdx = truncatedIndex + 1
+}
+
+func (d *peerMsgHandler) onReadyChangePeer(cp *execResultChangePeer) {
+	d.RaftGroup.ApplyConfChange(*cp.confChange)
+	if cp.confChange.NodeId == 0 {
+		// The conf change was rejected by the apply worker.
+		return
+	}
+	d.SetRegion(cp.region)
+	meta := d.ctx.storeMeta
+	meta.Lock()
+	meta.regions[d.regionId] = cp.region
+	meta.regionRanges.ReplaceOrInsert(&regionItem{region: cp.region})
+	meta.Unlock()
+
+	switch cp.confChange.ChangeType {
+	case eraftpb.ConfChangeType_AddNode, eraftpb.ConfChangeType_AddLearnerNode:
+		d.insertPeerCache(cp.peer)
+		if d.IsLeader() {
+			d.PeersStartPendingTime[cp.peer.Id] = time.Now()
+		}
+	case eraftpb.ConfChangeType_RemoveNode:
+		if cp.peer.Id == d.PeerId() {
+			d.destroyPeer()
+			return
+		}
+		d.removePeerCache(cp.peer.Id)
+		delete(d.PeersStartPendingTime, cp.peer.Id)
+	}
+	if d.IsLeader() {
+		d.HeartbeatScheduler(d.ctx.schedulerTaskSender)
+	}
+}
+
+// onReadySplitRegion updates the range of this peer and creates the peer of the new region on
+// this store.
+func (d *peerMsgHandler) onReadySplitRegion(derived *metapb.Region, regions []*metapb.Region) {
+	meta := d.ctx.storeMeta
+	meta.Lock()
+	defer meta.Unlock()
+	regionID := derived.Id
+	meta.regionRanges.Delete(&regionItem{region: d.Region()})
+	d.SetRegion(derived)
+	// The size of both regions is unknown until the split checker scans them again.
+	d.ApproximateSize = nil
+	d.SizeDiffHint = d.ctx.cfg.RegionSplitSize / 8
+	isLeader := d.IsLeader()
+	if isLeader {
+		d.HeartbeatScheduler(d.ctx.schedulerTaskSender)
+	}
+
+	for _, newRegion := range regions {
+		newRegionID := newRegion.Id
+		if newRegionID == regionID {
+			meta.regionRanges.ReplaceOrInsert(&regionItem{region: newRegion})
+			meta.regions[newRegionID] = newRegion
+			continue
+		}
+		if _, ok := meta.regions[newRegionID]; ok {
+			// The new region has been created on this store already, by a raft message of its
+			// leader received before the split was applied here.
+			log.Warn(fmt.Sprintf("%s new region %d is already created, skip", d.Tag, newRegionID))
+			continue
+		}
+		newPeer, err := createPeer(d.ctx.store.Id, d.ctx.cfg, d.ctx.applyTaskSender, d.ctx.snapTaskSender, d.ctx.engine, newRegion)
+		if err != nil {
+			// The region state has been persisted, the peer is created on restart.
+			panic(fmt.Sprintf("%s create new split region %v error %v", d.Tag, newRegion, err))
+		}
+		meta.regionRanges.ReplaceOrInsert(&regionItem{region: newRegion})
+		meta.regions[newRegionID] = newRegion
+		newPeer.SizeDiffHint = d.ctx.cfg.RegionSplitSize / 8
+		if isLeader && len(newRegion.Peers) > 1 {
+			// The peers of the new region on other stores are likely to follow soon, campaign
+			// right away rather than waiting for an election timeout.
+			if err := newPeer.RaftGroup.Campaign(); err != nil {
+				log.Warn(fmt.Sprintf("%s new region %d failed to campaign: %v", d.Tag, newRegionID, err))
+			}
+		}
+		for _, peer := range newRegion.Peers {
+			newPeer.insertPeerCache(peer)
+		}
+		newPeer.registerApplier()
+		d.ctx.router.register(newPeer)
+		_ = d.ctx.router.send(newRegionID, message.Msg{RegionID: newRegionID, Type: message.MsgTypeStart})
+		if isLeader {
+			newPeer.HeartbeatScheduler(d.ctx.schedulerTaskSender)
+		}
+	}
+}
+
+func (d *peerMsgHandler) onRaftMsg(msg *rspb.RaftMessage) error {
+	log.Debug(fmt.Sprintf("%s handle raft message %s from %d to %d",
+		d.Tag, msg.GetMessage().GetMsgType(), msg.GetFromPeer().GetId(), msg.GetToPeer().GetId()))
+	if !d.validateRaftMessage(msg) {
+		return nil
+	}
+	if d.stopped {
+		return nil
+	}
+	if msg.GetIsTombstone() {
+		// we receive a message tells us to remove self.
+		d.handleGCPeerMsg(msg)
+		return nil
+	}
+	if msg.ToPeer.Id > d.PeerId() {
+		// A newer peer of the region has been added to this store, so this one must have
+		// been removed while it was lagging behind.
+		log.Info(fmt.Sprintf("%s is stale as received a message for peer %d, destroy itself",
+			d.Tag, msg.ToPeer.Id))
+		d.destroyPeer()
+		return nil
+	}
+	if msg.ToPeer.Id < d.PeerId() {
+		log.Info(fmt.Sprintf("%s drop message for stale peer %d", d.Tag, msg.ToPeer.Id))
+		return nil
+	}
+	d.insertPeerCache(msg.GetFromPeer())
+	if msg.Message.MsgType == eraftpb.MessageType_MsgReadIndex && d.IsLeader() && !d.onReplicaReadIndex(msg.Message) {
+		return nil
+	}
+	if err := d.RaftGroup.Step(*msg.GetMessage()); err != nil {
+		return err
+	}
+	if d.AnyNewPeerCatchUp(msg.FromPeer.Id) {
+		d.HeartbeatScheduler(d.ctx.schedulerTaskSender)
+	}
+	return nil
+}
+
+// return false means the message is invalid, and can be ignored.
+func (d *peerMsgHandler) validateRaftMessage(msg *rspb.RaftMessage) bool {
+	regionID := msg.GetRegionId()
+	to := msg.GetToPeer()
+	if to.GetStoreId() != d.storeID() {
+		log.Warn(fmt.Sprintf("[region %d] store not match, to store id %d, mine %d, ignore it",
+			regionID, to.GetStoreId(), d.storeID()))
+		return false
+	}
+	if msg.RegionEpoch == nil {
+		log.Error(fmt.Sprintf("[region %d] missing epoch in raft message, ignore it", regionID))
+		return false
+	}
+	return true
+}
+
+func (d *peerMsgHandler) handleGCPeerMsg(msg *rspb.RaftMessage) {
+	fromEpoch := msg.RegionEpoch
+	if !util.IsEpochStale(d.Region().RegionEpoch, fromEpoch) {
+		return
+	}
+	if !util.PeerEqual(d.Meta, msg.ToPeer) {
+		log.Info(fmt.Sprintf("%s receive stale gc msg, ignore", d.Tag))
+		return
+	}
+	log.Info(fmt.Sprintf("%s peer %s receives gc message, trying to remove", d.Tag, msg.ToPeer))
+	if d.MaybeDestroy() {
+		d.destroyPeer()
+	}
+}
+
+func (d *peerMsgHandler) destroyPeer() {
+	log.Info(fmt.Sprintf("%s starts destroy", d.Tag))
+	regionID := d.regionId
+	region := d.Region()
+	isInitialized := d.isInitialized()
+	if err := d.Destroy(d.ctx.engine, false); err != nil {
+		// If not panic here, the peer will be recreated in the next restart,
+		// then it will be gc again. But if some overlap region is created
+		// before restarting, the gc action will delete the overlap region's
+		// data too.
+		panic(fmt.Sprintf("%s destroy peer %v", d.Tag, err))
+	}
+	d.ctx.router.close(regionID)
+	d.stopped = true
+
+	meta := d.ctx.storeMeta
+	meta.Lock()
+	defer meta.Unlock()
+	if isInitialized {
+		if item := meta.regionRanges.Get(&regionItem{region: region}); item != nil &&
+			item.(*regionItem).region.Id == regionID {
+			meta.regionRanges.Delete(item)
+		}
+	}
+	delete(meta.regions, regionID)
+}
+
+func (d *peerMsgHandler) onTick() {
+	if d.stopped {
+		return
+	}
+	d.ticker.tickClock()
+	if d.ticker.isOnTick(PeerTickRaft) {
+		d.onRaftBaseTick()
+	}
+	if d.ticker.isOnTick(PeerTickRaftLogGC) {
+		d.onRaftGCLogTick()
+	}
+	if d.ticker.isOnTick(PeerTickSplitRegionCheck) {
+		d.onSplitRegionCheckTick()
+	}
+	if d.ticker.isOnTick(PeerTickSchedulerHeartbeat) {
+		d.onSchedulerHeartbeatTick()
+	}
+}
+
+func (d *peerMsgHandler) startTicker() {
+	d.ctx.tickDriverSender <- d.regionId
+	d.ticker.schedule(PeerTickRaft)
+	d.ticker.schedule(PeerTickRaftLogGC)
+	d.ticker.schedule(PeerTickSplitRegionCheck)
+	d.ticker.schedule(PeerTickSchedulerHeartbeat)
+}
+
+func (d *peerMsgHandler) onRaftBaseTick() {
+	d.RaftGroup.Tick()
+	d.expireReads()
+	d.ticker.schedule(PeerTickRaft)
+}
+
+// onResolvedTs proposes to advance the resolved ts of the region with ts got from the scheduler,
+// see resolveTs.
+func (d *peerMsgHandler) onResolvedTs(ts uint64) {
+	// A region without followers has nobody to serve stale reads.
+	if !d.IsLeader() || !d.RaftGroup.Raft.CommittedEntryInCurrentTerm() || len(d.Region().Peers) == 1 {
+		return
+	}
+	// Async commit transactions which haven't read the max ts commit after ts, the others keep
+	// their locks in memory until the locks are in the kv engine. So the memory is checked first.
+	d.ctx.tsTracker.UpdateMaxTs(ts)
+	memLockTs := d.ctx.tsTracker.MinLockTs()
+	lockTs, err := minLockTs(d.ctx.engine.Kv, d.Region())
+	if err != nil {
+		log.Warn(fmt.Sprintf("%s failed to scan locks: %v", d.Tag, err))
+		return
+	}
+	resolvedTs := resolveTs(ts, memLockTs, lockTs)
+	if resolvedTs <= d.proposedResolvedTs || resolvedTs <= d.safeTs {
+		return
+	}
+	d.proposedResolvedTs = resolvedTs
+	request := &raft_cmdpb.RaftCmdRequest{
+		Header: &raft_cmdpb.RaftRequestHeader{
+			RegionId:    d.regionId,
+			Peer:        d.Meta,
+			RegionEpoch: d.Region().RegionEpoch,
+		},
+		AdminRequest: &raft_cmdpb.AdminRequest{
+			CmdType:    raft_cmdpb.AdminCmdType_ResolvedTs,
+			ResolvedTs: &raft_cmdpb.ResolvedTsRequest{ResolvedTs: resolvedTs},
+		},
+	}
+	d.proposeRaftCommand(request, message.NewCallback())
+}
+
+// onRaftGCLogTick proposes to compact the log up to the entries every follower has replicated.
+// Once the log has grown past RaftLogGcCountLimit it is compacted up to the applied index anyway,
+// the followers lagging behind catch up with a snapshot then.
+func (d *peerMsgHandler) onRaftGCLogTick() {
+	d.ticker.schedule(PeerTickRaftLogGC)
+	if !d.IsLeader() {
+		return
+	}
+
+	appliedIdx := d.peerStorage.AppliedIndex()
+	firstIdx, _ := d.peerStorage.FirstIndex()
+	compactIdx := appliedIdx
+	if appliedIdx < firstIdx+d.ctx.cfg.RaftLogGcCountLimit {
+		for id, pr := range d.RaftGroup.Raft.Prs {
+			if id != d.PeerId() && pr.Match < compactIdx {
+				compactIdx = pr.Match
+			}
+		}
+	}
+	// Compacting a handful of entries isn't worth a proposal.
+	if compactIdx < firstIdx || compactIdx-firstIdx+1 < d.ctx.cfg.RaftLogGcThreshold {
+		return
+	}
+	term, err := d.RaftGroup.Raft.RaftLog.Term(compactIdx)
+	if err != nil {
+		log.Warn(fmt.Sprintf("%s failed to get the term of compact index %d: %v", d.Tag, compactIdx, err))
+		return
+	}
+
+	request := &raft_cmdpb.RaftCmdRequest{
+		Header: &raft_cmdpb.RaftRequestHeader{
+			RegionId:    d.regionId,
+			Peer:        d.Meta,
+			RegionEpoch: d.Region().RegionEpoch,
+		},
+		AdminRequest: &raft_cmdpb.AdminRequest{
+			CmdType:    raft_cmdpb.AdminCmdType_CompactLog,
+			CompactLog: &raft_cmdpb.CompactLogRequest{CompactIndex: compactIdx, CompactTerm: term},
+		},
+	}
+	d.proposeRaftCommand(request, message.NewCallback())
+}
+
+func (d *peerMsgHandler) onSchedulerHeartbeatTick() {
+	d.ticker.schedule(PeerTickSchedulerHeartbeat)
+
+	if !d.IsLeader() {
+		return
+	}
+	d.HeartbeatScheduler(d.ctx.schedulerTaskSender)
+}
+
+func (d *peerMsgHandler) onSplitRegionCheckTick() {
+	d.ticker.schedule(PeerTickSplitRegionCheck)
+	// To avoid frequent scan, we only add new scan tasks if all previous tasks
+	// have finished.
+	if len(d.ctx.splitCheckTaskSender) > 0 {
+		return
+	}
+
+	if !d.IsLeader() {
+		return
+	}
+	if d.ApproximateSize != nil && d.SizeDiffHint < d.ctx.cfg.RegionSplitSize/8 {
+		return
+	}
+	d.ctx.splitCheckTaskSender <- &runner.SplitCheckTask{
+		Region: d.Region(),
+	}
+	d.SizeDiffHint = 0
+}
+
+// onPrepareSplitRegion asks the scheduler for the ids of the new region, the split is proposed
+// once the scheduler has answered.
+func (d *peerMsgHandler) onPrepareSplitRegion(regionEpoch *metapb.RegionEpoch, splitKey []byte, cb *message.Callback) {
+	if err := d.validateSplitRegion(regionEpoch, splitKey); err != nil {
+		cb.Done(util.ErrResp(err))
+		return
+	}
+	region := d.Region()
+	d.ctx.schedulerTaskSender <- &runner.SchedulerAskSplitTask{
+		Region:   region,
+		SplitKey: splitKey,
+		Peer:     d.Meta,
+		Callback: cb,
+	}
+}
+
+func (d *peerMsgHandler) validateSplitRegion(epoch *metapb.RegionEpoch, splitKey []byte) error {
+	if len(splitKey) == 0 {
+		err := errors.Errorf("%s split key should not be empty", d.Tag)
+		log.Error(err.Error())
+		return err
+	}
+
+	if !d.IsLeader() {
+		// region on this store is no longer leader, skipped.
+		log.Info(fmt.Sprintf("%s not leader, skip", d.Tag))
+		return &util.ErrNotLeader{
+			RegionId: d.regionId,
+			Leader:   d.getPeerFromCache(d.LeaderId()),
+		}
+	}
+
+	region := d.Region()
+	latestEpoch := region.GetRegionEpoch()
+
+	// This is a little difference for `check_region_epoch` in region split case.
+	// Here we just need to check `version` because `conf_ver` will be update
+	// to the latest value of the peer, and then send to Scheduler.
+	if latestEpoch.Version != epoch.Version {
+		log.Info(fmt.Sprintf("%s epoch changed, retry later, prev_epoch: %s, epoch %s",
+			d.Tag, latestEpoch, epoch))
+		return &util.ErrEpochNotMatch{
+			Message: fmt.Sprintf("%s epoch changed %s != %s, retry later", d.Tag, latestEpoch, epoch),
+			Regions: []*metapb.Region{region},
+		}
+	}
+	return nil
+}
+
+func (d *peerMsgHandler) onApproximateRegionSize(size uint64) {
+	d.ApproximateSize = &size
+}
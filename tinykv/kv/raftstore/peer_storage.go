@@ -0,0 +1,356 @@
+package raftstore
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/Connor1996/badger"
+	"github.com/golang/protobuf/proto"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/runner"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/raft"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+)
+
+// PeerStorage implements raft.Storage on top of the raft and kv engines. Log entries and the
+// raft hard state live in the raft engine, while the apply state and the region state live in
+// the kv engine, next to the data they describe.
+type PeerStorage struct {
+	// current region information of the peer
+	region *metapb.Region
+	// current raft state of the peer
+	raftState *rspb.RaftLocalState
+	// current apply state of the peer
+	applyState *rspb.RaftApplyState
+
+	// snapCh receives the snapshot being generated, nil if there is no generation in progress
+	snapCh chan *eraftpb.Snapshot
+	// the last generated snapshot
+	snapshot *eraftpb.Snapshot
+	// the snapshot worker generating the snapshots
+	snapSender chan<- worker.Task
+
+	// Engines include two badger instance: Raft and Kv
+	Engines *engine_util.Engines
+	// Tag used for logging
+	Tag string
+}
+
+// NewPeerStorage get the persist raftState from engines and return a peer storage
+func NewPeerStorage(engines *engine_util.Engines, region *metapb.Region, snapSender chan<- worker.Task, tag string) (*PeerStorage, error) {
+	log.Debug(fmt.Sprintf("%s creating storage for %s", tag, region.String()))
+	raftState, err := meta.InitRaftLocalState(engines.Raft, region)
+	if err != nil {
+		return nil, err
+	}
+	applyState, err := meta.InitApplyState(engines.Kv, region)
+	if err != nil {
+		return nil, err
+	}
+	// The raft engine is written after the kv engine when a snapshot is applied, so a crash in
+	// between can leave the raft state behind the truncated state. Everything up to the
+	// truncated index is in the kv engine already, so it is safe to catch the raft state up.
+	if raftState.LastIndex < applyState.TruncatedState.Index {
+		raftState.LastIndex = applyState.TruncatedState.Index
+		raftState.LastTerm = applyState.TruncatedState.Term
+		if raftState.HardState.Commit < applyState.TruncatedState.Index {
+			raftState.HardState.Commit = applyState.TruncatedState.Index
+		}
+		if raftState.HardState.Term < applyState.TruncatedState.Term {
+			raftState.HardState.Term = applyState.TruncatedState.Term
+		}
+	}
+	if raftState.LastIndex < applyState.AppliedIndex {
+		panic(fmt.Sprintf("%s unexpected raft log index: lastIndex %d < appliedIndex %d",
+			tag, raftState.LastIndex, applyState.AppliedIndex))
+	}
+
+	return &PeerStorage{
+		Engines:    engines,
+		region:     region,
+		Tag:        tag,
+		raftState:  raftState,
+		applyState: applyState,
+		snapSender: snapSender,
+	}, nil
+}
+
+// InitialState implements the Storage interface.
+func (ps *PeerStorage) InitialState() (eraftpb.HardState, eraftpb.ConfState, error) {
+	raftState := ps.raftState
+	if raft.IsEmptyHardState(*raftState.HardState) {
+		if ps.isInitialized() {
+			panic(fmt.Sprintf("peer for region %s is initialized but local state %+v has empty hard state",
+				ps.region, ps.raftState))
+		}
+		return eraftpb.HardState{}, eraftpb.ConfState{}, nil
+	}
+	return *raftState.HardState, util.ConfStateFromRegion(ps.region), nil
+}
+
+// Entries implements the Storage interface.
+func (ps *PeerStorage) Entries(low, high uint64) ([]eraftpb.Entry, error) {
+	if err := ps.checkRange(low, high); err != nil || low == high {
+		return nil, err
+	}
+	buf := make([]eraftpb.Entry, 0, high-low)
+	startKey := meta.RaftLogKey(ps.region.Id, low)
+	endKey := meta.RaftLogKey(ps.region.Id, high)
+	txn := ps.Engines.Raft.NewTransaction(false)
+	defer txn.Discard()
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	nextIndex := low
+	for iter.Seek(startKey); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		if bytes.Compare(item.Key(), endKey) >= 0 {
+			break
+		}
+		val, err := item.Value()
+		if err != nil {
+			return nil, err
+		}
+		var entry eraftpb.Entry
+		if err = entry.Unmarshal(val); err != nil {
+			return nil, err
+		}
+		// May meet gap or has been compacted.
+		if entry.Index != nextIndex {
+			break
+		}
+		nextIndex++
+		buf = append(buf, entry)
+	}
+	// If we get the correct number of entries, returns.
+	if len(buf) == int(high-low) {
+		return buf, nil
+	}
+	// Here means we don't fetch enough entries.
+	return nil, raft.ErrUnavailable
+}
+
+// Term implements the Storage interface.
+func (ps *PeerStorage) Term(idx uint64) (uint64, error) {
+	if idx == ps.truncatedIndex() {
+		return ps.truncatedTerm(), nil
+	}
+	if err := ps.checkRange(idx, idx+1); err != nil {
+		return 0, err
+	}
+	if ps.truncatedTerm() == ps.raftState.LastTerm || idx == ps.raftState.LastIndex {
+		return ps.raftState.LastTerm, nil
+	}
+	var entry eraftpb.Entry
+	if err := engine_util.GetMeta(ps.Engines.Raft, meta.RaftLogKey(ps.region.Id, idx), &entry); err != nil {
+		return 0, err
+	}
+	return entry.Term, nil
+}
+
+// LastIndex implements the Storage interface.
+func (ps *PeerStorage) LastIndex() (uint64, error) {
+	return ps.raftState.LastIndex, nil
+}
+
+// FirstIndex implements the Storage interface.
+func (ps *PeerStorage) FirstIndex() (uint64, error) {
+	return ps.truncatedIndex() + 1, nil
+}
+
+// Snapshot implements the Storage interface. Snapshots are generated by the snapshot worker, so
+// raft.ErrSnapshotTemporarilyUnavailable is returned until the one asked for is ready. The last
+// generated snapshot is reused for as long as the log following it is still around.
+func (ps *PeerStorage) Snapshot() (eraftpb.Snapshot, error) {
+	if ps.snapCh != nil {
+		select {
+		case snapshot := <-ps.snapCh:
+			ps.snapCh = nil
+			ps.snapshot = snapshot
+		default:
+			return eraftpb.Snapshot{}, raft.ErrSnapshotTemporarilyUnavailable
+		}
+	}
+	if ps.snapshot != nil && ps.snapshot.Metadata.Index >= ps.truncatedIndex() {
+		return *ps.snapshot, nil
+	}
+	ps.snapshot = nil
+	log.Info(fmt.Sprintf("%s requesting snapshot", ps.Tag))
+	ch := make(chan *eraftpb.Snapshot, 1)
+	ps.snapCh = ch
+	ps.snapSender <- &runner.SnapGenTask{RegionID: ps.region.Id, Notifier: ch}
+	return eraftpb.Snapshot{}, raft.ErrSnapshotTemporarilyUnavailable
+}
+
+func (ps *PeerStorage) isInitialized() bool {
+	return util.RegionInitialized(ps.region)
+}
+
+// Region returns the region the storage belongs to.
+func (ps *PeerStorage) Region() *metapb.Region {
+	return ps.region
+}
+
+// SetRegion updates the region after the apply worker changed it.
+func (ps *PeerStorage) SetRegion(region *metapb.Region) {
+	ps.region = region
+}
+
+func (ps *PeerStorage) checkRange(low, high uint64) error {
+	if low > high {
+		return errors.Errorf("low %d is greater than high %d", low, high)
+	} else if low <= ps.truncatedIndex() {
+		return raft.ErrCompacted
+	} else if high > ps.raftState.LastIndex+1 {
+		return errors.Errorf("entries' high %d is out of bound, lastIndex %d",
+			high, ps.raftState.LastIndex)
+	}
+	return nil
+}
+
+func (ps *PeerStorage) truncatedIndex() uint64 {
+	return ps.applyState.TruncatedState.Index
+}
+
+func (ps *PeerStorage) truncatedTerm() uint64 {
+	return ps.applyState.TruncatedState.Term
+}
+
+// AppliedIndex returns the applied index the raft worker last heard of from the apply worker.
+func (ps *PeerStorage) AppliedIndex() uint64 {
+	return ps.applyState.AppliedIndex
+}
+
+// setApplyState records the apply state reported by the apply worker. The apply worker is the
+// only writer of the apply state on disk, the raft worker only keeps a copy of it.
+func (ps *PeerStorage) setApplyState(applyState *rspb.RaftApplyState) {
+	ps.applyState = applyState
+}
+
+// Append the given entries to the raft log and update ps.raftState also delete log entries
+// that will never be committed.
+func (ps *PeerStorage) Append(entries []eraftpb.Entry, raftWB *engine_util.WriteBatch) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	log.Debug(fmt.Sprintf("%s append %d entries", ps.Tag, len(entries)))
+	prevLastIndex := ps.raftState.LastIndex
+	for i := range entries {
+		if err := raftWB.SetMeta(meta.RaftLogKey(ps.region.Id, entries[i].Index), &entries[i]); err != nil {
+			return err
+		}
+	}
+	last := entries[len(entries)-1]
+	// Delete any previously appended log entries which never committed.
+	for i := last.Index + 1; i <= prevLastIndex; i++ {
+		raftWB.DeleteMeta(meta.RaftLogKey(ps.region.Id, i))
+	}
+	ps.raftState.LastIndex = last.Index
+	ps.raftState.LastTerm = last.Term
+	return nil
+}
+
+// clearRaftMeta deletes the raft log and the raft state of the region, it is used when the peer
+// is destroyed.
+func (ps *PeerStorage) clearRaftMeta(raftWB *engine_util.WriteBatch) error {
+	return ClearRaftMeta(ps.Engines, raftWB, ps.region.Id, ps.raftState.LastIndex)
+}
+
+// ClearRaftMeta deletes the raft log and the raft state of the region.
+func ClearRaftMeta(engines *engine_util.Engines, raftWB *engine_util.WriteBatch, regionID uint64, lastIndex uint64) error {
+	start := meta.RaftLogKey(regionID, 0)
+	err := engines.Raft.View(func(txn *badger.Txn) error {
+		// Find the first raft log key of the region so we don't need to delete from index 0.
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		it.Seek(start)
+		if it.ValidForPrefix(meta.RegionRaftPrefixKey(regionID)) {
+			if idx, err := meta.RaftLogIndex(it.Item().Key()); err == nil {
+				start = meta.RaftLogKey(regionID, idx)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	firstIndex, _ := meta.RaftLogIndex(start)
+	for i := firstIndex; i <= lastIndex; i++ {
+		raftWB.DeleteMeta(meta.RaftLogKey(regionID, i))
+	}
+	raftWB.DeleteMeta(meta.RaftStateKey(regionID))
+	return nil
+}
+
+// SaveReadyState persists the entries and the hard state of the ready. Snapshots are handed to
+// the apply worker before this is called, see peerMsgHandler.HandleRaftReady.
+func (ps *PeerStorage) SaveReadyState(ready *raft.Ready) error {
+	raftWB := new(engine_util.WriteBatch)
+	if err := ps.Append(ready.Entries, raftWB); err != nil {
+		return err
+	}
+	if !raft.IsEmptyHardState(ready.HardState) {
+		*ps.raftState.HardState = ready.HardState
+	}
+	if err := raftWB.SetMeta(meta.RaftStateKey(ps.region.Id), ps.raftState); err != nil {
+		return err
+	}
+	return ps.Engines.WriteRaft(raftWB)
+}
+
+// restoreSnapshot resets the raft state to the one described by the snapshot after the apply
+// worker has ingested its data, and persists it.
+func (ps *PeerStorage) restoreSnapshot(snapshot *eraftpb.Snapshot, applyState *rspb.RaftApplyState, region *metapb.Region) error {
+	log.Info(fmt.Sprintf("%s restored snapshot at index %d term %d",
+		ps.Tag, snapshot.Metadata.Index, snapshot.Metadata.Term))
+	raftWB := new(engine_util.WriteBatch)
+	// Drop the old raft log, none of it is needed after the snapshot.
+	if ps.isInitialized() {
+		for i := ps.truncatedIndex() + 1; i <= ps.raftState.LastIndex; i++ {
+			raftWB.DeleteMeta(meta.RaftLogKey(ps.region.Id, i))
+		}
+	}
+	ps.raftState.LastIndex = snapshot.Metadata.Index
+	ps.raftState.LastTerm = snapshot.Metadata.Term
+	if ps.raftState.HardState.Commit < snapshot.Metadata.Index {
+		ps.raftState.HardState.Commit = snapshot.Metadata.Index
+	}
+	if ps.raftState.HardState.Term < snapshot.Metadata.Term {
+		ps.raftState.HardState.Term = snapshot.Metadata.Term
+	}
+	if err := raftWB.SetMeta(meta.RaftStateKey(ps.region.Id), ps.raftState); err != nil {
+		return err
+	}
+	if err := ps.Engines.WriteRaft(raftWB); err != nil {
+		return err
+	}
+	ps.applyState = applyState
+	ps.region = region
+	return nil
+}
+
+// decodeSnapshotData unmarshals the payload of a snapshot generated by runner.GenerateSnapshot.
+func decodeSnapshotData(snapshot *eraftpb.Snapshot) (*rspb.RaftSnapshotData, error) {
+	data := new(rspb.RaftSnapshotData)
+	if err := proto.Unmarshal(snapshot.Data, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func cloneApplyState(applyState *rspb.RaftApplyState) *rspb.RaftApplyState {
+	return &rspb.RaftApplyState{
+		AppliedIndex: applyState.AppliedIndex,
+		TruncatedState: &rspb.RaftTruncatedState{
+			Index: applyState.TruncatedState.Index,
+			Term:  applyState.TruncatedState.Term,
+		},
+		DataVersion: applyState.DataVersion,
+	}
+}
@@ -0,0 +1,154 @@
+package raftstore
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/runner"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/raft"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEngines(t *testing.T) *engine_util.Engines {
+	dir, err := ioutil.TempDir("", "peer_storage")
+	require.Nil(t, err)
+	cfg := config.NewTestConfig()
+	cfg.DBPath = dir
+	kvDB := engine_util.CreateDB("kv", cfg)
+	raftDB := engine_util.CreateDB("raft", cfg)
+	return engine_util.NewEngines(kvDB, raftDB, dir+"/kv", dir+"/raft")
+}
+
+func newTestPeerStorage(t *testing.T, engines *engine_util.Engines, snapCh chan worker.Task) *PeerStorage {
+	region, err := PrepareBootstrap(engines, 1, 1, 1)
+	require.Nil(t, err)
+	ps, err := NewPeerStorage(engines, region, snapCh, "")
+	require.Nil(t, err)
+	return ps
+}
+
+func newTestEntries(low, high, term uint64) []eraftpb.Entry {
+	var entries []eraftpb.Entry
+	for i := low; i < high; i++ {
+		entries = append(entries, eraftpb.Entry{Index: i, Term: term, Data: []byte{byte(i)}})
+	}
+	return entries
+}
+
+func TestPeerStorageAppend(t *testing.T) {
+	engines := newTestEngines(t)
+	defer engines.Destroy()
+	ps := newTestPeerStorage(t, engines, nil)
+
+	first, err := ps.FirstIndex()
+	require.Nil(t, err)
+	require.Equal(t, uint64(meta.RaftInitLogIndex+1), first)
+	term, err := ps.Term(meta.RaftInitLogIndex)
+	require.Nil(t, err)
+	require.Equal(t, uint64(meta.RaftInitLogTerm), term)
+
+	rd := &raft.Ready{Entries: newTestEntries(6, 10, 6)}
+	require.Nil(t, ps.SaveReadyState(rd))
+	last, err := ps.LastIndex()
+	require.Nil(t, err)
+	require.Equal(t, uint64(9), last)
+
+	// Conflicting entries replace the tail of the log.
+	rd = &raft.Ready{Entries: newTestEntries(8, 9, 7)}
+	require.Nil(t, ps.SaveReadyState(rd))
+	last, err = ps.LastIndex()
+	require.Nil(t, err)
+	require.Equal(t, uint64(8), last)
+	entries, err := ps.Entries(6, 9)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(entries))
+	require.Equal(t, uint64(7), entries[2].Term)
+
+	_, err = ps.Entries(5, 7)
+	require.Equal(t, raft.ErrCompacted, err)
+
+	// The log survives a restart.
+	ps, err = NewPeerStorage(engines, ps.Region(), nil, "")
+	require.Nil(t, err)
+	last, err = ps.LastIndex()
+	require.Nil(t, err)
+	require.Equal(t, uint64(8), last)
+	term, err = ps.Term(7)
+	require.Nil(t, err)
+	require.Equal(t, uint64(6), term)
+}
+
+func TestPeerStorageSnapshot(t *testing.T) {
+	engines := newTestEngines(t)
+	defer engines.Destroy()
+	snapCh := make(chan worker.Task, 1)
+	ps := newTestPeerStorage(t, engines, snapCh)
+	require.Nil(t, engine_util.PutCF(engines.Kv, engine_util.CfDefault, []byte("a"), []byte("v1")))
+	require.Nil(t, engine_util.PutCF(engines.Kv, engine_util.CfLock, []byte("b"), []byte("v2")))
+
+	// The snapshot is unavailable until the snapshot worker has generated it.
+	_, err := ps.Snapshot()
+	require.Equal(t, raft.ErrSnapshotTemporarilyUnavailable, err)
+	_, err = ps.Snapshot()
+	require.Equal(t, raft.ErrSnapshotTemporarilyUnavailable, err)
+	require.Equal(t, 1, len(snapCh))
+	runner.NewSnapGenHandler(engines).Handle(<-snapCh)
+
+	snapshot, err := ps.Snapshot()
+	require.Nil(t, err)
+	require.Equal(t, uint64(meta.RaftInitLogIndex), snapshot.Metadata.Index)
+	require.Equal(t, []uint64{1}, snapshot.Metadata.ConfState.Nodes)
+
+	data, err := decodeSnapshotData(&snapshot)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(data.Data))
+	cf, key := splitCFKey(data.Data[0].Key)
+	require.Equal(t, engine_util.CfDefault, cf)
+	require.Equal(t, []byte("a"), key)
+	cf, key = splitCFKey(data.Data[1].Key)
+	require.Equal(t, engine_util.CfLock, cf)
+	require.Equal(t, []byte("b"), key)
+
+	// The generated snapshot is reused.
+	_, err = ps.Snapshot()
+	require.Nil(t, err)
+	require.Equal(t, 0, len(snapCh))
+}
+
+func TestPeerStorageCompactLog(t *testing.T) {
+	engines := newTestEngines(t)
+	defer engines.Destroy()
+	ps := newTestPeerStorage(t, engines, nil)
+	require.Nil(t, ps.SaveReadyState(&raft.Ready{Entries: newTestEntries(6, 10, 6)}))
+
+	applyState := cloneApplyState(ps.applyState)
+	applyState.AppliedIndex = 9
+	applyState.TruncatedState.Index = 7
+	applyState.TruncatedState.Term = 6
+	ps.setApplyState(applyState)
+	first, err := ps.FirstIndex()
+	require.Nil(t, err)
+	require.Equal(t, uint64(8), first)
+	_, err = ps.Entries(7, 9)
+	require.Equal(t, raft.ErrCompacted, err)
+	term, err := ps.Term(7)
+	require.Nil(t, err)
+	require.Equal(t, uint64(6), term)
+
+	runner.NewRaftLogGCTaskHandler().Handle(&runner.RaftLogGCTask{
+		RaftEngine: engines.Raft,
+		RegionID:   ps.Region().Id,
+		EndIdx:     8,
+	})
+	_, err = meta.GetRaftEntry(engines.Raft, ps.Region().Id, 7)
+	require.Equal(t, badger.ErrKeyNotFound, err)
+	entries, err := ps.Entries(8, 10)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(entries))
+}
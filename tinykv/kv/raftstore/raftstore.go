@@ -0,0 +1,317 @@
+package raftstore
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Connor1996/badger"
+	"github.com/google/btree"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/runner"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/scheduler_client"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+)
+
+type regionItem struct {
+	region *metapb.Region
+}
+
+var _ btree.Item = &regionItem{}
+
+// Less orders the regions by their start key, the regions of a store never overlap.
+func (r *regionItem) Less(other btree.Item) bool {
+	left := r.region.GetStartKey()
+	right := other.(*regionItem).region.GetStartKey()
+	return bytes.Compare(left, right) < 0
+}
+
+type storeMeta struct {
+	sync.RWMutex
+	// region start key -> region
+	regionRanges *btree.BTree
+	// region_id -> region
+	regions map[uint64]*metapb.Region
+}
+
+func newStoreMeta() *storeMeta {
+	return &storeMeta{
+		regionRanges: btree.New(2),
+		regions:      map[uint64]*metapb.Region{},
+	}
+}
+
+// getOverlapRegions gets the regions which are overlapped with the specified region range.
+func (m *storeMeta) getOverlapRegions(region *metapb.Region) []*metapb.Region {
+	item := &regionItem{region: region}
+	var result *regionItem
+	// find is a helper function to find an item that contains the regions start key.
+	m.regionRanges.DescendLessOrEqual(item, func(i btree.Item) bool {
+		result = i.(*regionItem)
+		return false
+	})
+
+	if result == nil || engine_util.ExceedEndKey(region.GetStartKey(), result.region.GetEndKey()) {
+		result = item
+	}
+
+	var overlaps []*metapb.Region
+	m.regionRanges.AscendGreaterOrEqual(result, func(i btree.Item) bool {
+		over := i.(*regionItem)
+		if engine_util.ExceedEndKey(over.region.GetStartKey(), region.GetEndKey()) {
+			return false
+		}
+		overlaps = append(overlaps, over.region)
+		return true
+	})
+	return overlaps
+}
+
+type GlobalContext struct {
+	cfg                  *config.Config
+	engine               *engine_util.Engines
+	store                *metapb.Store
+	storeMeta            *storeMeta
+	router               *router
+	trans                Transport
+	schedulerTaskSender  chan<- worker.Task
+	applyTaskSender      chan<- worker.Task
+	splitCheckTaskSender chan<- worker.Task
+	snapTaskSender       chan<- worker.Task
+	raftLogGCTaskSender  chan<- worker.Task
+	schedulerClient      scheduler_client.Client
+	tickDriverSender     chan uint64
+	tsTracker            TsTracker
+}
+
+type Transport interface {
+	Send(msg *rspb.RaftMessage) error
+}
+
+// TsTracker tracks the timestamps of the transactions on the store, it is implemented by the concurrency
+// manager of the server. Reads served by followers are recorded in it on the leader, and the leader doesn't
+// advance the resolved ts past the transactions it knows.
+type TsTracker interface {
+	// UpdateMaxTs records a read at ts, async commit transactions commit after it.
+	UpdateMaxTs(ts uint64)
+	// MinLockTs returns the smallest start ts of the locks being written, or 0 if there is none.
+	MinLockTs() uint64
+}
+
+// loadPeers loads peers in this store. It scans the db engine, loads all regions and their peers from it
+// WARN: This store should not be used before initialized.
+func (bs *Raftstore) loadPeers() ([]*peer, error) {
+	// Scan region meta to get saved regions.
+	startKey := meta.RegionMetaMinKey
+	endKey := meta.RegionMetaMaxKey
+	ctx := bs.ctx
+	kvEngine := ctx.engine.Kv
+	storeID := ctx.store.Id
+
+	var totalCount, tombStoneCount int
+	var regionPeers []*peer
+
+	t := time.Now()
+	kvWB := new(engine_util.WriteBatch)
+	raftWB := new(engine_util.WriteBatch)
+	err := kvEngine.View(func(txn *badger.Txn) error {
+		// get all regions from RegionLocalState
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek(startKey); it.Valid(); it.Next() {
+			item := it.Item()
+			if bytes.Compare(item.Key(), endKey) >= 0 {
+				break
+			}
+			regionID, suffix, err := meta.DecodeRegionMetaKey(item.Key())
+			if err != nil {
+				return err
+			}
+			if suffix != meta.RegionStateSuffix {
+				continue
+			}
+			val, err := item.Value()
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			totalCount++
+			localState := new(rspb.RegionLocalState)
+			err = localState.Unmarshal(val)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			region := localState.Region
+			if localState.State == rspb.PeerState_Tombstone {
+				tombStoneCount++
+				bs.clearStaleMeta(kvWB, raftWB, localState)
+				continue
+			}
+
+			peer, err := createPeer(storeID, ctx.cfg, ctx.applyTaskSender, ctx.snapTaskSender, ctx.engine, region)
+			if err != nil {
+				return err
+			}
+			ctx.storeMeta.regionRanges.ReplaceOrInsert(&regionItem{region: region})
+			ctx.storeMeta.regions[regionID] = region
+			// No need to check duplicated here, because we use region id as the key
+			// in DB.
+			regionPeers = append(regionPeers, peer)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	kvWB.MustWriteToDB(ctx.engine.Kv)
+	raftWB.MustWriteToDB(ctx.engine.Raft)
+
+	log.Info(fmt.Sprintf("start store %d, region_count %d, tombstone_count %d, takes %v",
+		storeID, totalCount, tombStoneCount, time.Since(t)))
+	return regionPeers, nil
+}
+
+func (bs *Raftstore) clearStaleMeta(kvWB, raftWB *engine_util.WriteBatch, originState *rspb.RegionLocalState) {
+	region := originState.Region
+	raftState, err := meta.GetRaftLocalState(bs.ctx.engine.Raft, region.Id)
+	if err != nil {
+		// it has been cleaned up.
+		return
+	}
+	err = ClearRaftMeta(bs.ctx.engine, raftWB, region.Id, raftState.LastIndex)
+	if err != nil {
+		panic(err)
+	}
+	kvWB.DeleteMeta(meta.ApplyStateKey(region.Id))
+}
+
+type workers struct {
+	applyWorker      *worker.Worker
+	schedulerWorker  *worker.Worker
+	splitCheckWorker *worker.Worker
+	snapWorker       *worker.Worker
+	raftLogGCWorker  *worker.Worker
+	wg               *sync.WaitGroup
+}
+
+type Raftstore struct {
+	ctx        *GlobalContext
+	storeState *storeState
+	router     *router
+	workers    *workers
+	tickDriver *tickDriver
+	closeCh    chan struct{}
+	wg         *sync.WaitGroup
+}
+
+func (bs *Raftstore) start(
+	meta *metapb.Store,
+	cfg *config.Config,
+	engines *engine_util.Engines,
+	trans Transport,
+	schedulerClient scheduler_client.Client,
+	tsTracker TsTracker) error {
+	if bs.workers != nil {
+		return errors.New("raftstore is already started")
+	}
+	// TODO: we can get cluster meta regularly too later.
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	wg := new(sync.WaitGroup)
+	bs.workers = &workers{
+		applyWorker:      worker.NewWorker("apply-worker", wg),
+		schedulerWorker:  worker.NewWorker("scheduler-worker", wg),
+		splitCheckWorker: worker.NewWorker("split-check", wg),
+		snapWorker:       worker.NewWorker("snap-generator", wg),
+		raftLogGCWorker:  worker.NewWorker("raft-gc-worker", wg),
+		wg:               wg,
+	}
+	bs.ctx = &GlobalContext{
+		cfg:                  cfg,
+		engine:               engines,
+		store:                meta,
+		storeMeta:            newStoreMeta(),
+		router:               bs.router,
+		trans:                trans,
+		schedulerTaskSender:  bs.workers.schedulerWorker.Sender(),
+		applyTaskSender:      bs.workers.applyWorker.Sender(),
+		splitCheckTaskSender: bs.workers.splitCheckWorker.Sender(),
+		snapTaskSender:       bs.workers.snapWorker.Sender(),
+		raftLogGCTaskSender:  bs.workers.raftLogGCWorker.Sender(),
+		schedulerClient:      schedulerClient,
+		tickDriverSender:     bs.tickDriver.newRegionCh,
+		tsTracker:            tsTracker,
+	}
+	// The apply worker must be running before the peers register their appliers.
+	bs.workers.applyWorker.Start(newApplyWorker(meta.Id, engines, bs.router))
+	regionPeers, err := bs.loadPeers()
+	if err != nil {
+		return err
+	}
+
+	for _, peer := range regionPeers {
+		peer.registerApplier()
+		bs.router.register(peer)
+	}
+	bs.startWorkers(regionPeers)
+	return nil
+}
+
+func (bs *Raftstore) startWorkers(peers []*peer) {
+	ctx := bs.ctx
+	workers := bs.workers
+	router := bs.router
+	bs.wg.Add(2) // raftWorker, storeWorker
+	rw := newRaftWorker(ctx, router)
+	go rw.run(bs.closeCh, bs.wg)
+	sw := newStoreWorker(ctx, bs.storeState)
+	go sw.run(bs.closeCh, bs.wg)
+	router.sendStore(message.Msg{Type: message.MsgTypeStoreStart, Data: ctx.store})
+	for i := 0; i < len(peers); i++ {
+		regionID := peers[i].regionId
+		_ = router.send(regionID, message.Msg{RegionID: regionID, Type: message.MsgTypeStart})
+	}
+	workers.splitCheckWorker.Start(runner.NewSplitCheckHandler(ctx.engine.Kv, NewRaftstoreRouter(router), ctx.cfg))
+	workers.snapWorker.Start(runner.NewSnapGenHandler(ctx.engine))
+	workers.raftLogGCWorker.Start(runner.NewRaftLogGCTaskHandler())
+	workers.schedulerWorker.Start(runner.NewSchedulerTaskHandler(ctx.store.Id, ctx.schedulerClient, NewRaftstoreRouter(router)))
+	go bs.tickDriver.run()
+}
+
+func (bs *Raftstore) shutDown() {
+	close(bs.closeCh)
+	bs.wg.Wait()
+	bs.tickDriver.stop()
+	if bs.workers == nil {
+		return
+	}
+	workers := bs.workers
+	bs.workers = nil
+	workers.applyWorker.Stop()
+	workers.schedulerWorker.Stop()
+	workers.splitCheckWorker.Stop()
+	workers.snapWorker.Stop()
+	workers.raftLogGCWorker.Stop()
+	workers.wg.Wait()
+}
+
+func CreateRaftstore(cfg *config.Config) (*RaftstoreRouter, *Raftstore) {
+	storeSender, storeState := newStoreState(cfg)
+	router := newRouter(storeSender)
+	raftstore := &Raftstore{
+		router:     router,
+		storeState: storeState,
+		tickDriver: newTickDriver(cfg.RaftBaseTickInterval, router, storeState.ticker),
+		closeCh:    make(chan struct{}),
+		wg:         new(sync.WaitGroup),
+	}
+	return NewRaftstoreRouter(router), raftstore
+}
@@ -0,0 +1,57 @@
+package raftstore
+
+import (
+	"bytes"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+)
+
+// The resolved ts of a region is a timestamp no transaction in the region will commit at or below any more. The
+// leader calculates it from a timestamp got from the scheduler: transactions which haven't prewritten yet get their
+// commit ts later, so they commit after it, and async commit transactions commit after the max ts the leader has
+// recorded. Only the transactions which have locks, in the kv engine or being written, may commit below it.
+//
+// The resolved ts is replicated as an admin command, so a peer which has applied it has applied all the
+// transactions committed at or below it and may serve reads there without asking the leader.
+
+// resolveTs returns the resolved ts for ts, which is right below the smallest of the start ts of the locks, 0 means
+// there is no lock.
+func resolveTs(ts uint64, lockTs ...uint64) uint64 {
+	for _, startTs := range lockTs {
+		if startTs != 0 && startTs-1 < ts {
+			ts = startTs - 1
+		}
+	}
+	return ts
+}
+
+// minLockTs returns the smallest start ts of the locks of the region in the kv engine, or 0 if there is none.
+func minLockTs(db *badger.DB, region *metapb.Region) (uint64, error) {
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	iter := engine_util.NewCFIterator(engine_util.CfLock, txn)
+	defer iter.Close()
+
+	var minTs uint64
+	for iter.Seek(region.StartKey); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		if len(region.EndKey) > 0 && bytes.Compare(item.Key(), region.EndKey) >= 0 {
+			break
+		}
+		value, err := item.Value()
+		if err != nil {
+			return 0, err
+		}
+		lock, err := mvcc.ParseLock(value)
+		if err != nil {
+			return 0, err
+		}
+		if minTs == 0 || lock.Ts < minTs {
+			minTs = lock.Ts
+		}
+	}
+	return minTs, nil
+}
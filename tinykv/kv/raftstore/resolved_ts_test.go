@@ -0,0 +1,42 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveTs(t *testing.T) {
+	require.Equal(t, uint64(100), resolveTs(100))
+	require.Equal(t, uint64(100), resolveTs(100, 0, 0))
+	require.Equal(t, uint64(89), resolveTs(100, 90, 0))
+	require.Equal(t, uint64(79), resolveTs(100, 90, 80))
+	require.Equal(t, uint64(100), resolveTs(100, 110, 120))
+}
+
+func TestMinLockTs(t *testing.T) {
+	engines := newTestEngines(t)
+	defer engines.Destroy()
+
+	region := &metapb.Region{StartKey: []byte("b"), EndKey: []byte("d")}
+	minTs, err := minLockTs(engines.Kv, region)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), minTs)
+
+	wb := new(engine_util.WriteBatch)
+	for key, ts := range map[string]uint64{"a": 10, "b": 50, "c": 40, "d": 20} {
+		lock := &mvcc.Lock{Primary: []byte(key), Ts: ts, Ttl: 100, Kind: mvcc.WriteKindPut}
+		wb.SetCF(engine_util.CfLock, []byte(key), lock.ToBytes())
+	}
+	require.Nil(t, engines.WriteKV(wb))
+
+	minTs, err = minLockTs(engines.Kv, region)
+	require.Nil(t, err)
+	require.Equal(t, uint64(40), minTs)
+	minTs, err = minLockTs(engines.Kv, &metapb.Region{StartKey: []byte("c")})
+	require.Nil(t, err)
+	require.Equal(t, uint64(20), minTs)
+}
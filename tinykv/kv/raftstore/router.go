@@ -0,0 +1,149 @@
+package raftstore
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/raft"
+
+	"github.com/pingcap/errors"
+)
+
+// peerState contains the peer states that needs to run raft command and apply command.
+type peerState struct {
+	closed uint32
+	peer   *peer
+}
+
+// router routes a message to a peer.
+type router struct {
+	peers       sync.Map // regionID -> peerState
+	peerSender  chan message.Msg
+	storeSender chan<- message.Msg
+}
+
+func newRouter(storeSender chan<- message.Msg) *router {
+	pm := &router{
+		peerSender:  make(chan message.Msg, 40960),
+		storeSender: storeSender,
+	}
+	return pm
+}
+
+func (pr *router) get(regionID uint64) *peerState {
+	v, ok := pr.peers.Load(regionID)
+	if ok {
+		return v.(*peerState)
+	}
+	return nil
+}
+
+func (pr *router) register(peer *peer) {
+	id := peer.regionId
+	newPeer := &peerState{
+		peer: peer,
+	}
+	pr.peers.Store(id, newPeer)
+}
+
+func (pr *router) close(regionID uint64) {
+	v, ok := pr.peers.Load(regionID)
+	if ok {
+		ps := v.(*peerState)
+		atomic.StoreUint32(&ps.closed, 1)
+		pr.peers.Delete(regionID)
+	}
+}
+
+func (pr *router) send(regionID uint64, msg message.Msg) error {
+	msg.RegionID = regionID
+	p := pr.get(regionID)
+	if p == nil || atomic.LoadUint32(&p.closed) == 1 {
+		return errPeerNotFound
+	}
+	pr.peerSender <- msg
+	return nil
+}
+
+// trySend is like send, but fails instead of blocking when the peers are too far behind their
+// messages.
+func (pr *router) trySend(regionID uint64, msg message.Msg) error {
+	msg.RegionID = regionID
+	p := pr.get(regionID)
+	if p == nil || atomic.LoadUint32(&p.closed) == 1 {
+		return errPeerNotFound
+	}
+	select {
+	case pr.peerSender <- msg:
+		return nil
+	default:
+		return errPeerBusy
+	}
+}
+
+func (pr *router) sendStore(msg message.Msg) {
+	pr.storeSender <- msg
+}
+
+var (
+	errPeerNotFound = errors.New("peer not found")
+	errPeerBusy     = errors.New("peer message queue is full")
+)
+
+// RaftstoreRouter is the exported router used by the server and the transport to deliver
+// messages and commands to the raftstore.
+type RaftstoreRouter struct {
+	router *router
+}
+
+func NewRaftstoreRouter(router *router) *RaftstoreRouter {
+	return &RaftstoreRouter{router: router}
+}
+
+func (r *RaftstoreRouter) Send(regionID uint64, msg message.Msg) error {
+	return r.router.send(regionID, msg)
+}
+
+// SendRaftMessage delivers a raft message from another store. Messages for a region that has
+// no peer on this store yet go to the store worker, which may create the peer.
+func (r *RaftstoreRouter) SendRaftMessage(msg *raft_serverpb.RaftMessage) error {
+	regionID := msg.RegionId
+	if r.router.send(regionID, message.NewPeerMsg(message.MsgTypeRaftMessage, regionID, msg)) != nil {
+		r.router.sendStore(message.NewPeerMsg(message.MsgTypeStoreRaftMessage, regionID, msg))
+	}
+	return nil
+}
+
+// ReportSnapshotStatus tells the peer of a region whether the snapshot it sent to another peer
+// was delivered.
+func (r *RaftstoreRouter) ReportSnapshotStatus(regionID, toPeerID uint64, status raft.SnapshotStatus) {
+	msg := &message.MsgSnapStatus{ToPeerID: toPeerID, Status: status}
+	_ = r.router.send(regionID, message.NewPeerMsg(message.MsgTypeSnapStatus, regionID, msg))
+}
+
+// ReportUnreachable tells the peer of a region that a message to another peer was dropped.
+func (r *RaftstoreRouter) ReportUnreachable(regionID, toPeerID uint64) {
+	_ = r.router.send(regionID, message.NewPeerMsg(message.MsgTypePeerUnreachable, regionID, toPeerID))
+}
+
+// SendRaftCommand delivers a command from a client. Commands are rejected rather than queued
+// when the raftstore can't keep up, so the client backs off instead of piling up requests.
+func (r *RaftstoreRouter) SendRaftCommand(req *raft_cmdpb.RaftCmdRequest, cb *message.Callback) error {
+	cmd := &message.MsgRaftCmd{
+		Request:  req,
+		Callback: cb,
+	}
+	regionID := req.Header.RegionId
+	switch err := r.router.trySend(regionID, message.NewPeerMsg(message.MsgTypeRaftCmd, regionID, cmd)); err {
+	case errPeerNotFound:
+		return &util.ErrRegionNotFound{RegionId: regionID}
+	case errPeerBusy:
+		return &util.ErrServerIsBusy{Reason: err.Error()}
+	default:
+		return err
+	}
+}
@@ -0,0 +1,79 @@
+package runner
+
+import (
+	"fmt"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap/log"
+)
+
+// RaftLogGCTask deletes the raft log entries in [StartIdx, EndIdx) of the region. StartIdx is 0
+// if the first entry is unknown, it is then looked up in the raft engine.
+type RaftLogGCTask struct {
+	RaftEngine *badger.DB
+	RegionID   uint64
+	StartIdx   uint64
+	EndIdx     uint64
+}
+
+// raftLogGCTaskHandler deletes the raft log entries made useless by a log compaction.
+type raftLogGCTaskHandler struct{}
+
+func NewRaftLogGCTaskHandler() *raftLogGCTaskHandler {
+	return &raftLogGCTaskHandler{}
+}
+
+// gcRaftLog does the GC job and returns the count of logs collected.
+func (r *raftLogGCTaskHandler) gcRaftLog(raftDb *badger.DB, regionID, startIdx, endIdx uint64) (uint64, error) {
+	firstIdx := startIdx
+	if firstIdx == 0 {
+		firstIdx = endIdx
+		err := raftDb.View(func(txn *badger.Txn) error {
+			it := txn.NewIterator(badger.DefaultIteratorOptions)
+			defer it.Close()
+			it.Seek(meta.RaftLogKey(regionID, 0))
+			if !it.ValidForPrefix(meta.RegionRaftPrefixKey(regionID)) {
+				return nil
+			}
+			idx, err := meta.RaftLogIndex(it.Item().Key())
+			if err == nil {
+				firstIdx = idx
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	if firstIdx >= endIdx {
+		log.Debug(fmt.Sprintf("[region %d] no need to gc raft log", regionID))
+		return 0, nil
+	}
+
+	raftWb := engine_util.WriteBatch{}
+	for idx := firstIdx; idx < endIdx; idx++ {
+		raftWb.DeleteMeta(meta.RaftLogKey(regionID, idx))
+	}
+	if err := raftWb.WriteToDB(raftDb); err != nil {
+		return 0, err
+	}
+	return endIdx - firstIdx, nil
+}
+
+func (r *raftLogGCTaskHandler) Handle(t worker.Task) {
+	logGcTask, ok := t.(*RaftLogGCTask)
+	if !ok {
+		log.Error(fmt.Sprintf("unsupported worker.Task: %+v", t))
+		return
+	}
+	log.Debug(fmt.Sprintf("[region %d] execute gc log, endIndex %d", logGcTask.RegionID, logGcTask.EndIdx))
+	collected, err := r.gcRaftLog(logGcTask.RaftEngine, logGcTask.RegionID, logGcTask.StartIdx, logGcTask.EndIdx)
+	if err != nil {
+		log.Error(fmt.Sprintf("[region %d] failed to gc: %v", logGcTask.RegionID, err))
+	} else {
+		log.Debug(fmt.Sprintf("[region %d] collected %d logs", logGcTask.RegionID, collected))
+	}
+}
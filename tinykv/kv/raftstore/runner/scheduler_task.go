@@ -0,0 +1,181 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/scheduler_client"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+type SchedulerAskSplitTask struct {
+	Region   *metapb.Region
+	SplitKey []byte
+	Peer     *metapb.Peer
+	Callback *message.Callback
+}
+
+type SchedulerRegionHeartbeatTask struct {
+	Region          *metapb.Region
+	Peer            *metapb.Peer
+	PendingPeers    []*metapb.Peer
+	ApproximateSize *uint64
+}
+
+type SchedulerStoreHeartbeatTask struct {
+	Stats  *schedulerpb.StoreStats
+	Engine *badger.DB
+	Path   string
+}
+
+// SchedulerResolvedTsTask gets a timestamp from the scheduler and sends it to the regions, see
+// message.MsgTypeResolvedTs.
+type SchedulerResolvedTsTask struct {
+	RegionIDs []uint64
+}
+
+// SchedulerTaskHandler reports the state of the store and its regions to the scheduler, and
+// turns the operators the scheduler sends back into admin commands.
+type SchedulerTaskHandler struct {
+	storeID         uint64
+	SchedulerClient scheduler_client.Client
+	router          message.RaftRouter
+}
+
+func NewSchedulerTaskHandler(storeID uint64, SchedulerClient scheduler_client.Client, router message.RaftRouter) *SchedulerTaskHandler {
+	return &SchedulerTaskHandler{
+		storeID:         storeID,
+		SchedulerClient: SchedulerClient,
+		router:          router,
+	}
+}
+
+func (r *SchedulerTaskHandler) Handle(t worker.Task) {
+	switch t.(type) {
+	case *SchedulerAskSplitTask:
+		r.onAskSplit(t.(*SchedulerAskSplitTask))
+	case *SchedulerRegionHeartbeatTask:
+		r.onHeartbeat(t.(*SchedulerRegionHeartbeatTask))
+	case *SchedulerStoreHeartbeatTask:
+		r.onStoreHeartbeat(t.(*SchedulerStoreHeartbeatTask))
+	case *SchedulerResolvedTsTask:
+		r.onResolvedTs(t.(*SchedulerResolvedTsTask))
+	default:
+		log.Error(fmt.Sprintf("unsupported worker.Task: %+v", t))
+	}
+}
+
+func (r *SchedulerTaskHandler) Start() {
+	r.SchedulerClient.SetRegionHeartbeatResponseHandler(r.storeID, r.onRegionHeartbeatResponse)
+}
+
+func (r *SchedulerTaskHandler) onRegionHeartbeatResponse(resp *schedulerpb.RegionHeartbeatResponse) {
+	if changePeer := resp.GetChangePeer(); changePeer != nil {
+		r.sendAdminRequest(resp.RegionId, resp.RegionEpoch, resp.TargetPeer, &raft_cmdpb.AdminRequest{
+			CmdType: raft_cmdpb.AdminCmdType_ChangePeer,
+			ChangePeer: &raft_cmdpb.ChangePeerRequest{
+				ChangeType: changePeer.ChangeType,
+				Peer:       changePeer.Peer,
+			},
+		}, message.NewCallback())
+	} else if transferLeader := resp.GetTransferLeader(); transferLeader != nil {
+		r.sendAdminRequest(resp.RegionId, resp.RegionEpoch, resp.TargetPeer, &raft_cmdpb.AdminRequest{
+			CmdType: raft_cmdpb.AdminCmdType_TransferLeader,
+			TransferLeader: &raft_cmdpb.TransferLeaderRequest{
+				Peer: transferLeader.Peer,
+			},
+		}, message.NewCallback())
+	}
+}
+
+// onAskSplit allocates the ids of the new region and its peers from the scheduler, then proposes
+// the split through the leader of the region.
+func (r *SchedulerTaskHandler) onAskSplit(t *SchedulerAskSplitTask) {
+	resp, err := r.SchedulerClient.AskSplit(context.TODO(), t.Region)
+	if err != nil {
+		log.Error("ask split failed", zap.Uint64("region", t.Region.Id), zap.Error(err))
+		t.Callback.Done(util.ErrResp(err))
+		return
+	}
+	r.sendAdminRequest(t.Region.Id, t.Region.RegionEpoch, t.Peer, &raft_cmdpb.AdminRequest{
+		CmdType: raft_cmdpb.AdminCmdType_Split,
+		Split: &raft_cmdpb.SplitRequest{
+			SplitKey:    t.SplitKey,
+			NewRegionId: resp.NewRegionId,
+			NewPeerIds:  resp.NewPeerIds,
+		},
+	}, t.Callback)
+}
+
+func (r *SchedulerTaskHandler) onResolvedTs(t *SchedulerResolvedTsTask) {
+	ts, err := r.SchedulerClient.GetTS(context.TODO())
+	if err != nil {
+		log.Error("get ts failed", zap.Error(err))
+		return
+	}
+	for _, regionID := range t.RegionIDs {
+		// The region may have been removed meanwhile.
+		_ = r.router.Send(regionID, message.NewPeerMsg(message.MsgTypeResolvedTs, regionID, ts))
+	}
+}
+
+func (r *SchedulerTaskHandler) onHeartbeat(t *SchedulerRegionHeartbeatTask) {
+	var size uint64
+	if t.ApproximateSize != nil {
+		size = *t.ApproximateSize
+	}
+	req := &schedulerpb.RegionHeartbeatRequest{
+		Region:          t.Region,
+		Leader:          t.Peer,
+		PendingPeers:    t.PendingPeers,
+		ApproximateSize: size,
+	}
+	if err := r.SchedulerClient.RegionHeartbeat(req); err != nil {
+		log.Error("region heartbeat failed", zap.Uint64("region", t.Region.Id), zap.Error(err))
+	}
+}
+
+func (r *SchedulerTaskHandler) onStoreHeartbeat(t *SchedulerStoreHeartbeatTask) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(t.Path, &stat); err != nil {
+		log.Error("get disk stat for path failed", zap.String("path", t.Path), zap.Error(err))
+		return
+	}
+	capacity := stat.Blocks * uint64(stat.Bsize)
+	lsmSize, vlogSize := t.Engine.Size()
+	usedSize := uint64(lsmSize + vlogSize)
+	available := stat.Bavail * uint64(stat.Bsize)
+	if capacity-available < usedSize {
+		usedSize = capacity - available
+	}
+	t.Stats.Capacity = capacity
+	t.Stats.UsedSize = usedSize
+	t.Stats.Available = available
+
+	if err := r.SchedulerClient.StoreHeartbeat(context.TODO(), t.Stats); err != nil {
+		log.Error("store heartbeat failed", zap.Uint64("store", t.Stats.StoreId), zap.Error(err))
+	}
+}
+
+func (r *SchedulerTaskHandler) sendAdminRequest(regionID uint64, epoch *metapb.RegionEpoch, peer *metapb.Peer, req *raft_cmdpb.AdminRequest, callback *message.Callback) {
+	cmd := &raft_cmdpb.RaftCmdRequest{
+		Header: &raft_cmdpb.RaftRequestHeader{
+			RegionId:    regionID,
+			Peer:        peer,
+			RegionEpoch: epoch,
+		},
+		AdminRequest: req,
+	}
+	if err := r.router.SendRaftCommand(cmd, callback); err != nil {
+		log.Error("send admin request failed", zap.Uint64("region", regionID), zap.Error(err))
+	}
+}
@@ -0,0 +1,114 @@
+package runner
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+)
+
+type SnapGenTask struct {
+	RegionID uint64
+	// Notifier receives the generated snapshot, or nil if the generation failed.
+	Notifier chan<- *eraftpb.Snapshot
+}
+
+// snapGenHandler builds region snapshots off the raft worker, scanning a large region can take
+// a while and would otherwise stall every other peer of the store.
+type snapGenHandler struct {
+	engines *engine_util.Engines
+}
+
+func NewSnapGenHandler(engines *engine_util.Engines) *snapGenHandler {
+	return &snapGenHandler{engines: engines}
+}
+
+func (r *snapGenHandler) Handle(t worker.Task) {
+	task, ok := t.(*SnapGenTask)
+	if !ok {
+		log.Error(fmt.Sprintf("unsupported worker.Task: %+v", t))
+		return
+	}
+	snapshot, err := GenerateSnapshot(r.engines, task.RegionID)
+	if err != nil {
+		log.Error(fmt.Sprintf("[region %d] failed to generate snapshot: %v", task.RegionID, err))
+		task.Notifier <- nil
+		return
+	}
+	task.Notifier <- snapshot
+}
+
+// GenerateSnapshot builds a snapshot of the region from a consistent view of the kv engine, so its
+// index is whatever the apply worker has applied when it is taken.
+func GenerateSnapshot(engines *engine_util.Engines, regionID uint64) (*eraftpb.Snapshot, error) {
+	txn := engines.Kv.NewTransaction(false)
+	defer txn.Discard()
+
+	applyState := new(rspb.RaftApplyState)
+	if err := engine_util.GetMetaFromTxn(txn, meta.ApplyStateKey(regionID), applyState); err != nil {
+		return nil, err
+	}
+	regionState := new(rspb.RegionLocalState)
+	if err := engine_util.GetMetaFromTxn(txn, meta.RegionStateKey(regionID), regionState); err != nil {
+		return nil, err
+	}
+	if regionState.State != rspb.PeerState_Normal {
+		return nil, errors.Errorf("snap job %d seems stale, skip", regionID)
+	}
+	index := applyState.AppliedIndex
+	term := applyState.TruncatedState.Term
+	if index != applyState.TruncatedState.Index {
+		entry, err := meta.GetRaftEntry(engines.Raft, regionID, index)
+		if err != nil {
+			return nil, err
+		}
+		term = entry.Term
+	}
+
+	region := regionState.Region
+	data := &rspb.RaftSnapshotData{Region: region}
+	for _, cf := range engine_util.CFs {
+		it := engine_util.NewCFIterator(cf, txn)
+		for it.Seek(region.StartKey); it.Valid(); it.Next() {
+			item := it.Item()
+			if engine_util.ExceedEndKey(item.Key(), region.EndKey) {
+				break
+			}
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				it.Close()
+				return nil, err
+			}
+			// The key in RaftSnapshotData keeps the column family prefix so the receiver can
+			// write it back as is.
+			key := engine_util.KeyWithCF(cf, item.KeyCopy(nil))
+			data.Data = append(data.Data, &rspb.KeyValue{Key: key, Value: value})
+			data.FileSize += uint64(len(key) + len(value))
+		}
+		it.Close()
+	}
+
+	confState := util.ConfStateFromRegion(region)
+	snapshot := &eraftpb.Snapshot{
+		Metadata: &eraftpb.SnapshotMetadata{
+			Index:     index,
+			Term:      term,
+			ConfState: &confState,
+		},
+	}
+	var err error
+	snapshot.Data, err = proto.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	log.Info(fmt.Sprintf("[region %d] generated snapshot at index %d term %d, %d keys",
+		regionID, index, term, len(data.Data)))
+	return snapshot, nil
+}
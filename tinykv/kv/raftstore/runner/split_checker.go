@@ -0,0 +1,142 @@
+package runner
+
+import (
+	"fmt"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/util/codec"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap/log"
+)
+
+type SplitCheckTask struct {
+	Region *metapb.Region
+}
+
+// splitCheckHandler scans the data of a region to get its approximate size, and asks the peer
+// to split the region if it has grown larger than RegionMaxSize.
+type splitCheckHandler struct {
+	engine  *badger.DB
+	router  message.RaftRouter
+	checker *sizeSplitChecker
+}
+
+func NewSplitCheckHandler(engine *badger.DB, router message.RaftRouter, conf *config.Config) *splitCheckHandler {
+	runner := &splitCheckHandler{
+		engine:  engine,
+		router:  router,
+		checker: newSizeSplitChecker(conf.RegionMaxSize, conf.RegionSplitSize),
+	}
+	return runner
+}
+
+// Handle checks whether the region should be split or not.
+func (r *splitCheckHandler) Handle(t worker.Task) {
+	spCheckTask, ok := t.(*SplitCheckTask)
+	if !ok {
+		log.Error(fmt.Sprintf("unsupported worker.Task: %+v", t))
+		return
+	}
+	region := spCheckTask.Region
+	regionID := region.Id
+	log.Debug(fmt.Sprintf("executing split check worker.Task: [regionId: %d, startKey: %s, endKey: %s]", regionID,
+		region.StartKey, region.EndKey))
+	key := r.splitCheck(regionID, region.StartKey, region.EndKey)
+	if key == nil {
+		log.Debug(fmt.Sprintf("[region %d] no need to send, split key not found", regionID))
+		return
+	}
+	// The keys of the transactional API are encoded with a timestamp suffix, never split
+	// between two versions of the same user key.
+	if _, userKey, err := codec.DecodeBytes(key); err == nil {
+		key = codec.EncodeBytes(userKey)
+	}
+	if err := util.CheckKeyInRegionExclusive(key, region); err != nil {
+		log.Debug(fmt.Sprintf("[region %d] split key %v is not in the region, skip", regionID, key))
+		return
+	}
+	msg := message.Msg{
+		Type:     message.MsgTypeSplitRegion,
+		RegionID: regionID,
+		Data: &message.MsgSplitRegion{
+			RegionEpoch: region.GetRegionEpoch(),
+			SplitKey:    key,
+			Callback:    message.NewCallback(),
+		},
+	}
+	if err := r.router.Send(regionID, msg); err != nil {
+		log.Warn(fmt.Sprintf("[region %d] failed to send split region message: %v", regionID, err))
+	}
+}
+
+// splitCheck scans the default column family of the region, it returns the split key if the
+// region is too large, otherwise it reports the approximate size of the region to the peer.
+func (r *splitCheckHandler) splitCheck(regionID uint64, startKey, endKey []byte) []byte {
+	txn := r.engine.NewTransaction(false)
+	defer txn.Discard()
+	r.checker.reset()
+	it := engine_util.NewCFIterator(engine_util.CfDefault, txn)
+	defer it.Close()
+	for it.Seek(startKey); it.Valid(); it.Next() {
+		item := it.Item()
+		key := item.Key()
+		if engine_util.ExceedEndKey(key, endKey) {
+			break
+		}
+		if r.checker.onKv(key, item) {
+			return r.checker.getSplitKey()
+		}
+	}
+	size := r.checker.currentSize
+	_ = r.router.Send(regionID, message.Msg{
+		Type:     message.MsgTypeRegionApproximateSize,
+		RegionID: regionID,
+		Data:     size,
+	})
+	return nil
+}
+
+// sizeSplitChecker remembers the first key after RegionSplitSize bytes, and tells the caller to
+// stop scanning once RegionMaxSize bytes have been seen.
+type sizeSplitChecker struct {
+	maxSize   uint64
+	splitSize uint64
+
+	currentSize uint64
+	splitKey    []byte
+}
+
+func newSizeSplitChecker(maxSize, splitSize uint64) *sizeSplitChecker {
+	return &sizeSplitChecker{
+		maxSize:   maxSize,
+		splitSize: splitSize,
+	}
+}
+
+func (checker *sizeSplitChecker) reset() {
+	checker.currentSize = 0
+	checker.splitKey = nil
+}
+
+func (checker *sizeSplitChecker) onKv(key []byte, item engine_util.DBItem) bool {
+	valueSize := uint64(item.ValueSize())
+	size := uint64(len(key)) + valueSize
+	checker.currentSize += size
+	if checker.currentSize > checker.splitSize && checker.splitKey == nil {
+		checker.splitKey = util.SafeCopy(key)
+	}
+	return checker.currentSize > checker.maxSize
+}
+
+func (checker *sizeSplitChecker) getSplitKey() []byte {
+	// Make sure not to split when less than maxSize for last part
+	if checker.currentSize < checker.maxSize {
+		checker.splitKey = nil
+	}
+	return checker.splitKey
+}
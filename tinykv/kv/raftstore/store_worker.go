@@ -0,0 +1,261 @@
+package raftstore
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/runner"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+)
+
+type storeState struct {
+	id       uint64
+	receiver <-chan message.Msg
+	ticker   *ticker
+}
+
+func newStoreState(cfg *config.Config) (chan<- message.Msg, *storeState) {
+	ch := make(chan message.Msg, 40960)
+	state := &storeState{
+		receiver: (<-chan message.Msg)(ch),
+		ticker:   newStoreTicker(cfg),
+	}
+	return (chan<- message.Msg)(ch), state
+}
+
+// storeWorker runs store commands, such as creating a peer for a region which doesn't exist
+// on this store yet and reporting the store heartbeat.
+type storeWorker struct {
+	*storeState
+	ctx *GlobalContext
+}
+
+func newStoreWorker(ctx *GlobalContext, state *storeState) *storeWorker {
+	return &storeWorker{
+		storeState: state,
+		ctx:        ctx,
+	}
+}
+
+func (sw *storeWorker) run(closeCh <-chan struct{}, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		var msg message.Msg
+		select {
+		case <-closeCh:
+			return
+		case msg = <-sw.receiver:
+		}
+		sw.handleMsg(msg)
+	}
+}
+
+func (d *storeWorker) onTick(tick StoreTick) {
+	switch tick {
+	case StoreTickSchedulerStoreHeartbeat:
+		d.onSchedulerStoreHeartbeatTick()
+	case StoreTickResolvedTs:
+		d.onResolvedTsTick()
+	}
+}
+
+func (d *storeWorker) handleMsg(msg message.Msg) {
+	switch msg.Type {
+	case message.MsgTypeStoreRaftMessage:
+		if err := d.onRaftMessage(msg.Data.(*rspb.RaftMessage)); err != nil {
+			log.Error(fmt.Sprintf("handle raft message failed storeID %d, %v", d.id, err))
+		}
+	case message.MsgTypeStoreTick:
+		d.onTick(msg.Data.(StoreTick))
+	case message.MsgTypeStoreStart:
+		d.start(msg.Data.(*metapb.Store))
+	}
+}
+
+func (d *storeWorker) start(store *metapb.Store) {
+	d.id = store.Id
+	d.ticker.scheduleStore(StoreTickSchedulerStoreHeartbeat)
+	d.ticker.scheduleStore(StoreTickResolvedTs)
+}
+
+// Checks if the message is targeting a stale peer.
+//
+// Returns true means the message can be dropped silently.
+func (d *storeWorker) checkMsg(msg *rspb.RaftMessage) (bool, error) {
+	regionID := msg.GetRegionId()
+	fromEpoch := msg.GetRegionEpoch()
+	msgType := msg.Message.MsgType
+	isVoteMsg := util.IsVoteMessage(msg.Message)
+	fromStoreID := msg.FromPeer.GetStoreId()
+
+	// Check if the target is tombstone.
+	localState := new(rspb.RegionLocalState)
+	err := engine_util.GetMeta(d.ctx.engine.Kv, meta.RegionStateKey(regionID), localState)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	if localState.State != rspb.PeerState_Tombstone {
+		return false, errors.Errorf("region %d not exist but not tombstone: %s", regionID, localState)
+	}
+	log.Debug(fmt.Sprintf("region %d in tombstone state: %s", regionID, localState))
+	region := localState.Region
+	regionEpoch := region.RegionEpoch
+	// The region in this peer is already destroyed
+	if util.IsEpochStale(fromEpoch, regionEpoch) {
+		log.Info(fmt.Sprintf("tombstone peer receives a stale message. region_id:%d, from_region_epoch:%s, current_region_epoch:%s, msg_type:%s",
+			regionID, fromEpoch, regionEpoch, msgType))
+		notExist := util.FindPeer(region, fromStoreID) == nil
+		handleStaleMsg(d.ctx.trans, msg, regionEpoch, isVoteMsg && notExist)
+		return true, nil
+	}
+	if fromEpoch.ConfVer == regionEpoch.ConfVer {
+		return false, errors.Errorf("tombstone peer [epoch: %s] received an invalid message %s, ignore it",
+			regionEpoch, msgType)
+	}
+	return false, nil
+}
+
+func (d *storeWorker) onRaftMessage(msg *rspb.RaftMessage) error {
+	regionID := msg.RegionId
+	if err := d.ctx.router.send(regionID, message.Msg{Type: message.MsgTypeRaftMessage, Data: msg}); err == nil {
+		return nil
+	}
+	log.Debug(fmt.Sprintf("handle raft message. from_peer:%d, to_peer:%d, store:%d, region:%d, msg_type:%s",
+		msg.FromPeer.Id, msg.ToPeer.Id, d.storeState.id, regionID, msg.Message.MsgType))
+	if msg.ToPeer.StoreId != d.ctx.store.Id {
+		log.Warn(fmt.Sprintf("store not match, ignore it. store_id:%d, to_store_id:%d, region_id:%d",
+			d.ctx.store.Id, msg.ToPeer.StoreId, regionID))
+		return nil
+	}
+	if msg.RegionEpoch == nil {
+		log.Error(fmt.Sprintf("missing region epoch in raft message, ignore it. region_id:%d", regionID))
+		return nil
+	}
+	if msg.IsTombstone {
+		// Target tombstone peer doesn't exist, so ignore it.
+		return nil
+	}
+	ok, err := d.checkMsg(msg)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+	created, err := d.maybeCreatePeer(regionID, msg)
+	if err != nil {
+		return err
+	}
+	if !created {
+		return nil
+	}
+	_ = d.ctx.router.send(regionID, message.Msg{Type: message.MsgTypeRaftMessage, Data: msg})
+	return nil
+}
+
+// If target peer doesn't exist, create it.
+//
+// return false to indicate that target peer is in invalid state or
+// doesn't exist and can't be created.
+func (d *storeWorker) maybeCreatePeer(regionID uint64, msg *rspb.RaftMessage) (bool, error) {
+	meta := d.ctx.storeMeta
+	meta.Lock()
+	defer meta.Unlock()
+	if _, ok := meta.regions[regionID]; ok {
+		return true, nil
+	}
+	if !util.IsInitialMsg(msg.Message) {
+		log.Debug(fmt.Sprintf("target peer %s doesn't exist", msg.ToPeer))
+		return false, nil
+	}
+
+	for _, region := range meta.getOverlapRegions(&metapb.Region{
+		StartKey: msg.StartKey,
+		EndKey:   msg.EndKey,
+	}) {
+		log.Debug(fmt.Sprintf("msg %s of region %d is overlapped with exist region %s",
+			msg.Message.MsgType, regionID, region))
+		return false, nil
+	}
+
+	peer, err := replicatePeer(
+		d.ctx.store.Id, d.ctx.cfg, d.ctx.applyTaskSender, d.ctx.snapTaskSender, d.ctx.engine, regionID, msg.ToPeer)
+	if err != nil {
+		return false, err
+	}
+	peer.registerApplier()
+	// following snapshot may overlap, should insert into region_ranges after
+	// snapshot is applied.
+	meta.regions[regionID] = peer.Region()
+	d.ctx.router.register(peer)
+	_ = d.ctx.router.send(regionID, message.Msg{Type: message.MsgTypeStart})
+	return true, nil
+}
+
+func (d *storeWorker) storeHeartbeatScheduler() {
+	stats := new(schedulerpb.StoreStats)
+	stats.StoreId = d.ctx.store.Id
+	d.ctx.storeMeta.RLock()
+	stats.RegionCount = uint32(len(d.ctx.storeMeta.regions))
+	d.ctx.storeMeta.RUnlock()
+	d.ctx.schedulerTaskSender <- &runner.SchedulerStoreHeartbeatTask{
+		Stats:  stats,
+		Engine: d.ctx.engine.Kv,
+		Path:   d.ctx.cfg.DBPath,
+	}
+}
+
+func (d *storeWorker) onSchedulerStoreHeartbeatTick() {
+	d.storeHeartbeatScheduler()
+	d.ticker.scheduleStore(StoreTickSchedulerStoreHeartbeat)
+}
+
+// onResolvedTsTick asks the scheduler worker for a new timestamp, the leaders of the regions on the store advance
+// their resolved ts with it.
+func (d *storeWorker) onResolvedTsTick() {
+	d.ticker.scheduleStore(StoreTickResolvedTs)
+	d.ctx.storeMeta.RLock()
+	regionIDs := make([]uint64, 0, len(d.ctx.storeMeta.regions))
+	for regionID := range d.ctx.storeMeta.regions {
+		regionIDs = append(regionIDs, regionID)
+	}
+	d.ctx.storeMeta.RUnlock()
+	d.ctx.schedulerTaskSender <- &runner.SchedulerResolvedTsTask{RegionIDs: regionIDs}
+}
+
+func handleStaleMsg(trans Transport, msg *rspb.RaftMessage, curEpoch *metapb.RegionEpoch,
+	needGC bool) {
+	regionID := msg.RegionId
+	fromPeer := msg.FromPeer
+	toPeer := msg.ToPeer
+	msgType := msg.Message.GetMsgType()
+
+	if !needGC {
+		log.Info(fmt.Sprintf("raft message is stale, ignore it. region_id:%d, from_peer_id:%d, to_peer_id:%d, msg_type:%s, current_region_epoch:%s",
+			regionID, fromPeer.Id, toPeer.Id, msgType, curEpoch))
+		return
+	}
+	gcMsg := &rspb.RaftMessage{
+		RegionId:    regionID,
+		FromPeer:    toPeer,
+		ToPeer:      fromPeer,
+		RegionEpoch: curEpoch,
+		IsTombstone: true,
+	}
+	if err := trans.Send(gcMsg); err != nil {
+		log.Error(fmt.Sprintf("send message failed. region_id:%d, error:%v", regionID, err))
+	}
+}
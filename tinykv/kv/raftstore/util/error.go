@@ -0,0 +1,80 @@
+package util
+
+import (
+	"fmt"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap/errors"
+)
+
+type ErrNotLeader struct {
+	RegionId uint64
+	Leader   *metapb.Peer
+}
+
+func (e *ErrNotLeader) Error() string {
+	return fmt.Sprintf("region %v is not leader", e.RegionId)
+}
+
+type ErrRegionNotFound struct {
+	RegionId uint64
+}
+
+func (e *ErrRegionNotFound) Error() string {
+	return fmt.Sprintf("region %v is not found", e.RegionId)
+}
+
+type ErrKeyNotInRegion struct {
+	Key    []byte
+	Region *metapb.Region
+}
+
+func (e *ErrKeyNotInRegion) Error() string {
+	return fmt.Sprintf("key %v is not in region %v", e.Key, e.Region)
+}
+
+type ErrEpochNotMatch struct {
+	Message string
+	Regions []*metapb.Region
+}
+
+func (e *ErrEpochNotMatch) Error() string {
+	return fmt.Sprintf("epoch not match, error msg %v, regions %v", e.Message, e.Regions)
+}
+
+type ErrStaleCommand struct{}
+
+func (e *ErrStaleCommand) Error() string {
+	return fmt.Sprintf("stale command")
+}
+
+type ErrStoreNotMatch struct {
+	RequestStoreId uint64
+	ActualStoreId  uint64
+}
+
+func (e *ErrStoreNotMatch) Error() string {
+	return fmt.Sprintf("store not match, request store id is %v, but actual store id is %v", e.RequestStoreId, e.ActualStoreId)
+}
+
+// ErrDataIsNotReady is returned for a stale read when the peer hasn't applied all the data committed before
+// the read ts.
+type ErrDataIsNotReady struct {
+	RegionId uint64
+	PeerId   uint64
+	SafeTs   uint64
+}
+
+func (e *ErrDataIsNotReady) Error() string {
+	return fmt.Sprintf("data is not ready, region %v, peer %v, safe ts %v", e.RegionId, e.PeerId, e.SafeTs)
+}
+
+type ErrServerIsBusy struct {
+	Reason string
+}
+
+func (e *ErrServerIsBusy) Error() string {
+	return fmt.Sprintf("server is busy, reason: %v", e.Reason)
+}
+
+var ErrStopped = errors.New("raftstore is stopped")
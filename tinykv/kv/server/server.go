@@ -1,15 +1,30 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"time"
 
+	"github.com/pingcap-incubator/tinykv/kv/backup"
+	"github.com/pingcap-incubator/tinykv/kv/cdc"
 	"github.com/pingcap-incubator/tinykv/kv/coprocessor"
 	"github.com/pingcap-incubator/tinykv/kv/storage"
+	"github.com/pingcap-incubator/tinykv/kv/storage/raft_storage"
 	"github.com/pingcap-incubator/tinykv/kv/transaction/commands"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/concurrency"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/deadlock"
 	"github.com/pingcap-incubator/tinykv/kv/transaction/latches"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	coppb "github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/kv"
 )
 
@@ -19,21 +34,32 @@ var _ tinykvpb.TinyKvServer = new(Server)
 // It implements the `TinyKvServer` interface, these interface will be used by the tinysql server or the tinykv
 // client.
 type Server struct {
-	storage    storage.Storage
-	Latches    *latches.Latches
+	storage            storage.Storage
+	Latches            *latches.Latches
+	Detector           *deadlock.Detector
+	ConcurrencyManager *concurrency.Manager
+	// CDC writes for the transactional commands and streams their changes, see package cdc.
+	CDC *cdc.Hub
+	// RawTTL makes the raw commands store a time to live with each value. It changes how raw values are stored, so
+	// it must not change once a store holds raw data.
+	RawTTL     bool
 	copHandler *coprocessor.CopHandler
 }
 
 func NewServer(storage storage.Storage) *Server {
+	concurrencyManager := concurrency.NewManager()
 	return &Server{
-		storage: storage,
-		Latches: latches.NewLatches(),
+		storage:            storage,
+		Latches:            latches.NewLatches(),
+		Detector:           deadlock.NewDetector(deadlock.DefaultTTL),
+		ConcurrencyManager: concurrencyManager,
+		CDC:                cdc.NewHub(storage, concurrencyManager),
 	}
 }
 
 // Run runs a transactional command.
 func (server *Server) Run(cmd commands.Command) (interface{}, error) {
-	return commands.RunCommand(cmd, server.storage, server.Latches)
+	return commands.RunCommand(cmd, server.CDC, server.Latches)
 }
 
 // The below functions are Server's gRPC API (implements TinyKvServer).
@@ -43,6 +69,9 @@ func (server *Server) Run(cmd commands.Command) (interface{}, error) {
 
 // KvGet returns the value of the key, the visibility is judged by the `Version` field of `GetRequest`.
 func (server *Server) KvGet(_ context.Context, req *kvrpcpb.GetRequest) (*kvrpcpb.GetResponse, error) {
+	if locked := new(kvrpcpb.GetResponse); server.ConcurrencyManager.ReadKey(req.Key, req.Version, locked) {
+		return locked, nil
+	}
 	cmd := commands.NewGet(req)
 	resp, err := server.Run(&cmd)
 	if err != nil {
@@ -54,10 +83,44 @@ func (server *Server) KvGet(_ context.Context, req *kvrpcpb.GetRequest) (*kvrpcp
 	return resp.(*kvrpcpb.GetResponse), err
 }
 
+// KvBatchGet returns the values of many keys at the `Version` field of `BatchGetRequest`, keys which don't exist are
+// left out of the response.
+func (server *Server) KvBatchGet(_ context.Context, req *kvrpcpb.BatchGetRequest) (*kvrpcpb.BatchGetResponse, error) {
+	// Keys locked in memory are reported without reading them, the others are read by the command.
+	var locked []*kvrpcpb.KvPair
+	keys := make([][]byte, 0, len(req.Keys))
+	for _, key := range req.Keys {
+		if pair := (&kvrpcpb.KvPair{Key: key}); server.ConcurrencyManager.ReadKey(key, req.Version, pair) {
+			locked = append(locked, pair)
+		} else {
+			keys = append(keys, key)
+		}
+	}
+	cmd := commands.NewBatchGet(&kvrpcpb.BatchGetRequest{Context: req.Context, Keys: keys, Version: req.Version})
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.BatchGetResponse))
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*kvrpcpb.BatchGetResponse), nil
+	}
+	batchGetResp := resp.(*kvrpcpb.BatchGetResponse)
+	batchGetResp.Pairs = append(batchGetResp.Pairs, locked...)
+	return batchGetResp, nil
+}
+
 // KvScan returns the valuee of all the keys in a specific range defined by the `startKey` of the ScanRequest.
 // The visibility is judged by the `Version` field of `ScanRequest`.
 func (server *Server) KvScan(_ context.Context, req *kvrpcpb.ScanRequest) (*kvrpcpb.ScanResponse, error) {
-
+	// Check the memory locks in the range the scan actually reads, a reverse scan reads [EndKey, StartKey).
+	startKey, endKey := req.StartKey, req.EndKey
+	if req.Reverse {
+		startKey, endKey = req.EndKey, req.StartKey
+	}
+	if keyError := server.ConcurrencyManager.ReadRange(startKey, endKey, req.Version); keyError != nil {
+		return &kvrpcpb.ScanResponse{Pairs: []*kvrpcpb.KvPair{{Key: keyError.Locked.Key, Error: keyError}}}, nil
+	}
 	cmd := commands.NewScan(req)
 	resp, err := server.Run(&cmd)
 	if err != nil {
@@ -71,7 +134,24 @@ func (server *Server) KvScan(_ context.Context, req *kvrpcpb.ScanRequest) (*kvrp
 
 // KvPrewrite is the main entry of transactional write, the first stage of 2PC.
 func (server *Server) KvPrewrite(_ context.Context, req *kvrpcpb.PrewriteRequest) (*kvrpcpb.PrewriteResponse, error) {
-	cmd := commands.NewPrewrite(req)
+	if req.UseAsyncCommit || req.TryOnePc {
+		// The commit ts is calculated from the max ts, keep the locks in memory so that reads which bump the max ts
+		// after that don't miss them, see package concurrency.
+		keys := make([][]byte, 0, len(req.Mutations))
+		for _, m := range req.Mutations {
+			keys = append(keys, m.Key)
+			// The kind of the lock decides whether it blocks reads, like the lock the prewrite writes.
+			server.ConcurrencyManager.LockKeys([][]byte{m.Key}, &mvcc.Lock{
+				Primary:        req.PrimaryLock,
+				Ts:             req.StartVersion,
+				Ttl:            req.LockTtl,
+				Kind:           mvcc.WriteKindFromProto(m.Op),
+				UseAsyncCommit: req.UseAsyncCommit,
+			})
+		}
+		defer server.ConcurrencyManager.UnlockKeys(keys, req.StartVersion)
+	}
+	cmd := commands.NewPrewrite(req, server.ConcurrencyManager)
 	resp, err := server.Run(&cmd)
 	if err != nil {
 		resp, err = regionError(err, new(kvrpcpb.PrewriteResponse))
@@ -84,6 +164,7 @@ func (server *Server) KvPrewrite(_ context.Context, req *kvrpcpb.PrewriteRequest
 
 // KvCommit is the main entry of transactional write, the second stage of 2PC.
 func (server *Server) KvCommit(_ context.Context, req *kvrpcpb.CommitRequest) (*kvrpcpb.CommitResponse, error) {
+	server.Detector.CleanUp(req.StartVersion)
 	cmd := commands.NewCommit(req)
 	resp, err := server.Run(&cmd)
 	if err != nil {
@@ -111,8 +192,37 @@ func (server *Server) KvCheckTxnStatus(_ context.Context, req *kvrpcpb.CheckTxnS
 	return resp.(*kvrpcpb.CheckTxnStatusResponse), err
 }
 
+// KvTxnHeartBeat extends the TTL of a transaction's primary lock, a long-running transaction sends it periodically so
+// that other transactions don't consider its locks expired.
+func (server *Server) KvTxnHeartBeat(_ context.Context, req *kvrpcpb.TxnHeartBeatRequest) (*kvrpcpb.TxnHeartBeatResponse, error) {
+	cmd := commands.NewTxnHeartBeat(req)
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.TxnHeartBeatResponse))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp.(*kvrpcpb.TxnHeartBeatResponse), err
+}
+
+// KvCheckSecondaryLocks decides the status of the secondary keys of an async commit transaction whose primary lock has
+// expired.
+func (server *Server) KvCheckSecondaryLocks(_ context.Context, req *kvrpcpb.CheckSecondaryLocksRequest) (*kvrpcpb.CheckSecondaryLocksResponse, error) {
+	cmd := commands.NewCheckSecondaryLocks(req)
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.CheckSecondaryLocksResponse))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp.(*kvrpcpb.CheckSecondaryLocksResponse), err
+}
+
 // KvBatchRollback is used rollback the transaction lock keys if the transaction will NOT commit.
 func (server *Server) KvBatchRollback(_ context.Context, req *kvrpcpb.BatchRollbackRequest) (*kvrpcpb.BatchRollbackResponse, error) {
+	server.Detector.CleanUp(req.StartVersion)
 	cmd := commands.NewRollback(req)
 	resp, err := server.Run(&cmd)
 	if err != nil {
@@ -126,6 +236,7 @@ func (server *Server) KvBatchRollback(_ context.Context, req *kvrpcpb.BatchRollb
 
 // KvResolveLock is used to resolve the prewrite lock if the related transaction status is decided(commit/rollback).
 func (server *Server) KvResolveLock(_ context.Context, req *kvrpcpb.ResolveLockRequest) (*kvrpcpb.ResolveLockResponse, error) {
+	server.Detector.CleanUp(req.StartVersion)
 	cmd := commands.NewResolveLock(req)
 	resp, err := server.Run(&cmd)
 	if err != nil {
@@ -137,14 +248,186 @@ func (server *Server) KvResolveLock(_ context.Context, req *kvrpcpb.ResolveLockR
 	return resp.(*kvrpcpb.ResolveLockResponse), err
 }
 
+// KvPessimisticLock is used to lock keys of a pessimistic transaction before it is prewritten.
+func (server *Server) KvPessimisticLock(_ context.Context, req *kvrpcpb.PessimisticLockRequest) (*kvrpcpb.PessimisticLockResponse, error) {
+	cmd := commands.NewPessimisticLock(req)
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.PessimisticLockResponse))
+		if err != nil {
+			return nil, err
+		}
+	}
+	server.detectDeadlock(req, resp.(*kvrpcpb.PessimisticLockResponse))
+	return resp.(*kvrpcpb.PessimisticLockResponse), err
+}
+
+// KvPessimisticRollback is used to release the pessimistic locks of a statement which failed.
+func (server *Server) KvPessimisticRollback(_ context.Context, req *kvrpcpb.PessimisticRollbackRequest) (*kvrpcpb.PessimisticRollbackResponse, error) {
+	server.Detector.CleanUp(req.StartVersion)
+	cmd := commands.NewPessimisticRollback(req)
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.PessimisticRollbackResponse))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp.(*kvrpcpb.PessimisticRollbackResponse), err
+}
+
+// KvScanLock is used to find the locks which must be resolved before a GC.
+func (server *Server) KvScanLock(_ context.Context, req *kvrpcpb.ScanLockRequest) (*kvrpcpb.ScanLockResponse, error) {
+	cmd := commands.NewScanLock(req)
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.ScanLockResponse))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp.(*kvrpcpb.ScanLockResponse), err
+}
+
+// KvGC is used to remove old versions of keys once the GC safe point has advanced.
+func (server *Server) KvGC(_ context.Context, req *kvrpcpb.GCRequest) (*kvrpcpb.GCResponse, error) {
+	cmd := commands.NewGC(req)
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.GCResponse))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp.(*kvrpcpb.GCResponse), err
+}
+
+// deleteRangeBatchSize is the number of keys KvDeleteRange deletes in one write to storage.
+const deleteRangeBatchSize = 4096
+
+// KvDeleteRange removes all the data of the keys in a range, such as a dropped table. It doesn't run as a command: no
+// transaction may use the range any more, so it needs no latches and writes a batch of deletes at a time.
+func (server *Server) KvDeleteRange(_ context.Context, req *kvrpcpb.DeleteRangeRequest) (*kvrpcpb.DeleteRangeResponse, error) {
+	response := new(kvrpcpb.DeleteRangeResponse)
+	for {
+		reader, err := server.storage.Reader(req.Context)
+		if rawRegionError(err, response) {
+			return response, nil
+		}
+		txn := mvcc.NewTxn(reader, 0)
+		more, err := mvcc.DeleteRange(&txn, req.StartKey, req.EndKey, deleteRangeBatchSize)
+		reader.Close()
+		if err == nil && len(txn.Writes()) > 0 {
+			err = server.storage.Write(req.Context, txn.Writes())
+		}
+		if rawRegionError(err, response) || !more {
+			return response, nil
+		}
+	}
+}
+
+// ChangeData streams the changes of a range of a region, see kvrpcpb.ChangeDataRequest.
+func (server *Server) ChangeData(req *kvrpcpb.ChangeDataRequest, stream tinykvpb.TinyKv_ChangeDataServer) error {
+	return server.CDC.Subscribe(req, stream)
+}
+
+// Backup writes the values of a range of a region at the backup ts to a file, see kvrpcpb.BackupRequest.
+func (server *Server) Backup(_ context.Context, req *kvrpcpb.BackupRequest) (*kvrpcpb.BackupResponse, error) {
+	resp := new(kvrpcpb.BackupResponse)
+	if keyError := server.ConcurrencyManager.ReadRange(req.StartKey, req.EndKey, req.BackupTs); keyError != nil {
+		resp.Locked = keyError.Locked
+		return resp, nil
+	}
+	// Name the file after the range, so that the backups of the ranges of a region don't overwrite each other and a
+	// retried backup overwrites the file of the failed one.
+	name := fmt.Sprintf("%d_%x.sst", req.Context.GetRegionId(), sha256.Sum256(req.StartKey))
+	var checksum *backup.Checksum
+	reader, err := server.storage.Reader(req.Context)
+	if err == nil {
+		txn := mvcc.RoTxn{Reader: reader, StartTS: req.BackupTs}
+		checksum, err = mvcc.Backup(&txn, filepath.Join(req.Path, name), req.StartKey, req.EndKey)
+		reader.Close()
+	}
+	if keyError, ok := errors.Cause(err).(*mvcc.KeyError); ok && keyError.Locked != nil {
+		resp.Locked = keyError.Locked
+	} else if err != nil {
+		if _, err := regionError(err, resp); err != nil {
+			resp.OtherError = err.Error()
+		}
+	} else if checksum != nil {
+		resp.Files = append(resp.Files, &kvrpcpb.BackupFile{
+			Name:       name,
+			StartKey:   req.StartKey,
+			EndKey:     req.EndKey,
+			Crc64Xor:   checksum.Crc64Xor,
+			TotalKvs:   checksum.TotalKvs,
+			TotalBytes: checksum.TotalBytes,
+		})
+	}
+	return resp, nil
+}
+
+// restoreBatchSize is the number of pairs Restore ingests in one write to storage.
+const restoreBatchSize = 4096
+
+// Restore ingests a backup file into a region, see kvrpcpb.RestoreRequest. Like KvDeleteRange it doesn't run as a
+// command: no transaction uses the restored range yet, so it needs no latches and writes a batch of pairs at a time.
+func (server *Server) Restore(_ context.Context, req *kvrpcpb.RestoreRequest) (*kvrpcpb.RestoreResponse, error) {
+	response := new(kvrpcpb.RestoreResponse)
+	if filepath.Base(req.Name) != req.Name {
+		response.Error = fmt.Sprintf("invalid backup file name %q", req.Name)
+		return response, nil
+	}
+	r, err := backup.OpenFile(filepath.Join(req.Path, req.Name))
+	if rawRegionError(err, response) {
+		return response, nil
+	}
+	checksum := new(backup.Checksum)
+	for more := true; more; {
+		txn := mvcc.NewTxn(nil, req.RestoreTs)
+		more, err = mvcc.Restore(&txn, r, req, restoreBatchSize, checksum)
+		if err == nil && len(txn.Writes()) > 0 {
+			err = server.storage.Write(req.Context, txn.Writes())
+		}
+		if rawRegionError(err, response) {
+			return response, nil
+		}
+	}
+	response.Crc64Xor = checksum.Crc64Xor
+	response.TotalKvs = checksum.TotalKvs
+	response.TotalBytes = checksum.TotalBytes
+	return response, nil
+}
+
+// detectDeadlock reports keys which a pessimistic lock request found locked to the deadlock detector; the client will
+// wait for these locks before it retries. If waiting would deadlock, the locked error is replaced by a deadlock error.
+func (server *Server) detectDeadlock(req *kvrpcpb.PessimisticLockRequest, resp *kvrpcpb.PessimisticLockResponse) {
+	if resp.RegionError != nil {
+		return
+	}
+	if len(resp.Errors) == 0 {
+		server.Detector.CleanUp(req.StartVersion)
+		return
+	}
+	for i, keyError := range resp.Errors {
+		if keyError.Locked == nil {
+			continue
+		}
+		if dl := server.Detector.Detect(req.StartVersion, keyError.Locked.LockVersion, keyError.Locked.Key); dl != nil {
+			resp.Errors[i] = &kvrpcpb.KeyError{Deadlock: dl}
+		}
+	}
+}
+
 // Raw API. These commands are handled inline rather than by using Run and am implementation of the Commands interface.
 // This is because these commands are fairly straightforward and do not share a lot of code with the transactional
-// commands.
+// commands. The raw writes take the latches of their keys, so that RawCompareAndSwap is atomic.
 func (server *Server) RawGet(_ context.Context, req *kvrpcpb.RawGetRequest) (*kvrpcpb.RawGetResponse, error) {
 	response := new(kvrpcpb.RawGetResponse)
-	reader, err := server.storage.Reader(req.Context) // TODO:这里的reader没没有close可能会造成内存泄漏
+	reader, err := server.storage.Reader(req.Context)
 	if !rawRegionError(err, response) {
-		val, err := reader.GetCF(req.Cf, req.Key)
+		defer reader.Close()
+		val, _, err := server.rawGet(reader, req.Cf, req.Key)
 		if err != nil {
 			rawRegionError(err, response)
 		} else if val == nil {
@@ -157,21 +440,61 @@ func (server *Server) RawGet(_ context.Context, req *kvrpcpb.RawGetRequest) (*kv
 	return response, nil
 }
 
+// RawBatchGet returns the values of many keys, keys which don't exist are left out of the response.
+func (server *Server) RawBatchGet(_ context.Context, req *kvrpcpb.RawBatchGetRequest) (*kvrpcpb.RawBatchGetResponse, error) {
+	response := new(kvrpcpb.RawBatchGetResponse)
+	reader, err := server.storage.Reader(req.Context)
+	if rawRegionError(err, response) {
+		return response, nil
+	}
+	defer reader.Close()
+	for _, key := range req.Keys {
+		val, _, err := server.rawGet(reader, req.Cf, key)
+		if rawRegionError(err, response) {
+			response.Pairs = nil
+			break
+		}
+		if val != nil {
+			response.Pairs = append(response.Pairs, &kvrpcpb.KvPair{Key: key, Value: val})
+		}
+	}
+	return response, nil
+}
+
 func (server *Server) RawPut(_ context.Context, req *kvrpcpb.RawPutRequest) (*kvrpcpb.RawPutResponse, error) {
 	response := new(kvrpcpb.RawPutResponse)
-	err := server.storage.Write(req.Context, []storage.Modify{{
+	val, err := server.encodeRawValue(req.Value, req.Ttl)
+	if rawRegionError(err, response) {
+		return response, nil
+	}
+	err = server.rawWrite(req.Context, []storage.Modify{{
 		Data: storage.Put{
 			Key:   req.Key,
-			Value: req.Value,
+			Value: val,
 			Cf:    req.Cf,
 		}}})
 	rawRegionError(err, response)
 	return response, nil
 }
 
+// RawBatchPut writes many pairs at once, with the same time to live.
+func (server *Server) RawBatchPut(_ context.Context, req *kvrpcpb.RawBatchPutRequest) (*kvrpcpb.RawBatchPutResponse, error) {
+	response := new(kvrpcpb.RawBatchPutResponse)
+	modifies := make([]storage.Modify, 0, len(req.Pairs))
+	for _, pair := range req.Pairs {
+		val, err := server.encodeRawValue(pair.Value, req.Ttl)
+		if rawRegionError(err, response) {
+			return response, nil
+		}
+		modifies = append(modifies, storage.Modify{Data: storage.Put{Key: pair.Key, Value: val, Cf: req.Cf}})
+	}
+	rawRegionError(server.rawWrite(req.Context, modifies), response)
+	return response, nil
+}
+
 func (server *Server) RawDelete(_ context.Context, req *kvrpcpb.RawDeleteRequest) (*kvrpcpb.RawDeleteResponse, error) {
 	response := new(kvrpcpb.RawDeleteResponse)
-	err := server.storage.Write(req.Context, []storage.Modify{{
+	err := server.rawWrite(req.Context, []storage.Modify{{
 		Data: storage.Delete{
 			Key: req.Key,
 			Cf:  req.Cf,
@@ -180,24 +503,82 @@ func (server *Server) RawDelete(_ context.Context, req *kvrpcpb.RawDeleteRequest
 	return response, nil
 }
 
+// RawBatchDelete deletes many keys at once.
+func (server *Server) RawBatchDelete(_ context.Context, req *kvrpcpb.RawBatchDeleteRequest) (*kvrpcpb.RawBatchDeleteResponse, error) {
+	response := new(kvrpcpb.RawBatchDeleteResponse)
+	modifies := make([]storage.Modify, 0, len(req.Keys))
+	for _, key := range req.Keys {
+		modifies = append(modifies, storage.Modify{Data: storage.Delete{Key: key, Cf: req.Cf}})
+	}
+	rawRegionError(server.rawWrite(req.Context, modifies), response)
+	return response, nil
+}
+
+// RawDeleteRange deletes the keys of a range, a batch of them at a time. It can't take the latches of a range, so a
+// RawCompareAndSwap which runs at the same time may see a key of the range before or after it is deleted.
+func (server *Server) RawDeleteRange(_ context.Context, req *kvrpcpb.RawDeleteRangeRequest) (*kvrpcpb.RawDeleteRangeResponse, error) {
+	response := new(kvrpcpb.RawDeleteRangeResponse)
+	for {
+		reader, err := server.storage.Reader(req.Context)
+		if rawRegionError(err, response) {
+			return response, nil
+		}
+		var deletes []storage.Modify
+		it := reader.IterCF(req.Cf)
+		for it.Seek(req.StartKey); it.Valid() && len(deletes) < deleteRangeBatchSize; it.Next() {
+			key := it.Item().KeyCopy(nil)
+			if engine_util.ExceedEndKey(key, req.EndKey) {
+				break
+			}
+			deletes = append(deletes, storage.Modify{Data: storage.Delete{Key: key, Cf: req.Cf}})
+		}
+		it.Close()
+		reader.Close()
+		if len(deletes) == 0 {
+			return response, nil
+		}
+		if rawRegionError(server.storage.Write(req.Context, deletes), response) || len(deletes) < deleteRangeBatchSize {
+			return response, nil
+		}
+	}
+}
+
 func (server *Server) RawScan(_ context.Context, req *kvrpcpb.RawScanRequest) (*kvrpcpb.RawScanResponse, error) {
 	response := new(kvrpcpb.RawScanResponse)
 
 	reader, err := server.storage.Reader(req.Context)
 	if !rawRegionError(err, response) {
-		// To scan, we need to get an iterator for the underlying storage.
-		it := reader.IterCF(req.Cf)
+		defer reader.Close()
+		// To scan, we need to get an iterator for the underlying storage. A reverse scan walks down from its start key,
+		// which it leaves out, to its end key.
+		var it engine_util.DBIterator
+		if req.Reverse {
+			it = reader.ReverseIterCF(req.Cf)
+		} else {
+			it = reader.IterCF(req.Cf)
+		}
 		defer it.Close()
-		// Initialize the iterator. Termination condition is that the iterator is still valid (i.e.
-		// we have not reached the end of the DB) and we haven't exceeded the client-specified limit.
-		for it.Seek(req.StartKey); it.Valid() && len(response.Kvs) < int(req.Limit); it.Next() {
+		it.Seek(req.StartKey)
+		if req.Reverse && len(req.StartKey) > 0 && it.Valid() && bytes.Equal(it.Item().Key(), req.StartKey) {
+			it.Next()
+		}
+		// Termination condition is that the iterator is still valid (i.e. we have not reached the end of the DB or of
+		// the range) and we haven't exceeded the client-specified limit.
+		for ; it.Valid() && len(response.Kvs) < int(req.Limit); it.Next() {
 			item := it.Item()
+			if req.Reverse && len(req.EndKey) > 0 && bytes.Compare(item.Key(), req.EndKey) < 0 ||
+				!req.Reverse && engine_util.ExceedEndKey(item.Key(), req.EndKey) {
+				break
+			}
 			key := item.KeyCopy(nil)
 			value, err := item.ValueCopy(nil)
+			if err == nil {
+				value, _, err = server.decodeRawValue(value)
+			}
 			if err != nil {
 				rawRegionError(err, response)
 				break
-			} else {
+			} else if value != nil {
 				response.Kvs = append(response.Kvs, &kvrpcpb.KvPair{
 					Key:   key,
 					Value: value,
@@ -209,25 +590,151 @@ func (server *Server) RawScan(_ context.Context, req *kvrpcpb.RawScanRequest) (*
 	return response, nil
 }
 
+// RawCompareAndSwap writes a value if the current value of the key is the expected one. It holds the latch of the key
+// between reading and writing it.
+func (server *Server) RawCompareAndSwap(_ context.Context, req *kvrpcpb.RawCASRequest) (*kvrpcpb.RawCASResponse, error) {
+	response := new(kvrpcpb.RawCASResponse)
+	val, err := server.encodeRawValue(req.Value, req.Ttl)
+	if rawRegionError(err, response) {
+		return response, nil
+	}
+	keys := [][]byte{req.Key}
+	server.Latches.WaitForLatches(keys)
+	defer server.Latches.ReleaseLatches(keys)
+
+	reader, err := server.storage.Reader(req.Context)
+	if rawRegionError(err, response) {
+		return response, nil
+	}
+	previous, _, err := server.rawGet(reader, req.Cf, req.Key)
+	reader.Close()
+	if rawRegionError(err, response) {
+		return response, nil
+	}
+	response.PreviousNotExist = previous == nil
+	response.PreviousValue = previous
+	if req.PreviousNotExist != (previous == nil) || previous != nil && !bytes.Equal(previous, req.PreviousValue) {
+		return response, nil
+	}
+	err = server.storage.Write(req.Context, []storage.Modify{{
+		Data: storage.Put{
+			Key:   req.Key,
+			Value: val,
+			Cf:    req.Cf,
+		}}})
+	if !rawRegionError(err, response) {
+		response.Succeed = true
+	}
+	return response, nil
+}
+
+// RawGetKeyTTL returns the remaining time to live of a key, it needs raw TTLs to be enabled.
+func (server *Server) RawGetKeyTTL(_ context.Context, req *kvrpcpb.RawGetKeyTTLRequest) (*kvrpcpb.RawGetKeyTTLResponse, error) {
+	response := new(kvrpcpb.RawGetKeyTTLResponse)
+	if !server.RawTTL {
+		response.Error = errRawTTLDisabled.Error()
+		return response, nil
+	}
+	reader, err := server.storage.Reader(req.Context)
+	if rawRegionError(err, response) {
+		return response, nil
+	}
+	defer reader.Close()
+	val, ttl, err := server.rawGet(reader, req.Cf, req.Key)
+	if !rawRegionError(err, response) {
+		response.NotFound = val == nil
+		response.Ttl = ttl
+	}
+	return response, nil
+}
+
+// rawGet reads the value of a raw key and its remaining time to live. An expired value is nil, as if the key didn't
+// exist.
+func (server *Server) rawGet(reader storage.StorageReader, cf string, key []byte) ([]byte, uint64, error) {
+	val, err := reader.GetCF(cf, key)
+	if err != nil || val == nil {
+		return nil, 0, err
+	}
+	return server.decodeRawValue(val)
+}
+
+// rawWrite writes modifies while holding the latches of their keys.
+func (server *Server) rawWrite(ctx *kvrpcpb.Context, modifies []storage.Modify) error {
+	keys := make([][]byte, 0, len(modifies))
+	for i := range modifies {
+		keys = append(keys, modifies[i].Key())
+	}
+	server.Latches.WaitForLatches(keys)
+	defer server.Latches.ReleaseLatches(keys)
+	return server.storage.Write(ctx, modifies)
+}
+
+var errRawTTLDisabled = errors.New("raw TTLs are not enabled on this store")
+
+// encodeRawValue encodes a raw value as it is stored. Without raw TTLs the value is stored as is. With them, it is
+// followed by the unix time in seconds at which it expires, or 0 if it never expires.
+func (server *Server) encodeRawValue(value []byte, ttl uint64) ([]byte, error) {
+	if !server.RawTTL {
+		if ttl != 0 {
+			return nil, errRawTTLDisabled
+		}
+		return value, nil
+	}
+	var expireTs uint64
+	if ttl != 0 {
+		expireTs = uint64(time.Now().Unix()) + ttl
+	}
+	encoded := make([]byte, len(value)+8)
+	copy(encoded, value)
+	binary.BigEndian.PutUint64(encoded[len(value):], expireTs)
+	return encoded, nil
+}
+
+// decodeRawValue decodes a stored raw value and returns its remaining time to live, see encodeRawValue. Values are
+// expired lazily: an expired value stays in storage until it is overwritten or deleted, but it is read as nil.
+func (server *Server) decodeRawValue(stored []byte) ([]byte, uint64, error) {
+	if !server.RawTTL {
+		return stored, 0, nil
+	}
+	if len(stored) < 8 {
+		return nil, 0, errors.Errorf("raw value %x is too short to hold its expire time", stored)
+	}
+	value := stored[:len(stored)-8]
+	expireTs := binary.BigEndian.Uint64(stored[len(stored)-8:])
+	if expireTs == 0 {
+		return value, 0, nil
+	}
+	now := uint64(time.Now().Unix())
+	if expireTs <= now {
+		return nil, 0, nil
+	}
+	return value, expireTs - now, nil
+}
+
 // Raft commands (tinykv <-> tinykv); these are trivially forwarded to storage.
 func (server *Server) Raft(stream tinykvpb.TinyKv_RaftServer) error {
-	return nil
+	return server.storage.(*raft_storage.RaftStorage).Raft(stream)
 }
 
 func (server *Server) Snapshot(stream tinykvpb.TinyKv_SnapshotServer) error {
-	return nil
+	return server.storage.(*raft_storage.RaftStorage).Snapshot(stream)
 }
 
 // SQL push down commands.
 func (server *Server) Coprocessor(_ context.Context, req *coppb.Request) (*coppb.Response, error) {
 	resp := new(coppb.Response)
+	for _, r := range req.Ranges {
+		if keyError := server.ConcurrencyManager.ReadRange(r.Start, r.End, req.StartTs); keyError != nil {
+			resp.Locked = keyError.Locked
+			return resp, nil
+		}
+	}
 	reader, err := server.storage.Reader(req.Context)
 	if err != nil {
-		resp, err := regionError(err, resp)
-		if err != nil {
-			return nil, err
+		if _, err := regionError(err, resp); err != nil {
+			resp.OtherError = err.Error()
 		}
-		return resp.(*coppb.Response), err
+		return resp, nil
 	}
 	switch req.Tp {
 	case kv.ReqTypeDAG:
@@ -242,12 +749,26 @@ func (server *Server) Coprocessor(_ context.Context, req *coppb.Request) (*coppb
 // of resp. This is only a valid way to handle errors for the raw commands. Returns true if err is
 // non-nil, false otherwise.
 func rawRegionError(err error, resp interface{}) bool {
-	return false
+	if err == nil {
+		return false
+	}
+	respValue := reflect.ValueOf(resp).Elem()
+	if regionErr, ok := errors.Cause(err).(storage.RegionError); ok {
+		respValue.FieldByName("RegionError").Set(reflect.ValueOf(regionErr.RegionErr()))
+	} else {
+		respValue.FieldByName("Error").Set(reflect.ValueOf(err.Error()))
+	}
+	return true
 }
 
 // regionError is a help method for handling region errors. If error is a region error, then it is added to resp (which
 // muse have a `regionError` field; the response is returned. If the error is not a region error, then regionError returns
 // nil and the error.
 func regionError(err error, resp interface{}) (interface{}, error) {
+	if regionErr, ok := errors.Cause(err).(storage.RegionError); ok {
+		respValue := reflect.ValueOf(resp).Elem()
+		respValue.FieldByName("RegionError").Set(reflect.ValueOf(regionErr.RegionErr()))
+		return resp, nil
+	}
 	return nil, err
 }
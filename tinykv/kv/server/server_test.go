@@ -1,15 +1,19 @@
 package server
 
 import (
+	"encoding/binary"
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/storage"
 	"github.com/pingcap-incubator/tinykv/kv/storage/standalone_storage"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -341,3 +345,208 @@ func TestByteArray(t *testing.T) {
 	a := []byte{233, 1}
 	fmt.Println(len(a))
 }
+
+func TestRawRegionError(t *testing.T) {
+	resp := new(kvrpcpb.RawGetResponse)
+	assert.False(t, rawRegionError(nil, resp))
+
+	leader := &metapb.Peer{Id: 2, StoreId: 2}
+	assert.True(t, rawRegionError(errors.WithStack(&storage.ErrNotLeader{RegionId: 1, Leader: leader}), resp))
+	assert.Equal(t, leader, resp.RegionError.GetNotLeader().GetLeader())
+	assert.Empty(t, resp.Error)
+
+	resp = new(kvrpcpb.RawGetResponse)
+	assert.True(t, rawRegionError(errors.New("disk is broken"), resp))
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, "disk is broken", resp.Error)
+}
+
+func TestRegionError(t *testing.T) {
+	regions := []*metapb.Region{{Id: 1}, {Id: 2}}
+	resp, err := regionError(&storage.ErrEpochNotMatch{Regions: regions}, new(kvrpcpb.GetResponse))
+	assert.Nil(t, err)
+	assert.Equal(t, regions, resp.(*kvrpcpb.GetResponse).RegionError.GetEpochNotMatch().GetCurrentRegions())
+
+	resp, err = regionError(&storage.ErrServerIsBusy{Reason: "too many writes"}, new(kvrpcpb.CommitResponse))
+	assert.Nil(t, err)
+	assert.Equal(t, "too many writes", resp.(*kvrpcpb.CommitResponse).RegionError.GetServerIsBusy().GetReason())
+
+	origin := errors.New("disk is broken")
+	resp, err = regionError(origin, new(kvrpcpb.GetResponse))
+	assert.Nil(t, resp)
+	assert.Equal(t, origin, err)
+}
+
+func TestRawBatchPutGetDelete1(t *testing.T) {
+	conf := config.NewTestConfig()
+	s := standalone_storage.NewStandAloneStorage(conf)
+	server := NewServer(s)
+	defer cleanUpTestData(conf)
+	defer s.Stop()
+
+	cf := engine_util.CfDefault
+	put := &kvrpcpb.RawBatchPutRequest{
+		Pairs: []*kvrpcpb.KvPair{{Key: []byte{1}, Value: []byte{42}}, {Key: []byte{2}, Value: []byte{43}}, {Key: []byte{3}, Value: []byte{44}}},
+		Cf:    cf,
+	}
+	putResp, err := server.RawBatchPut(nil, put)
+	assert.Nil(t, err)
+	assert.Empty(t, putResp.Error)
+
+	del := &kvrpcpb.RawBatchDeleteRequest{Keys: [][]byte{{1}, {3}}, Cf: cf}
+	delResp, err := server.RawBatchDelete(nil, del)
+	assert.Nil(t, err)
+	assert.Empty(t, delResp.Error)
+
+	get := &kvrpcpb.RawBatchGetRequest{Keys: [][]byte{{1}, {2}, {3}, {4}}, Cf: cf}
+	resp, err := server.RawBatchGet(nil, get)
+	assert.Nil(t, err)
+	assert.Equal(t, []*kvrpcpb.KvPair{{Key: []byte{2}, Value: []byte{43}}}, resp.Pairs)
+}
+
+func TestRawDeleteRange1(t *testing.T) {
+	conf := config.NewTestConfig()
+	s := standalone_storage.NewStandAloneStorage(conf)
+	server := NewServer(s)
+	defer cleanUpTestData(conf)
+	defer s.Stop()
+
+	cf := engine_util.CfDefault
+	for i := byte(1); i <= 5; i++ {
+		assert.Nil(t, Set(s, cf, []byte{i}, []byte{233, i}))
+	}
+
+	req := &kvrpcpb.RawDeleteRangeRequest{StartKey: []byte{2}, EndKey: []byte{4}, Cf: cf}
+	resp, err := server.RawDeleteRange(nil, req)
+	assert.Nil(t, err)
+	assert.Empty(t, resp.Error)
+
+	for i := byte(1); i <= 5; i++ {
+		got, err := Get(s, cf, []byte{i})
+		assert.Nil(t, err)
+		if i == 2 || i == 3 {
+			assert.Nil(t, got)
+		} else {
+			assert.Equal(t, []byte{233, i}, got)
+		}
+	}
+}
+
+func TestRawScanRange1(t *testing.T) {
+	conf := config.NewTestConfig()
+	s := standalone_storage.NewStandAloneStorage(conf)
+	server := NewServer(s)
+	defer cleanUpTestData(conf)
+	defer s.Stop()
+
+	cf := engine_util.CfDefault
+	for i := byte(1); i <= 5; i++ {
+		assert.Nil(t, Set(s, cf, []byte{i}, []byte{233, i}))
+	}
+
+	scanKeys := func(req *kvrpcpb.RawScanRequest) [][]byte {
+		resp, err := server.RawScan(nil, req)
+		assert.Nil(t, err)
+		assert.Empty(t, resp.Error)
+		var keys [][]byte
+		for _, kv := range resp.Kvs {
+			keys = append(keys, kv.Key)
+		}
+		return keys
+	}
+	assert.Equal(t, [][]byte{{2}, {3}}, scanKeys(&kvrpcpb.RawScanRequest{StartKey: []byte{2}, EndKey: []byte{4}, Limit: 10, Cf: cf}))
+	// A reverse scan leaves out its start key and includes its end key.
+	assert.Equal(t, [][]byte{{3}, {2}}, scanKeys(&kvrpcpb.RawScanRequest{StartKey: []byte{4}, EndKey: []byte{2}, Limit: 10, Cf: cf, Reverse: true}))
+	assert.Equal(t, [][]byte{{5}, {4}}, scanKeys(&kvrpcpb.RawScanRequest{Limit: 2, Cf: cf, Reverse: true}))
+}
+
+func TestRawCompareAndSwap1(t *testing.T) {
+	conf := config.NewTestConfig()
+	s := standalone_storage.NewStandAloneStorage(conf)
+	server := NewServer(s)
+	defer cleanUpTestData(conf)
+	defer s.Stop()
+
+	cf := engine_util.CfDefault
+	req := &kvrpcpb.RawCASRequest{Key: []byte{99}, Value: []byte{42}, PreviousNotExist: true, Cf: cf}
+	resp, err := server.RawCompareAndSwap(nil, req)
+	assert.Nil(t, err)
+	assert.True(t, resp.Succeed)
+	assert.True(t, resp.PreviousNotExist)
+
+	// The key exists now, so the same request fails and returns the current value.
+	resp, err = server.RawCompareAndSwap(nil, req)
+	assert.Nil(t, err)
+	assert.False(t, resp.Succeed)
+	assert.False(t, resp.PreviousNotExist)
+	assert.Equal(t, []byte{42}, resp.PreviousValue)
+
+	req = &kvrpcpb.RawCASRequest{Key: []byte{99}, Value: []byte{43}, PreviousValue: []byte{41}, Cf: cf}
+	resp, err = server.RawCompareAndSwap(nil, req)
+	assert.Nil(t, err)
+	assert.False(t, resp.Succeed)
+
+	req.PreviousValue = []byte{42}
+	resp, err = server.RawCompareAndSwap(nil, req)
+	assert.Nil(t, err)
+	assert.True(t, resp.Succeed)
+	got, err := Get(s, cf, []byte{99})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{43}, got)
+}
+
+func TestRawTTL1(t *testing.T) {
+	conf := config.NewTestConfig()
+	s := standalone_storage.NewStandAloneStorage(conf)
+	server := NewServer(s)
+	defer cleanUpTestData(conf)
+	defer s.Stop()
+
+	cf := engine_util.CfDefault
+	put := &kvrpcpb.RawPutRequest{Key: []byte{1}, Value: []byte{42}, Cf: cf, Ttl: 100}
+	putResp, err := server.RawPut(nil, put)
+	assert.Nil(t, err)
+	assert.Equal(t, errRawTTLDisabled.Error(), putResp.Error)
+
+	server.RawTTL = true
+	putResp, err = server.RawPut(nil, put)
+	assert.Nil(t, err)
+	assert.Empty(t, putResp.Error)
+	put = &kvrpcpb.RawPutRequest{Key: []byte{2}, Value: []byte{43}, Cf: cf}
+	_, err = server.RawPut(nil, put)
+	assert.Nil(t, err)
+	// A value which expired a second ago.
+	expired := make([]byte, 9)
+	expired[0] = 44
+	binary.BigEndian.PutUint64(expired[1:], uint64(time.Now().Unix()-1))
+	assert.Nil(t, Set(s, cf, []byte{3}, expired))
+
+	ttlResp, err := server.RawGetKeyTTL(nil, &kvrpcpb.RawGetKeyTTLRequest{Key: []byte{1}, Cf: cf})
+	assert.Nil(t, err)
+	assert.False(t, ttlResp.NotFound)
+	assert.True(t, ttlResp.Ttl > 0 && ttlResp.Ttl <= 100)
+	ttlResp, err = server.RawGetKeyTTL(nil, &kvrpcpb.RawGetKeyTTLRequest{Key: []byte{2}, Cf: cf})
+	assert.Nil(t, err)
+	assert.False(t, ttlResp.NotFound)
+	assert.Equal(t, uint64(0), ttlResp.Ttl)
+
+	getResp, err := server.RawGet(nil, &kvrpcpb.RawGetRequest{Key: []byte{1}, Cf: cf})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{42}, getResp.Value)
+	getResp, err = server.RawGet(nil, &kvrpcpb.RawGetRequest{Key: []byte{3}, Cf: cf})
+	assert.Nil(t, err)
+	assert.True(t, getResp.NotFound)
+
+	// The expired value stays in storage but scans skip it.
+	scanResp, err := server.RawScan(nil, &kvrpcpb.RawScanRequest{StartKey: []byte{1}, Limit: 10, Cf: cf})
+	assert.Nil(t, err)
+	assert.Equal(t, []*kvrpcpb.KvPair{{Key: []byte{1}, Value: []byte{42}}, {Key: []byte{2}, Value: []byte{43}}}, scanResp.Kvs)
+	got, err := Get(s, cf, []byte{3})
+	assert.Nil(t, err)
+	assert.Equal(t, expired, got)
+
+	// An expired key doesn't exist for a compare and swap.
+	casResp, err := server.RawCompareAndSwap(nil, &kvrpcpb.RawCASRequest{Key: []byte{3}, Value: []byte{45}, PreviousNotExist: true, Cf: cf})
+	assert.Nil(t, err)
+	assert.True(t, casResp.Succeed)
+}
@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+)
+
+// RegionError is an error caused by the region a request was sent to rather than by the request itself, such as
+// the peer not being the leader of the region. The client is expected to update its region cache and retry, so the
+// server reports these errors in the `RegionError` field of the response instead of failing the RPC.
+type RegionError interface {
+	error
+	// RegionErr converts the error to its protobuf form.
+	RegionErr() *errorpb.Error
+}
+
+type ErrNotLeader struct {
+	RegionId uint64
+	// Leader is the leader known by the peer, nil if it doesn't know any.
+	Leader *metapb.Peer
+}
+
+func (e *ErrNotLeader) Error() string {
+	return fmt.Sprintf("region %d is not leader", e.RegionId)
+}
+
+func (e *ErrNotLeader) RegionErr() *errorpb.Error {
+	return &errorpb.Error{
+		Message:   e.Error(),
+		NotLeader: &errorpb.NotLeader{RegionId: e.RegionId, Leader: e.Leader},
+	}
+}
+
+type ErrRegionNotFound struct {
+	RegionId uint64
+}
+
+func (e *ErrRegionNotFound) Error() string {
+	return fmt.Sprintf("region %d is not found", e.RegionId)
+}
+
+func (e *ErrRegionNotFound) RegionErr() *errorpb.Error {
+	return &errorpb.Error{
+		Message:        e.Error(),
+		RegionNotFound: &errorpb.RegionNotFound{RegionId: e.RegionId},
+	}
+}
+
+// ErrStaleCommand means the command was proposed by a leader which has been replaced before the command could be
+// committed, it may be retried safely.
+type ErrStaleCommand struct{}
+
+func (e *ErrStaleCommand) Error() string {
+	return "stale command"
+}
+
+func (e *ErrStaleCommand) RegionErr() *errorpb.Error {
+	return &errorpb.Error{
+		Message:      e.Error(),
+		StaleCommand: &errorpb.StaleCommand{},
+	}
+}
+
+type ErrEpochNotMatch struct {
+	Message string
+	// Regions are the current regions covering the range of the region in the request.
+	Regions []*metapb.Region
+}
+
+func (e *ErrEpochNotMatch) Error() string {
+	return fmt.Sprintf("epoch not match, error msg %v, regions %v", e.Message, e.Regions)
+}
+
+func (e *ErrEpochNotMatch) RegionErr() *errorpb.Error {
+	return &errorpb.Error{
+		Message:       e.Message,
+		EpochNotMatch: &errorpb.EpochNotMatch{CurrentRegions: e.Regions},
+	}
+}
+
+// ErrServerIsBusy means the server can't take more requests for now, the client should back off before retrying.
+type ErrServerIsBusy struct {
+	Reason string
+}
+
+func (e *ErrServerIsBusy) Error() string {
+	return fmt.Sprintf("server is busy, reason: %s", e.Reason)
+}
+
+func (e *ErrServerIsBusy) RegionErr() *errorpb.Error {
+	return &errorpb.Error{Message: e.Error(), ServerIsBusy: &errorpb.ServerIsBusy{Reason: e.Reason}}
+}
+
+// NewRegionError converts a protobuf region error back to the typed error of this package. Errors without a typed
+// counterpart are kept in their protobuf form.
+func NewRegionError(err *errorpb.Error) RegionError {
+	switch {
+	case err.NotLeader != nil:
+		return &ErrNotLeader{RegionId: err.NotLeader.RegionId, Leader: err.NotLeader.Leader}
+	case err.RegionNotFound != nil:
+		return &ErrRegionNotFound{RegionId: err.RegionNotFound.RegionId}
+	case err.StaleCommand != nil:
+		return &ErrStaleCommand{}
+	case err.EpochNotMatch != nil:
+		return &ErrEpochNotMatch{Message: err.Message, Regions: err.EpochNotMatch.CurrentRegions}
+	case err.ServerIsBusy != nil:
+		return &ErrServerIsBusy{Reason: err.ServerIsBusy.Reason}
+	}
+	return &pbRegionError{err: err}
+}
+
+// pbRegionError is a region error which has no typed counterpart, like KeyNotInRegion or StoreNotMatch.
+type pbRegionError struct {
+	err *errorpb.Error
+}
+
+func (e *pbRegionError) Error() string {
+	return e.err.String()
+}
+
+func (e *pbRegionError) RegionErr() *errorpb.Error {
+	return e.err
+}
@@ -153,24 +153,43 @@ func (mr *memReader) GetCF(cf string, key []byte) ([]byte, error) {
 }
 
 func (mr *memReader) IterCF(cf string) engine_util.DBIterator {
-	var data *llrb.LLRB
-	switch cf {
-	case engine_util.CfDefault:
-		data = mr.inner.CfDefault
-	case engine_util.CfLock:
-		data = mr.inner.CfLock
-	case engine_util.CfWrite:
-		data = mr.inner.CfWrite
-	default:
+	data := mr.cfData(cf)
+	if data == nil {
 		return nil
 	}
 
 	mr.iterCount += 1
 	min := data.Min()
 	if min == nil {
-		return &memIter{data, memItem{}, mr}
+		return &memIter{data: data, reader: mr}
 	}
-	return &memIter{data, min.(memItem), mr}
+	return &memIter{data: data, item: min.(memItem), reader: mr}
+}
+
+func (mr *memReader) ReverseIterCF(cf string) engine_util.DBIterator {
+	data := mr.cfData(cf)
+	if data == nil {
+		return nil
+	}
+
+	mr.iterCount += 1
+	max := data.Max()
+	if max == nil {
+		return &memIter{data: data, reader: mr, reverse: true}
+	}
+	return &memIter{data: data, item: max.(memItem), reader: mr, reverse: true}
+}
+
+func (mr *memReader) cfData(cf string) *llrb.LLRB {
+	switch cf {
+	case engine_util.CfDefault:
+		return mr.inner.CfDefault
+	case engine_util.CfLock:
+		return mr.inner.CfLock
+	case engine_util.CfWrite:
+		return mr.inner.CfWrite
+	}
+	return nil
 }
 
 func (r *memReader) Close() {
@@ -180,9 +199,10 @@ func (r *memReader) Close() {
 }
 
 type memIter struct {
-	data   *llrb.LLRB
-	item   memItem
-	reader *memReader
+	data    *llrb.LLRB
+	item    memItem
+	reader  *memReader
+	reverse bool
 }
 
 func (it *memIter) Item() engine_util.DBItem {
@@ -195,7 +215,11 @@ func (it *memIter) Next() {
 	first := true
 	oldItem := it.item
 	it.item = memItem{}
-	it.data.AscendGreaterOrEqual(oldItem, func(item llrb.Item) bool {
+	step := it.data.AscendGreaterOrEqual
+	if it.reverse {
+		step = it.data.DescendLessOrEqual
+	}
+	step(oldItem, func(item llrb.Item) bool {
 		// Skip the first item, which will be it.item
 		if first {
 			first = false
@@ -208,6 +232,20 @@ func (it *memIter) Next() {
 }
 func (it *memIter) Seek(key []byte) {
 	it.item = memItem{}
+	if it.reverse {
+		if len(key) == 0 {
+			if max := it.data.Max(); max != nil {
+				it.item = max.(memItem)
+			}
+			return
+		}
+		it.data.DescendLessOrEqual(memItem{key: key}, func(item llrb.Item) bool {
+			it.item = item.(memItem)
+
+			return false
+		})
+		return
+	}
 	it.data.AscendGreaterOrEqual(memItem{key: key}, func(item llrb.Item) bool {
 		it.item = item.(memItem)
 
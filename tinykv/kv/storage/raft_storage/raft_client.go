@@ -0,0 +1,223 @@
+package raft_storage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap-incubator/tinykv/raft"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// snapChunkSize is the size of the snapshot data carried by each chunk of a Snapshot stream.
+const snapChunkSize = 1024 * 1024
+
+// raftConn is a client stream of the Raft RPC to another store.
+type raftConn struct {
+	streamMu sync.Mutex
+	stream   tinykvpb.TinyKv_RaftClient
+	cc       *grpc.ClientConn
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+func newRaftConn(addr string, cfg *config.Config) (*raftConn, error) {
+	cc, err := grpc.Dial(addr, grpc.WithInsecure(),
+		grpc.WithInitialWindowSize(2*1024*1024),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                3 * time.Second,
+			Timeout:             60 * time.Second,
+			PermitWithoutStream: true,
+		}))
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := tinykvpb.NewTinyKvClient(cc).Raft(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &raftConn{
+		stream: stream,
+		cc:     cc,
+		ctx:    ctx,
+		cancel: cancel,
+	}, nil
+}
+
+func (c *raftConn) Stop() {
+	c.cancel()
+	_ = c.cc.Close()
+}
+
+func (c *raftConn) Send(msg *raft_serverpb.RaftMessage) error {
+	c.streamMu.Lock()
+	defer c.streamMu.Unlock()
+	return c.stream.Send(msg)
+}
+
+// SendSnapshot streams the raft message carrying a snapshot on a Snapshot RPC. The first chunk
+// carries the message without the snapshot data, the data follows in chunks of snapChunkSize.
+func (c *raftConn) SendSnapshot(msg *raft_serverpb.RaftMessage) error {
+	stream, err := tinykvpb.NewTinyKvClient(c.cc).Snapshot(c.ctx)
+	if err != nil {
+		return err
+	}
+	data := msg.Message.Snapshot.Data
+	snapshot := *msg.Message.Snapshot
+	snapshot.Data = nil
+	raftMsg := *msg.Message
+	raftMsg.Snapshot = &snapshot
+	head := *msg
+	head.Message = &raftMsg
+	if err := stream.Send(&raft_serverpb.SnapshotChunk{Message: &head}); err != nil {
+		return err
+	}
+	for len(data) > 0 {
+		size := snapChunkSize
+		if len(data) < size {
+			size = len(data)
+		}
+		if err := stream.Send(&raft_serverpb.SnapshotChunk{Data: data[:size]}); err != nil {
+			return err
+		}
+		data = data[size:]
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+// snapKey identifies the snapshots sent to a peer.
+type snapKey struct {
+	regionID uint64
+	peerID   uint64
+}
+
+// RaftClient keeps one raftConn per store address and the resolved address of each store.
+// Messages which can't be delivered are reported back to the sending peer through the router,
+// so that raft stops replicating optimistically to the unreachable peer.
+type RaftClient struct {
+	config *config.Config
+	router *raftstore.RaftstoreRouter
+	sync.RWMutex
+	conns map[string]*raftConn
+	addrs map[uint64]string
+	// the snapshots being sent, keyed by snapKey
+	sendingSnaps sync.Map
+}
+
+func newRaftClient(config *config.Config, router *raftstore.RaftstoreRouter) *RaftClient {
+	return &RaftClient{
+		config: config,
+		router: router,
+		conns:  make(map[string]*raftConn),
+		addrs:  make(map[uint64]string),
+	}
+}
+
+func (c *RaftClient) getConn(addr string, regionID uint64) (*raftConn, error) {
+	c.RLock()
+	conn, ok := c.conns[addr]
+	if ok {
+		c.RUnlock()
+		return conn, nil
+	}
+	c.RUnlock()
+	newConn, err := newRaftConn(addr, c.config)
+	if err != nil {
+		return nil, err
+	}
+	c.Lock()
+	defer c.Unlock()
+	if conn, ok := c.conns[addr]; ok {
+		newConn.Stop()
+		return conn, nil
+	}
+	c.conns[addr] = newConn
+	return newConn, nil
+}
+
+func (c *RaftClient) Send(storeID uint64, addr string, msg *raft_serverpb.RaftMessage) error {
+	conn, err := c.getConn(addr, msg.GetRegionId())
+	if err != nil {
+		c.reportUnreachable(msg)
+		return err
+	}
+	if msg.GetMessage().GetMsgType() == eraftpb.MessageType_MsgSnapshot {
+		c.sendSnapshot(conn, msg)
+		return nil
+	}
+	err = conn.Send(msg)
+	if err == nil {
+		return nil
+	}
+
+	log.Error("raft client failed to send", zap.Uint64("store", storeID), zap.String("addr", addr), zap.Error(err))
+	c.reportUnreachable(msg)
+	c.Lock()
+	defer c.Unlock()
+	conn.Stop()
+	delete(c.conns, addr)
+	if oldAddr, ok := c.addrs[storeID]; ok && oldAddr == addr {
+		delete(c.addrs, storeID)
+	}
+	return err
+}
+
+// sendSnapshot sends the snapshot in the background, so a large region doesn't hold up the raft
+// messages of the other regions. The result is reported to the sending peer, and the copies
+// asked for while one is still being sent to the same peer are dropped.
+func (c *RaftClient) sendSnapshot(conn *raftConn, msg *raft_serverpb.RaftMessage) {
+	key := snapKey{regionID: msg.GetRegionId(), peerID: msg.GetToPeer().GetId()}
+	if _, sending := c.sendingSnaps.LoadOrStore(key, struct{}{}); sending {
+		return
+	}
+	go func() {
+		start := time.Now()
+		err := conn.SendSnapshot(msg)
+		// Done sending before reporting, raft may send the snapshot again as soon as it learns
+		// the result.
+		c.sendingSnaps.Delete(key)
+		if err != nil {
+			log.Error("raft client failed to send snapshot", zap.Uint64("region", key.regionID),
+				zap.Uint64("peer", key.peerID), zap.Error(err))
+			c.router.ReportSnapshotStatus(key.regionID, key.peerID, raft.SnapshotFailure)
+			return
+		}
+		log.Info("raft client sent snapshot", zap.Uint64("region", key.regionID), zap.Uint64("peer", key.peerID),
+			zap.Int("size", len(msg.Message.Snapshot.Data)), zap.Duration("takes", time.Since(start)))
+		c.router.ReportSnapshotStatus(key.regionID, key.peerID, raft.SnapshotFinish)
+	}()
+}
+
+// reportUnreachable reports a message which was dropped before it was sent. A dropped snapshot
+// is reported as failed, otherwise raft would wait for it forever.
+func (c *RaftClient) reportUnreachable(msg *raft_serverpb.RaftMessage) {
+	regionID, toPeerID := msg.GetRegionId(), msg.GetToPeer().GetId()
+	if msg.GetMessage().GetMsgType() == eraftpb.MessageType_MsgSnapshot {
+		c.router.ReportSnapshotStatus(regionID, toPeerID, raft.SnapshotFailure)
+	}
+	c.router.ReportUnreachable(regionID, toPeerID)
+}
+
+func (c *RaftClient) GetAddr(storeID uint64) string {
+	c.RLock()
+	defer c.RUnlock()
+	v, _ := c.addrs[storeID]
+	return v
+}
+
+func (c *RaftClient) InsertAddr(storeID uint64, addr string) {
+	c.Lock()
+	defer c.Unlock()
+	c.addrs[storeID] = addr
+}
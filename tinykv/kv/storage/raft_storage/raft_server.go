@@ -0,0 +1,235 @@
+package raft_storage
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/scheduler_client"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/storage"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap/errors"
+)
+
+// RaftStorage is an implementation of `Storage` (see tikv/server.go) backed by a Raft node. It is part of a Raft network.
+// By using Raft, reads and writes are consistent with other nodes in the TinyKV instance.
+type RaftStorage struct {
+	engines *engine_util.Engines
+	config  *config.Config
+
+	node            *raftstore.Node
+	raftRouter      *raftstore.RaftstoreRouter
+	raftSystem      *raftstore.Raftstore
+	resolveWorker   *worker.Worker
+	schedulerClient scheduler_client.Client
+
+	wg sync.WaitGroup
+}
+
+// toRegionError converts the errors reported by the raftstore about the region of a request to the
+// region errors of the storage, other errors are returned as is.
+func toRegionError(err error) error {
+	switch e := errors.Cause(err).(type) {
+	case *util.ErrServerIsBusy:
+		return &storage.ErrServerIsBusy{Reason: e.Reason}
+	case *util.ErrNotLeader, *util.ErrRegionNotFound, *util.ErrKeyNotInRegion, *util.ErrEpochNotMatch,
+		*util.ErrStaleCommand, *util.ErrStoreNotMatch, *util.ErrDataIsNotReady:
+		return storage.NewRegionError(util.RaftstoreErrToPbError(e))
+	}
+	return err
+}
+
+func (rs *RaftStorage) checkResponse(resp *raft_cmdpb.RaftCmdResponse, reqCount int) error {
+	if resp.Header.Error != nil {
+		return storage.NewRegionError(resp.Header.Error)
+	}
+	if len(resp.Responses) != reqCount {
+		return errors.Errorf("responses count %d is not equal to requests count %d",
+			len(resp.Responses), reqCount)
+	}
+	return nil
+}
+
+// NewRaftStorage creates a new storage engine backed by a raftstore.
+func NewRaftStorage(conf *config.Config) *RaftStorage {
+	kvDB := engine_util.CreateDB("kv", conf)
+	raftDB := engine_util.CreateDB("raft", conf)
+	kvPath := filepath.Join(conf.DBPath, "kv")
+	raftPath := filepath.Join(conf.DBPath, "raft")
+	engines := engine_util.NewEngines(kvDB, raftDB, kvPath, raftPath)
+
+	return &RaftStorage{engines: engines, config: conf}
+}
+
+func (rs *RaftStorage) Write(ctx *kvrpcpb.Context, batch []storage.Modify) error {
+	var reqs []*raft_cmdpb.Request
+	for _, m := range batch {
+		switch m.Data.(type) {
+		case storage.Put:
+			put := m.Data.(storage.Put)
+			reqs = append(reqs, &raft_cmdpb.Request{
+				CmdType: raft_cmdpb.CmdType_Put,
+				Put: &raft_cmdpb.PutRequest{
+					Cf:    put.Cf,
+					Key:   put.Key,
+					Value: put.Value,
+				}})
+		case storage.Delete:
+			delete := m.Data.(storage.Delete)
+			reqs = append(reqs, &raft_cmdpb.Request{
+				CmdType: raft_cmdpb.CmdType_Delete,
+				Delete: &raft_cmdpb.DeleteRequest{
+					Cf:  delete.Cf,
+					Key: delete.Key,
+				}})
+		}
+	}
+
+	header := &raft_cmdpb.RaftRequestHeader{
+		RegionId:    ctx.RegionId,
+		Peer:        ctx.Peer,
+		RegionEpoch: ctx.RegionEpoch,
+		Term:        ctx.Term,
+	}
+	request := &raft_cmdpb.RaftCmdRequest{
+		Header:   header,
+		Requests: reqs,
+	}
+	cb := message.NewCallback()
+	if err := rs.raftRouter.SendRaftCommand(request, cb); err != nil {
+		return toRegionError(err)
+	}
+
+	return rs.checkResponse(cb.WaitResp(), len(reqs))
+}
+
+func (rs *RaftStorage) Reader(ctx *kvrpcpb.Context) (storage.StorageReader, error) {
+	header := &raft_cmdpb.RaftRequestHeader{
+		RegionId:    ctx.RegionId,
+		Peer:        ctx.Peer,
+		RegionEpoch: ctx.RegionEpoch,
+		Term:        ctx.Term,
+		ReplicaRead: ctx.ReplicaRead,
+		StaleRead:   ctx.StaleRead,
+		ReadTs:      ctx.ReadTs,
+	}
+	request := &raft_cmdpb.RaftCmdRequest{
+		Header: header,
+		Requests: []*raft_cmdpb.Request{{
+			CmdType: raft_cmdpb.CmdType_Snap,
+			Snap:    &raft_cmdpb.SnapRequest{},
+		}},
+	}
+	cb := message.NewCallback()
+	if err := rs.raftRouter.SendRaftCommand(request, cb); err != nil {
+		return nil, toRegionError(err)
+	}
+
+	resp := cb.WaitResp()
+	if err := rs.checkResponse(resp, 1); err != nil {
+		if cb.Txn != nil {
+			cb.Txn.Discard()
+		}
+		return nil, err
+	}
+	if cb.Txn == nil {
+		panic("can not found region snap")
+	}
+	return NewRegionReader(cb.Txn, *resp.Responses[0].GetSnap().Region), nil
+}
+
+// Raft receives the raft messages sent by other stores and hands them to the raftstore.
+func (rs *RaftStorage) Raft(stream tinykvpb.TinyKv_RaftServer) error {
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		_ = rs.raftRouter.SendRaftMessage(msg)
+	}
+}
+
+// Snapshot receives a snapshot sent in chunks by RaftClient, the raft message carrying it is
+// passed to the raftstore once all the data has arrived.
+func (rs *RaftStorage) Snapshot(stream tinykvpb.TinyKv_SnapshotServer) error {
+	var msg *raft_serverpb.RaftMessage
+	var data []byte
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if chunk.Message != nil {
+			msg = chunk.Message
+		}
+		data = append(data, chunk.Data...)
+	}
+	if msg.GetMessage().GetSnapshot() == nil {
+		return errors.New("snapshot stream carries no snapshot message")
+	}
+	msg.Message.Snapshot.Data = data
+	if err := rs.raftRouter.SendRaftMessage(msg); err != nil {
+		return err
+	}
+	return stream.SendAndClose(&raft_serverpb.Done{})
+}
+
+// Start starts the raftstore, tsTracker is the concurrency manager of the server which uses the storage.
+func (rs *RaftStorage) Start(tsTracker raftstore.TsTracker) error {
+	schedulerClient, err := scheduler_client.NewClient(strings.Split(rs.config.SchedulerAddr, ","), "")
+	if err != nil {
+		return err
+	}
+	return rs.StartWithSchedulerClient(schedulerClient, tsTracker)
+}
+
+// StartWithSchedulerClient is like Start, but talks to the scheduler through schedulerClient instead of connecting
+// to SchedulerAddr. Tests use it to run a cluster with a mock scheduler.
+func (rs *RaftStorage) StartWithSchedulerClient(schedulerClient scheduler_client.Client, tsTracker raftstore.TsTracker) error {
+	cfg := rs.config
+	rs.schedulerClient = schedulerClient
+	rs.raftRouter, rs.raftSystem = raftstore.CreateRaftstore(cfg)
+
+	rs.resolveWorker = worker.NewWorker("resolver", &rs.wg)
+	resolveSender := rs.resolveWorker.Sender()
+	resolveRunner := newResolverRunner(schedulerClient)
+	rs.resolveWorker.Start(resolveRunner)
+
+	raftClient := newRaftClient(cfg, rs.raftRouter)
+	trans := NewServerTransport(raftClient, resolveSender)
+
+	rs.node = raftstore.NewNode(rs.raftSystem, rs.config, schedulerClient, tsTracker)
+	return rs.node.Start(context.TODO(), rs.engines, trans)
+}
+
+// GetTS returns a timestamp allocated by the scheduler, the storage must have been started.
+func (rs *RaftStorage) GetTS(ctx context.Context) (uint64, error) {
+	return rs.schedulerClient.GetTS(ctx)
+}
+
+func (rs *RaftStorage) Stop() error {
+	rs.node.Stop()
+	rs.resolveWorker.Stop()
+	rs.wg.Wait()
+	if err := rs.engines.Raft.Close(); err != nil {
+		return err
+	}
+	if err := rs.engines.Kv.Close(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package raft_storage
+
+import (
+	"bytes"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+)
+
+// RegionReader reads the data of one region from a badger transaction opened by the apply worker.
+type RegionReader struct {
+	txn    *badger.Txn
+	region *metapb.Region
+}
+
+func NewRegionReader(txn *badger.Txn, region metapb.Region) *RegionReader {
+	return &RegionReader{
+		txn:    txn,
+		region: &region,
+	}
+}
+
+func (r *RegionReader) GetCF(cf string, key []byte) ([]byte, error) {
+	if err := util.CheckKeyInRegion(key, r.region); err != nil {
+		return nil, toRegionError(err)
+	}
+	val, err := engine_util.GetCFFromTxn(r.txn, cf, key)
+	if err == badger.ErrKeyNotFound {
+		return nil, nil
+	}
+	return val, err
+}
+
+func (r *RegionReader) IterCF(cf string) engine_util.DBIterator {
+	return NewRegionIterator(engine_util.NewCFIterator(cf, r.txn), r.region)
+}
+
+func (r *RegionReader) ReverseIterCF(cf string) engine_util.DBIterator {
+	return NewRegionIterator(engine_util.NewCFReverseIterator(cf, r.txn), r.region)
+}
+
+// DataVersion returns the data version kept in the apply state of the region, which the apply worker writes together
+// with the data.
+func (r *RegionReader) DataVersion() (uint64, error) {
+	applyState := new(rspb.RaftApplyState)
+	if err := engine_util.GetMetaFromTxn(r.txn, meta.ApplyStateKey(r.region.Id), applyState); err != nil {
+		return 0, err
+	}
+	return applyState.DataVersion, nil
+}
+
+func (r *RegionReader) Close() {
+	r.txn.Discard()
+}
+
+// RegionIterator wraps a db iterator and only allow it to iterate in the region. It behaves as if underlying
+// db only contains one region.
+type RegionIterator struct {
+	iter   *engine_util.BadgerIterator
+	region *metapb.Region
+}
+
+func NewRegionIterator(iter *engine_util.BadgerIterator, region *metapb.Region) *RegionIterator {
+	return &RegionIterator{
+		iter:   iter,
+		region: region,
+	}
+}
+
+func (it *RegionIterator) Item() engine_util.DBItem {
+	return it.iter.Item()
+}
+
+func (it *RegionIterator) Valid() bool {
+	if !it.iter.Valid() {
+		return false
+	}
+	if it.iter.Reverse() {
+		return bytes.Compare(it.iter.Item().Key(), it.region.StartKey) >= 0
+	}
+	return !engine_util.ExceedEndKey(it.iter.Item().Key(), it.region.EndKey)
+}
+
+func (it *RegionIterator) Close() {
+	it.iter.Close()
+}
+
+func (it *RegionIterator) Next() {
+	it.iter.Next()
+}
+
+func (it *RegionIterator) Seek(key []byte) {
+	if it.iter.Reverse() && (len(key) == 0 || engine_util.ExceedEndKey(key, it.region.EndKey)) {
+		// Start from the last key of the region.
+		it.iter.Seek(it.region.EndKey)
+		if it.iter.Valid() && len(it.region.EndKey) > 0 && bytes.Equal(it.iter.Item().Key(), it.region.EndKey) {
+			it.iter.Next()
+		}
+		return
+	}
+	if err := util.CheckKeyInRegion(key, it.region); err != nil {
+		panic(err)
+	}
+	it.iter.Seek(key)
+}
@@ -0,0 +1,71 @@
+package raft_storage
+
+import (
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// ServerTransport sends the raft messages of the raftstore to other stores, resolving the
+// address of a store through the scheduler the first time it is used.
+type ServerTransport struct {
+	raftClient        *RaftClient
+	resolverScheduler chan<- worker.Task
+	resolving         sync.Map
+}
+
+func NewServerTransport(raftClient *RaftClient, resolverScheduler chan<- worker.Task) *ServerTransport {
+	return &ServerTransport{
+		raftClient:        raftClient,
+		resolverScheduler: resolverScheduler,
+	}
+}
+
+func (t *ServerTransport) Send(msg *raft_serverpb.RaftMessage) error {
+	storeID := msg.GetToPeer().GetStoreId()
+	t.SendStore(storeID, msg)
+	return nil
+}
+
+func (t *ServerTransport) SendStore(storeID uint64, msg *raft_serverpb.RaftMessage) {
+	addr := t.raftClient.GetAddr(storeID)
+	if addr != "" {
+		t.WriteData(storeID, addr, msg)
+		return
+	}
+	if _, ok := t.resolving.Load(storeID); ok {
+		log.Debug("store address is being resolved, msg dropped", zap.Uint64("storeID", storeID), zap.Stringer("msg", msg))
+		t.raftClient.reportUnreachable(msg)
+		return
+	}
+	log.Debug("begin to resolve store address", zap.Uint64("storeID", storeID))
+	t.resolving.Store(storeID, struct{}{})
+	t.Resolve(storeID, msg)
+}
+
+func (t *ServerTransport) Resolve(storeID uint64, msg *raft_serverpb.RaftMessage) {
+	callback := func(addr string, err error) {
+		// clear resolving
+		t.resolving.Delete(storeID)
+		if err != nil {
+			log.Error("resolve store address failed", zap.Uint64("storeID", storeID), zap.Error(err))
+			t.raftClient.reportUnreachable(msg)
+			return
+		}
+		t.raftClient.InsertAddr(storeID, addr)
+		t.WriteData(storeID, addr, msg)
+	}
+	t.resolverScheduler <- &resolveAddrTask{
+		storeID:  storeID,
+		callback: callback,
+	}
+}
+
+func (t *ServerTransport) WriteData(storeID uint64, addr string, msg *raft_serverpb.RaftMessage) {
+	if err := t.raftClient.Send(storeID, addr, msg); err != nil {
+		log.Error("send raft msg err", zap.Error(err))
+	}
+}
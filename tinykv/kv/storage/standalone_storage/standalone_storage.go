@@ -66,6 +66,10 @@ func (b *BadgerReader) IterCF(cf string) engine_util.DBIterator {
 	return engine_util.NewCFIterator(cf, b.txn)
 }
 
+func (b *BadgerReader) ReverseIterCF(cf string) engine_util.DBIterator {
+	return engine_util.NewCFReverseIterator(cf, b.txn)
+}
+
 func (b *BadgerReader) Close() {
 	b.txn.Discard()
 }
@@ -17,5 +17,13 @@ type StorageReader interface {
 	// When the key doesn't exist, return nil for the value
 	GetCF(cf string, key []byte) ([]byte, error)
 	IterCF(cf string) engine_util.DBIterator
+	// ReverseIterCF returns an iterator which walks the column family from the largest key to the smallest
+	ReverseIterCF(cf string) engine_util.DBIterator
 	Close()
 }
+
+// DataVersionReader is implemented by the readers of a region which know the version of the data they read. The
+// version changes whenever the data of the region changes.
+type DataVersionReader interface {
+	DataVersion() (uint64, error)
+}
@@ -0,0 +1,217 @@
+package test_raftstore
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/server"
+	"github.com/pingcap-incubator/tinykv/kv/storage"
+	"github.com/pingcap-incubator/tinykv/kv/storage/raft_storage"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// requestTimeout bounds how long a request is retried while the cluster elects leaders or changes its regions.
+const requestTimeout = 10 * time.Second
+
+// Cluster is a TinyKV cluster for tests. Each store is a RaftStorage served over gRPC on a local port like the
+// servers started by kv/main.go, and the stores share a MockSchedulerClient.
+type Cluster struct {
+	t         *testing.T
+	scheduler *MockSchedulerClient
+	// stores are keyed by their address, which the scheduler knows them by.
+	stores map[string]*Store
+}
+
+// Store is a store of a Cluster.
+type Store struct {
+	Storage    *raft_storage.RaftStorage
+	Server     *server.Server
+	grpcServer *grpc.Server
+	dir        string
+	stopped    bool
+}
+
+// NewCluster starts a cluster of count stores, setConfig may change the test config of each store before it starts.
+// The first store bootstraps the cluster, and the scheduler adds a peer of the first region on every store.
+func NewCluster(t *testing.T, count int, setConfig func(*config.Config)) *Cluster {
+	c := &Cluster{
+		t:         t,
+		scheduler: NewMockSchedulerClient(1, count),
+		stores:    make(map[string]*Store),
+	}
+	for i := 0; i < count; i++ {
+		c.startStore(setConfig)
+		if i == 0 {
+			// The other stores must find the cluster bootstrapped.
+			c.MustWaitRegion(nil, func(region *metapb.Region) bool { return true })
+		}
+	}
+	return c
+}
+
+func (c *Cluster) startStore(setConfig func(*config.Config)) {
+	dir, err := ioutil.TempDir("", "test-raftstore")
+	require.Nil(c.t, err)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(c.t, err)
+
+	conf := config.NewTestConfig()
+	conf.DBPath = dir
+	conf.StoreAddr = l.Addr().String()
+	if setConfig != nil {
+		setConfig(conf)
+	}
+	raftStorage := raft_storage.NewRaftStorage(conf)
+	srv := server.NewServer(raftStorage)
+	require.Nil(c.t, raftStorage.StartWithSchedulerClient(c.scheduler, srv.ConcurrencyManager))
+	grpcServer := grpc.NewServer()
+	tinykvpb.RegisterTinyKvServer(grpcServer, srv)
+	go func() {
+		_ = grpcServer.Serve(l)
+	}()
+	c.stores[conf.StoreAddr] = &Store{Storage: raftStorage, Server: srv, grpcServer: grpcServer, dir: dir}
+}
+
+// Store returns the store with storeID, or nil if the scheduler doesn't know it.
+func (c *Cluster) Store(storeID uint64) *Store {
+	store, err := c.scheduler.GetStore(context.TODO(), storeID)
+	if err != nil {
+		return nil
+	}
+	return c.stores[store.Address]
+}
+
+// StopStore stops the store with storeID as if it failed, its data is kept until Shutdown.
+func (c *Cluster) StopStore(storeID uint64) {
+	store := c.Store(storeID)
+	require.NotNil(c.t, store)
+	store.stop(c.t)
+}
+
+func (s *Store) stop(t *testing.T) {
+	if s.stopped {
+		return
+	}
+	s.stopped = true
+	s.grpcServer.Stop()
+	require.Nil(t, s.Storage.Stop())
+}
+
+// Shutdown stops all the stores and removes their data.
+func (c *Cluster) Shutdown() {
+	for _, store := range c.stores {
+		store.stop(c.t)
+		os.RemoveAll(store.dir)
+	}
+}
+
+// GetRegion returns the region of key and its leader as last reported to the scheduler.
+func (c *Cluster) GetRegion(key []byte) (*metapb.Region, *metapb.Peer) {
+	region, leader, err := c.scheduler.GetRegion(context.TODO(), key)
+	require.Nil(c.t, err)
+	return region, leader
+}
+
+// MustWaitRegion waits until the region of key, as reported to the scheduler, satisfies cond and returns it.
+func (c *Cluster) MustWaitRegion(key []byte, cond func(*metapb.Region) bool) *metapb.Region {
+	for start := time.Now(); time.Since(start) < requestTimeout; time.Sleep(50 * time.Millisecond) {
+		region, _, err := c.scheduler.GetRegion(context.TODO(), key)
+		if err == nil && cond(region) {
+			return region
+		}
+	}
+	c.t.Fatalf("the region of key %v isn't ready in %v", key, requestTimeout)
+	return nil
+}
+
+// Put writes a value to cf with RaftStorage.Write.
+func (c *Cluster) Put(cf string, key, value []byte) error {
+	return c.onLeader(key, func(store *Store, ctx *kvrpcpb.Context) error {
+		return store.Storage.Write(ctx, []storage.Modify{{Data: storage.Put{Cf: cf, Key: key, Value: value}}})
+	})
+}
+
+// Get reads the value of key in cf from a RaftStorage.Reader.
+func (c *Cluster) Get(cf string, key []byte) ([]byte, error) {
+	var value []byte
+	err := c.onLeader(key, func(store *Store, ctx *kvrpcpb.Context) error {
+		reader, err := store.Storage.Reader(ctx)
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+		value, err = reader.GetCF(cf, key)
+		return err
+	})
+	return value, err
+}
+
+// GetFromPeer reads the value of key in cf from a replica read of peer, which is served by the peer even if it is a
+// follower once it has applied the writes committed before the read.
+func (c *Cluster) GetFromPeer(cf string, key []byte, peer *metapb.Peer) ([]byte, error) {
+	var value []byte
+	var err error
+	for start := time.Now(); time.Since(start) < requestTimeout; time.Sleep(100 * time.Millisecond) {
+		var region *metapb.Region
+		region, _, err = c.scheduler.GetRegion(context.TODO(), key)
+		if err != nil {
+			continue
+		}
+		ctx := &kvrpcpb.Context{RegionId: region.Id, RegionEpoch: region.RegionEpoch, Peer: peer, ReplicaRead: true}
+		var reader storage.StorageReader
+		if reader, err = c.Store(peer.StoreId).Storage.Reader(ctx); err != nil {
+			continue
+		}
+		value, err = reader.GetCF(cf, key)
+		reader.Close()
+		if err == nil {
+			return value, nil
+		}
+	}
+	return nil, err
+}
+
+func (c *Cluster) MustPut(cf string, key, value []byte) {
+	require.Nil(c.t, c.Put(cf, key, value))
+}
+
+func (c *Cluster) MustGet(cf string, key, value []byte) {
+	val, err := c.Get(cf, key)
+	require.Nil(c.t, err)
+	require.Equal(c.t, value, val)
+}
+
+// onLeader calls f with each running peer of the region of key in turn, starting with the leader known by the
+// scheduler, until f succeeds. The peers are tried again while there is no leader or the region is changing, up to
+// requestTimeout.
+func (c *Cluster) onLeader(key []byte, f func(*Store, *kvrpcpb.Context) error) error {
+	var err error
+	for start := time.Now(); time.Since(start) < requestTimeout; time.Sleep(100 * time.Millisecond) {
+		var region *metapb.Region
+		var leader *metapb.Peer
+		region, leader, err = c.scheduler.GetRegion(context.TODO(), key)
+		if err != nil {
+			continue
+		}
+		for _, peer := range append([]*metapb.Peer{leader}, region.Peers...) {
+			store := c.Store(peer.StoreId)
+			if store == nil || store.stopped {
+				continue
+			}
+			ctx := &kvrpcpb.Context{RegionId: region.Id, RegionEpoch: region.RegionEpoch, Peer: peer}
+			if err = f(store, ctx); err == nil {
+				return nil
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,66 @@
+package test_raftstore
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(i int) []byte {
+	return []byte(fmt.Sprintf("k%03d", i))
+}
+
+func testValue(i int) []byte {
+	return []byte(fmt.Sprintf("v%03d", i))
+}
+
+// TestReplicate tests that the first region gets a peer on every store, and that the writes are applied by all of
+// them.
+func TestReplicate(t *testing.T) {
+	cluster := NewCluster(t, 3, nil)
+	defer cluster.Shutdown()
+	region := cluster.MustWaitRegion(nil, func(region *metapb.Region) bool { return len(region.Peers) == 3 })
+
+	for i := 0; i < 10; i++ {
+		cluster.MustPut(engine_util.CfDefault, testKey(i), testValue(i))
+	}
+	for i := 0; i < 10; i++ {
+		cluster.MustGet(engine_util.CfDefault, testKey(i), testValue(i))
+	}
+	for _, peer := range region.Peers {
+		val, err := cluster.GetFromPeer(engine_util.CfDefault, testKey(9), peer)
+		require.Nil(t, err)
+		require.Equal(t, testValue(9), val)
+	}
+}
+
+// TestStoreFailure tests that a cluster of three stores keeps serving reads and writes after the store of the leader
+// fails.
+func TestStoreFailure(t *testing.T) {
+	cluster := NewCluster(t, 3, nil)
+	defer cluster.Shutdown()
+	region := cluster.MustWaitRegion(nil, func(region *metapb.Region) bool { return len(region.Peers) == 3 })
+
+	for i := 0; i < 10; i++ {
+		cluster.MustPut(engine_util.CfDefault, testKey(i), testValue(i))
+	}
+	// Wait for the new peers to catch up, until then the data is only on the leader.
+	for _, peer := range region.Peers {
+		val, err := cluster.GetFromPeer(engine_util.CfDefault, testKey(9), peer)
+		require.Nil(t, err)
+		require.Equal(t, testValue(9), val)
+	}
+	_, leader := cluster.GetRegion(nil)
+	cluster.StopStore(leader.StoreId)
+
+	// The two other stores elect a new leader.
+	for i := 10; i < 20; i++ {
+		cluster.MustPut(engine_util.CfDefault, testKey(i), testValue(i))
+	}
+	for i := 0; i < 20; i++ {
+		cluster.MustGet(engine_util.CfDefault, testKey(i), testValue(i))
+	}
+}
@@ -0,0 +1,230 @@
+package test_raftstore
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
+	"github.com/pingcap/errors"
+)
+
+// MockSchedulerClient is an in-memory scheduler for a test cluster. It learns the regions from the region heartbeats
+// of their leaders, and adds peers to a region until it has a replica on each of maxPeerCount stores.
+type MockSchedulerClient struct {
+	clusterID    uint64
+	maxPeerCount int
+	nextID       uint64
+	tso          uint64
+
+	mu           sync.Mutex
+	bootstrapped bool
+	stores       map[uint64]*metapb.Store
+	regions      map[uint64]*regionInfo
+	handlers     map[uint64]func(*schedulerpb.RegionHeartbeatResponse)
+}
+
+type regionInfo struct {
+	region *metapb.Region
+	leader *metapb.Peer
+}
+
+func NewMockSchedulerClient(clusterID uint64, maxPeerCount int) *MockSchedulerClient {
+	return &MockSchedulerClient{
+		clusterID:    clusterID,
+		maxPeerCount: maxPeerCount,
+		tso:          uint64(time.Now().UnixNano()/int64(time.Millisecond)) << 18,
+		stores:       make(map[uint64]*metapb.Store),
+		regions:      make(map[uint64]*regionInfo),
+		handlers:     make(map[uint64]func(*schedulerpb.RegionHeartbeatResponse)),
+	}
+}
+
+func (m *MockSchedulerClient) GetClusterID(ctx context.Context) uint64 {
+	return m.clusterID
+}
+
+func (m *MockSchedulerClient) AllocID(ctx context.Context) (uint64, error) {
+	return atomic.AddUint64(&m.nextID, 1), nil
+}
+
+func (m *MockSchedulerClient) GetTS(ctx context.Context) (uint64, error) {
+	return atomic.AddUint64(&m.tso, 1), nil
+}
+
+func (m *MockSchedulerClient) Bootstrap(ctx context.Context, store *metapb.Store) (*schedulerpb.BootstrapResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	resp := &schedulerpb.BootstrapResponse{Header: &schedulerpb.ResponseHeader{ClusterId: m.clusterID}}
+	if m.bootstrapped {
+		resp.Header.Error = &schedulerpb.Error{Type: schedulerpb.ErrorType_ALREADY_BOOTSTRAPPED, Message: "cluster is already bootstrapped"}
+		return resp, nil
+	}
+	m.bootstrapped = true
+	m.stores[store.Id] = proto.Clone(store).(*metapb.Store)
+	return resp, nil
+}
+
+func (m *MockSchedulerClient) IsBootstrapped(ctx context.Context) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.bootstrapped, nil
+}
+
+func (m *MockSchedulerClient) PutStore(ctx context.Context, store *metapb.Store) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stores[store.Id] = proto.Clone(store).(*metapb.Store)
+	return nil
+}
+
+func (m *MockSchedulerClient) GetStore(ctx context.Context, storeID uint64) (*metapb.Store, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	store, ok := m.stores[storeID]
+	if !ok {
+		return nil, errors.Errorf("store %d not found", storeID)
+	}
+	return proto.Clone(store).(*metapb.Store), nil
+}
+
+func (m *MockSchedulerClient) GetRegion(ctx context.Context, key []byte) (*metapb.Region, *metapb.Peer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, info := range m.regions {
+		if containsKey(info.region, key) {
+			return info.clone()
+		}
+	}
+	return nil, nil, errors.Errorf("region of key %v not found", key)
+}
+
+func (m *MockSchedulerClient) GetRegionByID(ctx context.Context, regionID uint64) (*metapb.Region, *metapb.Peer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	info, ok := m.regions[regionID]
+	if !ok {
+		return nil, nil, errors.Errorf("region %d not found", regionID)
+	}
+	return info.clone()
+}
+
+func (m *MockSchedulerClient) AskSplit(ctx context.Context, region *metapb.Region) (*schedulerpb.AskSplitResponse, error) {
+	resp := &schedulerpb.AskSplitResponse{
+		Header:      &schedulerpb.ResponseHeader{ClusterId: m.clusterID},
+		NewRegionId: atomic.AddUint64(&m.nextID, 1),
+	}
+	for range region.Peers {
+		resp.NewPeerIds = append(resp.NewPeerIds, atomic.AddUint64(&m.nextID, 1))
+	}
+	return resp, nil
+}
+
+func (m *MockSchedulerClient) StoreHeartbeat(ctx context.Context, stats *schedulerpb.StoreStats) error {
+	return nil
+}
+
+// RegionHeartbeat records the region reported by its leader, and answers with a ChangePeer operator if the region
+// has fewer replicas than it should.
+func (m *MockSchedulerClient) RegionHeartbeat(req *schedulerpb.RegionHeartbeatRequest) error {
+	m.mu.Lock()
+	region := req.Region
+	if old, ok := m.regions[region.Id]; ok && isEpochStale(region.RegionEpoch, old.region.RegionEpoch) {
+		m.mu.Unlock()
+		return nil
+	}
+	for id, info := range m.regions {
+		if id == region.Id || !overlaps(info.region, region) {
+			continue
+		}
+		if info.region.RegionEpoch.Version > region.RegionEpoch.Version {
+			// A newer region covers the reported one, which is stale.
+			m.mu.Unlock()
+			return nil
+		}
+		// The region has been split or merged since it was reported.
+		delete(m.regions, id)
+	}
+	m.regions[region.Id] = &regionInfo{
+		region: proto.Clone(region).(*metapb.Region),
+		leader: proto.Clone(req.Leader).(*metapb.Peer),
+	}
+	resp := m.addPeerOperator(req)
+	handler := m.handlers[req.Leader.StoreId]
+	m.mu.Unlock()
+
+	if resp != nil && handler != nil {
+		handler(resp)
+	}
+	return nil
+}
+
+// addPeerOperator returns an operator adding a peer on a store which has no replica of the region yet, or nil if the
+// region has enough replicas or a peer it added is still catching up.
+func (m *MockSchedulerClient) addPeerOperator(req *schedulerpb.RegionHeartbeatRequest) *schedulerpb.RegionHeartbeatResponse {
+	region := req.Region
+	if len(region.Peers) >= m.maxPeerCount || len(req.PendingPeers) > 0 {
+		return nil
+	}
+	storeIDs := make([]uint64, 0, len(m.stores))
+	for id := range m.stores {
+		storeIDs = append(storeIDs, id)
+	}
+	sort.Slice(storeIDs, func(i, j int) bool { return storeIDs[i] < storeIDs[j] })
+	for _, storeID := range storeIDs {
+		if findPeer(region, storeID) != nil {
+			continue
+		}
+		return &schedulerpb.RegionHeartbeatResponse{
+			Header:      &schedulerpb.ResponseHeader{ClusterId: m.clusterID},
+			RegionId:    region.Id,
+			RegionEpoch: region.RegionEpoch,
+			TargetPeer:  req.Leader,
+			ChangePeer: &schedulerpb.ChangePeer{
+				Peer:       &metapb.Peer{Id: atomic.AddUint64(&m.nextID, 1), StoreId: storeID},
+				ChangeType: eraftpb.ConfChangeType_AddNode,
+			},
+		}
+	}
+	return nil
+}
+
+func (m *MockSchedulerClient) SetRegionHeartbeatResponseHandler(storeID uint64, h func(*schedulerpb.RegionHeartbeatResponse)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlers[storeID] = h
+}
+
+func (m *MockSchedulerClient) Close() {}
+
+func (info *regionInfo) clone() (*metapb.Region, *metapb.Peer, error) {
+	return proto.Clone(info.region).(*metapb.Region), proto.Clone(info.leader).(*metapb.Peer), nil
+}
+
+func isEpochStale(epoch, latest *metapb.RegionEpoch) bool {
+	return epoch.Version < latest.Version || epoch.ConfVer < latest.ConfVer
+}
+
+func containsKey(region *metapb.Region, key []byte) bool {
+	return bytes.Compare(key, region.StartKey) >= 0 && (len(region.EndKey) == 0 || bytes.Compare(key, region.EndKey) < 0)
+}
+
+func overlaps(a, b *metapb.Region) bool {
+	return (len(b.EndKey) == 0 || bytes.Compare(a.StartKey, b.EndKey) < 0) &&
+		(len(a.EndKey) == 0 || bytes.Compare(b.StartKey, a.EndKey) < 0)
+}
+
+func findPeer(region *metapb.Region, storeID uint64) *metapb.Peer {
+	for _, peer := range region.Peers {
+		if peer.StoreId == storeID {
+			return peer
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package test_raftstore
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSplit tests that a region larger than RegionMaxSize is split at the first key after RegionSplitSize bytes, and
+// that requests with the epoch or range of the region before the split are rejected.
+func TestSplit(t *testing.T) {
+	cluster := NewCluster(t, 3, func(conf *config.Config) {
+		conf.RegionMaxSize = 1500
+		conf.RegionSplitSize = 1000
+	})
+	defer cluster.Shutdown()
+	origin := cluster.MustWaitRegion(nil, func(region *metapb.Region) bool { return len(region.Peers) == 3 })
+
+	// Each key takes 100 bytes with its value, so the first key after 1000 bytes is key 10.
+	value := bytes.Repeat([]byte{'v'}, 96)
+	for i := 0; i < 20; i++ {
+		cluster.MustPut(engine_util.CfDefault, testKey(i), value)
+	}
+	left := cluster.MustWaitRegion(nil, func(region *metapb.Region) bool { return len(region.EndKey) > 0 })
+	require.Equal(t, origin.Id, left.Id)
+	require.Equal(t, testKey(10), left.EndKey)
+	require.Equal(t, origin.RegionEpoch.Version+1, left.RegionEpoch.Version)
+	right := cluster.MustWaitRegion(testKey(19), func(region *metapb.Region) bool { return region.Id != origin.Id })
+	require.Equal(t, testKey(10), right.StartKey)
+	require.Empty(t, right.EndKey)
+	require.Len(t, right.Peers, 3)
+	cluster.MustGet(engine_util.CfDefault, testKey(9), value)
+	cluster.MustGet(engine_util.CfDefault, testKey(10), value)
+
+	// rawPut writes key to the leader of the left region with ctx, it returns the region error of the response.
+	rawPut := func(ctx kvrpcpb.Context, key []byte) *errorpb.Error {
+		for _, peer := range left.Peers {
+			ctx.Peer = peer
+			resp, err := cluster.Store(peer.StoreId).Server.RawPut(context.TODO(), &kvrpcpb.RawPutRequest{
+				Context: &ctx,
+				Key:     key,
+				Value:   value,
+				Cf:      engine_util.CfDefault,
+			})
+			require.Nil(t, err)
+			if resp.RegionError.GetNotLeader() == nil {
+				return resp.RegionError
+			}
+		}
+		t.Fatal("no leader of the left region")
+		return nil
+	}
+	regionErr := rawPut(kvrpcpb.Context{RegionId: origin.Id, RegionEpoch: origin.RegionEpoch}, testKey(1))
+	require.NotNil(t, regionErr.GetEpochNotMatch())
+	regionErr = rawPut(kvrpcpb.Context{RegionId: left.Id, RegionEpoch: left.RegionEpoch}, testKey(15))
+	require.NotNil(t, regionErr.GetKeyNotInRegion())
+	require.Equal(t, testKey(15), regionErr.GetKeyNotInRegion().Key)
+	regionErr = rawPut(kvrpcpb.Context{RegionId: left.Id, RegionEpoch: left.RegionEpoch}, testKey(1))
+	require.Nil(t, regionErr)
+}
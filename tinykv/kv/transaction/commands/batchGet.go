@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// BatchGet reads the values of many keys at one snapshot. Each key is checked for locks like Get does, a locked key
+// is reported in its own pair so that the client can resolve the lock and retry only the locked keys.
+type BatchGet struct {
+	ReadOnly
+	CommandBase
+	request *kvrpcpb.BatchGetRequest
+}
+
+func NewBatchGet(request *kvrpcpb.BatchGetRequest) BatchGet {
+	return BatchGet{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.Version,
+		},
+		request: request,
+	}
+}
+
+func (bg *BatchGet) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
+	response := new(kvrpcpb.BatchGetResponse)
+
+	for _, key := range bg.request.Keys {
+		pair := &kvrpcpb.KvPair{Key: key}
+		lock, err := txn.GetLock(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if lock.IsLockedFor(key, txn.StartTS, pair) {
+			response.Pairs = append(response.Pairs, pair)
+			continue
+		}
+
+		value, err := txn.GetValue(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if value == nil {
+			// Keys which don't exist are left out.
+			continue
+		}
+		pair.Value = value
+		response.Pairs = append(response.Pairs, pair)
+	}
+
+	return response, nil, nil
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// CheckSecondaryLocks is used to decide the status of an async commit transaction whose primary lock has expired. The
+// transaction is committed if all its keys are prewritten, so each secondary key either still has an async commit
+// lock, is committed, or is rolled back. A key which was never prewritten is rolled back here, so that the transaction
+// can't be committed by a late prewrite.
+type CheckSecondaryLocks struct {
+	CommandBase
+	request *kvrpcpb.CheckSecondaryLocksRequest
+}
+
+func NewCheckSecondaryLocks(request *kvrpcpb.CheckSecondaryLocksRequest) CheckSecondaryLocks {
+	return CheckSecondaryLocks{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.StartVersion,
+		},
+		request: request,
+	}
+}
+
+func (c *CheckSecondaryLocks) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
+	response := new(kvrpcpb.CheckSecondaryLocksResponse)
+
+	for _, key := range c.request.Keys {
+		lock, err := txn.GetLock(key)
+		if err != nil {
+			return nil, err
+		}
+		if lock != nil && lock.Ts == txn.StartTS {
+			if !lock.IsPessimistic() {
+				response.Locks = append(response.Locks, lock.Info(key))
+				continue
+			}
+			// The key was never prewritten, so the transaction can't have been committed.
+			txn.DeleteLock(key)
+			txn.PutWrite(key, txn.StartTS, &mvcc.Write{StartTS: txn.StartTS, Kind: mvcc.WriteKindRollback})
+			continue
+		}
+
+		write, commitTs, err := txn.CurrentWrite(key)
+		if err != nil {
+			return nil, err
+		}
+		if write == nil {
+			txn.PutWrite(key, txn.StartTS, &mvcc.Write{StartTS: txn.StartTS, Kind: mvcc.WriteKindRollback})
+			continue
+		}
+		if write.Kind != mvcc.WriteKindRollback {
+			// The transaction is committed, the other keys don't matter.
+			response.Locks = nil
+			response.CommitTs = commitTs
+			return response, nil
+		}
+	}
+
+	return response, nil
+}
+
+func (c *CheckSecondaryLocks) WillWrite() [][]byte {
+	return c.request.Keys
+}
@@ -34,6 +34,14 @@ func (c *CheckTxnStatus) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
 	}
 
 	if lock != nil && lock.Ts == txn.StartTS {
+		if lock.UseAsyncCommit && !c.request.ForceSyncCommit {
+			// The transaction may be committed already if all its secondary locks are prewritten, so the lock is
+			// never rolled back. The client checks the secondary locks once the lock has expired.
+			response.Action = kvrpcpb.Action_NoAction
+			response.LockTtl = lock.Ttl
+			response.LockInfo = lock.Info(key)
+			return response, nil
+		}
 		if physical(lock.Ts)+lock.Ttl < physical(c.request.CurrentTs) {
 			// YOUR CODE HERE (lab1).
 			// Lock has expired, try to rollback it. `mvcc.WriteKindRollback` could be used to
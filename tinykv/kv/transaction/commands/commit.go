@@ -76,14 +76,14 @@ func commitKey(key []byte, commitTs uint64, txn *mvcc.MvccTxn, response interfac
 				return response, nil
 			}
 
-			if existingWrite.Kind == mvcc.WriteKindPut {
+			// The key is committed already, whatever the kind of its write.
+			if existingWrite.Kind != mvcc.WriteKindRollback {
 				return nil, nil
-			} else if existingWrite.Kind == mvcc.WriteKindRollback {
-				respValue := reflect.ValueOf(response)
-				keyError := &kvrpcpb.KeyError{Retryable: fmt.Sprintf("lock not found for key %v", key)}
-				reflect.Indirect(respValue).FieldByName("Error").Set(reflect.ValueOf(keyError))
-				return response, nil
 			}
+			respValue := reflect.ValueOf(response)
+			keyError := &kvrpcpb.KeyError{Retryable: fmt.Sprintf("lock not found for key %v", key)}
+			reflect.Indirect(respValue).FieldByName("Error").Set(reflect.ValueOf(keyError))
+			return response, nil
 		}
 
 		// 如果是其他事务的锁
@@ -96,6 +96,22 @@ func commitKey(key []byte, commitTs uint64, txn *mvcc.MvccTxn, response interfac
 
 	}
 
+	// A pessimistic lock which was never prewritten can't be committed.
+	if lock.IsPessimistic() {
+		respValue := reflect.ValueOf(response)
+		keyError := &kvrpcpb.KeyError{Abort: fmt.Sprintf("pessimistic lock of key %v is not prewritten", key)}
+		reflect.Indirect(respValue).FieldByName("Error").Set(reflect.ValueOf(keyError))
+		return response, nil
+	}
+
+	// An async commit transaction must not commit before a read which may have missed it.
+	if commitTs < lock.MinCommitTs {
+		respValue := reflect.ValueOf(response)
+		keyError := &kvrpcpb.KeyError{Abort: fmt.Sprintf("commit ts %d of key %v is smaller than its min commit ts %d", commitTs, key, lock.MinCommitTs)}
+		reflect.Indirect(respValue).FieldByName("Error").Set(reflect.ValueOf(keyError))
+		return response, nil
+	}
+
 	// Commit a Write object to the DB
 	write := mvcc.Write{StartTS: txn.StartTS, Kind: lock.Kind}
 	txn.PutWrite(key, commitTs, &write)
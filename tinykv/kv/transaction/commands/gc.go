@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// GC removes the versions of keys which can't be read by any transaction reading at or after the safe point. The keys
+// to collect are found in the read phase and then latched, like ResolveLock. Versions committed after the safe point
+// are never touched, so collecting races with no command except another GC, and removing a version twice is harmless.
+type GC struct {
+	CommandBase
+	request *kvrpcpb.GCRequest
+	writes  []mvcc.GcWrite
+	nextKey []byte
+}
+
+func NewGC(request *kvrpcpb.GCRequest) GC {
+	return GC{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.SafePoint,
+		},
+		request: request,
+	}
+}
+
+func (gc *GC) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
+	writes, nextKey, err := mvcc.GcWrites(txn, gc.request.StartKey, gc.request.SafePoint, int(gc.request.Limit))
+	if err != nil {
+		return nil, nil, err
+	}
+	gc.writes = writes
+	gc.nextKey = nextKey
+	if len(writes) == 0 {
+		return &kvrpcpb.GCResponse{NextKey: nextKey}, nil, nil
+	}
+
+	var keys [][]byte
+	for i, w := range writes {
+		if i == 0 || string(w.Key) != string(writes[i-1].Key) {
+			keys = append(keys, w.Key)
+		}
+	}
+	return nil, keys, nil
+}
+
+func (gc *GC) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
+	log.Debug("gc writes", zap.Uint64("safe_point", gc.request.SafePoint), zap.Int("writes", len(gc.writes)))
+	for _, w := range gc.writes {
+		txn.DeleteWrite(w.Key, w.CommitTs)
+		if w.Write.Kind == mvcc.WriteKindPut {
+			txn.DeleteValueAt(w.Key, w.Write.StartTS)
+		}
+	}
+	return &kvrpcpb.GCResponse{NextKey: gc.nextKey}, nil
+}
+
+func (gc *GC) WillWrite() [][]byte {
+	return nil
+}
@@ -38,7 +38,7 @@ func (g *Get) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
 	// if there is a lock on key
 	lock, err := txn.GetLock(key)
 
-	if lock != nil && lock.Kind == mvcc.WriteKindPut {
+	if lock != nil && lock.BlocksReads() {
 
 		if g.request.Version < lock.Ts {
 			value, _ := txn.GetValue(key)
@@ -46,8 +46,7 @@ func (g *Get) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
 			return response, nil, nil
 		}
 
-		lockInfo := kvrpcpb.LockInfo{PrimaryLock: lock.Primary, LockVersion: lock.Ts, Key: key, LockTtl: lock.Ttl}
-		keyError := kvrpcpb.KeyError{Locked: &lockInfo}
+		keyError := kvrpcpb.KeyError{Locked: lock.Info(key)}
 		response.Error = &keyError
 		return response, nil, err
 	}
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// PessimisticLock locks keys of a pessimistic transaction while the transaction is executing. Unlike prewrite,
+// a write is only a conflict if it was committed after the for_update_ts of the request, which the client
+// advances every time it retries a statement. Either all keys are locked or none of them are.
+type PessimisticLock struct {
+	CommandBase
+	request *kvrpcpb.PessimisticLockRequest
+}
+
+func NewPessimisticLock(request *kvrpcpb.PessimisticLockRequest) PessimisticLock {
+	return PessimisticLock{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.StartVersion,
+		},
+		request: request,
+	}
+}
+
+func (pl *PessimisticLock) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
+	response := new(kvrpcpb.PessimisticLockResponse)
+
+	// Check all keys before writing any lock, so that a failed request leaves no locks behind.
+	var toLock [][]byte
+	for _, m := range pl.request.Mutations {
+		needLock, keyError, err := pl.checkKey(txn, m.Key)
+		if err != nil {
+			return nil, err
+		}
+		if keyError != nil {
+			response.Errors = append(response.Errors, keyError)
+		} else if needLock {
+			toLock = append(toLock, m.Key)
+		}
+	}
+	if len(response.Errors) > 0 {
+		return response, nil
+	}
+
+	for _, key := range toLock {
+		log.Debug("pessimistic lock key", zap.Uint64("start_ts", txn.StartTS),
+			zap.Uint64("for_update_ts", pl.request.ForUpdateTs),
+			zap.String("key", hex.EncodeToString(key)))
+		txn.PutLock(key, &mvcc.Lock{
+			Primary:     pl.request.PrimaryLock,
+			Ts:          txn.StartTS,
+			Ttl:         pl.request.LockTtl,
+			Kind:        mvcc.WriteKindPessimisticLock,
+			ForUpdateTs: pl.request.ForUpdateTs,
+		})
+	}
+	return response, nil
+}
+
+// checkKey returns whether key needs to be locked, or the key error which prevents locking it.
+func (pl *PessimisticLock) checkKey(txn *mvcc.MvccTxn, key []byte) (bool, *kvrpcpb.KeyError, error) {
+	lock, err := txn.GetLock(key)
+	if err != nil {
+		return false, nil, err
+	}
+	if lock != nil {
+		if lock.Ts != txn.StartTS {
+			return false, &kvrpcpb.KeyError{Locked: lock.Info(key)}, nil
+		}
+		// The key is locked by this transaction already. A pessimistic lock is refreshed with the newer
+		// for_update_ts, a prewritten lock is left alone.
+		return lock.IsPessimistic() && lock.ForUpdateTs < pl.request.ForUpdateTs, nil, nil
+	}
+
+	// The transaction may have been rolled back by another one resolving its locks.
+	currentWrite, _, err := txn.CurrentWrite(key)
+	if err != nil {
+		return false, nil, err
+	}
+	if currentWrite != nil {
+		return false, &kvrpcpb.KeyError{Abort: fmt.Sprintf("transaction %d has already been finished on key %v", txn.StartTS, key)}, nil
+	}
+
+	_, commitTs, err := txn.MostRecentWrite(key)
+	if err != nil {
+		return false, nil, err
+	}
+	if commitTs > pl.request.ForUpdateTs {
+		return false, &kvrpcpb.KeyError{Conflict: &kvrpcpb.WriteConflict{
+			StartTs:    txn.StartTS,
+			ConflictTs: commitTs,
+			Key:        key,
+			Primary:    pl.request.PrimaryLock,
+		}}, nil
+	}
+	return true, nil, nil
+}
+
+func (pl *PessimisticLock) WillWrite() [][]byte {
+	result := [][]byte{}
+	for _, m := range pl.request.Mutations {
+		result = append(result, m.Key)
+	}
+	return result
+}
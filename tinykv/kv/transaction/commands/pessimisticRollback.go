@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"encoding/hex"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// PessimisticRollback releases the pessimistic locks a transaction acquired for a statement which failed, so that
+// the statement can be retried with a newer for_update_ts. No rollback record is written since the transaction
+// itself goes on.
+type PessimisticRollback struct {
+	CommandBase
+	request *kvrpcpb.PessimisticRollbackRequest
+}
+
+func NewPessimisticRollback(request *kvrpcpb.PessimisticRollbackRequest) PessimisticRollback {
+	return PessimisticRollback{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.StartVersion,
+		},
+		request: request,
+	}
+}
+
+func (pr *PessimisticRollback) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
+	response := new(kvrpcpb.PessimisticRollbackResponse)
+
+	for _, key := range pr.request.Keys {
+		lock, err := txn.GetLock(key)
+		if err != nil {
+			return nil, err
+		}
+		// Prewritten locks and the locks acquired by a later statement are left alone.
+		if lock == nil || lock.Ts != txn.StartTS || !lock.IsPessimistic() || lock.ForUpdateTs > pr.request.ForUpdateTs {
+			continue
+		}
+		log.Debug("pessimistic rollback key", zap.Uint64("start_ts", txn.StartTS),
+			zap.Uint64("for_update_ts", pr.request.ForUpdateTs),
+			zap.String("key", hex.EncodeToString(key)))
+		txn.DeleteLock(key)
+	}
+	return response, nil
+}
+
+func (pr *PessimisticRollback) WillWrite() [][]byte {
+	return pr.request.Keys
+}
@@ -1,7 +1,10 @@
 package commands
 
 import (
+	"bytes"
 	"encoding/hex"
+	"fmt"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/concurrency"
 	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	"github.com/pingcap/log"
@@ -12,18 +15,30 @@ import (
 // if the whole transaction can be written to underlying storage atomically and without conflicting with other
 // transactions (complete or in-progress) then success is returned to the client. If all a client's prewrites succeed,
 // then it will send a commit message. I.e., prewrite is the first phase in a two phase commit.
+//
+// For async commit, there is no second phase: the transaction is committed once all its keys are prewritten, see
+// CheckSecondaryLocks. For 1PC, all keys are in one request and prewrite commits them right away. In both cases the
+// commit ts is calculated by prewrite, see package concurrency.
 type Prewrite struct {
 	CommandBase
 	request *kvrpcpb.PrewriteRequest
+	cm      *concurrency.Manager
+	// minCommitTs is the smallest commit ts of an async commit or 1PC transaction, 0 for an ordinary two phase commit.
+	minCommitTs uint64
+	// onePcMutations are the mutations to commit if the whole 1PC request succeeds.
+	onePcMutations []*kvrpcpb.Mutation
+	// committedTs is the commit ts of the transaction if the request is a retry and its keys are committed already.
+	committedTs uint64
 }
 
-func NewPrewrite(request *kvrpcpb.PrewriteRequest) Prewrite {
+func NewPrewrite(request *kvrpcpb.PrewriteRequest, cm *concurrency.Manager) Prewrite {
 	return Prewrite{
 		CommandBase: CommandBase{
 			context: request.Context,
 			startTs: request.StartVersion,
 		},
 		request: request,
+		cm:      cm,
 	}
 }
 
@@ -45,9 +60,21 @@ func NewPrewrite(request *kvrpcpb.PrewriteRequest) Prewrite {
 func (p *Prewrite) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
 	response := new(kvrpcpb.PrewriteResponse)
 
+	if p.request.UseAsyncCommit || p.request.TryOnePc {
+		p.minCommitTs = p.calculateMinCommitTs()
+	}
+
 	// Prewrite all mutations in the request.
-	for _, m := range p.request.Mutations {
-		keyError, err := p.prewriteMutation(txn, m)
+	for i, m := range p.request.Mutations {
+		var keyError *kvrpcpb.KeyError
+		var err error
+		if !isPrewriteOp(m.Op) {
+			keyError = &kvrpcpb.KeyError{Abort: fmt.Sprintf("unexpected op %v of key %v in prewrite", m.Op, m.Key)}
+		} else if i < len(p.request.IsPessimisticLock) && p.request.IsPessimisticLock[i] {
+			keyError, err = p.prewritePessimisticMutation(txn, m)
+		} else {
+			keyError, err = p.prewriteMutation(txn, m)
+		}
 		if keyError != nil {
 			response.Errors = append(response.Errors, keyError)
 		} else if err != nil {
@@ -55,9 +82,68 @@ func (p *Prewrite) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
 		}
 	}
 
+	if p.committedTs > 0 && len(response.Errors) == 0 {
+		// An earlier 1PC request has committed the transaction, report its commit ts again.
+		response.OnePcCommitTs = p.committedTs
+		return response, nil
+	}
+	if p.request.TryOnePc && p.minCommitTs > 0 {
+		if len(response.Errors) > 0 {
+			// Nothing is written, the client will retry.
+			return response, nil
+		}
+		if len(p.onePcMutations) < len(p.request.Mutations) {
+			// Some keys were prewritten by an earlier request, which fell back to two phase commit.
+			p.minCommitTs = 0
+			for _, m := range p.onePcMutations {
+				p.writeLockAndValue(txn, m)
+			}
+			return response, nil
+		}
+		p.commitOnePc(txn)
+		response.OnePcCommitTs = p.minCommitTs
+		return response, nil
+	}
+	if p.request.UseAsyncCommit {
+		response.MinCommitTs = p.minCommitTs
+	}
+
 	return response, nil
 }
 
+// calculateMinCommitTs returns the smallest commit ts of an async commit or 1PC transaction: it must be larger than
+// any ts a read has used, so that no read misses the transaction. It returns 0 if the ts would exceed the max_commit_ts
+// of the request, the keys are then prewritten for an ordinary two phase commit.
+func (p *Prewrite) calculateMinCommitTs() uint64 {
+	minCommitTs := p.cm.MaxTs()
+	if p.request.StartVersion > minCommitTs {
+		minCommitTs = p.request.StartVersion
+	}
+	if p.request.ForUpdateTs > minCommitTs {
+		minCommitTs = p.request.ForUpdateTs
+	}
+	minCommitTs++
+	if p.request.MaxCommitTs > 0 && minCommitTs > p.request.MaxCommitTs {
+		log.Debug("fall back to two phase commit", zap.Uint64("start_ts", p.request.StartVersion),
+			zap.Uint64("min_commit_ts", minCommitTs), zap.Uint64("max_commit_ts", p.request.MaxCommitTs))
+		return 0
+	}
+	return minCommitTs
+}
+
+// commitOnePc writes the mutations of a 1PC request as committed at minCommitTs.
+func (p *Prewrite) commitOnePc(txn *mvcc.MvccTxn) {
+	for i, m := range p.onePcMutations {
+		if i < len(p.request.IsPessimisticLock) && p.request.IsPessimisticLock[i] {
+			txn.DeleteLock(m.Key)
+		}
+		if hasValue(m) {
+			txn.PutValue(m.Key, m.Value)
+		}
+		txn.PutWrite(m.Key, p.minCommitTs, &mvcc.Write{StartTS: txn.StartTS, Kind: mvcc.WriteKindFromProto(m.Op)})
+	}
+}
+
 // prewriteMutation prewrites mut to txn. It returns (nil, nil) on success, (err, nil) if the key in mut is already
 // locked or there is any other key error, and (nil, err) if an internal error occurs.
 func (p *Prewrite) prewriteMutation(txn *mvcc.MvccTxn, mut *kvrpcpb.Mutation) (*kvrpcpb.KeyError, error) {
@@ -70,39 +156,43 @@ func (p *Prewrite) prewriteMutation(txn *mvcc.MvccTxn, mut *kvrpcpb.Mutation) (*
 	//		 denote to write conflict error, try to set error information properly in the `kvrpcpb.KeyError`
 	//		 response.
 	existingWrite, commitTS, err := txn.RoTxn.MostRecentWrite(key)
+	if err != nil {
+		return nil, err
+	}
 	keyError := new(kvrpcpb.KeyError)
 
-	if txn.StartTS < commitTS && existingWrite.Kind == mvcc.WriteKindPut {
+	if txn.StartTS < commitTS && existingWrite.Kind != mvcc.WriteKindRollback {
+		if p.request.TryOnePc && existingWrite.StartTS == txn.StartTS {
+			// The write is the commit record of this transaction, the request is a retry of a 1PC prewrite which
+			// committed the key already.
+			p.committedTs = commitTS
+			return nil, nil
+		}
 		conflict := kvrpcpb.WriteConflict{StartTs: txn.StartTS, Key: key, Primary: p.request.PrimaryLock}
 		keyError.Conflict = &conflict
-		return keyError, err
+		return keyError, nil
 	}
 
 	// YOUR CODE HERE (lab1).
 	// Check if key is locked. Report key is locked error if lock does exist, note the key could be locked
 	// by this transaction already and the current prewrite request is stale.
 	lock, err := txn.RoTxn.GetLock(key)
+	if err != nil {
+		return nil, err
+	}
 
 	if lock != nil {
 
 		// 如果key对应的lock正在被占用
-		if lock.Kind == mvcc.WriteKindPut {
-
-			// 如果同一个事务多次发出相同的prewrite请求
-			if lock.Ts == txn.StartTS {
-				return nil, nil
-			}
-
-			lockInfo := kvrpcpb.LockInfo{LockVersion: lock.Ts, LockTtl: lock.Ttl, Key: key, PrimaryLock: p.request.GetPrimaryLock()}
-			keyError.Locked = &lockInfo
+		// A pessimistic lock of another transaction blocks the key just like a prewritten one.
+		if lock.Ts != txn.StartTS {
+			keyError.Locked = lock.Info(key)
 			return keyError, nil
 		}
 
-		// 如果lock过时了
-		if lock.Ts == txn.StartTS && lock.Kind == mvcc.WriteKindRollback {
-			lockInfo := kvrpcpb.LockInfo{LockVersion: lock.Ts, LockTtl: lock.Ttl, Key: key, PrimaryLock: p.request.GetPrimaryLock()}
-			keyError.Locked = &lockInfo
-			return keyError, nil
+		// 如果同一个事务多次发出相同的prewrite请求
+		if !lock.IsPessimistic() {
+			return nil, nil
 		}
 
 	} else {
@@ -115,17 +205,108 @@ func (p *Prewrite) prewriteMutation(txn *mvcc.MvccTxn, mut *kvrpcpb.Mutation) (*
 		}
 	}
 
+	if keyError, err := checkNotExists(txn, mut); keyError != nil || err != nil {
+		return keyError, err
+	}
+
 	// YOUR CODE HERE (lab1).
 	// Write a lock and value.
 	// Hint: Check the interfaces provided by `mvccTxn.Txn`.
-	tmpLock := mvcc.Lock{Ttl: p.request.LockTtl, Kind: mvcc.WriteKindPut, Ts: p.request.StartVersion, Primary: p.request.GetPrimaryLock()} // 这个保证所有的key写入的都是pk的值
-	txn.PutLock(key, &tmpLock)
+	p.writeLockAndValue(txn, mut)
 
-	txn.PutValue(key, mut.Value)
+	return nil, nil
+}
 
+// prewritePessimisticMutation prewrites a key which the transaction has locked with a PessimisticLock request.
+// Write conflicts were checked when the key was locked, so it only has to convert the pessimistic lock.
+func (p *Prewrite) prewritePessimisticMutation(txn *mvcc.MvccTxn, mut *kvrpcpb.Mutation) (*kvrpcpb.KeyError, error) {
+	key := mut.Key
+	log.Debug("prewrite pessimistic key", zap.Uint64("start_ts", txn.StartTS),
+		zap.Uint64("for_update_ts", p.request.ForUpdateTs),
+		zap.String("key", hex.EncodeToString(key)))
+	lock, err := txn.GetLock(key)
+	if err != nil {
+		return nil, err
+	}
+	if lock == nil && p.request.TryOnePc {
+		// A 1PC request converts the pessimistic locks and commits right away, the request may be its retry.
+		write, commitTs, err := txn.MostRecentWrite(key)
+		if err != nil {
+			return nil, err
+		}
+		if write != nil && write.StartTS == txn.StartTS && write.Kind != mvcc.WriteKindRollback {
+			p.committedTs = commitTs
+			return nil, nil
+		}
+	}
+	if lock == nil || lock.Ts != txn.StartTS {
+		// The lock was rolled back or resolved by another transaction, the transaction can't go on.
+		return &kvrpcpb.KeyError{Abort: fmt.Sprintf("pessimistic lock not found for key %v", key)}, nil
+	}
+	if !lock.IsPessimistic() {
+		// The prewrite request is stale, the key is prewritten already.
+		return nil, nil
+	}
+	if keyError, err := checkNotExists(txn, mut); keyError != nil || err != nil {
+		return keyError, err
+	}
+	p.writeLockAndValue(txn, mut)
 	return nil, nil
 }
 
+// checkNotExists returns an AlreadyExist key error if mut is an insert and its key has a committed value. The key is
+// locked by the transaction and there is no conflicting write, so nothing is committed after the latest value.
+func checkNotExists(txn *mvcc.MvccTxn, mut *kvrpcpb.Mutation) (*kvrpcpb.KeyError, error) {
+	if mut.Op != kvrpcpb.Op_Insert {
+		return nil, nil
+	}
+	// A pessimistic transaction may insert a key committed after its start ts, so read the latest value.
+	latest := mvcc.RoTxn{Reader: txn.Reader, StartTS: mvcc.TsMax}
+	value, err := latest.GetValue(mut.Key)
+	if err != nil {
+		return nil, err
+	}
+	if value != nil {
+		return &kvrpcpb.KeyError{AlreadyExist: &kvrpcpb.AlreadyExist{Key: mut.Key}}, nil
+	}
+	return nil, nil
+}
+
+// isPrewriteOp returns true for the ops a prewrite mutation may have.
+func isPrewriteOp(op kvrpcpb.Op) bool {
+	switch op {
+	case kvrpcpb.Op_Put, kvrpcpb.Op_Del, kvrpcpb.Op_Lock, kvrpcpb.Op_Insert:
+		return true
+	}
+	return false
+}
+
+// hasValue returns true if mut writes a value to the default CF.
+func hasValue(mut *kvrpcpb.Mutation) bool {
+	return mut.Op == kvrpcpb.Op_Put || mut.Op == kvrpcpb.Op_Insert
+}
+
+func (p *Prewrite) writeLockAndValue(txn *mvcc.MvccTxn, mut *kvrpcpb.Mutation) {
+	if p.request.TryOnePc && p.minCommitTs > 0 {
+		p.onePcMutations = append(p.onePcMutations, mut)
+		return
+	}
+	kind := mvcc.WriteKindFromProto(mut.Op)
+	tmpLock := mvcc.Lock{Ttl: p.request.LockTtl, Kind: kind, Ts: p.request.StartVersion, Primary: p.request.GetPrimaryLock()} // 这个保证所有的key写入的都是pk的值
+	if p.request.UseAsyncCommit && p.minCommitTs > 0 {
+		tmpLock.UseAsyncCommit = true
+		tmpLock.MinCommitTs = p.minCommitTs
+		if bytes.Equal(mut.Key, p.request.PrimaryLock) {
+			tmpLock.Secondaries = p.request.Secondaries
+		}
+	}
+	txn.PutLock(mut.Key, &tmpLock)
+
+	if hasValue(mut) {
+		txn.PutValue(mut.Key, mut.Value)
+	}
+}
+
 func (p *Prewrite) WillWrite() [][]byte {
 	result := [][]byte{}
 	for _, m := range p.request.Mutations {
@@ -46,8 +46,12 @@ func (rl *ResolveLock) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
 			txn.DeleteLock(kl.Key)
 		}
 
-		if commitTs > 0 && rl.request.StartVersion == kl.Lock.Ts {
-			txn.PutWrite(kl.Key, commitTs, &(mvcc.Write{rl.StartTs(), mvcc.WriteKindPut}))
+		if commitTs > 0 && rl.request.StartVersion == kl.Lock.Ts && kl.Lock.IsPessimistic() {
+			// The transaction committed without prewriting this key, the lock is left over from a
+			// statement which failed and can simply be dropped.
+			txn.DeleteLock(kl.Key)
+		} else if commitTs > 0 && rl.request.StartVersion == kl.Lock.Ts {
+			txn.PutWrite(kl.Key, commitTs, &(mvcc.Write{rl.StartTs(), kl.Lock.Kind}))
 			txn.DeleteLock(kl.Key)
 		}
 
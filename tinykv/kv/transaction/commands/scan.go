@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"bytes"
+
 	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 )
@@ -25,7 +27,12 @@ func NewScan(request *kvrpcpb.ScanRequest) Scan {
 func (s *Scan) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
 	response := new(kvrpcpb.ScanResponse)
 
-	scanner := mvcc.NewScanner(s.request.StartKey, txn)
+	var scanner mvcc.PairScanner
+	if s.request.Reverse {
+		scanner = mvcc.NewReverseScanner(s.request.StartKey, txn)
+	} else {
+		scanner = mvcc.NewScanner(s.request.StartKey, txn)
+	}
 	defer scanner.Close()
 	limit := s.request.Limit
 	for {
@@ -52,6 +59,14 @@ func (s *Scan) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
 			// Reached the end of the DB
 			return response, nil, nil
 		}
+		if s.request.Reverse && bytes.Compare(key, s.request.EndKey) < 0 {
+			// Reached the lower bound of a reverse scan.
+			return response, nil, nil
+		}
+		if !s.request.Reverse && len(s.request.EndKey) > 0 && bytes.Compare(key, s.request.EndKey) >= 0 {
+			// Reached the upper bound of a forward scan.
+			return response, nil, nil
+		}
 
 		pair := kvrpcpb.KvPair{}
 		pair.Key = key
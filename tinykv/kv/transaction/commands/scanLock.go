@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// ScanLock finds the locks of transactions which started no later than max_version. Before a GC, the client resolves
+// these locks so that no transaction older than the safe point is left undecided.
+type ScanLock struct {
+	ReadOnly
+	CommandBase
+	request *kvrpcpb.ScanLockRequest
+}
+
+func NewScanLock(request *kvrpcpb.ScanLockRequest) ScanLock {
+	return ScanLock{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.MaxVersion,
+		},
+		request: request,
+	}
+}
+
+func (sl *ScanLock) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
+	response := new(kvrpcpb.ScanLockResponse)
+
+	keyLocks, err := mvcc.LocksBefore(txn, sl.request.StartKey, sl.request.MaxVersion, int(sl.request.Limit))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, kl := range keyLocks {
+		response.Locks = append(response.Locks, kl.Lock.Info(kl.Key))
+	}
+	return response, nil, nil
+}
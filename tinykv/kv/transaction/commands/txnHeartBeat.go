@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// TxnHeartBeat extends the TTL of a transaction's primary lock, so that a transaction which runs longer than its
+// initial TTL isn't rolled back by CheckTxnStatus requests from other transactions.
+type TxnHeartBeat struct {
+	CommandBase
+	request *kvrpcpb.TxnHeartBeatRequest
+}
+
+func NewTxnHeartBeat(request *kvrpcpb.TxnHeartBeatRequest) TxnHeartBeat {
+	return TxnHeartBeat{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.StartVersion,
+		},
+		request: request,
+	}
+}
+
+func (hb *TxnHeartBeat) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
+	key := hb.request.PrimaryLock
+	response := new(kvrpcpb.TxnHeartBeatResponse)
+
+	lock, err := txn.GetLock(key)
+	if err != nil {
+		return nil, err
+	}
+	if lock == nil || lock.Ts != txn.StartTS {
+		// The transaction has been committed or rolled back, possibly by another transaction which found its lock
+		// expired.
+		response.Error = &kvrpcpb.KeyError{Abort: fmt.Sprintf("transaction %d not found on primary key %v", txn.StartTS, key)}
+		return response, nil
+	}
+	if hb.request.AdviseLockTtl > lock.Ttl {
+		lock.Ttl = hb.request.AdviseLockTtl
+		txn.PutLock(key, lock)
+	}
+	response.LockTtl = lock.Ttl
+	return response, nil
+}
+
+func (hb *TxnHeartBeat) WillWrite() [][]byte {
+	return [][]byte{hb.request.PrimaryLock}
+}
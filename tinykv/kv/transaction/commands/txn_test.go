@@ -10,6 +10,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/storage"
 	"github.com/pingcap-incubator/tinykv/kv/storage/standalone_storage"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/concurrency"
 	latches2 "github.com/pingcap-incubator/tinykv/kv/transaction/latches"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/tsoutil"
@@ -76,7 +77,7 @@ func mustPrewritePut(t *testing.T, store storage.Storage, latches *latches2.Latc
 		StartVersion: startTS,
 		LockTtl:      lockTTL,
 	}
-	prewriteCmd := NewPrewrite(req)
+	prewriteCmd := NewPrewrite(req, concurrency.NewManager())
 	resp, err := RunCommand(&prewriteCmd, store, latches)
 	assert.Nil(t, err)
 	prewriteResp, ok := resp.(*kvrpcpb.PrewriteResponse)
@@ -98,7 +99,7 @@ func mustPrewriteDel(t *testing.T, store storage.Storage, latches *latches2.Latc
 		StartVersion: startTS,
 		LockTtl:      lockTTL,
 	}
-	prewriteCmd := NewPrewrite(req)
+	prewriteCmd := NewPrewrite(req, concurrency.NewManager())
 	resp, err := RunCommand(&prewriteCmd, store, latches)
 	assert.Nil(t, err)
 	prewriteResp, ok := resp.(*kvrpcpb.PrewriteResponse)
@@ -121,7 +122,7 @@ func mustPrewritePutErr(t *testing.T, store storage.Storage, latches *latches2.L
 		StartVersion: startTS,
 		LockTtl:      lockTTL,
 	}
-	prewriteCmd := NewPrewrite(req)
+	prewriteCmd := NewPrewrite(req, concurrency.NewManager())
 	resp, err := RunCommand(&prewriteCmd, store, latches)
 	assert.Nil(t, err)
 	prewriteResp, ok := resp.(*kvrpcpb.PrewriteResponse)
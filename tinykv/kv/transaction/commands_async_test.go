@@ -0,0 +1,230 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func (builder *testBuilder) getLock(key []byte) *mvcc.Lock {
+	lock, err := mvcc.ParseLock(builder.mem.Get(engine_util.CfLock, key))
+	assert.Nil(builder.t, err)
+	return lock
+}
+
+// read runs a get at ts, so that the max ts of the server is at least ts.
+func (builder *testBuilder) read(key []byte, ts uint64) {
+	resp := builder.runOneRequest(&kvrpcpb.GetRequest{Key: key, Version: ts}).(*kvrpcpb.GetResponse)
+	assert.Nil(builder.t, resp.Error)
+}
+
+// TestAsyncCommitPrewrite tests that an async commit prewrite calculates the min commit ts from the reads the server
+// has seen, and records the secondaries in the primary lock.
+func TestAsyncCommitPrewrite(t *testing.T) {
+	builder := newBuilder(t)
+	builder.read([]byte{9}, 200)
+
+	req := builder.prewriteRequest(mutation(1, []byte{42}, kvrpcpb.Op_Put), mutation(2, []byte{43}, kvrpcpb.Op_Put))
+	req.UseAsyncCommit = true
+	req.Secondaries = [][]byte{{2}}
+	resp := builder.runOneRequest(req).(*kvrpcpb.PrewriteResponse)
+
+	assert.Empty(t, resp.Errors)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, uint64(201), resp.MinCommitTs)
+	assert.Equal(t, uint64(0), resp.OnePcCommitTs)
+	builder.assertLens(2, 2, 0)
+	primary := builder.getLock([]byte{1})
+	assert.True(t, primary.UseAsyncCommit)
+	assert.Equal(t, uint64(201), primary.MinCommitTs)
+	assert.Equal(t, [][]byte{{2}}, primary.Secondaries)
+	secondary := builder.getLock([]byte{2})
+	assert.True(t, secondary.UseAsyncCommit)
+	assert.Equal(t, uint64(201), secondary.MinCommitTs)
+	assert.Empty(t, secondary.Secondaries)
+
+	// The transaction can't commit before the read.
+	commit := builder.commitRequest([]byte{1}, []byte{2})
+	commit.StartVersion = req.StartVersion
+	commit.CommitVersion = 150
+	commitResp := builder.runOneRequest(commit).(*kvrpcpb.CommitResponse)
+	assert.NotNil(t, commitResp.Error)
+	builder.assertLens(2, 2, 0)
+
+	commit.CommitVersion = 201
+	commitResp = builder.runOneRequest(commit).(*kvrpcpb.CommitResponse)
+	assert.Nil(t, commitResp.Error)
+	builder.assertLens(2, 0, 2)
+	builder.assert([]kv{
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 201, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 201, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+}
+
+// TestAsyncCommitFallback tests that keys are prewritten for two phase commit if the min commit ts would exceed the
+// max commit ts.
+func TestAsyncCommitFallback(t *testing.T) {
+	builder := newBuilder(t)
+	builder.read([]byte{9}, 200)
+
+	req := builder.prewriteRequest(mutation(1, []byte{42}, kvrpcpb.Op_Put))
+	req.UseAsyncCommit = true
+	req.MaxCommitTs = 150
+	resp := builder.runOneRequest(req).(*kvrpcpb.PrewriteResponse)
+
+	assert.Empty(t, resp.Errors)
+	assert.Equal(t, uint64(0), resp.MinCommitTs)
+	builder.assertLens(1, 1, 0)
+	builder.assert([]kv{
+		{cf: engine_util.CfLock, key: []byte{1}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+}
+
+// TestOnePc tests that a 1PC prewrite commits its keys.
+func TestOnePc(t *testing.T) {
+	builder := newBuilder(t)
+	builder.read([]byte{9}, 200)
+
+	req := builder.prewriteRequest(mutation(1, []byte{42}, kvrpcpb.Op_Put), mutation(2, []byte{43}, kvrpcpb.Op_Put))
+	req.TryOnePc = true
+	resp := builder.runOneRequest(req).(*kvrpcpb.PrewriteResponse)
+
+	assert.Empty(t, resp.Errors)
+	assert.Equal(t, uint64(201), resp.OnePcCommitTs)
+	builder.assertLens(2, 0, 2)
+	builder.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfDefault, key: []byte{2}, ts: 100, value: []byte{43}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 201, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 201, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+}
+
+// TestOnePcLocked tests that a 1PC prewrite writes nothing if any key is locked.
+func TestOnePcLocked(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{2}, ts: 90, value: []byte{7}},
+		{cf: engine_util.CfLock, key: []byte{2}, value: []byte{2, 1, 0, 0, 0, 0, 0, 0, 0, 90, 0, 0, 0, 0, 0, 0, 0, 10}},
+	})
+
+	req := builder.prewriteRequest(mutation(1, []byte{42}, kvrpcpb.Op_Put), mutation(2, []byte{43}, kvrpcpb.Op_Put))
+	req.TryOnePc = true
+	resp := builder.runOneRequest(req).(*kvrpcpb.PrewriteResponse)
+
+	assert.Len(t, resp.Errors, 1)
+	assert.NotNil(t, resp.Errors[0].Locked)
+	assert.Equal(t, uint64(0), resp.OnePcCommitTs)
+	builder.assertLens(1, 1, 0)
+}
+
+// TestOnePcRetry tests that a 1PC prewrite sent again after it has committed reports the same commit ts instead of a
+// write conflict with its own writes.
+func TestOnePcRetry(t *testing.T) {
+	builder := newBuilder(t)
+	builder.read([]byte{9}, 200)
+
+	req := builder.prewriteRequest(mutation(1, []byte{42}, kvrpcpb.Op_Put), mutation(2, []byte{43}, kvrpcpb.Op_Lock))
+	req.TryOnePc = true
+	resp := builder.runOneRequest(req).(*kvrpcpb.PrewriteResponse)
+	assert.Empty(t, resp.Errors)
+	assert.Equal(t, uint64(201), resp.OnePcCommitTs)
+
+	builder.read([]byte{9}, 300)
+	resp = builder.runOneRequest(req).(*kvrpcpb.PrewriteResponse)
+	assert.Empty(t, resp.Errors)
+	assert.Equal(t, uint64(201), resp.OnePcCommitTs)
+	builder.assertLens(1, 0, 2)
+	builder.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 201, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 201, value: []byte{5, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+}
+
+// TestCheckTxnStatusAsyncCommit tests that an expired async commit lock is only rolled back if sync commit is forced.
+func TestCheckTxnStatusAsyncCommit(t *testing.T) {
+	builder := newBuilder(t)
+	lock := mvcc.Lock{
+		Primary:        []byte{1},
+		Ts:             100,
+		Ttl:            10,
+		Kind:           mvcc.WriteKindPut,
+		UseAsyncCommit: true,
+		MinCommitTs:    101,
+		Secondaries:    [][]byte{{2}},
+	}
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfLock, key: []byte{1}, value: lock.ToBytes()},
+	})
+
+	req := &kvrpcpb.CheckTxnStatusRequest{PrimaryKey: []byte{1}, LockTs: 100, CurrentTs: 1 << 40}
+	resp := builder.runOneRequest(req).(*kvrpcpb.CheckTxnStatusResponse)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, kvrpcpb.Action_NoAction, resp.Action)
+	assert.Equal(t, uint64(10), resp.LockTtl)
+	assert.NotNil(t, resp.LockInfo)
+	assert.True(t, resp.LockInfo.UseAsyncCommit)
+	assert.Equal(t, [][]byte{{2}}, resp.LockInfo.Secondaries)
+	builder.assertLens(1, 1, 0)
+
+	req.ForceSyncCommit = true
+	resp = builder.runOneRequest(req).(*kvrpcpb.CheckTxnStatusResponse)
+	assert.Equal(t, kvrpcpb.Action_TTLExpireRollback, resp.Action)
+	assert.Equal(t, uint64(0), resp.LockTtl)
+	builder.assertLens(0, 0, 1)
+}
+
+// TestCheckSecondaryLocks tests that secondary keys which aren't prewritten are rolled back.
+func TestCheckSecondaryLocks(t *testing.T) {
+	builder := newBuilder(t)
+	lock := mvcc.Lock{
+		Primary:        []byte{1},
+		Ts:             100,
+		Ttl:            10,
+		Kind:           mvcc.WriteKindPut,
+		UseAsyncCommit: true,
+		MinCommitTs:    101,
+	}
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{2}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfLock, key: []byte{2}, value: lock.ToBytes()},
+	})
+
+	resp := builder.runOneRequest(checkSecondaryLocksRequest(100, []byte{2}, []byte{3})).(*kvrpcpb.CheckSecondaryLocksResponse)
+	assert.Nil(t, resp.Error)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, uint64(0), resp.CommitTs)
+	assert.Len(t, resp.Locks, 1)
+	assert.Equal(t, []byte{2}, resp.Locks[0].Key)
+	assert.Equal(t, uint64(101), resp.Locks[0].MinCommitTs)
+	builder.assertLens(1, 1, 1)
+	builder.assert([]kv{
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 100, value: []byte{3, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+
+	// A late prewrite of the rolled back key fails.
+	prewrite := builder.prewriteRequest(mutation(3, []byte{43}, kvrpcpb.Op_Put))
+	prewrite.StartVersion = 100
+	prewriteResp := builder.runOneRequest(prewrite).(*kvrpcpb.PrewriteResponse)
+	assert.Len(t, prewriteResp.Errors, 1)
+	builder.assertLens(1, 1, 1)
+}
+
+// TestCheckSecondaryLocksCommitted tests that the commit ts is returned if any secondary key is committed.
+func TestCheckSecondaryLocksCommitted(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{2}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+
+	resp := builder.runOneRequest(checkSecondaryLocksRequest(100, []byte{3}, []byte{2})).(*kvrpcpb.CheckSecondaryLocksResponse)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, uint64(110), resp.CommitTs)
+	assert.Empty(t, resp.Locks)
+}
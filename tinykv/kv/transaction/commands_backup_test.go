@@ -0,0 +1,144 @@
+package transaction
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/backup"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBackupRestore tests that a backup holds the values visible at the backup ts, and that a restore ingests them
+// under the rewritten keys.
+func TestBackupRestore(t *testing.T) {
+	dir := t.TempDir()
+	builder := builderForBackup(t)
+	resp, err := builder.server.Backup(context.Background(), &kvrpcpb.BackupRequest{
+		StartKey: []byte{1},
+		EndKey:   []byte{4},
+		BackupTs: 60,
+		Path:     dir,
+	})
+	require.Nil(t, err)
+	require.Nil(t, resp.RegionError)
+	require.Nil(t, resp.Locked)
+	require.Empty(t, resp.OtherError)
+	require.Len(t, resp.Files, 1)
+	file := resp.Files[0]
+
+	// Key 1 is overwritten after the backup ts, key 2 is deleted before it and key 4 is out of the range.
+	r, err := backup.OpenFile(filepath.Join(dir, file.Name))
+	require.Nil(t, err)
+	var checksum backup.Checksum
+	for _, pair := range [][]byte{{1, 42}, {3, 44}} {
+		key, value, err := r.Next()
+		require.Nil(t, err)
+		assert.Equal(t, pair[:1], key)
+		assert.Equal(t, pair[1:], value)
+		checksum.Update(key, value)
+	}
+	key, _, err := r.Next()
+	require.Nil(t, err)
+	assert.Nil(t, key)
+	assert.Equal(t, checksum.Crc64Xor, file.Crc64Xor)
+	assert.Equal(t, uint64(2), file.TotalKvs)
+	assert.Equal(t, uint64(4), file.TotalBytes)
+
+	restore := newBuilder(t)
+	req := &kvrpcpb.RestoreRequest{
+		Path:         dir,
+		Name:         file.Name,
+		StartKey:     []byte{9},
+		RewriteRules: []*kvrpcpb.RewriteRule{{OldKeyPrefix: []byte{3}, NewKeyPrefix: []byte{9, 3}}},
+		RestoreTs:    100,
+	}
+	restoreResp, err := restore.server.Restore(context.Background(), req)
+	require.Nil(t, err)
+	require.Empty(t, restoreResp.Error)
+	// Only key 3 is rewritten into the range.
+	assert.Equal(t, uint64(1), restoreResp.TotalKvs)
+	restore.assertLens(1, 0, 1)
+	restore.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{9, 3}, ts: 100, value: []byte{44}},
+		{cf: engine_util.CfWrite, key: []byte{9, 3}, ts: 100, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+
+	req.StartKey = nil
+	restoreResp, err = restore.server.Restore(context.Background(), req)
+	require.Nil(t, err)
+	require.Empty(t, restoreResp.Error)
+	assert.Equal(t, file.Crc64Xor, restoreResp.Crc64Xor)
+	assert.Equal(t, file.TotalKvs, restoreResp.TotalKvs)
+	assert.Equal(t, file.TotalBytes, restoreResp.TotalBytes)
+	getResp := restore.runOneRequest(&kvrpcpb.GetRequest{Key: []byte{1}, Version: 101}).(*kvrpcpb.GetResponse)
+	assert.Equal(t, []byte{42}, getResp.Value)
+}
+
+// TestBackupLocked tests that a backup reports a lock taken before the backup ts, but not one taken after it.
+func TestBackupLocked(t *testing.T) {
+	builder := builderForBackup(t)
+	builder.init([]kv{
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 62, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+	req := &kvrpcpb.BackupRequest{StartKey: []byte{1}, BackupTs: 60, Path: t.TempDir()}
+	resp, err := builder.server.Backup(context.Background(), req)
+	require.Nil(t, err)
+	assert.Nil(t, resp.Locked)
+	assert.Len(t, resp.Files, 1)
+
+	req.BackupTs = 70
+	resp, err = builder.server.Backup(context.Background(), req)
+	require.Nil(t, err)
+	require.NotNil(t, resp.Locked)
+	assert.Equal(t, []byte{3}, resp.Locked.Key)
+	assert.Equal(t, uint64(62), resp.Locked.LockVersion)
+	assert.Empty(t, resp.Files)
+}
+
+// TestRestoreCorruptFile tests that a corrupted backup file isn't ingested.
+func TestRestoreCorruptFile(t *testing.T) {
+	dir := t.TempDir()
+	builder := builderForBackup(t)
+	resp, err := builder.server.Backup(context.Background(), &kvrpcpb.BackupRequest{BackupTs: 60, Path: dir})
+	require.Nil(t, err)
+	require.Len(t, resp.Files, 1)
+
+	path := filepath.Join(dir, resp.Files[0].Name)
+	data, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	data[len(data)/2] ^= 1
+	require.Nil(t, ioutil.WriteFile(path, data, 0644))
+
+	restore := newBuilder(t)
+	restoreResp, err := restore.server.Restore(context.Background(), &kvrpcpb.RestoreRequest{
+		Path:      dir,
+		Name:      resp.Files[0].Name,
+		RestoreTs: 100,
+	})
+	require.Nil(t, err)
+	assert.Contains(t, restoreResp.Error, "checksum mismatch")
+	restore.assertLens(0, 0, 0)
+}
+
+func builderForBackup(t *testing.T) *testBuilder {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 50, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 62, value: []byte{43}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 64, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 62}},
+		{cf: engine_util.CfDefault, key: []byte{2}, ts: 50, value: []byte{45}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 58, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 56}},
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 50, value: []byte{44}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfDefault, key: []byte{4}, ts: 50, value: []byte{46}},
+		{cf: engine_util.CfWrite, key: []byte{4}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+	})
+	return &builder
+}
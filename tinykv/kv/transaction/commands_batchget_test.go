@@ -0,0 +1,89 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBatchGet tests that BatchGet returns the values of existing keys and leaves out the others.
+func TestBatchGet(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 50, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfDefault, key: []byte{2}, ts: 50, value: []byte{43}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 60, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 58}},
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 70, value: []byte{44}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 74, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 70}},
+	})
+	resp := builder.runOneRequest(batchGetRequest(65, []byte{1}, []byte{2}, []byte{3}, []byte{4})).(*kvrpcpb.BatchGetResponse)
+
+	assert.Nil(t, resp.RegionError)
+	assert.Len(t, resp.Pairs, 1)
+	assert.Nil(t, resp.Pairs[0].Error)
+	assert.Equal(t, []byte{1}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{42}, resp.Pairs[0].Value)
+
+	resp = builder.runOneRequest(batchGetRequest(55, []byte{1}, []byte{2}, []byte{3})).(*kvrpcpb.BatchGetResponse)
+	assert.Len(t, resp.Pairs, 2)
+	assert.Equal(t, []byte{42}, resp.Pairs[0].Value)
+	assert.Equal(t, []byte{43}, resp.Pairs[1].Value)
+}
+
+// TestBatchGetLocked tests that a key locked for the read gets a pair with the lock error and the other keys are read.
+func TestBatchGetLocked(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 50, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfLock, key: []byte{1}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 200, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfDefault, key: []byte{2}, ts: 50, value: []byte{43}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfLock, key: []byte{2}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 90, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 50, value: []byte{44}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 5, 0, 0, 0, 0, 0, 0, 0, 90, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+	resp := builder.runOneRequest(batchGetRequest(100, []byte{1}, []byte{2}, []byte{3})).(*kvrpcpb.BatchGetResponse)
+
+	// The lock of key 1 is newer than the read and key 3 is only locked for update.
+	assert.Len(t, resp.Pairs, 3)
+	assert.Equal(t, []byte{42}, resp.Pairs[0].Value)
+	assert.Nil(t, resp.Pairs[1].Value)
+	assert.NotNil(t, resp.Pairs[1].Error.Locked)
+	assert.Equal(t, []byte{2}, resp.Pairs[1].Key)
+	assert.Equal(t, []byte{2}, resp.Pairs[1].Error.Locked.Key)
+	assert.Equal(t, uint64(90), resp.Pairs[1].Error.Locked.LockVersion)
+	assert.Equal(t, []byte{44}, resp.Pairs[2].Value)
+}
+
+// TestBatchGetMemLock tests that keys locked in memory by an async commit prewrite are reported as locked.
+func TestBatchGetMemLock(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 50, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfDefault, key: []byte{2}, ts: 50, value: []byte{43}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+	})
+	manager := builder.server.ConcurrencyManager
+	manager.LockKeys([][]byte{{2}}, &mvcc.Lock{Primary: []byte{2}, Ts: 90, Kind: mvcc.WriteKindPut, UseAsyncCommit: true})
+	resp := builder.runOneRequest(batchGetRequest(100, []byte{1}, []byte{2})).(*kvrpcpb.BatchGetResponse)
+
+	assert.Len(t, resp.Pairs, 2)
+	assert.Equal(t, []byte{1}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{42}, resp.Pairs[0].Value)
+	assert.Equal(t, []byte{2}, resp.Pairs[1].Key)
+	assert.NotNil(t, resp.Pairs[1].Error.Locked)
+	assert.Equal(t, uint64(100), manager.MaxTs())
+
+	manager.UnlockKeys([][]byte{{2}}, 90)
+	resp = builder.runOneRequest(batchGetRequest(100, []byte{1}, []byte{2})).(*kvrpcpb.BatchGetResponse)
+	assert.Len(t, resp.Pairs, 2)
+	assert.Equal(t, []byte{43}, resp.Pairs[1].Value)
+}
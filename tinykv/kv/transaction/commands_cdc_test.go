@@ -0,0 +1,144 @@
+package transaction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// TestChangeData tests that a ChangeData stream gets the changes committed after its start ts and the locks of its
+// range first, then the changes as they are written, and resolved ts which are below the locks.
+func TestChangeData(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 30, value: []byte{41}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 34, value: (&mvcc.Write{StartTS: 30, Kind: mvcc.WriteKindPut}).ToBytes()},
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 50, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 54, value: (&mvcc.Write{StartTS: 50, Kind: mvcc.WriteKindPut}).ToBytes()},
+		{cf: engine_util.CfDefault, key: []byte{2}, ts: 60, value: []byte{43}},
+		{cf: engine_util.CfLock, key: []byte{2}, value: (&mvcc.Lock{Primary: []byte{2}, Ts: 60, Ttl: 10, Kind: mvcc.WriteKindPut}).ToBytes()},
+		{cf: engine_util.CfDefault, key: []byte{9}, ts: 50, value: []byte{49}},
+		{cf: engine_util.CfWrite, key: []byte{9}, ts: 54, value: (&mvcc.Write{StartTS: 50, Kind: mvcc.WriteKindPut}).ToBytes()},
+	})
+	builder.server.CDC.SetTsSource(fixedTs(100), 10*time.Millisecond)
+	stream := builder.subscribe([]byte{1}, []byte{9}, 40)
+	defer stream.cancel()
+
+	event := stream.next(t)
+	assert.Equal(t, []*kvrpcpb.ChangeDataEntry{
+		{Type: kvrpcpb.ChangeDataEntry_Commit, Key: []byte{1}, Value: []byte{42}, Op: kvrpcpb.Op_Put, StartTs: 50, CommitTs: 54},
+		{Type: kvrpcpb.ChangeDataEntry_Prewrite, Key: []byte{2}, Value: []byte{43}, Op: kvrpcpb.Op_Put, StartTs: 60},
+		{Type: kvrpcpb.ChangeDataEntry_Initialized},
+	}, event.Entries)
+	// The lock keeps the resolved ts below its start ts.
+	assert.Equal(t, uint64(59), stream.next(t).ResolvedTs)
+
+	builder.runOneRequest(&kvrpcpb.CommitRequest{StartVersion: 60, CommitVersion: 65, Keys: [][]byte{{2}}})
+	event = stream.nextEntries(t, 59)
+	assert.Equal(t, []*kvrpcpb.ChangeDataEntry{
+		{Type: kvrpcpb.ChangeDataEntry_Commit, Key: []byte{2}, Value: []byte{43}, Op: kvrpcpb.Op_Put, StartTs: 60, CommitTs: 65},
+	}, event.Entries)
+	event = stream.next(t)
+	assert.Equal(t, uint64(100), event.ResolvedTs)
+
+	// Keys out of the range are left out.
+	builder.runOneRequest(&kvrpcpb.PrewriteRequest{
+		Mutations:    []*kvrpcpb.Mutation{mutation(9, []byte{50}, kvrpcpb.Op_Put)},
+		PrimaryLock:  []byte{9},
+		StartVersion: 110,
+		LockTtl:      10,
+	})
+	builder.runOneRequest(&kvrpcpb.PrewriteRequest{
+		Mutations:    []*kvrpcpb.Mutation{mutation(3, nil, kvrpcpb.Op_Del)},
+		PrimaryLock:  []byte{3},
+		StartVersion: 111,
+		LockTtl:      10,
+	})
+	builder.runOneRequest(&kvrpcpb.BatchRollbackRequest{StartVersion: 111, Keys: [][]byte{{3}}})
+	event = stream.nextEntries(t, 100)
+	assert.Equal(t, []*kvrpcpb.ChangeDataEntry{
+		{Type: kvrpcpb.ChangeDataEntry_Prewrite, Key: []byte{3}, Op: kvrpcpb.Op_Del, StartTs: 111},
+	}, event.Entries)
+	event = stream.nextEntries(t, 100)
+	assert.Equal(t, []*kvrpcpb.ChangeDataEntry{
+		{Type: kvrpcpb.ChangeDataEntry_Rollback, Key: []byte{3}, Op: kvrpcpb.Op_Rollback, StartTs: 111},
+	}, event.Entries)
+
+	// A one-phase commit is sent as a commit with its value.
+	resp := builder.runOneRequest(&kvrpcpb.PrewriteRequest{
+		Mutations:    []*kvrpcpb.Mutation{mutation(4, []byte{44}, kvrpcpb.Op_Put)},
+		PrimaryLock:  []byte{4},
+		StartVersion: 120,
+		LockTtl:      10,
+		TryOnePc:     true,
+	}).(*kvrpcpb.PrewriteResponse)
+	assert.NotZero(t, resp.OnePcCommitTs)
+	event = stream.nextEntries(t, 100)
+	assert.Equal(t, []*kvrpcpb.ChangeDataEntry{
+		{Type: kvrpcpb.ChangeDataEntry_Commit, Key: []byte{4}, Value: []byte{44}, Op: kvrpcpb.Op_Put, StartTs: 120, CommitTs: resp.OnePcCommitTs},
+	}, event.Entries)
+}
+
+type fixedTs uint64
+
+func (ts fixedTs) GetTS(context.Context) (uint64, error) {
+	return uint64(ts), nil
+}
+
+// changeDataStream is the server side of a ChangeData stream, which hands the events to the test.
+type changeDataStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	events chan *kvrpcpb.ChangeDataEvent
+}
+
+func (builder *testBuilder) subscribe(startKey, endKey []byte, startTs uint64) *changeDataStream {
+	stream := &changeDataStream{events: make(chan *kvrpcpb.ChangeDataEvent)}
+	stream.ctx, stream.cancel = context.WithCancel(context.Background())
+	req := &kvrpcpb.ChangeDataRequest{Context: &kvrpcpb.Context{}, StartKey: startKey, EndKey: endKey, StartTs: startTs}
+	go func() {
+		assert.Nil(builder.t, builder.server.ChangeData(req, stream))
+	}()
+	return stream
+}
+
+func (s *changeDataStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *changeDataStream) Send(event *kvrpcpb.ChangeDataEvent) error {
+	select {
+	case s.events <- event:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *changeDataStream) next(t *testing.T) *kvrpcpb.ChangeDataEvent {
+	select {
+	case event := <-s.events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("no change data event")
+		return nil
+	}
+}
+
+// nextEntries skips the events without entries, which must carry resolved ts no larger than maxResolvedTs.
+func (s *changeDataStream) nextEntries(t *testing.T, maxResolvedTs uint64) *kvrpcpb.ChangeDataEvent {
+	for {
+		event := s.next(t)
+		if len(event.Entries) > 0 {
+			return event
+		}
+		assert.True(t, event.ResolvedTs <= maxResolvedTs, "resolved ts %d is ahead of the changes", event.ResolvedTs)
+	}
+}
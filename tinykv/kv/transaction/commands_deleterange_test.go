@@ -0,0 +1,71 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeleteRange tests that DeleteRange removes the values, writes and locks of the keys in the range and nothing else.
+func TestDeleteRange(t *testing.T) {
+	builder := builderForDeleteRange(t)
+	resp := builder.runOneRequest(deleteRangeRequest([]byte{2}, []byte{3})).(*kvrpcpb.DeleteRangeResponse)
+
+	assert.Nil(t, resp.RegionError)
+	assert.Empty(t, resp.Error)
+	builder.assertLens(2, 1, 2)
+	builder.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 50, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 50, value: []byte{45}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 1, 0, 0, 0, 0, 0, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+
+	// An empty end key deletes to the end.
+	resp = builder.runOneRequest(deleteRangeRequest([]byte{1, 0}, nil)).(*kvrpcpb.DeleteRangeResponse)
+	assert.Empty(t, resp.Error)
+	builder.assertLens(1, 0, 1)
+}
+
+// TestDeleteRangeLimit tests that mvcc.DeleteRange stops at the limit and can be continued.
+func TestDeleteRangeLimit(t *testing.T) {
+	builder := builderForDeleteRange(t)
+	reader, err := builder.mem.Reader(nil)
+	assert.Nil(t, err)
+	defer reader.Close()
+
+	txn := mvcc.NewTxn(reader, 0)
+	more, err := mvcc.DeleteRange(&txn, []byte{2}, nil, 3)
+	assert.Nil(t, err)
+	assert.True(t, more)
+	assert.Len(t, txn.Writes(), 3)
+
+	txn = mvcc.NewTxn(reader, 0)
+	more, err = mvcc.DeleteRange(&txn, []byte{2}, nil, 0)
+	assert.Nil(t, err)
+	assert.False(t, more)
+	// Three values, four writes and two locks.
+	assert.Len(t, txn.Writes(), 9)
+}
+
+func builderForDeleteRange(t *testing.T) *testBuilder {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 50, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfDefault, key: []byte{2}, ts: 50, value: []byte{43}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 58, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 56}},
+		{cf: engine_util.CfDefault, key: []byte{2, 5}, ts: 50, value: []byte{44}},
+		{cf: engine_util.CfWrite, key: []byte{2, 5}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfLock, key: []byte{2, 5}, value: []byte{2, 1, 0, 0, 0, 0, 0, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 50, value: []byte{45}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 54, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 1, 0, 0, 0, 0, 0, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+	return &builder
+}
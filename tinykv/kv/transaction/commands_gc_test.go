@@ -0,0 +1,97 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestScanLock tests that only locks of transactions started no later than max_version are returned.
+func TestScanLock(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfLock, key: []byte{2}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 90, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 110, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{4}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 95, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{5}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 80, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+	var cmd kvrpcpb.ScanLockRequest
+	cmd.MaxVersion = 100
+	cmd.StartKey = []byte{3}
+	cmd.Limit = 10
+	resp := builder.runOneRequest(&cmd).(*kvrpcpb.ScanLockResponse)
+
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, 2, len(resp.Locks))
+	assert.Equal(t, []byte{4}, resp.Locks[0].Key)
+	assert.Equal(t, uint64(95), resp.Locks[0].LockVersion)
+	assert.Equal(t, []byte{5}, resp.Locks[1].Key)
+
+	cmd.Limit = 1
+	resp = builder.runOneRequest(&cmd).(*kvrpcpb.ScanLockResponse)
+	assert.Equal(t, 1, len(resp.Locks))
+	assert.Equal(t, []byte{4}, resp.Locks[0].Key)
+}
+
+func gcRequest(safePoint uint64, startKey []byte, limit uint32) *kvrpcpb.GCRequest {
+	var req kvrpcpb.GCRequest
+	req.SafePoint = safePoint
+	req.StartKey = startKey
+	req.Limit = limit
+	return &req
+}
+
+// TestGC tests that only the latest put visible at the safe point and later writes are kept.
+func TestGC(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 80, value: []byte{40}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 85, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 90, value: []byte{41}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 95, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 90}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 97, value: []byte{3, 0, 0, 0, 0, 0, 0, 0, 97}},
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 110, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 115, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 110}},
+		{cf: engine_util.CfDefault, key: []byte{4}, ts: 80, value: []byte{43}},
+		{cf: engine_util.CfWrite, key: []byte{4}, ts: 85, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfWrite, key: []byte{4}, ts: 90, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 88}},
+	})
+	resp := builder.runOneRequest(gcRequest(100, []byte{}, 0)).(*kvrpcpb.GCResponse)
+
+	assert.Nil(t, resp.Error)
+	assert.Nil(t, resp.NextKey)
+	builder.assertLens(2, 0, 2)
+	builder.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 90},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 95},
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 110},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 115},
+	})
+
+	var get kvrpcpb.GetRequest
+	get.Key = []byte{3}
+	get.Version = 100
+	getResp := builder.runOneRequest(&get).(*kvrpcpb.GetResponse)
+	assert.Equal(t, []byte{41}, getResp.Value)
+}
+
+// TestGCLimit tests that a GC stops after limit keys and returns the key to continue from.
+func TestGCLimit(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 85, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfDefault, key: []byte{4}, ts: 80, value: []byte{43}},
+		{cf: engine_util.CfWrite, key: []byte{4}, ts: 85, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfWrite, key: []byte{4}, ts: 90, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 88}},
+	})
+	resp := builder.runOneRequest(gcRequest(100, []byte{}, 1)).(*kvrpcpb.GCResponse)
+
+	assert.Equal(t, []byte{4}, resp.NextKey)
+	builder.assertLens(1, 0, 2)
+
+	resp = builder.runOneRequest(gcRequest(100, resp.NextKey, 1)).(*kvrpcpb.GCResponse)
+	assert.Nil(t, resp.NextKey)
+	builder.assertLens(0, 0, 0)
+}
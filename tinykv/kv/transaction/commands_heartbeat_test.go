@@ -0,0 +1,56 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTxnHeartBeat tests that a heartbeat extends the TTL of the primary lock but never shortens it.
+func TestTxnHeartBeat(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfLock, key: []byte{1}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 10}},
+	})
+	resp := builder.runOneRequest(txnHeartBeatRequest(100, []byte{1}, 50)).(*kvrpcpb.TxnHeartBeatResponse)
+
+	assert.Nil(t, resp.Error)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, uint64(50), resp.LockTtl)
+	builder.assertLens(1, 1, 0)
+	builder.assert([]kv{
+		{cf: engine_util.CfLock, key: []byte{1}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 50}},
+	})
+
+	resp = builder.runOneRequest(txnHeartBeatRequest(100, []byte{1}, 20)).(*kvrpcpb.TxnHeartBeatResponse)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, uint64(50), resp.LockTtl)
+	builder.assert([]kv{
+		{cf: engine_util.CfLock, key: []byte{1}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 50}},
+	})
+}
+
+// TestTxnHeartBeatNotFound tests that a heartbeat fails if the transaction no longer holds its primary lock.
+func TestTxnHeartBeatNotFound(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 101, value: []byte{42}},
+		{cf: engine_util.CfLock, key: []byte{1}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 101, 0, 0, 0, 0, 0, 0, 0, 10}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 100, value: []byte{3, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+	// Another transaction holds the lock.
+	resp := builder.runOneRequest(txnHeartBeatRequest(100, []byte{1}, 50)).(*kvrpcpb.TxnHeartBeatResponse)
+	assert.NotNil(t, resp.Error)
+	assert.Equal(t, uint64(0), resp.LockTtl)
+
+	// The transaction has been rolled back.
+	resp = builder.runOneRequest(txnHeartBeatRequest(100, []byte{2}, 50)).(*kvrpcpb.TxnHeartBeatResponse)
+	assert.NotNil(t, resp.Error)
+	builder.assertLens(1, 1, 1)
+	builder.assert([]kv{
+		{cf: engine_util.CfLock, key: []byte{1}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 101, 0, 0, 0, 0, 0, 0, 0, 10}},
+	})
+}
@@ -0,0 +1,131 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+// get reads key at ts and returns the value.
+func (builder *testBuilder) get(key []byte, ts uint64) []byte {
+	resp := builder.runOneRequest(&kvrpcpb.GetRequest{Key: key, Version: ts}).(*kvrpcpb.GetResponse)
+	assert.Nil(builder.t, resp.Error)
+	return resp.Value
+}
+
+// TestPrewriteDelete tests that a Del mutation locks the key without writing a value and commits a delete.
+func TestPrewriteDelete(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 80, value: []byte{5}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 90, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+	})
+	cmd := builder.prewriteRequest(mutation(3, nil, kvrpcpb.Op_Del))
+	resp := builder.runOneRequest(cmd).(*kvrpcpb.PrewriteResponse)
+
+	assert.Empty(t, resp.Errors)
+	builder.assertLens(1, 1, 1)
+	builder.assert([]kv{
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 2, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+
+	// The delete lock blocks reads.
+	getResp := builder.runOneRequest(&kvrpcpb.GetRequest{Key: []byte{3}, Version: 105}).(*kvrpcpb.GetResponse)
+	assert.NotNil(t, getResp.Error)
+	assert.Equal(t, kvrpcpb.Op_Del, getResp.Error.Locked.LockType)
+
+	commit := builder.commitRequest([]byte{3})
+	commit.StartVersion = 100
+	commitResp := builder.runOneRequest(commit).(*kvrpcpb.CommitResponse)
+	assert.Nil(t, commitResp.Error)
+	builder.assertLens(1, 0, 2)
+	builder.assert([]kv{
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 111, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+	assert.Nil(t, builder.get([]byte{3}, 120))
+	assert.Equal(t, []byte{5}, builder.get([]byte{3}, 95))
+}
+
+// TestPrewriteLock tests that a Lock mutation neither writes a value nor hides the current one.
+func TestPrewriteLock(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 80, value: []byte{5}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 90, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+	})
+	cmd := builder.prewriteRequest(mutation(3, nil, kvrpcpb.Op_Lock))
+	resp := builder.runOneRequest(cmd).(*kvrpcpb.PrewriteResponse)
+
+	assert.Empty(t, resp.Errors)
+	builder.assertLens(1, 1, 1)
+	builder.assert([]kv{
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 5, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+
+	// The lock doesn't block reads.
+	assert.Equal(t, []byte{5}, builder.get([]byte{3}, 105))
+
+	commit := builder.commitRequest([]byte{3})
+	commit.StartVersion = 100
+	commitResp := builder.runOneRequest(commit).(*kvrpcpb.CommitResponse)
+	assert.Nil(t, commitResp.Error)
+	builder.assertLens(1, 0, 2)
+	builder.assert([]kv{
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 111, value: []byte{5, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+	assert.Equal(t, []byte{5}, builder.get([]byte{3}, 120))
+
+	scanResp := builder.runOneRequest(&kvrpcpb.ScanRequest{StartKey: []byte{1}, Limit: 10, Version: 120}).(*kvrpcpb.ScanResponse)
+	assert.Len(t, scanResp.Pairs, 1)
+	assert.Equal(t, []byte{3}, scanResp.Pairs[0].Key)
+	assert.Equal(t, []byte{5}, scanResp.Pairs[0].Value)
+
+	// The lock conflicts with a transaction which started before it committed.
+	cmd = builder.prewriteRequest(mutation(3, []byte{6}, kvrpcpb.Op_Put))
+	cmd.StartVersion = 105
+	resp = builder.runOneRequest(cmd).(*kvrpcpb.PrewriteResponse)
+	assert.Len(t, resp.Errors, 1)
+	assert.NotNil(t, resp.Errors[0].Conflict)
+}
+
+// TestPrewriteInsert tests that an Insert mutation fails if the key has a value.
+func TestPrewriteInsert(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 80, value: []byte{5}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 90, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfDefault, key: []byte{4}, ts: 80, value: []byte{5}},
+		{cf: engine_util.CfWrite, key: []byte{4}, ts: 90, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfWrite, key: []byte{4}, ts: 95, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 92}},
+	})
+	cmd := builder.prewriteRequest(mutation(3, []byte{42}, kvrpcpb.Op_Insert))
+	resp := builder.runOneRequest(cmd).(*kvrpcpb.PrewriteResponse)
+
+	assert.Len(t, resp.Errors, 1)
+	assert.NotNil(t, resp.Errors[0].AlreadyExist)
+	assert.Equal(t, []byte{3}, resp.Errors[0].AlreadyExist.Key)
+	builder.assertLens(2, 0, 3)
+
+	// Deleted and missing keys can be inserted.
+	cmd = builder.prewriteRequest(mutation(4, []byte{42}, kvrpcpb.Op_Insert), mutation(5, []byte{43}, kvrpcpb.Op_Insert))
+	resp = builder.runOneRequest(cmd).(*kvrpcpb.PrewriteResponse)
+	assert.Empty(t, resp.Errors)
+	builder.assertLens(4, 2, 3)
+	builder.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{4}, value: []byte{42}},
+		{cf: engine_util.CfLock, key: []byte{4}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, builder.ts(), 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+}
+
+// TestPrewriteUnexpectedOp tests that a prewrite rejects mutations which can't be prewritten.
+func TestPrewriteUnexpectedOp(t *testing.T) {
+	builder := newBuilder(t)
+	cmd := builder.prewriteRequest(mutation(3, nil, kvrpcpb.Op_Rollback))
+	resp := builder.runOneRequest(cmd).(*kvrpcpb.PrewriteResponse)
+
+	assert.Len(t, resp.Errors, 1)
+	assert.NotEmpty(t, resp.Errors[0].Abort)
+	builder.assertLens(0, 0, 0)
+}
@@ -0,0 +1,211 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPessimisticLock tests locking keys which are not locked or written.
+func TestPessimisticLock(t *testing.T) {
+	builder := newBuilder(t)
+	cmd := builder.pessimisticLockRequest([]byte{3}, []byte{4})
+	cmd.LockTtl = 1000
+	resp := builder.runOneRequest(cmd).(*kvrpcpb.PessimisticLockResponse)
+
+	assert.Empty(t, resp.Errors)
+	assert.Nil(t, resp.RegionError)
+	builder.assertLens(0, 2, 0)
+	builder.assert([]kv{
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100, 4, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 3, 232}},
+		{cf: engine_util.CfLock, key: []byte{4}, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100, 4, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 3, 232}},
+	})
+}
+
+// TestPessimisticLockWritten tests that only writes committed after for_update_ts conflict.
+func TestPessimisticLockWritten(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 80, value: []byte{5}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 105, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+	})
+	cmd := builder.pessimisticLockRequest([]byte{3})
+	resp := builder.runOneRequest(cmd).(*kvrpcpb.PessimisticLockResponse)
+
+	assert.Equal(t, 1, len(resp.Errors))
+	assert.NotNil(t, resp.Errors[0].Conflict)
+	assert.Equal(t, uint64(105), resp.Errors[0].Conflict.ConflictTs)
+	builder.assertLens(1, 0, 1)
+
+	// Retry with a newer for_update_ts.
+	cmd.ForUpdateTs = 110
+	resp = builder.runOneRequest(cmd).(*kvrpcpb.PessimisticLockResponse)
+
+	assert.Empty(t, resp.Errors)
+	builder.assertLens(1, 1, 1)
+	builder.assert([]kv{
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 110, 4, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+}
+
+// TestPessimisticLockLocked tests that no key is locked if any of them is locked by another transaction.
+func TestPessimisticLockLocked(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{4}, ts: 90, value: []byte{5}},
+		{cf: engine_util.CfLock, key: []byte{4}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 90, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+	cmd := builder.pessimisticLockRequest([]byte{3}, []byte{4})
+	resp := builder.runOneRequest(cmd).(*kvrpcpb.PessimisticLockResponse)
+
+	assert.Equal(t, 1, len(resp.Errors))
+	assert.NotNil(t, resp.Errors[0].Locked)
+	assert.Equal(t, uint64(90), resp.Errors[0].Locked.LockVersion)
+	builder.assertLens(1, 1, 0)
+}
+
+// TestPrewritePessimistic tests that prewrite converts pessimistic locks and commit writes the values.
+func TestPrewritePessimistic(t *testing.T) {
+	builder := newBuilder(t)
+	lock := builder.pessimisticLockRequest([]byte{3})
+	prewrite := builder.prewriteRequest(mutation(3, []byte{42}, kvrpcpb.Op_Put), mutation(4, []byte{43}, kvrpcpb.Op_Put))
+	prewrite.StartVersion = lock.StartVersion
+	prewrite.ForUpdateTs = lock.ForUpdateTs
+	prewrite.IsPessimisticLock = []bool{true, false}
+	commit := builder.commitRequest([]byte{3}, []byte{4})
+	commit.StartVersion = lock.StartVersion
+	resps := builder.runRequests(lock, prewrite)
+
+	assert.Empty(t, resps[0].(*kvrpcpb.PessimisticLockResponse).Errors)
+	assert.Empty(t, resps[1].(*kvrpcpb.PrewriteResponse).Errors)
+	builder.assertLens(2, 2, 0)
+	builder.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+
+	resp := builder.runOneRequest(commit).(*kvrpcpb.CommitResponse)
+	assert.Nil(t, resp.Error)
+	builder.assertLens(2, 0, 2)
+}
+
+// TestPrewritePessimisticNotLocked tests that a pessimistic prewrite fails if the key isn't locked.
+func TestPrewritePessimisticNotLocked(t *testing.T) {
+	builder := newBuilder(t)
+	cmd := builder.prewriteRequest(mutation(3, []byte{42}, kvrpcpb.Op_Put))
+	cmd.IsPessimisticLock = []bool{true}
+	resp := builder.runOneRequest(cmd).(*kvrpcpb.PrewriteResponse)
+
+	assert.Equal(t, 1, len(resp.Errors))
+	assert.NotEmpty(t, resp.Errors[0].Abort)
+	builder.assertLens(0, 0, 0)
+}
+
+// TestPrewriteLockedPessimistic tests that a pessimistic lock blocks the prewrite of another transaction.
+func TestPrewriteLockedPessimistic(t *testing.T) {
+	builder := newBuilder(t)
+	lock := builder.pessimisticLockRequest([]byte{3})
+	prewrite := builder.prewriteRequest(mutation(3, []byte{42}, kvrpcpb.Op_Put))
+	resps := builder.runRequests(lock, prewrite)
+
+	assert.Equal(t, 1, len(resps[1].(*kvrpcpb.PrewriteResponse).Errors))
+	assert.Equal(t, kvrpcpb.Op_PessimisticLock, resps[1].(*kvrpcpb.PrewriteResponse).Errors[0].Locked.LockType)
+	builder.assertLens(0, 1, 0)
+}
+
+// TestPrewritePessimisticInsert tests that the insert of a pessimistically locked key fails if the key has a value,
+// even one committed after the start ts of the transaction.
+func TestPrewritePessimisticInsert(t *testing.T) {
+	builder := newBuilder(t)
+	lock := builder.pessimisticLockRequest([]byte{3}, []byte{4}, []byte{5})
+	lock.ForUpdateTs = 120
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 105, value: []byte{5}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 105}},
+		// Key 5 is deleted.
+		{cf: engine_util.CfDefault, key: []byte{5}, ts: 80, value: []byte{6}},
+		{cf: engine_util.CfWrite, key: []byte{5}, ts: 90, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfWrite, key: []byte{5}, ts: 110, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 105}},
+	})
+	prewrite := builder.prewriteRequest(mutation(3, []byte{42}, kvrpcpb.Op_Insert), mutation(4, []byte{43}, kvrpcpb.Op_Insert),
+		mutation(5, []byte{44}, kvrpcpb.Op_Insert))
+	prewrite.StartVersion = lock.StartVersion
+	prewrite.ForUpdateTs = lock.ForUpdateTs
+	prewrite.IsPessimisticLock = []bool{true, true, true}
+	resps := builder.runRequests(lock, prewrite)
+
+	assert.Empty(t, resps[0].(*kvrpcpb.PessimisticLockResponse).Errors)
+	errors := resps[1].(*kvrpcpb.PrewriteResponse).Errors
+	assert.Equal(t, 1, len(errors))
+	assert.Equal(t, []byte{3}, errors[0].AlreadyExist.Key)
+}
+
+// TestGetPessimisticLocked tests that a pessimistic lock doesn't block reads.
+func TestGetPessimisticLocked(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 80, value: []byte{5}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 90, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+	})
+	lock := builder.pessimisticLockRequest([]byte{3})
+	var get kvrpcpb.GetRequest
+	get.Key = []byte{3}
+	get.Version = builder.nextTs()
+	resps := builder.runRequests(lock, &get)
+
+	assert.Empty(t, resps[0].(*kvrpcpb.PessimisticLockResponse).Errors)
+	assert.Nil(t, resps[1].(*kvrpcpb.GetResponse).Error)
+	assert.Equal(t, []byte{5}, resps[1].(*kvrpcpb.GetResponse).Value)
+}
+
+// TestPessimisticRollback tests that only the pessimistic locks acquired up to for_update_ts are removed.
+func TestPessimisticRollback(t *testing.T) {
+	builder := newBuilder(t)
+	lock := builder.pessimisticLockRequest([]byte{3})
+	lock2 := builder.pessimisticLockRequest([]byte{4})
+	lock2.StartVersion = lock.StartVersion
+	prewrite := builder.prewriteRequest(mutation(5, []byte{42}, kvrpcpb.Op_Put))
+	prewrite.StartVersion = lock.StartVersion
+	builder.runRequests(lock, lock2, prewrite)
+	builder.assertLens(1, 3, 0)
+
+	resp := builder.runOneRequest(pessimisticRollbackRequest(lock.StartVersion, lock.ForUpdateTs, []byte{3}, []byte{4}, []byte{5}, []byte{6})).(*kvrpcpb.PessimisticRollbackResponse)
+	assert.Empty(t, resp.Errors)
+	builder.assertLens(1, 2, 0)
+	assert.Nil(t, builder.mem.Get(engine_util.CfLock, []byte{3}))
+	assert.NotNil(t, builder.mem.Get(engine_util.CfLock, []byte{4}))
+	assert.NotNil(t, builder.mem.Get(engine_util.CfLock, []byte{5}))
+}
+
+// TestPessimisticLockDeadlock tests that a transaction gets a deadlock error rather than waiting for a transaction
+// which waits for it.
+func TestPessimisticLockDeadlock(t *testing.T) {
+	builder := newBuilder(t)
+	lock1 := builder.pessimisticLockRequest([]byte{3})
+	lock2 := builder.pessimisticLockRequest([]byte{4})
+	wait1 := builder.pessimisticLockRequest([]byte{4})
+	wait1.StartVersion, wait1.ForUpdateTs = lock1.StartVersion, lock1.ForUpdateTs
+	wait2 := builder.pessimisticLockRequest([]byte{3})
+	wait2.StartVersion, wait2.ForUpdateTs = lock2.StartVersion, lock2.ForUpdateTs
+	resps := builder.runRequests(lock1, lock2, wait1, wait2)
+
+	assert.Empty(t, resps[0].(*kvrpcpb.PessimisticLockResponse).Errors)
+	assert.Empty(t, resps[1].(*kvrpcpb.PessimisticLockResponse).Errors)
+	assert.Equal(t, 1, len(resps[2].(*kvrpcpb.PessimisticLockResponse).Errors))
+	assert.NotNil(t, resps[2].(*kvrpcpb.PessimisticLockResponse).Errors[0].Locked)
+	errors := resps[3].(*kvrpcpb.PessimisticLockResponse).Errors
+	assert.Equal(t, 1, len(errors))
+	assert.NotNil(t, errors[0].Deadlock)
+	assert.Equal(t, lock1.StartVersion, errors[0].Deadlock.LockTs)
+	assert.Equal(t, []byte{3}, errors[0].Deadlock.LockKey)
+	assert.Equal(t, 2, len(errors[0].Deadlock.WaitChain))
+	builder.assertLens(0, 2, 0)
+
+	// Once the first transaction gives up waiting, the second one can wait for it.
+	builder.runOneRequest(pessimisticRollbackRequest(lock1.StartVersion, lock1.ForUpdateTs, []byte{4}))
+	resp := builder.runOneRequest(wait2).(*kvrpcpb.PessimisticLockResponse)
+	assert.Equal(t, 1, len(resp.Errors))
+	assert.NotNil(t, resp.Errors[0].Locked)
+}
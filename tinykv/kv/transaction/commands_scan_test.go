@@ -0,0 +1,148 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestScanVersions tests that a key with many writes and a locked key are each returned once.
+func TestScanVersions(t *testing.T) {
+	builder := builderForVersionedScan(t)
+	req := builder.scanRequest([]byte{0}, 10)
+	req.Version = 120
+	resp := builder.runOneRequest(req).(*kvrpcpb.ScanResponse)
+
+	assert.Nil(t, resp.RegionError)
+	assert.Len(t, resp.Pairs, 3)
+	assert.Equal(t, []byte{1}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{61}, resp.Pairs[0].Value)
+	assert.NotNil(t, resp.Pairs[1].Error.Locked)
+	assert.Equal(t, []byte{3}, resp.Pairs[1].Error.Locked.Key)
+	assert.Equal(t, []byte{4}, resp.Pairs[2].Key)
+	assert.Equal(t, []byte{54}, resp.Pairs[2].Value)
+}
+
+// TestReverseScan tests a reverse scan from the end of the DB.
+func TestReverseScan(t *testing.T) {
+	builder := builderForVersionedScan(t)
+	resp := builder.runOneRequest(reverseScanRequest(120, nil, nil, 10)).(*kvrpcpb.ScanResponse)
+
+	assert.Nil(t, resp.RegionError)
+	assert.Len(t, resp.Pairs, 3)
+	assert.Equal(t, []byte{4}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{54}, resp.Pairs[0].Value)
+	assert.NotNil(t, resp.Pairs[1].Error.Locked)
+	assert.Equal(t, []byte{3}, resp.Pairs[1].Error.Locked.Key)
+	assert.Equal(t, []byte{1}, resp.Pairs[2].Key)
+	assert.Equal(t, []byte{61}, resp.Pairs[2].Value)
+
+	// Earlier writes are visible to an earlier read.
+	resp = builder.runOneRequest(reverseScanRequest(92, nil, nil, 10)).(*kvrpcpb.ScanResponse)
+	assert.Len(t, resp.Pairs, 4)
+	for i, pair := range resp.Pairs {
+		assert.Nil(t, pair.Error)
+		assert.Equal(t, []byte{byte(4 - i)}, pair.Key)
+		assert.Equal(t, []byte{byte(54 - i)}, pair.Value)
+	}
+}
+
+// TestReverseScanRange tests that a reverse scan reads [end_key, start_key) and respects the limit.
+func TestReverseScanRange(t *testing.T) {
+	builder := builderForVersionedScan(t)
+	resp := builder.runOneRequest(reverseScanRequest(92, []byte{4}, []byte{2}, 10)).(*kvrpcpb.ScanResponse)
+
+	assert.Nil(t, resp.RegionError)
+	assert.Len(t, resp.Pairs, 2)
+	assert.Equal(t, []byte{3}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{2}, resp.Pairs[1].Key)
+
+	resp = builder.runOneRequest(reverseScanRequest(92, []byte{4}, nil, 1)).(*kvrpcpb.ScanResponse)
+	assert.Len(t, resp.Pairs, 1)
+	assert.Equal(t, []byte{3}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{53}, resp.Pairs[0].Value)
+
+	resp = builder.runOneRequest(reverseScanRequest(92, []byte{1}, nil, 10)).(*kvrpcpb.ScanResponse)
+	assert.Empty(t, resp.Pairs)
+}
+
+// TestScanEndKey tests that a forward scan stops before end_key.
+func TestScanEndKey(t *testing.T) {
+	builder := builderForVersionedScan(t)
+	req := builder.scanRequest([]byte{1}, 10)
+	req.Version = 92
+	req.EndKey = []byte{3}
+	resp := builder.runOneRequest(req).(*kvrpcpb.ScanResponse)
+
+	assert.Nil(t, resp.RegionError)
+	assert.Len(t, resp.Pairs, 2)
+	assert.Equal(t, []byte{1}, resp.Pairs[0].Key)
+	assert.Equal(t, []byte{2}, resp.Pairs[1].Key)
+}
+
+// TestScanMemLock tests that scans check the keys locked in memory by an async commit prewrite in the range they
+// read, a reverse scan reads below its start key.
+func TestScanMemLock(t *testing.T) {
+	builder := builderForVersionedScan(t)
+	manager := builder.server.ConcurrencyManager
+	manager.LockKeys([][]byte{{2}}, &mvcc.Lock{Primary: []byte{2}, Ts: 115, Kind: mvcc.WriteKindPut, UseAsyncCommit: true})
+
+	resp := builder.runOneRequest(reverseScanRequest(120, []byte{4}, nil, 10)).(*kvrpcpb.ScanResponse)
+	assert.Len(t, resp.Pairs, 1)
+	assert.Equal(t, []byte{2}, resp.Pairs[0].Key)
+	assert.Equal(t, uint64(115), resp.Pairs[0].Error.Locked.LockVersion)
+
+	// The lock isn't in [end_key, start_key) of these scans.
+	resp = builder.runOneRequest(reverseScanRequest(120, []byte{2}, nil, 10)).(*kvrpcpb.ScanResponse)
+	assert.Len(t, resp.Pairs, 1)
+	assert.Equal(t, []byte{1}, resp.Pairs[0].Key)
+	resp = builder.runOneRequest(reverseScanRequest(120, nil, []byte{3}, 10)).(*kvrpcpb.ScanResponse)
+	assert.Len(t, resp.Pairs, 2)
+	assert.Equal(t, []byte{4}, resp.Pairs[0].Key)
+	assert.Equal(t, uint64(100), resp.Pairs[1].Error.Locked.LockVersion)
+
+	// A forward scan checks [start_key, end_key).
+	req := builder.scanRequest([]byte{3}, 10)
+	req.Version = 120
+	resp = builder.runOneRequest(req).(*kvrpcpb.ScanResponse)
+	assert.Len(t, resp.Pairs, 2)
+	assert.Equal(t, uint64(100), resp.Pairs[0].Error.Locked.LockVersion)
+	assert.Equal(t, []byte{4}, resp.Pairs[1].Key)
+	req = builder.scanRequest([]byte{1}, 10)
+	req.Version = 120
+	req.EndKey = []byte{3}
+	resp = builder.runOneRequest(req).(*kvrpcpb.ScanResponse)
+	assert.Len(t, resp.Pairs, 1)
+	assert.Equal(t, []byte{2}, resp.Pairs[0].Key)
+	assert.NotNil(t, resp.Pairs[0].Error.Locked)
+}
+
+func builderForVersionedScan(t *testing.T) *testBuilder {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		// Key 1 is written twice.
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 80, value: []byte{51}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 90, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfDefault, key: []byte{1}, ts: 95, value: []byte{61}},
+		{cf: engine_util.CfWrite, key: []byte{1}, ts: 99, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 95}},
+		// Key 2 is deleted at 99.
+		{cf: engine_util.CfDefault, key: []byte{2}, ts: 80, value: []byte{52}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 90, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfWrite, key: []byte{2}, ts: 99, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 95}},
+		// Key 3 is locked at 100.
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 80, value: []byte{53}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 90, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		// Key 4 has a rollback after its put.
+		{cf: engine_util.CfDefault, key: []byte{4}, ts: 80, value: []byte{54}},
+		{cf: engine_util.CfWrite, key: []byte{4}, ts: 90, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 80}},
+		{cf: engine_util.CfWrite, key: []byte{4}, ts: 110, value: []byte{3, 0, 0, 0, 0, 0, 0, 0, 110}},
+		// Key 5 is committed after every read.
+		{cf: engine_util.CfDefault, key: []byte{5}, ts: 130, value: []byte{55}},
+		{cf: engine_util.CfWrite, key: []byte{5}, ts: 135, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 130}},
+	})
+	return &builder
+}
@@ -209,3 +209,61 @@ func (builder *testBuilder) scanRequest(startKey []byte, limit uint32) *kvrpcpb.
 	req.Version = builder.nextTs()
 	return &req
 }
+
+func (builder *testBuilder) pessimisticLockRequest(keys ...[]byte) *kvrpcpb.PessimisticLockRequest {
+	var req kvrpcpb.PessimisticLockRequest
+	req.PrimaryLock = []byte{1}
+	req.StartVersion = builder.nextTs()
+	req.ForUpdateTs = req.StartVersion
+	for _, k := range keys {
+		req.Mutations = append(req.Mutations, &kvrpcpb.Mutation{Op: kvrpcpb.Op_PessimisticLock, Key: k})
+	}
+	return &req
+}
+
+func pessimisticRollbackRequest(startTs uint64, forUpdateTs uint64, keys ...[]byte) *kvrpcpb.PessimisticRollbackRequest {
+	var req kvrpcpb.PessimisticRollbackRequest
+	req.StartVersion = startTs
+	req.ForUpdateTs = forUpdateTs
+	req.Keys = keys
+	return &req
+}
+
+func txnHeartBeatRequest(startTs uint64, primary []byte, adviseTTL uint64) *kvrpcpb.TxnHeartBeatRequest {
+	var req kvrpcpb.TxnHeartBeatRequest
+	req.StartVersion = startTs
+	req.PrimaryLock = primary
+	req.AdviseLockTtl = adviseTTL
+	return &req
+}
+
+func checkSecondaryLocksRequest(startTs uint64, keys ...[]byte) *kvrpcpb.CheckSecondaryLocksRequest {
+	var req kvrpcpb.CheckSecondaryLocksRequest
+	req.StartVersion = startTs
+	req.Keys = keys
+	return &req
+}
+
+func batchGetRequest(version uint64, keys ...[]byte) *kvrpcpb.BatchGetRequest {
+	var req kvrpcpb.BatchGetRequest
+	req.Version = version
+	req.Keys = keys
+	return &req
+}
+
+func reverseScanRequest(version uint64, startKey []byte, endKey []byte, limit uint32) *kvrpcpb.ScanRequest {
+	var req kvrpcpb.ScanRequest
+	req.StartKey = startKey
+	req.EndKey = endKey
+	req.Limit = limit
+	req.Version = version
+	req.Reverse = true
+	return &req
+}
+
+func deleteRangeRequest(startKey []byte, endKey []byte) *kvrpcpb.DeleteRangeRequest {
+	var req kvrpcpb.DeleteRangeRequest
+	req.StartKey = startKey
+	req.EndKey = endKey
+	return &req
+}
@@ -0,0 +1,125 @@
+package concurrency
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// The concurrency manager keeps the state which async commit and one-phase commit need to be correct.
+//
+// Such transactions aren't given a commit ts by the client. Instead, prewrite calculates the smallest commit ts the
+// transaction may use from the largest ts any read on this server has used (max ts): if a read at ts has already
+// happened, the transaction has to commit after ts, otherwise the read could have missed a value committed before it.
+//
+// A read which bumps max ts right after a prewrite has read it must not miss the prewrite's locks, but the locks are
+// only visible once they are written to storage. So while the locks are written, they are also kept in memory and
+// checked by reads after they bump max ts and before they take a snapshot. Like latches, this only covers reads and
+// writes on this server.
+
+type Manager struct {
+	// Mutex to guard maxTs and memLocks.
+	mu    sync.Mutex
+	maxTs uint64
+	// memLocks maps keys to the locks of async commit and 1PC prewrites which are being written.
+	memLocks map[string]*mvcc.Lock
+}
+
+func NewManager() *Manager {
+	return &Manager{
+		memLocks: make(map[string]*mvcc.Lock),
+	}
+}
+
+// MaxTs returns the largest ts used by a read on this server.
+func (m *Manager) MaxTs() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.maxTs
+}
+
+// UpdateMaxTs records a read at ts. Reads at TsMax (see mvcc.Lock.IsLockedFor) only read committed data, so they are
+// ignored.
+func (m *Manager) UpdateMaxTs(ts uint64) {
+	if ts == mvcc.TsMax {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if ts > m.maxTs {
+		m.maxTs = ts
+	}
+}
+
+// LockKeys keeps lock in memory for keys until UnlockKeys is called. It must be called before the prewrite reads max
+// ts.
+func (m *Manager) LockKeys(keys [][]byte, lock *mvcc.Lock) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		m.memLocks[string(key)] = lock
+	}
+}
+
+// UnlockKeys removes the locks of the transaction started at startTs on keys, once they are written to storage or the
+// prewrite failed. Another transaction may have locked a key in the meantime, its lock is kept.
+func (m *Manager) UnlockKeys(keys [][]byte, startTs uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		if lock, ok := m.memLocks[string(key)]; ok && lock.Ts == startTs {
+			delete(m.memLocks, string(key))
+		}
+	}
+}
+
+// MinLockTs returns the smallest start ts of the locks kept in memory, or 0 if there is none. A transaction holding
+// such a lock may commit at any ts larger than its start ts.
+func (m *Manager) MinLockTs() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var minTs uint64
+	for _, lock := range m.memLocks {
+		if minTs == 0 || lock.Ts < minTs {
+			minTs = lock.Ts
+		}
+	}
+	return minTs
+}
+
+// ReadKey records a read of key at ts. If key is locked in memory for the read, it sets the Error field of resp like
+// mvcc.Lock.IsLockedFor does and returns true.
+func (m *Manager) ReadKey(key []byte, ts uint64, resp interface{}) bool {
+	m.UpdateMaxTs(ts)
+	m.mu.Lock()
+	lock := m.memLocks[string(key)]
+	m.mu.Unlock()
+	return lock.IsLockedFor(key, ts, resp)
+}
+
+// ReadRange records a read of the keys in [startKey, endKey) at ts, an empty endKey means there is no upper bound. It
+// returns an error for the smallest key in the range which is locked in memory for the read, or nil.
+func (m *Manager) ReadRange(startKey, endKey []byte, ts uint64) *kvrpcpb.KeyError {
+	m.UpdateMaxTs(ts)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var lockedKey []byte
+	var lockInfo *kvrpcpb.LockInfo
+	for k, lock := range m.memLocks {
+		key := []byte(k)
+		if bytes.Compare(key, startKey) < 0 || (len(endKey) > 0 && bytes.Compare(key, endKey) >= 0) || lock.Ts > ts ||
+			!lock.BlocksReads() {
+			continue
+		}
+		if lockedKey == nil || bytes.Compare(key, lockedKey) < 0 {
+			lockedKey = key
+			lockInfo = lock.Info(key)
+		}
+	}
+	if lockInfo == nil {
+		return nil
+	}
+	return &kvrpcpb.KeyError{Locked: lockInfo}
+}
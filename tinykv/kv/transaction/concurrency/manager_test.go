@@ -0,0 +1,70 @@
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxTs(t *testing.T) {
+	m := NewManager()
+	m.UpdateMaxTs(100)
+	m.UpdateMaxTs(50)
+	assert.Equal(t, uint64(100), m.MaxTs())
+	m.UpdateMaxTs(mvcc.TsMax)
+	assert.Equal(t, uint64(100), m.MaxTs())
+	m.ReadKey([]byte{1}, 150, new(kvrpcpb.GetResponse))
+	assert.Equal(t, uint64(150), m.MaxTs())
+	m.ReadRange([]byte{1}, nil, 200)
+	assert.Equal(t, uint64(200), m.MaxTs())
+}
+
+func TestMemLocks(t *testing.T) {
+	m := NewManager()
+	lock := &mvcc.Lock{Primary: []byte{1}, Ts: 100, Kind: mvcc.WriteKindPut}
+	m.LockKeys([][]byte{{1}, {3}}, lock)
+
+	resp := new(kvrpcpb.GetResponse)
+	assert.False(t, m.ReadKey([]byte{1}, 90, resp))
+	assert.False(t, m.ReadKey([]byte{2}, 110, resp))
+	assert.True(t, m.ReadKey([]byte{3}, 110, resp))
+	assert.Equal(t, []byte{3}, resp.Error.Locked.Key)
+
+	assert.Nil(t, m.ReadRange([]byte{2}, []byte{3}, 110))
+	assert.Nil(t, m.ReadRange([]byte{0}, nil, 90))
+	keyError := m.ReadRange([]byte{0}, nil, 110)
+	assert.NotNil(t, keyError)
+	assert.Equal(t, []byte{1}, keyError.Locked.Key)
+
+	// Only the locks of the transaction are removed.
+	m.LockKeys([][]byte{{3}}, &mvcc.Lock{Primary: []byte{3}, Ts: 105, Kind: mvcc.WriteKindPut})
+	m.UnlockKeys([][]byte{{1}, {3}}, 100)
+	assert.Nil(t, m.ReadRange([]byte{0}, []byte{3}, 110))
+	assert.True(t, m.ReadKey([]byte{3}, 110, new(kvrpcpb.GetResponse)))
+}
+
+// TestMemLocksOfLockMutations tests that the memory locks of Lock mutations don't block reads, like the locks they
+// write.
+func TestMemLocksOfLockMutations(t *testing.T) {
+	m := NewManager()
+	m.LockKeys([][]byte{{1}}, &mvcc.Lock{Primary: []byte{1}, Ts: 100, Kind: mvcc.WriteKindLock})
+	m.LockKeys([][]byte{{2}}, &mvcc.Lock{Primary: []byte{1}, Ts: 100, Kind: mvcc.WriteKindPut})
+
+	assert.False(t, m.ReadKey([]byte{1}, 110, new(kvrpcpb.GetResponse)))
+	assert.True(t, m.ReadKey([]byte{2}, 110, new(kvrpcpb.GetResponse)))
+	keyError := m.ReadRange([]byte{0}, nil, 110)
+	assert.NotNil(t, keyError)
+	assert.Equal(t, []byte{2}, keyError.Locked.Key)
+}
+
+func TestMinLockTs(t *testing.T) {
+	m := NewManager()
+	assert.Equal(t, uint64(0), m.MinLockTs())
+	m.LockKeys([][]byte{{1}}, &mvcc.Lock{Primary: []byte{1}, Ts: 100, Kind: mvcc.WriteKindPut})
+	m.LockKeys([][]byte{{2}}, &mvcc.Lock{Primary: []byte{2}, Ts: 90, Kind: mvcc.WriteKindPut})
+	assert.Equal(t, uint64(90), m.MinLockTs())
+	m.UnlockKeys([][]byte{{2}}, 90)
+	assert.Equal(t, uint64(100), m.MinLockTs())
+}
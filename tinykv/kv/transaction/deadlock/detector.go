@@ -0,0 +1,167 @@
+package deadlock
+
+import (
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// Deadlock detection finds pessimistic transactions which wait for each other's locks in a cycle. Such transactions
+// would otherwise retry until their lock wait times out, so instead one of them is told to abort.
+//
+// The detector keeps a wait-for graph: a transaction which finds a key locked by another transaction adds an edge from
+// itself to the lock's owner before it starts waiting. If the owner already (transitively) waits for the waiting
+// transaction, adding the edge would close a cycle, so the edge is not added and the cycle is returned instead.
+//
+// Waiting is done by the client, which retries the lock request, so the detector never knows for certain that a
+// transaction has stopped waiting. Edges are removed when the waiting transaction acquires its locks or finishes, and
+// every edge expires after a ttl unless the wait is reported again. Like latches, the graph is guarded by one mutex and
+// only covers waits reported to this server.
+
+// DefaultTTL is how long a wait-for edge lives if the waiting transaction doesn't report it again.
+const DefaultTTL = 3 * time.Second
+
+type waitForEntry struct {
+	key     []byte
+	keyHash uint64
+	updated time.Time
+}
+
+type Detector struct {
+	// waitForMap maps a waiting transaction to the transactions it waits for.
+	waitForMap map[uint64]map[uint64]*waitForEntry
+	// Mutex to guard waitForMap.
+	mu  sync.Mutex
+	ttl time.Duration
+	// Time of the last sweep of expired edges.
+	lastSweep time.Time
+}
+
+// NewDetector creates a new Detector whose wait-for edges expire after ttl.
+func NewDetector(ttl time.Duration) *Detector {
+	return &Detector{
+		waitForMap: make(map[uint64]map[uint64]*waitForEntry),
+		ttl:        ttl,
+		lastSweep:  time.Now(),
+	}
+}
+
+// KeyHash returns the hash used to identify key in wait-for edges.
+func KeyHash(key []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(key)
+	return h.Sum64()
+}
+
+// Detect records that txn waits for the lock on key owned by waitForTxn. If waitForTxn already waits for txn, then
+// waiting would deadlock; the edge is not recorded and the returned Deadlock describes the cycle. Otherwise Detect
+// returns nil.
+func (d *Detector) Detect(txn, waitForTxn uint64, key []byte) *kvrpcpb.Deadlock {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(d.lastSweep) > d.ttl {
+		d.sweep(now)
+	}
+
+	keyHash := KeyHash(key)
+	if path := d.findPath(waitForTxn, txn, now); path != nil {
+		chain := append([]*kvrpcpb.WaitForEntry{{
+			Txn:        txn,
+			WaitForTxn: waitForTxn,
+			KeyHash:    keyHash,
+			Key:        key,
+		}}, path...)
+		return &kvrpcpb.Deadlock{
+			LockTs:          waitForTxn,
+			LockKey:         key,
+			DeadlockKeyHash: path[len(path)-1].KeyHash,
+			WaitChain:       chain,
+		}
+	}
+
+	edges, ok := d.waitForMap[txn]
+	if !ok {
+		edges = make(map[uint64]*waitForEntry)
+		d.waitForMap[txn] = edges
+	}
+	edges[waitForTxn] = &waitForEntry{key: key, keyHash: keyHash, updated: now}
+	return nil
+}
+
+// CleanUp removes all edges from txn, it should be called when txn stops waiting, e.g., because it has acquired its
+// locks, committed, or rolled back.
+func (d *Detector) CleanUp(txn uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.waitForMap, txn)
+}
+
+// CleanUpWaitFor removes the edge from txn to waitForTxn, if there is one.
+func (d *Detector) CleanUpWaitFor(txn, waitForTxn uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if edges, ok := d.waitForMap[txn]; ok {
+		delete(edges, waitForTxn)
+		if len(edges) == 0 {
+			delete(d.waitForMap, txn)
+		}
+	}
+}
+
+// findPath returns the edges of a path from start to target, or nil if there is no such path. Expired edges are ignored.
+func (d *Detector) findPath(start, target uint64, now time.Time) []*kvrpcpb.WaitForEntry {
+	visited := map[uint64]struct{}{start: {}}
+	var path []*kvrpcpb.WaitForEntry
+
+	var visit func(txn uint64) bool
+	visit = func(txn uint64) bool {
+		for waitForTxn, entry := range d.waitForMap[txn] {
+			if now.Sub(entry.updated) > d.ttl {
+				continue
+			}
+			path = append(path, &kvrpcpb.WaitForEntry{
+				Txn:        txn,
+				WaitForTxn: waitForTxn,
+				KeyHash:    entry.keyHash,
+				Key:        entry.key,
+			})
+			if waitForTxn == target {
+				return true
+			}
+			if _, ok := visited[waitForTxn]; !ok {
+				visited[waitForTxn] = struct{}{}
+				if visit(waitForTxn) {
+					return true
+				}
+			}
+			path = path[:len(path)-1]
+		}
+		return false
+	}
+
+	if visit(start) {
+		return path
+	}
+	return nil
+}
+
+// sweep removes all expired edges.
+func (d *Detector) sweep(now time.Time) {
+	for txn, edges := range d.waitForMap {
+		for waitForTxn, entry := range edges {
+			if now.Sub(entry.updated) > d.ttl {
+				delete(edges, waitForTxn)
+			}
+		}
+		if len(edges) == 0 {
+			delete(d.waitForMap, txn)
+		}
+	}
+	d.lastSweep = now
+}
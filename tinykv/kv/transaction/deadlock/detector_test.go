@@ -0,0 +1,57 @@
+package deadlock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectCycle(t *testing.T) {
+	d := NewDetector(DefaultTTL)
+
+	assert.Nil(t, d.Detect(1, 2, []byte{1}))
+	assert.Nil(t, d.Detect(2, 3, []byte{2}))
+	// A transaction may wait for the same transaction again.
+	assert.Nil(t, d.Detect(1, 2, []byte{1}))
+
+	deadlock := d.Detect(3, 1, []byte{3})
+	assert.NotNil(t, deadlock)
+	assert.Equal(t, uint64(1), deadlock.LockTs)
+	assert.Equal(t, []byte{3}, deadlock.LockKey)
+	assert.Equal(t, KeyHash([]byte{2}), deadlock.DeadlockKeyHash)
+	assert.Equal(t, 3, len(deadlock.WaitChain))
+	for i, txns := range [][2]uint64{{3, 1}, {1, 2}, {2, 3}} {
+		assert.Equal(t, txns[0], deadlock.WaitChain[i].Txn)
+		assert.Equal(t, txns[1], deadlock.WaitChain[i].WaitForTxn)
+	}
+
+	// The edge which would have closed the cycle is not recorded.
+	assert.Nil(t, d.Detect(4, 3, []byte{4}))
+	assert.Nil(t, d.Detect(1, 4, []byte{5}))
+}
+
+func TestCleanUp(t *testing.T) {
+	d := NewDetector(DefaultTTL)
+
+	assert.Nil(t, d.Detect(1, 2, []byte{1}))
+	assert.Nil(t, d.Detect(1, 3, []byte{2}))
+	d.CleanUpWaitFor(1, 2)
+	assert.Nil(t, d.Detect(2, 1, []byte{3}))
+	assert.NotNil(t, d.Detect(3, 2, []byte{4}))
+
+	d.CleanUp(1)
+	assert.Nil(t, d.Detect(3, 2, []byte{4}))
+	assert.Empty(t, d.waitForMap[1])
+}
+
+func TestExpire(t *testing.T) {
+	d := NewDetector(10 * time.Millisecond)
+
+	assert.Nil(t, d.Detect(1, 2, []byte{1}))
+	time.Sleep(20 * time.Millisecond)
+	assert.Nil(t, d.Detect(2, 1, []byte{2}))
+	// The expired edge was swept.
+	_, ok := d.waitForMap[1]
+	assert.False(t, ok)
+}
@@ -1,7 +1,11 @@
 package latches
 
 import (
+	"hash/fnv"
+	"sort"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
 )
@@ -15,79 +19,149 @@ import (
 // only needed for writing. Only one thread can hold a latch at a time and all keys that a command might write must be locked
 // at once.
 //
-// Latching is implemented using a single map which maps keys to a Go WaitGroup. Access to this map is guarded by a mutex
-// to ensure that latching is atomic and consistent. Since the mutex is a global lock, it would cause intolerable contention
-// in a real system.
+// Keys are hashed into a fixed number of slots and a command latches the slots of its keys rather than the keys
+// themselves, so two keys in the same slot share a latch. Each slot has its own mutex and a FIFO queue of the commands
+// which want it: the command at the front of the queue holds the slot, the others wait until the command in front of
+// them releases it. So commands on different slots don't contend with each other and a command waiting for a hot key
+// can't be overtaken by later commands.
+//
+// A command takes the slots of its keys one by one in ascending slot order and keeps the slots it has while it waits for
+// the next one. Since every command takes slots in the same order, no two commands can wait for each other.
+
+// defaultSlotCount is the number of slots used by NewLatches. It must be a power of two.
+const defaultSlotCount = 2048
 
 type Latches struct {
-	// Before modifying any property of a key, the thread must have the latch for that key. `Latches` maps each latched
-	// key to a WaitGroup. Threads who find a key locked should wait on that WaitGroup.
-	latchMap map[string]*sync.WaitGroup
-	// Mutex to guard latchMap. A thread must hold this mutex while it makes any change to latchMap.
-	latchGuard sync.Mutex
+	// Contention counters, see Stats. They are first in the struct to be 64-bit aligned for atomic operations.
+	acquired uint64
+	waited   uint64
+	waitTime int64
+	slots    []slot
 	// An optional validation function, only used for testing.
 	Validation func(txn *mvcc.MvccTxn, keys [][]byte)
 }
 
+// slot is the latch of the keys which hash to it.
+type slot struct {
+	// Mutex to guard queue.
+	mu sync.Mutex
+	// The commands which want the slot in arrival order, queue[0] holds the slot.
+	queue []*waiter
+}
+
+// waiter is a command waiting for its slots.
+type waiter struct {
+	// Signalled when the waiter gets to the front of the queue of the slot it waits for.
+	wake chan struct{}
+}
+
+// Stats counts how much commands have contended for latches.
+type Stats struct {
+	// The number of times latches were acquired.
+	Acquired uint64
+	// The number of times a command had to wait for a slot held by another command.
+	Waited uint64
+	// The total time commands spent waiting for slots.
+	WaitTime time.Duration
+}
+
 // NewLatches creates a new Latches object for managing a databases latches. There should only be one such object, shared
 // between all threads.
 func NewLatches() *Latches {
-	l := new(Latches)
-	l.latchMap = make(map[string]*sync.WaitGroup)
-	return l
+	return newLatches(defaultSlotCount)
 }
 
-// AcquireLatches tries lock all Latches specified by keys. If this succeeds, nil is returned. If any of the keys are
-// locked, then AcquireLatches requires a WaitGroup which the thread can use to be woken when the lock is free.
-func (l *Latches) AcquireLatches(keysToLatch [][]byte) *sync.WaitGroup {
-	l.latchGuard.Lock()
-	defer l.latchGuard.Unlock()
-
-	// Check none of the keys we want to write are locked.
-	for _, key := range keysToLatch {
-		if latchWg, ok := l.latchMap[string(key)]; ok {
-			// Return a wait group to wait on.
-			return latchWg
+func newLatches(slotCount int) *Latches {
+	return &Latches{slots: make([]slot, slotCount)}
+}
+
+// slotsFor returns the sorted and deduplicated slots of keys.
+func (l *Latches) slotsFor(keys [][]byte) []int {
+	slots := make([]int, 0, len(keys))
+	for _, key := range keys {
+		h := fnv.New32a()
+		h.Write(key)
+		slots = append(slots, int(h.Sum32()&uint32(len(l.slots)-1)))
+	}
+	sort.Ints(slots)
+	unique := slots[:0]
+	for i, s := range slots {
+		if i == 0 || s != slots[i-1] {
+			unique = append(unique, s)
 		}
 	}
+	return unique
+}
 
-	// All Latches are available, lock them all with a new wait group.
-	wg := new(sync.WaitGroup)
-	wg.Add(1)
-	for _, key := range keysToLatch {
-		l.latchMap[string(key)] = wg
+// AcquireLatches tries to lock all latches specified by keys without waiting. If this succeeds, true is returned. If
+// any of the keys are locked, then no latch is taken and false is returned.
+func (l *Latches) AcquireLatches(keysToLatch [][]byte) bool {
+	slots := l.slotsFor(keysToLatch)
+	w := &waiter{wake: make(chan struct{}, 1)}
+	for i, idx := range slots {
+		s := &l.slots[idx]
+		s.mu.Lock()
+		if len(s.queue) > 0 {
+			s.mu.Unlock()
+			l.releaseSlots(slots[:i])
+			return false
+		}
+		s.queue = append(s.queue, w)
+		s.mu.Unlock()
 	}
+	atomic.AddUint64(&l.acquired, 1)
+	return true
+}
 
-	return nil
+// WaitForLatches locks all keys in keysToLatch. If a latch is already locked, then WaitForLatches waits behind the
+// commands which asked for it earlier. Therefore WaitForLatches may block for an unbounded length of time.
+func (l *Latches) WaitForLatches(keysToLatch [][]byte) {
+	w := &waiter{wake: make(chan struct{}, 1)}
+	for _, idx := range l.slotsFor(keysToLatch) {
+		s := &l.slots[idx]
+		s.mu.Lock()
+		s.queue = append(s.queue, w)
+		holder := len(s.queue) == 1
+		s.mu.Unlock()
+		if !holder {
+			start := time.Now()
+			<-w.wake
+			atomic.AddUint64(&l.waited, 1)
+			atomic.AddInt64(&l.waitTime, int64(time.Since(start)))
+		}
+	}
+	atomic.AddUint64(&l.acquired, 1)
 }
 
-// ReleaseLatches releases the latches for all keys in keysToUnlatch. It will wakeup any threads blocked on one of the
-// latches. All keys in keysToUnlatch must have been locked together in one call to AcquireLatches.
+// ReleaseLatches releases the latches for all keys in keysToUnlatch. It will wake up the next command waiting for each
+// of the latches. All keys in keysToUnlatch must have been locked together in one call to AcquireLatches or
+// WaitForLatches.
 func (l *Latches) ReleaseLatches(keysToUnlatch [][]byte) {
-	l.latchGuard.Lock()
-	defer l.latchGuard.Unlock()
-
-	first := true
-	for _, key := range keysToUnlatch {
-		if first {
-			wg := l.latchMap[string(key)]
-			wg.Done()
-			first = false
+	l.releaseSlots(l.slotsFor(keysToUnlatch))
+}
+
+func (l *Latches) releaseSlots(slots []int) {
+	for _, idx := range slots {
+		s := &l.slots[idx]
+		s.mu.Lock()
+		s.queue[0] = nil
+		s.queue = s.queue[1:]
+		if len(s.queue) > 0 {
+			s.queue[0].wake <- struct{}{}
+		} else {
+			// Drop the backing array so that an idle slot doesn't keep it.
+			s.queue = nil
 		}
-		delete(l.latchMap, string(key))
+		s.mu.Unlock()
 	}
 }
 
-// WaitForLatches attempts to lock all keys in keysToLatch using AcquireLatches. If a latch ia already locked, then =
-// WaitForLatches will wait for it to become unlocked then try again. Therefore WaitForLatches may block for an unbounded
-// length of time.
-func (l *Latches) WaitForLatches(keysToLatch [][]byte) {
-	for {
-		wg := l.AcquireLatches(keysToLatch)
-		if wg == nil {
-			return
-		}
-		wg.Wait()
+// Stats returns the contention counters of the latches.
+func (l *Latches) Stats() Stats {
+	return Stats{
+		Acquired: atomic.LoadUint64(&l.acquired),
+		Waited:   atomic.LoadUint64(&l.waited),
+		WaitTime: time.Duration(atomic.LoadInt64(&l.waitTime)),
 	}
 }
 
@@ -1,31 +1,145 @@
 package latches
 
 import (
+	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestAcquireLatches(t *testing.T) {
-	l := Latches{
-		latchMap: make(map[string]*sync.WaitGroup),
-	}
+	l := NewLatches()
 
 	// Acquiring a new latch is ok.
-	wg := l.AcquireLatches([][]byte{{}, {3}, {3, 0, 42}})
-	assert.Nil(t, wg)
+	ok := l.AcquireLatches([][]byte{{}, {3}, {3, 0, 42}})
+	assert.True(t, ok)
 
 	// Can only acquire once.
-	wg = l.AcquireLatches([][]byte{{}})
-	assert.NotNil(t, wg)
-	wg = l.AcquireLatches([][]byte{{3, 0, 42}})
-	assert.NotNil(t, wg)
+	ok = l.AcquireLatches([][]byte{{}})
+	assert.False(t, ok)
+	ok = l.AcquireLatches([][]byte{{3, 0, 42}})
+	assert.False(t, ok)
 
 	// Release then acquire is ok.
-	l.ReleaseLatches([][]byte{{3}, {3, 0, 43}})
-	wg = l.AcquireLatches([][]byte{{3}})
-	assert.Nil(t, wg)
-	wg = l.AcquireLatches([][]byte{{3, 0, 42}})
-	assert.NotNil(t, wg)
+	l.ReleaseLatches([][]byte{{}, {3}, {3, 0, 42}})
+	ok = l.AcquireLatches([][]byte{{3}})
+	assert.True(t, ok)
+	ok = l.AcquireLatches([][]byte{{3}, {3, 0, 42}})
+	assert.False(t, ok)
+	// A failed acquire takes no latch.
+	ok = l.AcquireLatches([][]byte{{3, 0, 42}})
+	assert.True(t, ok)
+}
+
+// TestSameSlot tests that keys which share a slot can be latched together.
+func TestSameSlot(t *testing.T) {
+	l := newLatches(1)
+
+	l.WaitForLatches([][]byte{{1}, {2}, {1}})
+	assert.False(t, l.AcquireLatches([][]byte{{3}}))
+	l.ReleaseLatches([][]byte{{1}, {2}, {1}})
+	assert.True(t, l.AcquireLatches([][]byte{{3}}))
+}
+
+// TestWaitForLatchesFIFO tests that waiting commands get a latch in the order they asked for it.
+func TestWaitForLatchesFIFO(t *testing.T) {
+	l := NewLatches()
+	key := [][]byte{{1}}
+	l.WaitForLatches(key)
+
+	order := make(chan int, 3)
+	for i := 0; i < 3; i++ {
+		go func(i int) {
+			l.WaitForLatches(key)
+			order <- i
+			l.ReleaseLatches(key)
+		}(i)
+		// Wait until the command is queued so that the arrival order is known.
+		for l.queueLen(key[0]) != i+2 {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	l.ReleaseLatches(key)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, i, <-order)
+	}
+	stats := l.Stats()
+	assert.Equal(t, uint64(4), stats.Acquired)
+	assert.Equal(t, uint64(3), stats.Waited)
+	assert.True(t, stats.WaitTime > 0)
+}
+
+// TestWaitForLatchesConcurrent tests that commands latching overlapping keys in different orders exclude each other
+// and don't deadlock.
+func TestWaitForLatchesConcurrent(t *testing.T) {
+	l := newLatches(16)
+	counters := make([]int, 8)
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				// Two different counters, taken in either order.
+				a := (i + j) % len(counters)
+				b := (a + 1 + j%(len(counters)-1)) % len(counters)
+				keys := [][]byte{{byte(a)}, {byte(b)}}
+				l.WaitForLatches(keys)
+				counters[a]++
+				counters[b]++
+				l.ReleaseLatches(keys)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	total := 0
+	for _, c := range counters {
+		total += c
+	}
+	assert.Equal(t, 16*200*2, total)
+}
+
+func (l *Latches) queueLen(key []byte) int {
+	s := &l.slots[l.slotsFor([][]byte{key})[0]]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.queue)
+}
+
+// BenchmarkLatches runs commands which latch a few keys each from many goroutines, like concurrent prewrites of
+// different transactions. With distinct keys the commands should rarely wait for each other.
+func BenchmarkLatches(b *testing.B) {
+	for _, keySpace := range []int{1, 16, 1 << 16} {
+		b.Run(fmt.Sprintf("keys-%d", keySpace), func(b *testing.B) {
+			l := NewLatches()
+			table := make([][]byte, keySpace)
+			for i := range table {
+				table[i] = []byte(fmt.Sprintf("key%d", i))
+			}
+			var next uint64
+			var mu sync.Mutex
+			b.RunParallel(func(pb *testing.PB) {
+				mu.Lock()
+				seed := next
+				next += 7919
+				mu.Unlock()
+				for pb.Next() {
+					seed++
+					keys := make([][]byte, 3)
+					for i := range keys {
+						k := (seed*31 + uint64(i)*131) % uint64(keySpace)
+						keys[i] = table[k]
+					}
+					l.WaitForLatches(keys)
+					l.ReleaseLatches(keys)
+				}
+			})
+			stats := l.Stats()
+			b.ReportMetric(float64(stats.Waited)/float64(stats.Acquired), "waits/op")
+		})
+	}
 }
@@ -0,0 +1,72 @@
+package mvcc
+
+import (
+	"bytes"
+
+	"github.com/pingcap-incubator/tinykv/kv/backup"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// Backup writes the values of the keys in [startKey, endKey) which are visible at txn.StartTS to a backup file at
+// path. An empty endKey means the range is unbounded. It returns the checksum of the written pairs, or nil if the
+// range has no key, in which case no file is written. A key locked by a transaction which started before txn.StartTS
+// is reported with a *KeyError, its value isn't known yet.
+func Backup(txn *RoTxn, path string, startKey, endKey []byte) (*backup.Checksum, error) {
+	var w *backup.FileWriter
+	checksum := new(backup.Checksum)
+	scanner := NewScanner(startKey, txn)
+	defer scanner.Close()
+	for {
+		key, value, err := scanner.Next()
+		if err == nil && (key == nil || engine_util.ExceedEndKey(key, endKey)) {
+			break
+		}
+		if err == nil && w == nil {
+			w, err = backup.CreateFile(path)
+		}
+		if err == nil {
+			err = w.Add(key, value)
+		}
+		if err != nil {
+			if w != nil {
+				w.Abort()
+			}
+			return nil, err
+		}
+		checksum.Update(key, value)
+	}
+	if w == nil {
+		return nil, nil
+	}
+	if err := w.Finish(); err != nil {
+		w.Abort()
+		return nil, err
+	}
+	return checksum, nil
+}
+
+// Restore adds to txn the writes which commit up to limit pairs read from r at txn.StartTS, see
+// kvrpcpb.RestoreRequest for how the keys are rewritten and which pairs are restored. A limit of 0 means no limit. The
+// restored pairs are added to checksum with their keys as they were backed up. It returns true if it stopped at the
+// limit, in which case there may be more pairs to restore.
+func Restore(txn *MvccTxn, r *backup.FileReader, req *kvrpcpb.RestoreRequest, limit int, checksum *backup.Checksum) (bool, error) {
+	for restored := 0; limit == 0 || restored < limit; {
+		key, value, err := r.Next()
+		if err != nil {
+			return false, err
+		}
+		if key == nil {
+			return false, nil
+		}
+		newKey := backup.RewriteKey(key, req.RewriteRules)
+		if bytes.Compare(newKey, req.StartKey) < 0 || engine_util.ExceedEndKey(newKey, req.EndKey) {
+			continue
+		}
+		checksum.Update(key, value)
+		txn.PutValue(newKey, value)
+		txn.PutWrite(newKey, txn.StartTS, &Write{StartTS: txn.StartTS, Kind: WriteKindPut})
+		restored++
+	}
+	return true, nil
+}
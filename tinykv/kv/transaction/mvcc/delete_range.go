@@ -0,0 +1,48 @@
+package mvcc
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/storage"
+	"github.com/pingcap-incubator/tinykv/kv/util/codec"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+)
+
+// DeleteRange adds to txn the deletes of up to limit keys, over all CFs, which hold the values, writes and locks of
+// the user keys in [startKey, endKey). An empty endKey means the range is unbounded and a limit of 0 means no limit.
+// It returns true if it stopped at the limit, in which case there may be more keys to delete.
+//
+// The deletes ignore the transaction's timestamp and any lock in the range, so this is only safe for ranges which no
+// transaction reads or writes any more.
+func DeleteRange(txn *MvccTxn, startKey, endKey []byte, limit int) (bool, error) {
+	// Values and writes are stored under encoded keys, locks under user keys.
+	encodedStart := codec.EncodeBytes(startKey)
+	var encodedEnd []byte
+	if len(endKey) > 0 {
+		encodedEnd = codec.EncodeBytes(endKey)
+	}
+	for _, cf := range []string{engine_util.CfDefault, engine_util.CfWrite, engine_util.CfLock} {
+		start, end := encodedStart, encodedEnd
+		if cf == engine_util.CfLock {
+			start, end = startKey, endKey
+		}
+
+		iter := txn.Reader.IterCF(cf)
+		for iter.Seek(start); iter.Valid(); iter.Next() {
+			key := iter.Item().KeyCopy(nil)
+			if engine_util.ExceedEndKey(key, end) {
+				break
+			}
+			if limit > 0 && len(txn.writes) == limit {
+				iter.Close()
+				return true, nil
+			}
+			txn.writes = append(txn.writes, storage.Modify{
+				Data: storage.Delete{
+					Key: key,
+					Cf:  cf,
+				},
+			})
+		}
+		iter.Close()
+	}
+	return false, nil
+}
@@ -0,0 +1,71 @@
+package mvcc
+
+import (
+	"bytes"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+)
+
+// GcWrite is a write which no transaction reading at or after the GC safe point can see. Removing it also removes
+// the value it refers to, if any.
+type GcWrite struct {
+	Key      []byte
+	CommitTs uint64
+	Write    *Write
+}
+
+// GcWrites scans the writes of up to limit keys, starting at startKey, and returns those which can be removed by a GC
+// at safePoint. For each key, the most recent put committed at or before safePoint is the version read at safePoint, so
+// it is kept and every write committed before it can be removed. Rollbacks committed at or before safePoint, and a
+// delete which is the version read at safePoint, can be removed too. A limit of 0 means no limit.
+//
+// GcWrites also returns the key to continue scanning from, or nil if the scan reached the end of the DB.
+func GcWrites(txn *RoTxn, startKey []byte, safePoint uint64, limit int) ([]GcWrite, []byte, error) {
+	var result []GcWrite
+	iter := txn.Reader.IterCF(engine_util.CfWrite)
+	defer iter.Close()
+
+	var currentKey []byte
+	keys := 0
+	// Whether the version of currentKey read at safePoint has been found.
+	found := false
+	for iter.Seek(EncodeKey(startKey, TsMax)); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		key := DecodeUserKey(item.Key())
+		if currentKey == nil || !bytes.Equal(key, currentKey) {
+			if limit > 0 && keys == limit {
+				return result, key, nil
+			}
+			keys++
+			currentKey = key
+			found = false
+		}
+
+		commitTs := DecodeTimestamp(item.Key())
+		if commitTs > safePoint {
+			continue
+		}
+		value, err := item.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+		write, err := ParseWrite(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		if found {
+			result = append(result, GcWrite{currentKey, commitTs, write})
+			continue
+		}
+		switch write.Kind {
+		case WriteKindPut:
+			found = true
+		case WriteKindDelete:
+			found = true
+			result = append(result, GcWrite{currentKey, commitTs, write})
+		default:
+			result = append(result, GcWrite{currentKey, commitTs, write})
+		}
+	}
+	return result, nil, nil
+}
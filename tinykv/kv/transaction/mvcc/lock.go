@@ -17,8 +17,20 @@ type Lock struct {
 	Ts      uint64
 	Ttl     uint64
 	Kind    WriteKind
+	// ForUpdateTs is only set for pessimistic locks, it is the for_update_ts of the
+	// PessimisticLock request which acquired the lock.
+	ForUpdateTs uint64
+	// UseAsyncCommit is set for locks prewritten for async commit. Such a transaction is committed once all its
+	// locks are prewritten, at the largest MinCommitTs of its locks.
+	UseAsyncCommit bool
+	MinCommitTs    uint64
+	// Secondaries are the other keys of an async commit transaction, only recorded in its primary lock.
+	Secondaries [][]byte
 }
 
+// lockFlagAsyncCommit is set in the kind byte of an encoded async commit lock.
+const lockFlagAsyncCommit = 0x80
+
 type KlPair struct {
 	Key  []byte
 	Lock *Lock
@@ -31,17 +43,63 @@ func (lock *Lock) Info(key []byte) *kvrpcpb.LockInfo {
 	info.LockVersion = lock.Ts
 	info.PrimaryLock = lock.Primary
 	info.LockTtl = lock.Ttl
+	info.LockType = lock.Kind.ToProto()
+	info.UseAsyncCommit = lock.UseAsyncCommit
+	info.MinCommitTs = lock.MinCommitTs
+	info.Secondaries = lock.Secondaries
 	return &info
 }
 
+// IsPessimistic returns true if the lock was acquired by a PessimisticLock request and hasn't
+// been prewritten yet.
+func (lock *Lock) IsPessimistic() bool {
+	return lock.Kind == WriteKindPessimisticLock
+}
+
+// BlocksReads returns false for locks which don't change the value of their key, i.e. pessimistic locks and the locks
+// of Lock mutations. Reads never wait for them.
+func (lock *Lock) BlocksReads() bool {
+	return lock.Kind != WriteKindPessimisticLock && lock.Kind != WriteKindLock
+}
+
+// ToBytes encodes the lock as primary|kind|ts|ttl. A pessimistic lock also stores its
+// for_update_ts between the primary and the kind, so that the kind stays at a fixed
+// offset from the end. An async commit lock stores its secondaries, each followed by its
+// length, their count and its min_commit_ts there instead, and sets a flag in the kind.
 func (lock *Lock) ToBytes() []byte {
-	buf := append(lock.Primary, byte(lock.Kind))
-	buf = append(buf, make([]byte, 16)...)
-	binary.BigEndian.PutUint64(buf[len(lock.Primary)+1:], lock.Ts)
-	binary.BigEndian.PutUint64(buf[len(lock.Primary)+9:], lock.Ttl)
+	buf := append([]byte{}, lock.Primary...)
+	if lock.IsPessimistic() {
+		buf = appendUint64(buf, lock.ForUpdateTs)
+	}
+	kind := byte(lock.Kind)
+	if lock.UseAsyncCommit {
+		// Secondaries are decoded from the end, so encode them backwards.
+		for i := len(lock.Secondaries) - 1; i >= 0; i-- {
+			buf = append(buf, lock.Secondaries[i]...)
+			buf = appendUint32(buf, uint32(len(lock.Secondaries[i])))
+		}
+		buf = appendUint32(buf, uint32(len(lock.Secondaries)))
+		buf = appendUint64(buf, lock.MinCommitTs)
+		kind |= lockFlagAsyncCommit
+	}
+	buf = append(buf, kind)
+	buf = appendUint64(buf, lock.Ts)
+	buf = appendUint64(buf, lock.Ttl)
 	return buf
 }
 
+func appendUint64(buf []byte, v uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	return append(buf, b[:]...)
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return append(buf, b[:]...)
+}
+
 // ParseLock attempts to parse a byte string into a Lock object.
 func ParseLock(input []byte) (*Lock, error) {
 	if len(input) <= 16 {
@@ -49,17 +107,50 @@ func ParseLock(input []byte) (*Lock, error) {
 	}
 
 	primaryLen := len(input) - 17
-	primary := input[:primaryLen]
 	kind := WriteKind(input[primaryLen])
 	ts := binary.BigEndian.Uint64(input[primaryLen+1:])
 	ttl := binary.BigEndian.Uint64(input[primaryLen+9:])
+	lock := &Lock{Ts: ts, Ttl: ttl}
+
+	if kind&lockFlagAsyncCommit != 0 {
+		kind &^= lockFlagAsyncCommit
+		lock.UseAsyncCommit = true
+		if primaryLen < 12 {
+			return nil, fmt.Errorf("mvcc: error parsing async commit lock, not enough input, found %d bytes", len(input))
+		}
+		primaryLen -= 8
+		lock.MinCommitTs = binary.BigEndian.Uint64(input[primaryLen:])
+		primaryLen -= 4
+		count := binary.BigEndian.Uint32(input[primaryLen:])
+		for i := uint32(0); i < count; i++ {
+			if primaryLen < 4 {
+				return nil, fmt.Errorf("mvcc: error parsing async commit lock, not enough input, found %d bytes", len(input))
+			}
+			primaryLen -= 4
+			secondaryLen := int(binary.BigEndian.Uint32(input[primaryLen:]))
+			if primaryLen < secondaryLen {
+				return nil, fmt.Errorf("mvcc: error parsing async commit lock, not enough input, found %d bytes", len(input))
+			}
+			primaryLen -= secondaryLen
+			lock.Secondaries = append(lock.Secondaries, input[primaryLen:primaryLen+secondaryLen])
+		}
+	}
+	if kind == WriteKindPessimisticLock {
+		if primaryLen < 8 {
+			return nil, fmt.Errorf("mvcc: error parsing pessimistic lock, not enough input, found %d bytes", len(input))
+		}
+		primaryLen -= 8
+		lock.ForUpdateTs = binary.BigEndian.Uint64(input[primaryLen:])
+	}
+	lock.Kind = kind
+	lock.Primary = input[:primaryLen]
 
-	return &Lock{Primary: primary, Ts: ts, Ttl: ttl, Kind: kind}, nil
+	return lock, nil
 }
 
 // IsLockedFor checks if lock locks key at txnStartTs.
 func (lock *Lock) IsLockedFor(key []byte, txnStartTs uint64, resp interface{}) bool {
-	if lock == nil {
+	if lock == nil || !lock.BlocksReads() {
 		return false
 	}
 	// If the point get read is from a single statement auto commit transaction, the version
@@ -102,3 +193,27 @@ func AllLocksForTxn(txn *RoTxn) ([]KlPair, error) {
 	}
 	return result, nil
 }
+
+// LocksBefore returns up to limit locks, starting at startKey, which belong to transactions started no later than maxTs.
+// A limit of 0 means no limit.
+func LocksBefore(txn *RoTxn, startKey []byte, maxTs uint64, limit int) ([]KlPair, error) {
+	var result []KlPair
+	iter := txn.Reader.IterCF(engine_util.CfLock)
+	defer iter.Close()
+
+	for iter.Seek(startKey); iter.Valid() && (limit == 0 || len(result) < limit); iter.Next() {
+		item := iter.Item()
+		val, err := item.Value()
+		if err != nil {
+			return nil, err
+		}
+		lock, err := ParseLock(val)
+		if err != nil {
+			return nil, err
+		}
+		if lock.Ts <= maxTs {
+			result = append(result, KlPair{item.KeyCopy(nil), lock})
+		}
+	}
+	return result, nil
+}
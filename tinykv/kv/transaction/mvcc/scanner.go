@@ -1,10 +1,17 @@
 package mvcc
 
 import (
+	"github.com/pingcap-incubator/tinykv/kv/util/codec"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 )
 
+// PairScanner reads key/value pairs one at a time, it is implemented by Scanner and ReverseScanner.
+type PairScanner interface {
+	Next() ([]byte, []byte, error)
+	Close()
+}
+
 // Scanner is used for reading multiple sequential key/value pairs from the storage layer. It is aware of the implementation
 // of the storage layer and returns results suitable for users.
 // Invariant: either the scanner is finished and cannot be used, or it is ready to return a value immediately.
@@ -38,20 +45,24 @@ func (scan *Scanner) Next() ([]byte, []byte, error) {
 
 		item := scan.writeIter.Item()
 		userKey := DecodeUserKey(item.Key())
-		commitTs := decodeTimestamp(item.Key())
+		commitTs := DecodeTimestamp(item.Key())
 
 		if commitTs >= scan.txn.StartTS {
 			// The key was not committed before our transaction started, find an earlier key.
+			scan.txn.MetNewerData = true
 			scan.writeIter.Seek(EncodeKey(userKey, commitTs-1))
 			continue
 		}
 
-		lock, err := scan.txn.GetLock(userKey)
+		lock, err := scan.txn.checkLock(userKey)
 		if err != nil {
 			return nil, nil, err
 		}
-		if lock != nil && lock.Ts < scan.txn.StartTS {
-			// The key is currently locked.
+		if lock != nil && lock.BlocksReads() && lock.Ts < scan.txn.StartTS {
+			// The key is currently locked. Pessimistic locks and the locks of Lock mutations don't
+			// block reads since they don't change the value. Skip the key so that the scan can go on
+			// after the error is reported.
+			scan.writeIter.Seek(EncodeKey(userKey, 0))
 			keyError := new(KeyError)
 			keyError.Locked = lock.Info(userKey)
 			return nil, nil, keyError
@@ -65,6 +76,11 @@ func (scan *Scanner) Next() ([]byte, []byte, error) {
 		if err != nil {
 			return nil, nil, err
 		}
+		if write.Kind == WriteKindRollback || write.Kind == WriteKindLock {
+			// The value didn't change, find an earlier write.
+			scan.writeIter.Seek(EncodeKey(userKey, commitTs-1))
+			continue
+		}
 		if write.Kind != WriteKindPut {
 			// Key is removed, go to next key.
 			scan.writeIter.Seek(EncodeKey(userKey, 0))
@@ -76,8 +92,73 @@ func (scan *Scanner) Next() ([]byte, []byte, error) {
 			return nil, nil, err
 		}
 
-		scan.writeIter.Next()
+		// Skip the older writes of the key.
+		scan.writeIter.Seek(EncodeKey(userKey, 0))
+
+		return userKey, value, nil
+	}
+}
+
+// ReverseScanner is like Scanner, but returns key/value pairs from the largest key to the smallest.
+type ReverseScanner struct {
+	writeIter engine_util.DBIterator
+	txn       *RoTxn
+}
+
+// NewReverseScanner creates a new scanner which reads the keys before endKey in descending order. An empty endKey
+// means the scan starts from the last key.
+func NewReverseScanner(endKey []byte, txn *RoTxn) *ReverseScanner {
+	writeIter := txn.Reader.ReverseIterCF(engine_util.CfWrite)
+	if len(endKey) == 0 {
+		writeIter.Seek(nil)
+	} else {
+		// Every write of endKey is greater than the encoded key alone, so the seek lands on a smaller key.
+		writeIter.Seek(codec.EncodeBytes(endKey))
+	}
+	return &ReverseScanner{
+		writeIter: writeIter,
+		txn:       txn,
+	}
+}
+
+func (scan *ReverseScanner) Close() {
+	scan.writeIter.Close()
+}
+
+// Next returns the previous key/value pair from the scanner. If the scanner is exhausted, then it will return
+// `nil, nil, nil`.
+func (scan *ReverseScanner) Next() ([]byte, []byte, error) {
+	for {
+		if !scan.writeIter.Valid() {
+			return nil, nil, nil
+		}
+
+		// The reverse iterator visits the writes of a key from the oldest to the newest, so rather than walking them
+		// read the value like a point get and then jump over all the writes of the key.
+		userKey := DecodeUserKey(scan.writeIter.Item().Key())
+		scan.writeIter.Seek(codec.EncodeBytes(userKey))
+
+		lock, err := scan.txn.checkLock(userKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		if lock != nil && lock.BlocksReads() && lock.Ts < scan.txn.StartTS {
+			keyError := new(KeyError)
+			keyError.Locked = lock.Info(userKey)
+			return nil, nil, keyError
+		}
 
+		if err = scan.txn.checkNewerWrite(userKey); err != nil {
+			return nil, nil, err
+		}
+		value, err := scan.txn.GetValue(userKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		if value == nil {
+			// The key doesn't exist at our timestamp.
+			continue
+		}
 		return userKey, value, nil
 	}
 }
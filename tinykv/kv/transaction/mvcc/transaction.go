@@ -81,8 +81,8 @@ func DecodeUserKey(key []byte) []byte {
 	return userKey
 }
 
-// decodeTimestamp takes a key + timestamp and returns the timestamp part.
-func decodeTimestamp(key []byte) uint64 {
+// DecodeTimestamp takes a key + timestamp and returns the timestamp part.
+func DecodeTimestamp(key []byte) uint64 {
 	left, _, err := codec.DecodeBytes(key)
 	if err != nil {
 		panic(err)
@@ -103,6 +103,9 @@ type MvccTxn struct {
 type RoTxn struct {
 	Reader  storage.StorageReader
 	StartTS uint64
+	// MetNewerData is set by the scanners once they skip a write committed or a lock taken after StartTS. What was
+	// read without meeting newer data is also what a later StartTS reads, until the data changes.
+	MetNewerData bool
 }
 
 func NewTxn(reader storage.StorageReader, startTs uint64) MvccTxn {
@@ -132,7 +135,7 @@ func (txn *RoTxn) mostRecentWriteBefore(key []byte, ts uint64) (*Write, uint64,
 		return nil, 0, nil
 	}
 	item := iter.Item()
-	commitTs := decodeTimestamp(item.Key())
+	commitTs := DecodeTimestamp(item.Key())
 	if bytes.Compare(DecodeUserKey(item.Key()), key) != 0 {
 		return nil, 0, nil
 	}
@@ -197,7 +200,7 @@ func (txn *RoTxn) GetValue(key []byte) ([]byte, error) {
 			return txn.Reader.GetCF(engine_util.CfDefault, EncodeKey(key, write.StartTS))
 		case WriteKindDelete:
 			return nil, nil
-		case WriteKindRollback:
+		case WriteKindRollback, WriteKindLock:
 		}
 	}
 
@@ -217,6 +220,16 @@ func (txn *MvccTxn) PutWrite(key []byte, ts uint64, write *Write) {
 	})
 }
 
+// DeleteWrite removes the write at key and ts.
+func (txn *MvccTxn) DeleteWrite(key []byte, ts uint64) {
+	txn.writes = append(txn.writes, storage.Modify{
+		Data: storage.Delete{
+			Key: EncodeKey(key, ts),
+			Cf:  engine_util.CfWrite,
+		},
+	})
+}
+
 // GetLock returns a lock if key is locked. It will return (nil, nil) if there is no lock on key, and (nil, err)
 // if an error occurs during lookup.
 func (txn *RoTxn) GetLock(key []byte) (*Lock, error) {
@@ -236,6 +249,38 @@ func (txn *RoTxn) GetLock(key []byte) (*Lock, error) {
 	return lock, nil
 }
 
+// CheckNewerData sets MetNewerData if key has a lock taken or a write committed after StartTS.
+func (txn *RoTxn) CheckNewerData(key []byte) error {
+	if _, err := txn.checkLock(key); err != nil {
+		return err
+	}
+	return txn.checkNewerWrite(key)
+}
+
+// checkLock is like GetLock, but sets MetNewerData if the lock is taken after StartTS.
+func (txn *RoTxn) checkLock(key []byte) (*Lock, error) {
+	lock, err := txn.GetLock(key)
+	if err != nil {
+		return nil, err
+	}
+	if lock != nil && lock.Ts >= txn.StartTS {
+		txn.MetNewerData = true
+	}
+	return lock, nil
+}
+
+// checkNewerWrite sets MetNewerData if key has a write committed after StartTS.
+func (txn *RoTxn) checkNewerWrite(key []byte) error {
+	write, commitTs, err := txn.MostRecentWrite(key)
+	if err != nil {
+		return err
+	}
+	if write != nil && commitTs >= txn.StartTS {
+		txn.MetNewerData = true
+	}
+	return nil
+}
+
 // PutLock adds a key/lock to this transaction.
 func (txn *MvccTxn) PutLock(key []byte, lock *Lock) {
 	txn.writes = append(txn.writes, storage.Modify{
@@ -273,6 +318,16 @@ func (txn *MvccTxn) PutValue(key []byte, value []byte) {
 	})
 }
 
+// DeleteValueAt removes the value written for key by the transaction which started at ts.
+func (txn *MvccTxn) DeleteValueAt(key []byte, ts uint64) {
+	txn.writes = append(txn.writes, storage.Modify{
+		Data: storage.Delete{
+			Key: EncodeKey(key, ts),
+			Cf:  engine_util.CfDefault,
+		},
+	})
+}
+
 // DeleteValue removes a key/value pair in this transaction.
 func (txn *MvccTxn) DeleteValue(key []byte) {
 	txn.writes = append(txn.writes, storage.Modify{
@@ -105,6 +105,26 @@ func TestGetLock4A(t *testing.T) {
 	assert.Equal(t, lock, *gotLock)
 }
 
+func TestAsyncCommitLock(t *testing.T) {
+	lock := Lock{
+		Primary:        []byte{16},
+		Ts:             100,
+		Ttl:            100000,
+		Kind:           WriteKindPut,
+		UseAsyncCommit: true,
+		MinCommitTs:    101,
+		Secondaries:    [][]byte{{1}, {2, 3}, {}},
+	}
+	gotLock, err := ParseLock(lock.ToBytes())
+	assert.Nil(t, err)
+	assert.Equal(t, lock, *gotLock)
+
+	lock.Secondaries = nil
+	gotLock, err = ParseLock(lock.ToBytes())
+	assert.Nil(t, err)
+	assert.Equal(t, lock, *gotLock)
+}
+
 func TestDeleteLock4A(t *testing.T) {
 	txn := testTxn(42, nil)
 	txn.DeleteLock([]byte{1})
@@ -294,3 +314,45 @@ func TestMostRecentWrite4A(t *testing.T) {
 	}, *write)
 	assert.Equal(t, uint64(52), ts)
 }
+
+func TestMetNewerData(t *testing.T) {
+	scanAll := func(txn *MvccTxn, reverse bool) {
+		var scanner PairScanner = NewScanner(nil, &txn.RoTxn)
+		if reverse {
+			scanner = NewReverseScanner(nil, &txn.RoTxn)
+		}
+		defer scanner.Close()
+		for {
+			key, _, err := scanner.Next()
+			assert.Nil(t, err)
+			if key == nil {
+				return
+			}
+		}
+	}
+	for _, reverse := range []bool{false, true} {
+		txn := testTxn(53, twoEntries)
+		scanAll(&txn, reverse)
+		assert.False(t, txn.MetNewerData)
+
+		// The write committed at 52 is skipped.
+		txn = testTxn(51, twoEntries)
+		scanAll(&txn, reverse)
+		assert.True(t, txn.MetNewerData)
+
+		// So is the lock taken at 60.
+		txn = testTxn(53, func(m *storage.MemStorage) {
+			twoEntries(m)
+			m.Set(engine_util.CfLock, []byte{16, 240}, (&Lock{Primary: []byte{16, 240}, Ts: 60, Ttl: 10, Kind: WriteKindPut}).ToBytes())
+		})
+		scanAll(&txn, reverse)
+		assert.True(t, txn.MetNewerData)
+	}
+
+	txn := testTxn(53, twoEntries)
+	assert.Nil(t, txn.CheckNewerData([]byte{16, 240}))
+	assert.False(t, txn.MetNewerData)
+	txn = testTxn(51, twoEntries)
+	assert.Nil(t, txn.CheckNewerData([]byte{16, 240}))
+	assert.True(t, txn.MetNewerData)
+}
@@ -40,6 +40,12 @@ const (
 	WriteKindPut      WriteKind = 1
 	WriteKindDelete   WriteKind = 2
 	WriteKindRollback WriteKind = 3
+	// WriteKindPessimisticLock is only used as the kind of a lock, see Lock.IsPessimistic.
+	// It is never written to the write CF.
+	WriteKindPessimisticLock WriteKind = 4
+	// WriteKindLock is written for a key which the transaction locked without changing its value, e.g. by
+	// SELECT ... FOR UPDATE. Reads skip it like a rollback.
+	WriteKindLock WriteKind = 5
 )
 
 func (wk WriteKind) ToProto() kvrpcpb.Op {
@@ -50,6 +56,10 @@ func (wk WriteKind) ToProto() kvrpcpb.Op {
 		return kvrpcpb.Op_Del
 	case WriteKindRollback:
 		return kvrpcpb.Op_Rollback
+	case WriteKindPessimisticLock:
+		return kvrpcpb.Op_PessimisticLock
+	case WriteKindLock:
+		return kvrpcpb.Op_Lock
 	}
 
 	return -1
@@ -57,12 +67,16 @@ func (wk WriteKind) ToProto() kvrpcpb.Op {
 
 func WriteKindFromProto(op kvrpcpb.Op) WriteKind {
 	switch op {
-	case kvrpcpb.Op_Put:
+	case kvrpcpb.Op_Put, kvrpcpb.Op_Insert:
 		return WriteKindPut
 	case kvrpcpb.Op_Del:
 		return WriteKindDelete
 	case kvrpcpb.Op_Rollback:
 		return WriteKindRollback
+	case kvrpcpb.Op_PessimisticLock:
+		return WriteKindPessimisticLock
+	case kvrpcpb.Op_Lock:
+		return WriteKindLock
 	default:
 		panic("unsupported type")
 	}
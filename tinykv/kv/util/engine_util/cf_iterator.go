@@ -55,8 +55,9 @@ func (i *CFItem) UserMeta() []byte {
 }
 
 type BadgerIterator struct {
-	iter   *badger.Iterator
-	prefix string
+	iter    *badger.Iterator
+	prefix  string
+	reverse bool
 }
 
 func NewCFIterator(cf string, txn *badger.Txn) *BadgerIterator {
@@ -66,6 +67,22 @@ func NewCFIterator(cf string, txn *badger.Txn) *BadgerIterator {
 	}
 }
 
+// NewCFReverseIterator creates an iterator which walks the column family from the largest key to the smallest.
+func NewCFReverseIterator(cf string, txn *badger.Txn) *BadgerIterator {
+	opts := badger.DefaultIteratorOptions
+	opts.Reverse = true
+	return &BadgerIterator{
+		iter:    txn.NewIterator(opts),
+		prefix:  cf + "_",
+		reverse: true,
+	}
+}
+
+// Reverse returns whether the iterator walks from the largest key to the smallest.
+func (it *BadgerIterator) Reverse() bool {
+	return it.reverse
+}
+
 func (it *BadgerIterator) Item() DBItem {
 	return &CFItem{
 		item:      it.iter.Item(),
@@ -88,6 +105,13 @@ func (it *BadgerIterator) Next() {
 }
 
 func (it *BadgerIterator) Seek(key []byte) {
+	if it.reverse && len(key) == 0 {
+		// Seek to the end of the column family, which is just before the first key after the prefix.
+		end := []byte(it.prefix)
+		end[len(end)-1]++
+		it.iter.Seek(end)
+		return
+	}
 	it.iter.Seek(append([]byte(it.prefix), key...))
 }
 
@@ -104,7 +128,8 @@ type DBIterator interface {
 	// to ensure you have access to a valid it.Item().
 	Next()
 	// Seek would seek to the provided key if present. If absent, it would seek to the next smallest key
-	// greater than provided.
+	// greater than provided. A reverse iterator seeks to the largest key less than provided instead, and
+	// to the last key when the provided key is empty.
 	Seek([]byte)
 
 	// Close the iterator
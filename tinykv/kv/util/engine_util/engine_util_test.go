@@ -90,4 +90,32 @@ func TestEngineUtil(t *testing.T) {
 	lockIter.Seek([]byte("d"))
 	require.False(t, lockIter.Valid())
 	lockIter.Close()
+
+	reverseIter := NewCFReverseIterator(CfDefault, txn)
+	reverseIter.Seek(nil)
+	item = reverseIter.Item()
+	require.True(t, bytes.Equal(item.Key(), []byte("d")))
+	reverseIter.Next()
+	item = reverseIter.Item()
+	require.True(t, bytes.Equal(item.Key(), []byte("c")))
+	reverseIter.Close()
+
+	reverseIter = NewCFReverseIterator(CfWrite, txn)
+	reverseIter.Seek([]byte("c"))
+	item = reverseIter.Item()
+	require.True(t, bytes.Equal(item.Key(), []byte("b")))
+	val, _ = item.Value()
+	require.True(t, bytes.Equal(val, []byte("b2")))
+	reverseIter.Next()
+	item = reverseIter.Item()
+	require.True(t, bytes.Equal(item.Key(), []byte("a")))
+	reverseIter.Next()
+	require.False(t, reverseIter.Valid())
+	reverseIter.Close()
+
+	reverseIter = NewCFReverseIterator(CfLock, txn)
+	reverseIter.Seek(nil)
+	item = reverseIter.Item()
+	require.True(t, bytes.Equal(item.Key(), []byte("c")))
+	reverseIter.Close()
 }
@@ -11,9 +11,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 
 	_ "github.com/gogo/protobuf/gogoproto"
-
 	errorpb "github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
-
 	kvrpcpb "github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 )
 
@@ -41,7 +39,7 @@ func (m *KeyRange) Reset()         { *m = KeyRange{} }
 func (m *KeyRange) String() string { return proto.CompactTextString(m) }
 func (*KeyRange) ProtoMessage()    {}
 func (*KeyRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_coprocessor_91b73e0df1108b57, []int{0}
+	return fileDescriptor_coprocessor_702d3a5dcc0502a4, []int{0}
 }
 func (m *KeyRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -85,21 +83,32 @@ func (m *KeyRange) GetEnd() []byte {
 }
 
 type Request struct {
-	Context              *kvrpcpb.Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
-	Tp                   int64            `protobuf:"varint,2,opt,name=tp,proto3" json:"tp,omitempty"`
-	Data                 []byte           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	StartTs              uint64           `protobuf:"varint,7,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
-	Ranges               []*KeyRange      `protobuf:"bytes,4,rep,name=ranges" json:"ranges,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Context *kvrpcpb.Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
+	Tp      int64            `protobuf:"varint,2,opt,name=tp,proto3" json:"tp,omitempty"`
+	Data    []byte           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	StartTs uint64           `protobuf:"varint,7,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	Ranges  []*KeyRange      `protobuf:"bytes,4,rep,name=ranges" json:"ranges,omitempty"`
+	// A scan is paged if paging_size is set. The response of a page holds at most paging_size rows and about
+	// paging_bytes bytes if that is set too, and its range is set to the range scanned if the scan stopped at the
+	// budget: the rows after range.end, or before range.start for a desc scan, are left to later pages. Requests
+	// which aggregate or sort the rows aren't paged.
+	PagingSize  uint64 `protobuf:"varint,8,opt,name=paging_size,json=pagingSize,proto3" json:"paging_size,omitempty"`
+	PagingBytes uint64 `protobuf:"varint,9,opt,name=paging_bytes,json=pagingBytes,proto3" json:"paging_bytes,omitempty"`
+	// The response may be cached if is_cache_enabled is set. A cached response is still valid if the data of the
+	// region hasn't changed, so it is sent back as a cache hit without the data if the data version of the region is
+	// cache_if_match_version.
+	IsCacheEnabled       bool     `protobuf:"varint,5,opt,name=is_cache_enabled,json=isCacheEnabled,proto3" json:"is_cache_enabled,omitempty"`
+	CacheIfMatchVersion  uint64   `protobuf:"varint,6,opt,name=cache_if_match_version,json=cacheIfMatchVersion,proto3" json:"cache_if_match_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_coprocessor_91b73e0df1108b57, []int{1}
+	return fileDescriptor_coprocessor_702d3a5dcc0502a4, []int{1}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,22 +172,59 @@ func (m *Request) GetRanges() []*KeyRange {
 	return nil
 }
 
+func (m *Request) GetPagingSize() uint64 {
+	if m != nil {
+		return m.PagingSize
+	}
+	return 0
+}
+
+func (m *Request) GetPagingBytes() uint64 {
+	if m != nil {
+		return m.PagingBytes
+	}
+	return 0
+}
+
+func (m *Request) GetIsCacheEnabled() bool {
+	if m != nil {
+		return m.IsCacheEnabled
+	}
+	return false
+}
+
+func (m *Request) GetCacheIfMatchVersion() uint64 {
+	if m != nil {
+		return m.CacheIfMatchVersion
+	}
+	return 0
+}
+
 type Response struct {
-	Data                 []byte            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	RegionError          *errorpb.Error    `protobuf:"bytes,2,opt,name=region_error,json=regionError" json:"region_error,omitempty"`
-	Locked               *kvrpcpb.LockInfo `protobuf:"bytes,3,opt,name=locked" json:"locked,omitempty"`
-	OtherError           string            `protobuf:"bytes,4,opt,name=other_error,json=otherError,proto3" json:"other_error,omitempty"`
-	Range                *KeyRange         `protobuf:"bytes,5,opt,name=range" json:"range,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Data        []byte            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	RegionError *errorpb.Error    `protobuf:"bytes,2,opt,name=region_error,json=regionError" json:"region_error,omitempty"`
+	Locked      *kvrpcpb.LockInfo `protobuf:"bytes,3,opt,name=locked" json:"locked,omitempty"`
+	OtherError  string            `protobuf:"bytes,4,opt,name=other_error,json=otherError,proto3" json:"other_error,omitempty"`
+	// The range scanned by a page which stopped at its budget, see Request.paging_size.
+	Range *KeyRange `protobuf:"bytes,5,opt,name=range" json:"range,omitempty"`
+	// is_cache_hit is set if the data version of the region is Request.cache_if_match_version, the data is left
+	// empty then.
+	IsCacheHit bool `protobuf:"varint,7,opt,name=is_cache_hit,json=isCacheHit,proto3" json:"is_cache_hit,omitempty"`
+	// The data version of the region the response is read at, set if the request enables the cache.
+	CacheLastVersion uint64 `protobuf:"varint,8,opt,name=cache_last_version,json=cacheLastVersion,proto3" json:"cache_last_version,omitempty"`
+	// can_be_cached is set if the response stays valid for a later start ts as long as the data version of the region
+	// is cache_last_version, i.e. the scan met no write committed nor lock taken after the start ts.
+	CanBeCached          bool     `protobuf:"varint,9,opt,name=can_be_cached,json=canBeCached,proto3" json:"can_be_cached,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_coprocessor_91b73e0df1108b57, []int{2}
+	return fileDescriptor_coprocessor_702d3a5dcc0502a4, []int{2}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,6 +288,27 @@ func (m *Response) GetRange() *KeyRange {
 	return nil
 }
 
+func (m *Response) GetIsCacheHit() bool {
+	if m != nil {
+		return m.IsCacheHit
+	}
+	return false
+}
+
+func (m *Response) GetCacheLastVersion() uint64 {
+	if m != nil {
+		return m.CacheLastVersion
+	}
+	return 0
+}
+
+func (m *Response) GetCanBeCached() bool {
+	if m != nil {
+		return m.CanBeCached
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*KeyRange)(nil), "coprocessor.KeyRange")
 	proto.RegisterType((*Request)(nil), "coprocessor.Request")
@@ -328,11 +395,36 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.IsCacheEnabled {
+		dAtA[i] = 0x28
+		i++
+		if m.IsCacheEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.CacheIfMatchVersion != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCoprocessor(dAtA, i, uint64(m.CacheIfMatchVersion))
+	}
 	if m.StartTs != 0 {
 		dAtA[i] = 0x38
 		i++
 		i = encodeVarintCoprocessor(dAtA, i, uint64(m.StartTs))
 	}
+	if m.PagingSize != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCoprocessor(dAtA, i, uint64(m.PagingSize))
+	}
+	if m.PagingBytes != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCoprocessor(dAtA, i, uint64(m.PagingBytes))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -396,6 +488,31 @@ func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n4
 	}
+	if m.IsCacheHit {
+		dAtA[i] = 0x38
+		i++
+		if m.IsCacheHit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.CacheLastVersion != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCoprocessor(dAtA, i, uint64(m.CacheLastVersion))
+	}
+	if m.CanBeCached {
+		dAtA[i] = 0x48
+		i++
+		if m.CanBeCached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -448,9 +565,21 @@ func (m *Request) Size() (n int) {
 			n += 1 + l + sovCoprocessor(uint64(l))
 		}
 	}
+	if m.IsCacheEnabled {
+		n += 2
+	}
+	if m.CacheIfMatchVersion != 0 {
+		n += 1 + sovCoprocessor(uint64(m.CacheIfMatchVersion))
+	}
 	if m.StartTs != 0 {
 		n += 1 + sovCoprocessor(uint64(m.StartTs))
 	}
+	if m.PagingSize != 0 {
+		n += 1 + sovCoprocessor(uint64(m.PagingSize))
+	}
+	if m.PagingBytes != 0 {
+		n += 1 + sovCoprocessor(uint64(m.PagingBytes))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -480,6 +609,15 @@ func (m *Response) Size() (n int) {
 		l = m.Range.Size()
 		n += 1 + l + sovCoprocessor(uint64(l))
 	}
+	if m.IsCacheHit {
+		n += 2
+	}
+	if m.CacheLastVersion != 0 {
+		n += 1 + sovCoprocessor(uint64(m.CacheLastVersion))
+	}
+	if m.CanBeCached {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -755,6 +893,45 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCacheEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoprocessor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCacheEnabled = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheIfMatchVersion", wireType)
+			}
+			m.CacheIfMatchVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoprocessor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheIfMatchVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
@@ -774,6 +951,44 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PagingSize", wireType)
+			}
+			m.PagingSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoprocessor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PagingSize |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PagingBytes", wireType)
+			}
+			m.PagingBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoprocessor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PagingBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCoprocessor(dAtA[iNdEx:])
@@ -984,6 +1199,65 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCacheHit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoprocessor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCacheHit = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheLastVersion", wireType)
+			}
+			m.CacheLastVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoprocessor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheLastVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanBeCached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoprocessor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanBeCached = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCoprocessor(dAtA[iNdEx:])
@@ -1111,30 +1385,39 @@ var (
 	ErrIntOverflowCoprocessor   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("coprocessor.proto", fileDescriptor_coprocessor_91b73e0df1108b57) }
+func init() { proto.RegisterFile("coprocessor.proto", fileDescriptor_coprocessor_702d3a5dcc0502a4) }
 
-var fileDescriptor_coprocessor_91b73e0df1108b57 = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xc1, 0x4e, 0xab, 0x40,
-	0x14, 0x7d, 0x53, 0x68, 0xe9, 0xbb, 0xb4, 0x4d, 0x3b, 0xa9, 0x09, 0x76, 0x81, 0xa4, 0x2b, 0xd4,
-	0x88, 0x11, 0xff, 0x40, 0xe3, 0xc2, 0xe8, 0x6a, 0xe2, 0xbe, 0xa1, 0x74, 0x44, 0x53, 0xc3, 0xc5,
-	0x99, 0xd1, 0xe8, 0x9f, 0xb8, 0xf7, 0x67, 0x8c, 0x2b, 0x3f, 0xc1, 0xd4, 0x1f, 0x31, 0xdc, 0x29,
-	0x4d, 0x37, 0xae, 0xb8, 0xe7, 0x70, 0x38, 0xf7, 0x9c, 0x0b, 0x8c, 0x72, 0xac, 0x14, 0xe6, 0x52,
-	0x6b, 0x54, 0x49, 0xa5, 0xd0, 0x20, 0xf7, 0xb7, 0xa8, 0x49, 0x5f, 0x2a, 0x85, 0xaa, 0x9a, 0xdb,
-	0x77, 0x93, 0xfe, 0xf2, 0x59, 0x55, 0xf9, 0x06, 0x8e, 0x0b, 0x2c, 0x90, 0xc6, 0xe3, 0x7a, 0xb2,
-	0xec, 0x34, 0x85, 0xee, 0x95, 0x7c, 0x15, 0x59, 0x59, 0x48, 0x3e, 0x86, 0xb6, 0x36, 0x99, 0x32,
-	0x01, 0x8b, 0x58, 0xdc, 0x13, 0x16, 0xf0, 0x21, 0x38, 0xb2, 0x5c, 0x04, 0x2d, 0xe2, 0xea, 0x71,
-	0xfa, 0xce, 0xc0, 0x13, 0xf2, 0xf1, 0x49, 0x6a, 0xc3, 0x0f, 0xc0, 0xcb, 0xb1, 0x34, 0xf2, 0xc5,
-	0x7e, 0xe5, 0xa7, 0xc3, 0xa4, 0x59, 0x7b, 0x6e, 0x79, 0xd1, 0x08, 0xf8, 0x00, 0x5a, 0xa6, 0x22,
-	0x23, 0x47, 0xb4, 0x4c, 0xc5, 0x39, 0xb8, 0x8b, 0xcc, 0x64, 0x81, 0x43, 0xd6, 0x34, 0xf3, 0x23,
-	0xe8, 0xa8, 0x3a, 0x8c, 0x0e, 0xdc, 0xc8, 0x89, 0xfd, 0x74, 0x27, 0xd9, 0x2e, 0xdd, 0x44, 0x15,
-	0x6b, 0x11, 0xdf, 0x85, 0x2e, 0xa5, 0x9c, 0x19, 0x1d, 0x78, 0x11, 0x8b, 0x5d, 0xe1, 0x11, 0xbe,
-	0xd1, 0xd3, 0x4f, 0x06, 0x5d, 0x21, 0x75, 0x85, 0xa5, 0x96, 0x9b, 0x55, 0x6c, 0x6b, 0xd5, 0x09,
-	0xf4, 0x94, 0x2c, 0xee, 0xb1, 0x9c, 0xd1, 0xdd, 0x28, 0x98, 0x9f, 0x0e, 0x92, 0xe6, 0x8a, 0x17,
-	0xf5, 0x53, 0xf8, 0x56, 0x43, 0x80, 0xef, 0x43, 0xe7, 0x01, 0xf3, 0xa5, 0x5c, 0x50, 0x66, 0x3f,
-	0x1d, 0x6d, 0xca, 0x5e, 0x63, 0xbe, 0xbc, 0x2c, 0x6f, 0x51, 0xac, 0x05, 0x7c, 0x0f, 0x7c, 0x34,
-	0x77, 0x52, 0xad, 0xcd, 0xdd, 0x88, 0xc5, 0xff, 0x05, 0x10, 0x65, 0xbd, 0x0e, 0xa1, 0x4d, 0x25,
-	0x82, 0x36, 0x59, 0xfd, 0x51, 0xd4, 0x6a, 0xce, 0x86, 0x1f, 0xab, 0x90, 0x7d, 0xad, 0x42, 0xf6,
-	0xbd, 0x0a, 0xd9, 0xdb, 0x4f, 0xf8, 0x6f, 0xde, 0xa1, 0xff, 0x77, 0xfa, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0xbb, 0xf7, 0xf1, 0x61, 0x15, 0x02, 0x00, 0x00,
+var fileDescriptor_coprocessor_702d3a5dcc0502a4 = []byte{
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x8e, 0xd3, 0x3e,
+	0x14, 0xc5, 0xff, 0xe9, 0x67, 0xe6, 0xa6, 0xad, 0x3a, 0xfe, 0x0f, 0x28, 0xcc, 0xa2, 0x84, 0xae,
+	0xc2, 0x57, 0x11, 0x9d, 0x37, 0xe8, 0x68, 0x24, 0x46, 0x0c, 0x1b, 0x83, 0xd8, 0x46, 0xae, 0x73,
+	0x9b, 0x46, 0x2d, 0x76, 0xb0, 0xcd, 0x88, 0x99, 0x27, 0xe1, 0x69, 0x58, 0x22, 0x96, 0x3c, 0x02,
+	0x2a, 0x2f, 0x82, 0x72, 0x9d, 0x56, 0xdd, 0xb0, 0xea, 0xbd, 0xe7, 0x1c, 0xdf, 0xda, 0xbf, 0x1b,
+	0x38, 0x95, 0xba, 0x32, 0x5a, 0xa2, 0xb5, 0xda, 0xcc, 0x2a, 0xa3, 0x9d, 0x66, 0xd1, 0x91, 0x74,
+	0x3e, 0x44, 0x63, 0xb4, 0xa9, 0x96, 0xde, 0x3b, 0x1f, 0x6e, 0x6e, 0x4d, 0x25, 0x0f, 0xed, 0x59,
+	0xa1, 0x0b, 0x4d, 0xe5, 0xab, 0xba, 0xf2, 0xea, 0x74, 0x0e, 0xe1, 0x5b, 0xbc, 0xe3, 0x42, 0x15,
+	0xc8, 0xce, 0xa0, 0x6b, 0x9d, 0x30, 0x2e, 0x0e, 0x92, 0x20, 0x1d, 0x70, 0xdf, 0xb0, 0x31, 0xb4,
+	0x51, 0xe5, 0x71, 0x8b, 0xb4, 0xba, 0x9c, 0xfe, 0x68, 0x41, 0x9f, 0xe3, 0xe7, 0x2f, 0x68, 0x1d,
+	0x7b, 0x06, 0x7d, 0xa9, 0x95, 0xc3, 0xaf, 0xfe, 0x54, 0x34, 0x1f, 0xcf, 0xf6, 0x7f, 0x7b, 0xe9,
+	0x75, 0xbe, 0x0f, 0xb0, 0x11, 0xb4, 0x5c, 0x45, 0x83, 0xda, 0xbc, 0xe5, 0x2a, 0xc6, 0xa0, 0x93,
+	0x0b, 0x27, 0xe2, 0x36, 0x8d, 0xa6, 0x9a, 0xbd, 0x84, 0x9e, 0xa9, 0x2f, 0x63, 0xe3, 0x4e, 0xd2,
+	0x4e, 0xa3, 0xf9, 0x83, 0xd9, 0xf1, 0xa3, 0xf7, 0x57, 0xe5, 0x4d, 0x88, 0xa5, 0x30, 0x2e, 0x6d,
+	0x26, 0x85, 0x5c, 0x63, 0x86, 0x4a, 0x2c, 0xb7, 0x98, 0xc7, 0xdd, 0x24, 0x48, 0x43, 0x3e, 0x2a,
+	0xed, 0x65, 0x2d, 0x5f, 0x79, 0x95, 0x5d, 0xc0, 0x43, 0x1f, 0x2b, 0x57, 0xd9, 0x27, 0xe1, 0xe4,
+	0x3a, 0xbb, 0x45, 0x63, 0x4b, 0xad, 0xe2, 0x5e, 0x12, 0xa4, 0x1d, 0xfe, 0x3f, 0xb9, 0xd7, 0xab,
+	0x77, 0xb5, 0xf7, 0xd1, 0x5b, 0xec, 0x11, 0x84, 0x04, 0x21, 0x73, 0x36, 0xee, 0x53, 0xac, 0x4f,
+	0xfd, 0x07, 0xcb, 0x1e, 0x43, 0x54, 0x89, 0xa2, 0x54, 0x45, 0x66, 0xcb, 0x7b, 0x8c, 0x43, 0x72,
+	0xc1, 0x4b, 0xef, 0xcb, 0x7b, 0x64, 0x4f, 0x60, 0xd0, 0x04, 0x96, 0x77, 0x0e, 0x6d, 0x7c, 0x42,
+	0x89, 0xe6, 0xd0, 0xa2, 0x96, 0xa6, 0xdf, 0x5b, 0x10, 0x72, 0xb4, 0x95, 0x56, 0x16, 0x0f, 0x34,
+	0x82, 0x23, 0x1a, 0xaf, 0x61, 0x60, 0xb0, 0x28, 0xb5, 0xca, 0x68, 0xb5, 0xc4, 0x2e, 0x9a, 0x8f,
+	0x66, 0xfb, 0x45, 0x5f, 0xd5, 0xbf, 0x3c, 0xf2, 0x19, 0x6a, 0xd8, 0x53, 0xe8, 0x6d, 0xb5, 0xdc,
+	0x60, 0x4e, 0x58, 0xa3, 0xf9, 0xe9, 0x61, 0x1f, 0x37, 0x5a, 0x6e, 0xae, 0xd5, 0x4a, 0xf3, 0x26,
+	0x50, 0x3f, 0x41, 0xbb, 0x35, 0x9a, 0x66, 0x78, 0x27, 0x09, 0xd2, 0x13, 0x0e, 0x24, 0xf9, 0x59,
+	0xcf, 0xa1, 0x4b, 0x9c, 0x09, 0xe9, 0x3f, 0x77, 0xe1, 0x33, 0x2c, 0x81, 0xc1, 0x61, 0x15, 0xeb,
+	0xd2, 0x11, 0xaf, 0x90, 0x43, 0xb3, 0x86, 0x37, 0xa5, 0x63, 0x2f, 0x80, 0x79, 0x7b, 0x2b, 0xac,
+	0x3b, 0xe0, 0xf7, 0xe4, 0xc6, 0xe4, 0xdc, 0x08, 0xeb, 0xf6, 0xec, 0xa7, 0x30, 0x94, 0x42, 0x65,
+	0x4b, 0xf4, 0x33, 0x73, 0x02, 0x18, 0xf2, 0x48, 0x0a, 0xb5, 0x40, 0x9a, 0x99, 0x2f, 0xc6, 0x3f,
+	0x77, 0x93, 0xe0, 0xd7, 0x6e, 0x12, 0xfc, 0xde, 0x4d, 0x82, 0x6f, 0x7f, 0x26, 0xff, 0x2d, 0x7b,
+	0xf4, 0x59, 0x5f, 0xfc, 0x1d, 0x00, 0x6a, 0x3e, 0x6c, 0x91, 0x2c, 0x03, 0x00, 0x00,
 }
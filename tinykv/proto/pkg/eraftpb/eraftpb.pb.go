@@ -25,24 +25,27 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type EntryType int32
 
 const (
-	EntryType_EntryNormal     EntryType = 0
-	EntryType_EntryConfChange EntryType = 1
+	EntryType_EntryNormal       EntryType = 0
+	EntryType_EntryConfChange   EntryType = 1
+	EntryType_EntryConfChangeV2 EntryType = 2
 )
 
 var EntryType_name = map[int32]string{
 	0: "EntryNormal",
 	1: "EntryConfChange",
+	2: "EntryConfChangeV2",
 }
 var EntryType_value = map[string]int32{
-	"EntryNormal":     0,
-	"EntryConfChange": 1,
+	"EntryNormal":       0,
+	"EntryConfChange":   1,
+	"EntryConfChangeV2": 2,
 }
 
 func (x EntryType) String() string {
 	return proto.EnumName(EntryType_name, int32(x))
 }
 func (EntryType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_2f2e0bcef614736b, []int{0}
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{0}
 }
 
 // Some MessageType defined here are local messages which not come from the network, but should
@@ -77,6 +80,24 @@ const (
 	// 'MessageType_MsgTimeoutNow' send from the leader to the leadership transfer target, to let
 	// the transfer target timeout immediately and start a new election.
 	MessageType_MsgTimeoutNow MessageType = 12
+	// 'MessageType_MsgPreVote' asks the peers whether they would vote for the node, it is sent
+	// before a real election when PreVote is enabled and doesn't change the term of the peers.
+	MessageType_MsgPreVote MessageType = 13
+	// 'MessageType_MsgPreVoteResponse' is the response to 'MessageType_MsgPreVote'.
+	MessageType_MsgPreVoteResponse MessageType = 14
+	// 'MessageType_MsgReadIndex' asks the leader for an index which is safe to read at, the
+	// request context is carried in the data of the only entry. It is local when stepped on the
+	// leader, and forwarded to the leader when stepped on a follower.
+	MessageType_MsgReadIndex MessageType = 15
+	// 'MessageType_MsgReadIndexResp' answers a forwarded 'MessageType_MsgReadIndex' with the
+	// read index.
+	MessageType_MsgReadIndexResp MessageType = 16
+	// 'MessageType_MsgSnapStatus' is a local message reporting to the leader whether a snapshot
+	// was delivered to the follower, 'reject' is set when the delivery failed.
+	MessageType_MsgSnapStatus MessageType = 17
+	// 'MessageType_MsgUnreachable' is a local message reporting to the leader that a message
+	// couldn't be delivered to the follower.
+	MessageType_MsgUnreachable MessageType = 18
 )
 
 var MessageType_name = map[int32]string{
@@ -92,6 +113,12 @@ var MessageType_name = map[int32]string{
 	9:  "MsgHeartbeatResponse",
 	11: "MsgTransferLeader",
 	12: "MsgTimeoutNow",
+	13: "MsgPreVote",
+	14: "MsgPreVoteResponse",
+	15: "MsgReadIndex",
+	16: "MsgReadIndexResp",
+	17: "MsgSnapStatus",
+	18: "MsgUnreachable",
 }
 var MessageType_value = map[string]int32{
 	"MsgHup":                 0,
@@ -106,13 +133,19 @@ var MessageType_value = map[string]int32{
 	"MsgHeartbeatResponse":   9,
 	"MsgTransferLeader":      11,
 	"MsgTimeoutNow":          12,
+	"MsgPreVote":             13,
+	"MsgPreVoteResponse":     14,
+	"MsgReadIndex":           15,
+	"MsgReadIndexResp":       16,
+	"MsgSnapStatus":          17,
+	"MsgUnreachable":         18,
 }
 
 func (x MessageType) String() string {
 	return proto.EnumName(MessageType_name, int32(x))
 }
 func (MessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_2f2e0bcef614736b, []int{1}
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{1}
 }
 
 type ConfChangeType int32
@@ -120,22 +153,61 @@ type ConfChangeType int32
 const (
 	ConfChangeType_AddNode    ConfChangeType = 0
 	ConfChangeType_RemoveNode ConfChangeType = 1
+	// adds a learner, or demotes a voter to a learner
+	ConfChangeType_AddLearnerNode ConfChangeType = 2
 )
 
 var ConfChangeType_name = map[int32]string{
 	0: "AddNode",
 	1: "RemoveNode",
+	2: "AddLearnerNode",
 }
 var ConfChangeType_value = map[string]int32{
-	"AddNode":    0,
-	"RemoveNode": 1,
+	"AddNode":        0,
+	"RemoveNode":     1,
+	"AddLearnerNode": 2,
 }
 
 func (x ConfChangeType) String() string {
 	return proto.EnumName(ConfChangeType_name, int32(x))
 }
 func (ConfChangeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_2f2e0bcef614736b, []int{2}
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{2}
+}
+
+// ConfChangeTransition specifies the behavior of a configuration change with respect to joint
+// consensus.
+type ConfChangeTransition int32
+
+const (
+	// Automatically use the simple protocol if possible, otherwise fall back to
+	// ConfChangeTransitionJointImplicit. Most applications will want to use this.
+	ConfChangeTransition_ConfChangeTransitionAuto ConfChangeTransition = 0
+	// Use joint consensus unconditionally, and transition out of them automatically
+	// (by proposing a zero configuration change).
+	ConfChangeTransition_ConfChangeTransitionJointImplicit ConfChangeTransition = 1
+	// Use joint consensus and remain in the joint configuration until the application
+	// proposes a no-op configuration change. This is suitable for applications that want
+	// to explicitly control the transitions.
+	ConfChangeTransition_ConfChangeTransitionJointExplicit ConfChangeTransition = 2
+)
+
+var ConfChangeTransition_name = map[int32]string{
+	0: "ConfChangeTransitionAuto",
+	1: "ConfChangeTransitionJointImplicit",
+	2: "ConfChangeTransitionJointExplicit",
+}
+var ConfChangeTransition_value = map[string]int32{
+	"ConfChangeTransitionAuto":          0,
+	"ConfChangeTransitionJointImplicit": 1,
+	"ConfChangeTransitionJointExplicit": 2,
+}
+
+func (x ConfChangeTransition) String() string {
+	return proto.EnumName(ConfChangeTransition_name, int32(x))
+}
+func (ConfChangeTransition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{3}
 }
 
 // The entry is a type of change that needs to be applied. It contains two data fields.
@@ -145,9 +217,9 @@ func (ConfChangeType) EnumDescriptor() ([]byte, []int) {
 // The context field can be used for any contextual data that might be relevant to the
 // application of the data.
 //
-// For configuration changes, the data will contain the ConfChange message and the
-// context will provide anything needed to assist the configuration change. The context
-// is for the user to set and use in this case.
+// For configuration changes, the data will contain the ConfChange (or ConfChangeV2 for
+// EntryConfChangeV2) message and the context will provide anything needed to assist the
+// configuration change. The context is for the user to set and use in this case.
 type Entry struct {
 	EntryType            EntryType `protobuf:"varint,1,opt,name=entry_type,json=entryType,proto3,enum=eraftpb.EntryType" json:"entry_type,omitempty"`
 	Term                 uint64    `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
@@ -162,7 +234,7 @@ func (m *Entry) Reset()         { *m = Entry{} }
 func (m *Entry) String() string { return proto.CompactTextString(m) }
 func (*Entry) ProtoMessage()    {}
 func (*Entry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_2f2e0bcef614736b, []int{0}
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{0}
 }
 func (m *Entry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,7 +306,7 @@ func (m *SnapshotMetadata) Reset()         { *m = SnapshotMetadata{} }
 func (m *SnapshotMetadata) String() string { return proto.CompactTextString(m) }
 func (*SnapshotMetadata) ProtoMessage()    {}
 func (*SnapshotMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_2f2e0bcef614736b, []int{1}
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{1}
 }
 func (m *SnapshotMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +368,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_2f2e0bcef614736b, []int{2}
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{2}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,27 +412,33 @@ func (m *Snapshot) GetMetadata() *SnapshotMetadata {
 }
 
 type Message struct {
-	MsgType              MessageType `protobuf:"varint,1,opt,name=msg_type,json=msgType,proto3,enum=eraftpb.MessageType" json:"msg_type,omitempty"`
-	To                   uint64      `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
-	From                 uint64      `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
-	Term                 uint64      `protobuf:"varint,4,opt,name=term,proto3" json:"term,omitempty"`
-	LogTerm              uint64      `protobuf:"varint,5,opt,name=log_term,json=logTerm,proto3" json:"log_term,omitempty"`
-	Index                uint64      `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
-	Entries              []*Entry    `protobuf:"bytes,7,rep,name=entries" json:"entries,omitempty"`
-	Commit               uint64      `protobuf:"varint,8,opt,name=commit,proto3" json:"commit,omitempty"`
-	Snapshot             *Snapshot   `protobuf:"bytes,9,opt,name=snapshot" json:"snapshot,omitempty"`
-	Reject               bool        `protobuf:"varint,10,opt,name=reject,proto3" json:"reject,omitempty"`
-	RejectHint           uint64      `protobuf:"varint,11,opt,name=reject_hint,json=rejectHint,proto3" json:"reject_hint,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	MsgType  MessageType `protobuf:"varint,1,opt,name=msg_type,json=msgType,proto3,enum=eraftpb.MessageType" json:"msg_type,omitempty"`
+	To       uint64      `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	From     uint64      `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	Term     uint64      `protobuf:"varint,4,opt,name=term,proto3" json:"term,omitempty"`
+	LogTerm  uint64      `protobuf:"varint,5,opt,name=log_term,json=logTerm,proto3" json:"log_term,omitempty"`
+	Index    uint64      `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	Entries  []*Entry    `protobuf:"bytes,7,rep,name=entries" json:"entries,omitempty"`
+	Commit   uint64      `protobuf:"varint,8,opt,name=commit,proto3" json:"commit,omitempty"`
+	Snapshot *Snapshot   `protobuf:"bytes,9,opt,name=snapshot" json:"snapshot,omitempty"`
+	Reject   bool        `protobuf:"varint,10,opt,name=reject,proto3" json:"reject,omitempty"`
+	// TODO: Delete Start
+	RejectHint uint64 `protobuf:"varint,11,opt,name=reject_hint,json=rejectHint,proto3" json:"reject_hint,omitempty"`
+	// TODO: Delete End
+	// context marks a vote request of a leader transfer, which is granted even if the voter
+	// has heard from the current leader lately. In heartbeats and their responses it carries
+	// the context of the latest pending read index request.
+	Context              []byte   `protobuf:"bytes,12,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_2f2e0bcef614736b, []int{3}
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{3}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,6 +544,13 @@ func (m *Message) GetRejectHint() uint64 {
 	return 0
 }
 
+func (m *Message) GetContext() []byte {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
 // HardState contains the state of a node, including the current term, commit index
 // and the vote record
 type HardState struct {
@@ -481,7 +566,7 @@ func (m *HardState) Reset()         { *m = HardState{} }
 func (m *HardState) String() string { return proto.CompactTextString(m) }
 func (*HardState) ProtoMessage()    {}
 func (*HardState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_2f2e0bcef614736b, []int{4}
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{4}
 }
 func (m *HardState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -533,8 +618,18 @@ func (m *HardState) GetCommit() uint64 {
 
 // ConfState contains the current membership information of the raft group
 type ConfState struct {
-	// all node id
-	Nodes                []uint64 `protobuf:"varint,1,rep,packed,name=nodes" json:"nodes,omitempty"`
+	// all voter id, of the incoming configuration if the group is in a joint configuration
+	Nodes []uint64 `protobuf:"varint,1,rep,packed,name=nodes" json:"nodes,omitempty"`
+	// the learners, which receive the log but don't vote
+	Learners []uint64 `protobuf:"varint,2,rep,packed,name=learners" json:"learners,omitempty"`
+	// the voters of the outgoing configuration, empty unless the group is in a joint
+	// configuration
+	VotersOutgoing []uint64 `protobuf:"varint,3,rep,packed,name=voters_outgoing,json=votersOutgoing" json:"voters_outgoing,omitempty"`
+	// the nodes which become learners once the group leaves the joint configuration, they are
+	// voters of the outgoing configuration in the meantime
+	LearnersNext []uint64 `protobuf:"varint,4,rep,packed,name=learners_next,json=learnersNext" json:"learners_next,omitempty"`
+	// if set, the joint configuration is left automatically once it has been applied
+	AutoLeave            bool     `protobuf:"varint,5,opt,name=auto_leave,json=autoLeave,proto3" json:"auto_leave,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -544,7 +639,7 @@ func (m *ConfState) Reset()         { *m = ConfState{} }
 func (m *ConfState) String() string { return proto.CompactTextString(m) }
 func (*ConfState) ProtoMessage()    {}
 func (*ConfState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_2f2e0bcef614736b, []int{5}
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{5}
 }
 func (m *ConfState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,6 +675,34 @@ func (m *ConfState) GetNodes() []uint64 {
 	return nil
 }
 
+func (m *ConfState) GetLearners() []uint64 {
+	if m != nil {
+		return m.Learners
+	}
+	return nil
+}
+
+func (m *ConfState) GetVotersOutgoing() []uint64 {
+	if m != nil {
+		return m.VotersOutgoing
+	}
+	return nil
+}
+
+func (m *ConfState) GetLearnersNext() []uint64 {
+	if m != nil {
+		return m.LearnersNext
+	}
+	return nil
+}
+
+func (m *ConfState) GetAutoLeave() bool {
+	if m != nil {
+		return m.AutoLeave
+	}
+	return false
+}
+
 // ConfChange is the data that attach on entry with EntryConfChange type
 type ConfChange struct {
 	ChangeType ConfChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=eraftpb.ConfChangeType" json:"change_type,omitempty"`
@@ -595,7 +718,7 @@ func (m *ConfChange) Reset()         { *m = ConfChange{} }
 func (m *ConfChange) String() string { return proto.CompactTextString(m) }
 func (*ConfChange) ProtoMessage()    {}
 func (*ConfChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_2f2e0bcef614736b, []int{6}
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{6}
 }
 func (m *ConfChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,6 +768,131 @@ func (m *ConfChange) GetContext() []byte {
 	return nil
 }
 
+// ConfChangeSingle is an individual configuration change operation, multiple such operations
+// can be carried out atomically via a ConfChangeV2.
+type ConfChangeSingle struct {
+	ChangeType           ConfChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=eraftpb.ConfChangeType" json:"change_type,omitempty"`
+	NodeId               uint64         `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ConfChangeSingle) Reset()         { *m = ConfChangeSingle{} }
+func (m *ConfChangeSingle) String() string { return proto.CompactTextString(m) }
+func (*ConfChangeSingle) ProtoMessage()    {}
+func (*ConfChangeSingle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{7}
+}
+func (m *ConfChangeSingle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfChangeSingle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfChangeSingle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ConfChangeSingle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfChangeSingle.Merge(dst, src)
+}
+func (m *ConfChangeSingle) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfChangeSingle) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfChangeSingle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfChangeSingle proto.InternalMessageInfo
+
+func (m *ConfChangeSingle) GetChangeType() ConfChangeType {
+	if m != nil {
+		return m.ChangeType
+	}
+	return ConfChangeType_AddNode
+}
+
+func (m *ConfChangeSingle) GetNodeId() uint64 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+// ConfChangeV2 is the data that attach on entry with EntryConfChangeV2 type. It changes the
+// configuration of the group in one step if it changes at most one voter, and through a joint
+// configuration otherwise.
+//
+// A ConfChangeV2 without changes leaves the joint configuration.
+type ConfChangeV2 struct {
+	Transition           ConfChangeTransition `protobuf:"varint,1,opt,name=transition,proto3,enum=eraftpb.ConfChangeTransition" json:"transition,omitempty"`
+	Changes              []*ConfChangeSingle  `protobuf:"bytes,2,rep,name=changes" json:"changes,omitempty"`
+	Context              []byte               `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ConfChangeV2) Reset()         { *m = ConfChangeV2{} }
+func (m *ConfChangeV2) String() string { return proto.CompactTextString(m) }
+func (*ConfChangeV2) ProtoMessage()    {}
+func (*ConfChangeV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eraftpb_c424c2bf2292e394, []int{8}
+}
+func (m *ConfChangeV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfChangeV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfChangeV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ConfChangeV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfChangeV2.Merge(dst, src)
+}
+func (m *ConfChangeV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfChangeV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfChangeV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfChangeV2 proto.InternalMessageInfo
+
+func (m *ConfChangeV2) GetTransition() ConfChangeTransition {
+	if m != nil {
+		return m.Transition
+	}
+	return ConfChangeTransition_ConfChangeTransitionAuto
+}
+
+func (m *ConfChangeV2) GetChanges() []*ConfChangeSingle {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *ConfChangeV2) GetContext() []byte {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Entry)(nil), "eraftpb.Entry")
 	proto.RegisterType((*SnapshotMetadata)(nil), "eraftpb.SnapshotMetadata")
@@ -653,9 +901,12 @@ func init() {
 	proto.RegisterType((*HardState)(nil), "eraftpb.HardState")
 	proto.RegisterType((*ConfState)(nil), "eraftpb.ConfState")
 	proto.RegisterType((*ConfChange)(nil), "eraftpb.ConfChange")
+	proto.RegisterType((*ConfChangeSingle)(nil), "eraftpb.ConfChangeSingle")
+	proto.RegisterType((*ConfChangeV2)(nil), "eraftpb.ConfChangeV2")
 	proto.RegisterEnum("eraftpb.EntryType", EntryType_name, EntryType_value)
 	proto.RegisterEnum("eraftpb.MessageType", MessageType_name, MessageType_value)
 	proto.RegisterEnum("eraftpb.ConfChangeType", ConfChangeType_name, ConfChangeType_value)
+	proto.RegisterEnum("eraftpb.ConfChangeTransition", ConfChangeTransition_name, ConfChangeTransition_value)
 }
 func (m *Entry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -864,6 +1115,12 @@ func (m *Message) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintEraftpb(dAtA, i, uint64(m.RejectHint))
 	}
+	if len(m.Context) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintEraftpb(dAtA, i, uint64(len(m.Context)))
+		i += copy(dAtA[i:], m.Context)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -938,6 +1195,67 @@ func (m *ConfState) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintEraftpb(dAtA, i, uint64(j4))
 		i += copy(dAtA[i:], dAtA5[:j4])
 	}
+	if len(m.Learners) > 0 {
+		dAtA7 := make([]byte, len(m.Learners)*10)
+		var j6 int
+		for _, num := range m.Learners {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEraftpb(dAtA, i, uint64(j6))
+		i += copy(dAtA[i:], dAtA7[:j6])
+	}
+	if len(m.VotersOutgoing) > 0 {
+		dAtA9 := make([]byte, len(m.VotersOutgoing)*10)
+		var j8 int
+		for _, num := range m.VotersOutgoing {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEraftpb(dAtA, i, uint64(j8))
+		i += copy(dAtA[i:], dAtA9[:j8])
+	}
+	if len(m.LearnersNext) > 0 {
+		dAtA11 := make([]byte, len(m.LearnersNext)*10)
+		var j10 int
+		for _, num := range m.LearnersNext {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintEraftpb(dAtA, i, uint64(j10))
+		i += copy(dAtA[i:], dAtA11[:j10])
+	}
+	if m.AutoLeave {
+		dAtA[i] = 0x28
+		i++
+		if m.AutoLeave {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -981,6 +1299,81 @@ func (m *ConfChange) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ConfChangeSingle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfChangeSingle) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ChangeType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEraftpb(dAtA, i, uint64(m.ChangeType))
+	}
+	if m.NodeId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEraftpb(dAtA, i, uint64(m.NodeId))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ConfChangeV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfChangeV2) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Transition != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEraftpb(dAtA, i, uint64(m.Transition))
+	}
+	if len(m.Changes) > 0 {
+		for _, msg := range m.Changes {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintEraftpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Context) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEraftpb(dAtA, i, uint64(len(m.Context)))
+		i += copy(dAtA[i:], m.Context)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintEraftpb(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1088,6 +1481,10 @@ func (m *Message) Size() (n int) {
 	if m.RejectHint != 0 {
 		n += 1 + sovEraftpb(uint64(m.RejectHint))
 	}
+	l = len(m.Context)
+	if l > 0 {
+		n += 1 + l + sovEraftpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1122,6 +1519,30 @@ func (m *ConfState) Size() (n int) {
 		}
 		n += 1 + sovEraftpb(uint64(l)) + l
 	}
+	if len(m.Learners) > 0 {
+		l = 0
+		for _, e := range m.Learners {
+			l += sovEraftpb(uint64(e))
+		}
+		n += 1 + sovEraftpb(uint64(l)) + l
+	}
+	if len(m.VotersOutgoing) > 0 {
+		l = 0
+		for _, e := range m.VotersOutgoing {
+			l += sovEraftpb(uint64(e))
+		}
+		n += 1 + sovEraftpb(uint64(l)) + l
+	}
+	if len(m.LearnersNext) > 0 {
+		l = 0
+		for _, e := range m.LearnersNext {
+			l += sovEraftpb(uint64(e))
+		}
+		n += 1 + sovEraftpb(uint64(l)) + l
+	}
+	if m.AutoLeave {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1147,28 +1568,65 @@ func (m *ConfChange) Size() (n int) {
 	return n
 }
 
-func sovEraftpb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *ConfChangeSingle) Size() (n int) {
+	var l int
+	_ = l
+	if m.ChangeType != 0 {
+		n += 1 + sovEraftpb(uint64(m.ChangeType))
+	}
+	if m.NodeId != 0 {
+		n += 1 + sovEraftpb(uint64(m.NodeId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
-func sozEraftpb(x uint64) (n int) {
-	return sovEraftpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Entry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEraftpb
+
+func (m *ConfChangeV2) Size() (n int) {
+	var l int
+	_ = l
+	if m.Transition != 0 {
+		n += 1 + sovEraftpb(uint64(m.Transition))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovEraftpb(uint64(l))
+		}
+	}
+	l = len(m.Context)
+	if l > 0 {
+		n += 1 + l + sovEraftpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovEraftpb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozEraftpb(x uint64) (n int) {
+	return sovEraftpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Entry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEraftpb
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -1801,6 +2259,37 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEraftpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context[:0], dAtA[iNdEx:postIndex]...)
+			if m.Context == nil {
+				m.Context = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEraftpb(dAtA[iNdEx:])
@@ -2022,6 +2511,212 @@ func (m *ConfState) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEraftpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Learners = append(m.Learners, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEraftpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEraftpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEraftpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Learners = append(m.Learners, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Learners", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEraftpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VotersOutgoing = append(m.VotersOutgoing, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEraftpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEraftpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEraftpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VotersOutgoing = append(m.VotersOutgoing, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersOutgoing", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEraftpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LearnersNext = append(m.LearnersNext, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEraftpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEraftpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEraftpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LearnersNext = append(m.LearnersNext, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearnersNext", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoLeave", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoLeave = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEraftpb(dAtA[iNdEx:])
@@ -2164,6 +2859,227 @@ func (m *ConfChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConfChangeSingle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEraftpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfChangeSingle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfChangeSingle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+			}
+			m.ChangeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeType |= (ConfChangeType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			m.NodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEraftpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEraftpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfChangeV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEraftpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfChangeV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfChangeV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transition", wireType)
+			}
+			m.Transition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transition |= (ConfChangeTransition(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEraftpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &ConfChangeSingle{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEraftpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context[:0], dAtA[iNdEx:postIndex]...)
+			if m.Context == nil {
+				m.Context = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEraftpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEraftpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEraftpb(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2269,50 +3185,67 @@ var (
 	ErrIntOverflowEraftpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("eraftpb.proto", fileDescriptor_eraftpb_2f2e0bcef614736b) }
-
-var fileDescriptor_eraftpb_2f2e0bcef614736b = []byte{
-	// 666 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x94, 0x5f, 0x4e, 0xdb, 0x4e,
-	0x10, 0xc7, 0xb3, 0xf9, 0x67, 0x7b, 0x1c, 0xc2, 0xb2, 0x3f, 0x7e, 0x60, 0xfa, 0x10, 0xa5, 0x7e,
-	0x8a, 0x90, 0xa0, 0x82, 0xaa, 0x52, 0x5f, 0x01, 0x55, 0xa2, 0x6a, 0x8d, 0x2a, 0x43, 0xfb, 0x1a,
-	0x2d, 0xf6, 0xc4, 0xa4, 0xc2, 0x5e, 0xd7, 0xbb, 0x50, 0x72, 0x93, 0x1e, 0xa2, 0x07, 0xe9, 0x63,
-	0x8f, 0x50, 0xd1, 0x23, 0xf4, 0x02, 0xd5, 0x6e, 0x6c, 0xc7, 0xa1, 0x6f, 0xf3, 0x1d, 0xcf, 0xee,
-	0x7c, 0xe6, 0x3b, 0x9b, 0xc0, 0x06, 0x16, 0x7c, 0xa6, 0xf2, 0xeb, 0xc3, 0xbc, 0x10, 0x4a, 0x30,
-	0xab, 0x94, 0xfe, 0x03, 0xf4, 0xde, 0x64, 0xaa, 0x58, 0xb0, 0x23, 0x00, 0xd4, 0xc1, 0x54, 0x2d,
-	0x72, 0xf4, 0xc8, 0x98, 0x4c, 0x86, 0xc7, 0xec, 0xb0, 0x3a, 0x65, 0x6a, 0xae, 0x16, 0x39, 0x86,
-	0x0e, 0x56, 0x21, 0x63, 0xd0, 0x55, 0x58, 0xa4, 0x5e, 0x7b, 0x4c, 0x26, 0xdd, 0xd0, 0xc4, 0x6c,
-	0x1b, 0x7a, 0xf3, 0x2c, 0xc6, 0x07, 0xaf, 0x63, 0x92, 0x4b, 0xa1, 0x2b, 0x63, 0xae, 0xb8, 0xd7,
-	0x1d, 0x93, 0xc9, 0x20, 0x34, 0xb1, 0x2f, 0x80, 0x5e, 0x66, 0x3c, 0x97, 0x37, 0x42, 0x05, 0xa8,
-	0xb8, 0xce, 0x69, 0x88, 0x48, 0x64, 0xb3, 0xa9, 0x54, 0x5c, 0x2d, 0x21, 0xdc, 0x06, 0xc4, 0x99,
-	0xc8, 0x66, 0x97, 0xfa, 0x4b, 0xe8, 0x44, 0x55, 0xb8, 0x6a, 0xd8, 0x7e, 0xd2, 0xd0, 0xa0, 0x75,
-	0x56, 0x68, 0xfe, 0x47, 0xb0, 0xab, 0x86, 0x35, 0x10, 0x59, 0x01, 0xb1, 0x57, 0x60, 0xa7, 0x25,
-	0x88, 0xb9, 0xcc, 0x3d, 0xde, 0xab, 0x5b, 0x3f, 0x25, 0x0d, 0xeb, 0x52, 0xff, 0x7b, 0x1b, 0xac,
-	0x00, 0xa5, 0xe4, 0x09, 0xb2, 0x17, 0x60, 0xa7, 0x32, 0x69, 0x5a, 0xb8, 0x5d, 0x5f, 0x51, 0xd6,
-	0x18, 0x13, 0xad, 0x54, 0x26, 0xc6, 0xc2, 0x21, 0xb4, 0x95, 0x28, 0xd1, 0xdb, 0x4a, 0x68, 0xae,
-	0x59, 0x21, 0x6a, 0x6e, 0x1d, 0xd7, 0xb3, 0x74, 0x1b, 0x36, 0xef, 0x81, 0x7d, 0x2b, 0x92, 0xa9,
-	0xc9, 0xf7, 0x4c, 0xde, 0xba, 0x15, 0xc9, 0xd5, 0xda, 0x06, 0xfa, 0x4d, 0x43, 0x26, 0x60, 0xe9,
-	0xc5, 0xcd, 0x51, 0x7a, 0xd6, 0xb8, 0x33, 0x71, 0x8f, 0x87, 0xeb, 0xbb, 0x0d, 0xab, 0xcf, 0x6c,
-	0x07, 0xfa, 0x91, 0x48, 0xd3, 0xb9, 0xf2, 0x6c, 0x73, 0x41, 0xa9, 0xd8, 0x01, 0xd8, 0xb2, 0x74,
-	0xc1, 0x73, 0x8c, 0x3d, 0x5b, 0xff, 0xd8, 0x13, 0xd6, 0x25, 0xfa, 0x9a, 0x02, 0x3f, 0x63, 0xa4,
-	0x3c, 0x18, 0x93, 0x89, 0x1d, 0x96, 0xca, 0x7f, 0x07, 0xce, 0x39, 0x2f, 0xe2, 0xe5, 0xf2, 0xaa,
-	0xd1, 0x48, 0x63, 0x34, 0x06, 0xdd, 0x7b, 0xa1, 0xb0, 0x7a, 0x55, 0x3a, 0x6e, 0x30, 0x75, 0x9a,
-	0x4c, 0xfe, 0x73, 0x70, 0xce, 0x9a, 0x2f, 0x21, 0x13, 0x31, 0x4a, 0x8f, 0x8c, 0x3b, 0x7a, 0x70,
-	0x23, 0xfc, 0x05, 0x80, 0x2e, 0x39, 0xbb, 0xe1, 0x59, 0x82, 0xec, 0x35, 0xb8, 0x91, 0x89, 0x9a,
-	0x3b, 0xda, 0x5d, 0x7b, 0x61, 0xcb, 0x4a, 0xb3, 0x26, 0x88, 0xea, 0x98, 0xed, 0x82, 0xa5, 0x2f,
-	0x9c, 0xce, 0xe3, 0x92, 0xac, 0xaf, 0xe5, 0xdb, 0x98, 0x79, 0x60, 0x45, 0x22, 0x53, 0xf8, 0xb0,
-	0x84, 0x1b, 0x84, 0x95, 0xdc, 0x3f, 0x02, 0xa7, 0xfe, 0xdd, 0xb0, 0x4d, 0x70, 0x8d, 0xb8, 0x10,
-	0x45, 0xca, 0x6f, 0x69, 0x8b, 0xfd, 0x07, 0x9b, 0x26, 0xb1, 0xea, 0x49, 0xc9, 0xfe, 0x1f, 0x02,
-	0x6e, 0xe3, 0xa1, 0x30, 0x80, 0x7e, 0x20, 0x93, 0xf3, 0xbb, 0x9c, 0xb6, 0x98, 0x0b, 0x56, 0x20,
-	0x93, 0x53, 0xe4, 0x8a, 0x12, 0x36, 0x04, 0x08, 0x64, 0xf2, 0xa1, 0x10, 0xb9, 0x90, 0x48, 0xdb,
-	0x6c, 0x03, 0x9c, 0x40, 0x26, 0x27, 0x79, 0x8e, 0x59, 0x4c, 0x3b, 0xec, 0x7f, 0xd8, 0xaa, 0x65,
-	0x88, 0x32, 0x17, 0x99, 0x44, 0xda, 0x65, 0x0c, 0x86, 0x81, 0x4c, 0x42, 0xfc, 0x72, 0x87, 0x52,
-	0x7d, 0x12, 0x0a, 0x69, 0x8f, 0x3d, 0x83, 0x9d, 0xf5, 0x5c, 0x5d, 0xdf, 0xd7, 0xd0, 0x81, 0x4c,
-	0xaa, 0xed, 0x52, 0x8b, 0x51, 0x18, 0x68, 0x1e, 0xe4, 0x85, 0xba, 0xd6, 0x20, 0x36, 0xf3, 0x60,
-	0xbb, 0x99, 0xa9, 0x0f, 0x3b, 0x25, 0xc3, 0x55, 0xc1, 0x33, 0x39, 0xc3, 0xe2, 0x3d, 0xf2, 0x18,
-	0x0b, 0xea, 0xb2, 0x2d, 0xd8, 0xd0, 0xe9, 0x79, 0x8a, 0xe2, 0x4e, 0x5d, 0x88, 0xaf, 0x74, 0xb0,
-	0x7f, 0x00, 0xc3, 0x75, 0xe7, 0xf5, 0xac, 0x27, 0x71, 0x7c, 0x21, 0x62, 0xa4, 0x2d, 0x3d, 0x6b,
-	0x88, 0xa9, 0xb8, 0x47, 0xa3, 0xc9, 0x29, 0xfd, 0xf1, 0x38, 0x22, 0x3f, 0x1f, 0x47, 0xe4, 0xd7,
-	0xe3, 0x88, 0x7c, 0xfb, 0x3d, 0x6a, 0x5d, 0xf7, 0xcd, 0xbf, 0xda, 0xcb, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x2c, 0x70, 0xc6, 0xf6, 0xe6, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("eraftpb.proto", fileDescriptor_eraftpb_c424c2bf2292e394) }
+
+var fileDescriptor_eraftpb_c424c2bf2292e394 = []byte{
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xce, 0x8c, 0x1d, 0x8f, 0x5d, 0xe3, 0x38, 0x9d, 0x22, 0x64, 0x67, 0x57, 0x6c, 0x30, 0x46,
+	0x08, 0x2b, 0x12, 0x8b, 0xc8, 0x0a, 0x89, 0x0b, 0x87, 0x6c, 0xb4, 0x52, 0x02, 0x71, 0x40, 0x93,
+	0xdd, 0x5c, 0xad, 0x8e, 0xa7, 0x32, 0x19, 0xe4, 0xe9, 0x1e, 0xa6, 0xdb, 0xc1, 0xb9, 0xf2, 0x14,
+	0x1c, 0x78, 0x02, 0x9e, 0x84, 0x23, 0x8f, 0x80, 0xc2, 0x01, 0x89, 0xa7, 0x40, 0xdd, 0xf3, 0xe3,
+	0x71, 0x08, 0xdc, 0xb8, 0x55, 0x7d, 0xf3, 0x75, 0xd5, 0x57, 0x5f, 0x75, 0xdb, 0xb0, 0x45, 0x39,
+	0xbf, 0xd6, 0xd9, 0xd5, 0x8b, 0x2c, 0x97, 0x5a, 0xa2, 0x57, 0xa6, 0xa3, 0x25, 0x6c, 0xbe, 0x16,
+	0x3a, 0xbf, 0xc3, 0xcf, 0x00, 0xc8, 0x04, 0x53, 0x7d, 0x97, 0x51, 0xe0, 0x0c, 0x9d, 0xf1, 0xe0,
+	0x10, 0x5f, 0x54, 0xa7, 0x2c, 0xe7, 0xcd, 0x5d, 0x46, 0x61, 0x8f, 0xaa, 0x10, 0x11, 0xda, 0x9a,
+	0xf2, 0x34, 0x70, 0x87, 0xce, 0xb8, 0x1d, 0xda, 0x18, 0x77, 0x61, 0x33, 0x11, 0x11, 0x2d, 0x83,
+	0x96, 0x05, 0x8b, 0xc4, 0x30, 0x23, 0xae, 0x79, 0xd0, 0x1e, 0x3a, 0xe3, 0x7e, 0x68, 0xe3, 0x91,
+	0x04, 0x76, 0x21, 0x78, 0xa6, 0x6e, 0xa4, 0x9e, 0x90, 0xe6, 0x06, 0x33, 0x22, 0x66, 0x52, 0x5c,
+	0x4f, 0x95, 0xe6, 0xba, 0x10, 0xe1, 0x37, 0x44, 0x1c, 0x4b, 0x71, 0x7d, 0x61, 0xbe, 0x84, 0xbd,
+	0x59, 0x15, 0xae, 0x1a, 0xba, 0x0f, 0x1a, 0x5a, 0x69, 0xad, 0x95, 0xb4, 0xd1, 0x5b, 0xe8, 0x56,
+	0x0d, 0x6b, 0x41, 0xce, 0x4a, 0x10, 0x7e, 0x0e, 0xdd, 0xb4, 0x14, 0x62, 0x8b, 0xf9, 0x87, 0x4f,
+	0xeb, 0xd6, 0x0f, 0x95, 0x86, 0x35, 0x75, 0xf4, 0xa7, 0x0b, 0xde, 0x84, 0x94, 0xe2, 0x31, 0xe1,
+	0xa7, 0xd0, 0x4d, 0x55, 0xdc, 0xb4, 0x70, 0xb7, 0x2e, 0x51, 0x72, 0xac, 0x89, 0x5e, 0xaa, 0x62,
+	0x13, 0xe0, 0x00, 0x5c, 0x2d, 0x4b, 0xe9, 0xae, 0x96, 0x46, 0xd7, 0x75, 0x2e, 0x6b, 0xdd, 0x26,
+	0xae, 0x67, 0x69, 0x37, 0x6c, 0x7e, 0x0a, 0xdd, 0xb9, 0x8c, 0xa7, 0x16, 0xdf, 0xb4, 0xb8, 0x37,
+	0x97, 0xf1, 0x9b, 0xb5, 0x0d, 0x74, 0x9a, 0x86, 0x8c, 0xc1, 0x33, 0x8b, 0x4b, 0x48, 0x05, 0xde,
+	0xb0, 0x35, 0xf6, 0x0f, 0x07, 0xeb, 0xbb, 0x0d, 0xab, 0xcf, 0xb8, 0x07, 0x9d, 0x99, 0x4c, 0xd3,
+	0x44, 0x07, 0x5d, 0x5b, 0xa0, 0xcc, 0xf0, 0x13, 0xe8, 0xaa, 0xd2, 0x85, 0xa0, 0x67, 0xed, 0xd9,
+	0xf9, 0x87, 0x3d, 0x61, 0x4d, 0x31, 0x65, 0x72, 0xfa, 0x8e, 0x66, 0x3a, 0x80, 0xa1, 0x33, 0xee,
+	0x86, 0x65, 0x86, 0xef, 0x83, 0x5f, 0x44, 0xd3, 0x9b, 0x44, 0xe8, 0xc0, 0xb7, 0x3d, 0xa0, 0x80,
+	0x4e, 0x12, 0xa1, 0x31, 0x00, 0x6f, 0x26, 0x85, 0xa6, 0xa5, 0x0e, 0xfa, 0x76, 0x3b, 0x55, 0x3a,
+	0xfa, 0x1a, 0x7a, 0x27, 0x3c, 0x8f, 0x8a, 0xbd, 0x57, 0xae, 0x38, 0x0d, 0x57, 0x10, 0xda, 0xb7,
+	0x52, 0x53, 0x75, 0x21, 0x4d, 0xdc, 0x18, 0xa7, 0xd5, 0x1c, 0x67, 0xf4, 0x8b, 0x03, 0xbd, 0xe3,
+	0xe6, 0x2d, 0x12, 0x32, 0x22, 0x15, 0x38, 0xc3, 0x96, 0x31, 0xcd, 0x26, 0xf8, 0x0c, 0xba, 0x73,
+	0xe2, 0xb9, 0xa0, 0x5c, 0x05, 0xae, 0xfd, 0x50, 0xe7, 0xf8, 0x31, 0x6c, 0x9b, 0xfa, 0xb9, 0x9a,
+	0xca, 0x85, 0x8e, 0x65, 0x22, 0xe2, 0xa0, 0x65, 0x29, 0x83, 0x02, 0xfe, 0xa6, 0x44, 0xf1, 0x43,
+	0xd8, 0xaa, 0x0e, 0x4d, 0x85, 0x99, 0xaa, 0x6d, 0x69, 0xfd, 0x0a, 0x3c, 0xa7, 0xa5, 0xc6, 0xe7,
+	0x00, 0x7c, 0xa1, 0xe5, 0x74, 0x4e, 0xfc, 0x96, 0xec, 0x46, 0xbb, 0x61, 0xcf, 0x20, 0x67, 0x06,
+	0x18, 0xdd, 0x01, 0x18, 0xad, 0xc7, 0x37, 0x5c, 0xc4, 0x84, 0x5f, 0x80, 0x3f, 0xb3, 0x51, 0xf3,
+	0xa2, 0x3d, 0x59, 0x7b, 0x26, 0x05, 0xd3, 0xde, 0x35, 0x98, 0xd5, 0x31, 0x3e, 0x01, 0xcf, 0x4c,
+	0x36, 0x4d, 0xa2, 0xd2, 0xa3, 0x8e, 0x49, 0x4f, 0xa3, 0xa6, 0xe9, 0xad, 0x75, 0xd3, 0x09, 0xd8,
+	0xaa, 0xe0, 0x45, 0x22, 0xe2, 0xf9, 0xff, 0x21, 0x60, 0xf4, 0xb3, 0x03, 0xfd, 0xd5, 0xb9, 0xcb,
+	0x43, 0xfc, 0x12, 0x40, 0xe7, 0x5c, 0xa8, 0x44, 0x27, 0x52, 0x94, 0x2d, 0x9e, 0x3f, 0xd6, 0xa2,
+	0x26, 0x85, 0x8d, 0x03, 0xf8, 0x12, 0xbc, 0xa2, 0x6d, 0xb1, 0xb9, 0xe6, 0x5b, 0x7e, 0x38, 0x4e,
+	0x58, 0x31, 0xff, 0xdd, 0x85, 0x83, 0x13, 0xe8, 0xd5, 0x3f, 0x81, 0xb8, 0x0d, 0xbe, 0x4d, 0xce,
+	0x65, 0x9e, 0xf2, 0x39, 0xdb, 0xc0, 0x77, 0x60, 0xdb, 0x02, 0xab, 0xca, 0xcc, 0xc1, 0x77, 0x61,
+	0xe7, 0x01, 0x78, 0x79, 0xc8, 0xdc, 0x83, 0xbf, 0x5c, 0xf0, 0x1b, 0x3f, 0x05, 0x08, 0xd0, 0x99,
+	0xa8, 0xf8, 0x64, 0x91, 0xb1, 0x0d, 0xf4, 0xc1, 0x9b, 0xa8, 0xf8, 0x15, 0x71, 0xcd, 0x1c, 0x1c,
+	0x00, 0x4c, 0x54, 0xfc, 0x6d, 0x2e, 0x33, 0xa9, 0x88, 0xb9, 0xb8, 0x05, 0xbd, 0x89, 0x8a, 0x8f,
+	0xb2, 0x8c, 0x44, 0xc4, 0x5a, 0xa6, 0x7c, 0x9d, 0x86, 0xa4, 0x32, 0x29, 0x14, 0xb1, 0x36, 0x22,
+	0x0c, 0x26, 0x2a, 0x0e, 0xe9, 0xfb, 0x05, 0x29, 0x7d, 0x29, 0x35, 0xb1, 0x4d, 0x7c, 0x06, 0x7b,
+	0xeb, 0x58, 0xcd, 0xef, 0x98, 0x59, 0x26, 0x2a, 0xae, 0xde, 0x2f, 0xf3, 0x90, 0x41, 0xdf, 0xe8,
+	0x21, 0x9e, 0xeb, 0x2b, 0x23, 0xa4, 0x8b, 0x01, 0xec, 0x36, 0x91, 0xfa, 0x70, 0xaf, 0xd4, 0x60,
+	0x37, 0x70, 0x4d, 0xf9, 0x19, 0xf1, 0x88, 0x72, 0xe6, 0xe3, 0x0e, 0x6c, 0x19, 0x38, 0x49, 0x49,
+	0x2e, 0xf4, 0xb9, 0xfc, 0x81, 0xf5, 0xeb, 0x61, 0xc8, 0x4a, 0xda, 0xc2, 0x3d, 0xc0, 0x55, 0x5e,
+	0x57, 0x1c, 0x94, 0xdd, 0x43, 0xe2, 0xd1, 0xa9, 0xf9, 0xd9, 0x62, 0xdb, 0xb8, 0x0b, 0xac, 0x89,
+	0x18, 0x2e, 0x63, 0x65, 0x0b, 0x23, 0xdb, 0xbc, 0xdf, 0x85, 0x62, 0x3b, 0xe5, 0xe4, 0x6f, 0x45,
+	0x4e, 0x7c, 0x76, 0xc3, 0xaf, 0xe6, 0xc4, 0xf0, 0xe0, 0x08, 0x06, 0xeb, 0x97, 0xd1, 0x58, 0x7c,
+	0x14, 0x45, 0xe7, 0x32, 0x22, 0xb6, 0x61, 0x54, 0x85, 0x94, 0xca, 0x5b, 0xb2, 0xb9, 0x63, 0x4a,
+	0x1c, 0x45, 0xd1, 0x59, 0xf1, 0x30, 0x2d, 0xe6, 0x1e, 0xfc, 0xe8, 0xc0, 0xee, 0x63, 0xb7, 0x0d,
+	0xdf, 0x83, 0xe0, 0x31, 0xfc, 0x68, 0xa1, 0x25, 0xdb, 0xc0, 0x8f, 0xe0, 0x83, 0xc7, 0xbe, 0x7e,
+	0x25, 0x13, 0xa1, 0x4f, 0xd3, 0x6c, 0x9e, 0xcc, 0x12, 0xb3, 0xe4, 0xff, 0xa2, 0xbd, 0x5e, 0x96,
+	0x34, 0xf7, 0x15, 0xfb, 0xf5, 0x7e, 0xdf, 0xf9, 0xed, 0x7e, 0xdf, 0xf9, 0xfd, 0x7e, 0xdf, 0xf9,
+	0xe9, 0x8f, 0xfd, 0x8d, 0xab, 0x8e, 0xfd, 0x1f, 0x7f, 0xf9, 0xf7, 0x00, 0x9a, 0xdd, 0xc2, 0x1c,
+	0xd8, 0x07, 0x00, 0x00,
 }
@@ -47,7 +47,7 @@ func (x StoreState) String() string {
 	return proto.EnumName(StoreState_name, int32(x))
 }
 func (StoreState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_metapb_33de520265e54ab4, []int{0}
+	return fileDescriptor_metapb_f0cff5e3efba49eb, []int{0}
 }
 
 type Cluster struct {
@@ -64,7 +64,7 @@ func (m *Cluster) Reset()         { *m = Cluster{} }
 func (m *Cluster) String() string { return proto.CompactTextString(m) }
 func (*Cluster) ProtoMessage()    {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metapb_33de520265e54ab4, []int{0}
+	return fileDescriptor_metapb_f0cff5e3efba49eb, []int{0}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -121,7 +121,7 @@ func (m *Store) Reset()         { *m = Store{} }
 func (m *Store) String() string { return proto.CompactTextString(m) }
 func (*Store) ProtoMessage()    {}
 func (*Store) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metapb_33de520265e54ab4, []int{1}
+	return fileDescriptor_metapb_f0cff5e3efba49eb, []int{1}
 }
 func (m *Store) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,7 +185,7 @@ func (m *RegionEpoch) Reset()         { *m = RegionEpoch{} }
 func (m *RegionEpoch) String() string { return proto.CompactTextString(m) }
 func (*RegionEpoch) ProtoMessage()    {}
 func (*RegionEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metapb_33de520265e54ab4, []int{2}
+	return fileDescriptor_metapb_f0cff5e3efba49eb, []int{2}
 }
 func (m *RegionEpoch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -244,7 +244,7 @@ func (m *Region) Reset()         { *m = Region{} }
 func (m *Region) String() string { return proto.CompactTextString(m) }
 func (*Region) ProtoMessage()    {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metapb_33de520265e54ab4, []int{3}
+	return fileDescriptor_metapb_f0cff5e3efba49eb, []int{3}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,8 +309,11 @@ func (m *Region) GetPeers() []*Peer {
 }
 
 type Peer struct {
-	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StoreId              uint64   `protobuf:"varint,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StoreId uint64 `protobuf:"varint,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	// a learner receives the raft log but doesn't vote, it is promoted to a voter once it has
+	// caught up with the leader
+	IsLearner            bool     `protobuf:"varint,3,opt,name=is_learner,json=isLearner,proto3" json:"is_learner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -320,7 +323,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metapb_33de520265e54ab4, []int{4}
+	return fileDescriptor_metapb_f0cff5e3efba49eb, []int{4}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,6 +366,13 @@ func (m *Peer) GetStoreId() uint64 {
 	return 0
 }
 
+func (m *Peer) GetIsLearner() bool {
+	if m != nil {
+		return m.IsLearner
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Cluster)(nil), "metapb.Cluster")
 	proto.RegisterType((*Store)(nil), "metapb.Store")
@@ -555,6 +565,16 @@ func (m *Peer) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.StoreId))
 	}
+	if m.IsLearner {
+		dAtA[i] = 0x18
+		i++
+		if m.IsLearner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -658,6 +678,9 @@ func (m *Peer) Size() (n int) {
 	if m.StoreId != 0 {
 		n += 1 + sovMetapb(uint64(m.StoreId))
 	}
+	if m.IsLearner {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1236,6 +1259,26 @@ func (m *Peer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLearner = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -1363,33 +1406,34 @@ var (
 	ErrIntOverflowMetapb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("metapb.proto", fileDescriptor_metapb_33de520265e54ab4) }
-
-var fileDescriptor_metapb_33de520265e54ab4 = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xdd, 0x8a, 0xd3, 0x40,
-	0x18, 0xdd, 0x49, 0xf3, 0xd3, 0x7e, 0xc9, 0x96, 0x30, 0x0a, 0x66, 0x15, 0x42, 0x08, 0x5e, 0x04,
-	0x2f, 0x56, 0xad, 0xe0, 0xad, 0xb0, 0x8b, 0x17, 0xe2, 0x85, 0x32, 0xab, 0xde, 0x78, 0x11, 0xd2,
-	0xce, 0xd7, 0x1a, 0xdc, 0xcc, 0x84, 0x99, 0xd9, 0xa5, 0x7d, 0x13, 0x9f, 0xc1, 0x27, 0xf1, 0xd2,
-	0x47, 0x90, 0xfa, 0x22, 0x32, 0x93, 0x06, 0x0b, 0xbd, 0xcb, 0xf9, 0x4e, 0xce, 0x77, 0xce, 0x77,
-	0x18, 0x48, 0x3a, 0x34, 0x4d, 0xbf, 0xbc, 0xec, 0x95, 0x34, 0x92, 0x86, 0x03, 0x7a, 0xfc, 0x70,
-	0x23, 0x37, 0xd2, 0x8d, 0x9e, 0xdb, 0xaf, 0x81, 0x2d, 0xdf, 0x40, 0x74, 0x7d, 0x7b, 0xa7, 0x0d,
-	0x2a, 0x3a, 0x07, 0xaf, 0xe5, 0x19, 0x29, 0x48, 0xe5, 0x33, 0xaf, 0xe5, 0xf4, 0x29, 0xcc, 0xbb,
-	0x66, 0x5b, 0xf7, 0x88, 0xaa, 0x5e, 0xc9, 0x3b, 0x61, 0x32, 0xaf, 0x20, 0xd5, 0x39, 0x4b, 0xba,
-	0x66, 0xfb, 0x11, 0x51, 0x5d, 0xdb, 0x59, 0xf9, 0x15, 0x82, 0x1b, 0x23, 0x15, 0x9e, 0xc8, 0x33,
-	0x88, 0x1a, 0xce, 0x15, 0x6a, 0xed, 0x74, 0x33, 0x36, 0x42, 0x5a, 0x41, 0xa0, 0x4d, 0x63, 0x30,
-	0x9b, 0x14, 0xa4, 0x9a, 0x2f, 0xe8, 0xe5, 0x21, 0xaf, 0xdb, 0x73, 0x63, 0x19, 0x36, 0xfc, 0x50,
-	0x5e, 0x41, 0xcc, 0x70, 0xd3, 0x4a, 0xf1, 0xb6, 0x97, 0xab, 0x6f, 0xf4, 0x02, 0xa6, 0x2b, 0x29,
-	0xd6, 0xf5, 0x3d, 0xaa, 0x83, 0x51, 0x64, 0xf1, 0x17, 0x54, 0xd6, 0xed, 0x1e, 0x95, 0x6e, 0xa5,
-	0x70, 0x6e, 0x3e, 0x1b, 0x61, 0xf9, 0x93, 0x40, 0x38, 0x2c, 0x39, 0x89, 0xf8, 0x04, 0x66, 0xda,
-	0x34, 0xca, 0xd4, 0xdf, 0x71, 0xe7, 0x64, 0x09, 0x9b, 0xba, 0xc1, 0x7b, 0xdc, 0xd1, 0x47, 0x10,
-	0xa1, 0xe0, 0x8e, 0x9a, 0x38, 0x2a, 0x44, 0xc1, 0x2d, 0xf1, 0x1a, 0x12, 0xe5, 0xf6, 0xd5, 0x68,
-	0x53, 0x65, 0x7e, 0x41, 0xaa, 0x78, 0xf1, 0x60, 0xbc, 0xe2, 0x28, 0x30, 0x8b, 0xd5, 0x51, 0xfa,
-	0x12, 0x02, 0xdb, 0xa5, 0xce, 0x82, 0x62, 0x52, 0xc5, 0x8b, 0x64, 0x14, 0xd8, 0x2e, 0xd9, 0x40,
-	0x95, 0x2f, 0xc1, 0xb7, 0xf0, 0x24, 0xe9, 0x05, 0x4c, 0xb5, 0x6d, 0xa7, 0x6e, 0xf9, 0x78, 0x9f,
-	0xc3, 0xef, 0xf8, 0xb3, 0x17, 0x00, 0xff, 0x8b, 0xa3, 0x21, 0x78, 0x9f, 0xfb, 0xf4, 0x8c, 0xc6,
-	0x10, 0x7d, 0x58, 0xaf, 0x6f, 0x5b, 0x81, 0x29, 0xa1, 0xe7, 0x30, 0xfb, 0x24, 0xbb, 0xa5, 0x36,
-	0x52, 0x60, 0xea, 0x5d, 0xa5, 0xbf, 0xf6, 0x39, 0xf9, 0xbd, 0xcf, 0xc9, 0x9f, 0x7d, 0x4e, 0x7e,
-	0xfc, 0xcd, 0xcf, 0x96, 0xa1, 0x7b, 0x0c, 0xaf, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x77, 0xf7,
-	0x52, 0x80, 0x3a, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("metapb.proto", fileDescriptor_metapb_f0cff5e3efba49eb) }
+
+var fileDescriptor_metapb_f0cff5e3efba49eb = []byte{
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xd1, 0x6a, 0xd4, 0x40,
+	0x14, 0xed, 0x64, 0x77, 0x93, 0xcd, 0x4d, 0xba, 0x84, 0x51, 0x30, 0x55, 0x0c, 0x21, 0xf8, 0x10,
+	0x7c, 0xa8, 0xb2, 0x82, 0xaf, 0x42, 0x8b, 0x0f, 0xa2, 0x60, 0x99, 0xaa, 0x2f, 0x3e, 0x84, 0xec,
+	0xce, 0xdd, 0x75, 0x70, 0x33, 0x13, 0x66, 0xa6, 0xa5, 0xfd, 0x13, 0xbf, 0xc1, 0x2f, 0xf1, 0xd1,
+	0x4f, 0x90, 0xf5, 0x47, 0x64, 0x26, 0x0d, 0x15, 0xf6, 0x2d, 0xe7, 0x9c, 0x9c, 0x7b, 0xcf, 0x3d,
+	0x0c, 0xa4, 0x1d, 0xda, 0xb6, 0x5f, 0x9d, 0xf6, 0x5a, 0x59, 0x45, 0xc3, 0x01, 0x3d, 0x7e, 0xb8,
+	0x55, 0x5b, 0xe5, 0xa9, 0x17, 0xee, 0x6b, 0x50, 0xab, 0x37, 0x10, 0x9d, 0xef, 0xae, 0x8c, 0x45,
+	0x4d, 0x17, 0x10, 0x08, 0x9e, 0x93, 0x92, 0xd4, 0x53, 0x16, 0x08, 0x4e, 0x9f, 0xc1, 0xa2, 0x6b,
+	0x6f, 0x9a, 0x1e, 0x51, 0x37, 0x6b, 0x75, 0x25, 0x6d, 0x1e, 0x94, 0xa4, 0x3e, 0x66, 0x69, 0xd7,
+	0xde, 0x5c, 0x20, 0xea, 0x73, 0xc7, 0x55, 0x5f, 0x61, 0x76, 0x69, 0x95, 0xc6, 0x03, 0x7b, 0x0e,
+	0x51, 0xcb, 0xb9, 0x46, 0x63, 0xbc, 0x2f, 0x66, 0x23, 0xa4, 0x35, 0xcc, 0x8c, 0x6d, 0x2d, 0xe6,
+	0x93, 0x92, 0xd4, 0x8b, 0x25, 0x3d, 0xbd, 0xcb, 0xeb, 0xe7, 0x5c, 0x3a, 0x85, 0x0d, 0x3f, 0x54,
+	0x67, 0x90, 0x30, 0xdc, 0x0a, 0x25, 0xdf, 0xf6, 0x6a, 0xfd, 0x8d, 0x9e, 0xc0, 0x7c, 0xad, 0xe4,
+	0xa6, 0xb9, 0x46, 0x7d, 0xb7, 0x28, 0x72, 0xf8, 0x0b, 0x6a, 0xb7, 0xed, 0x1a, 0xb5, 0x11, 0x4a,
+	0xfa, 0x6d, 0x53, 0x36, 0xc2, 0xea, 0x27, 0x81, 0x70, 0x18, 0x72, 0x10, 0xf1, 0x09, 0xc4, 0xc6,
+	0xb6, 0xda, 0x36, 0xdf, 0xf1, 0xd6, 0xdb, 0x52, 0x36, 0xf7, 0xc4, 0x7b, 0xbc, 0xa5, 0x8f, 0x20,
+	0x42, 0xc9, 0xbd, 0x34, 0xf1, 0x52, 0x88, 0x92, 0x3b, 0xe1, 0x35, 0xa4, 0xda, 0xcf, 0x6b, 0xd0,
+	0xa5, 0xca, 0xa7, 0x25, 0xa9, 0x93, 0xe5, 0x83, 0xf1, 0x8a, 0xff, 0x02, 0xb3, 0x44, 0xdf, 0x03,
+	0x5a, 0xc1, 0xcc, 0x75, 0x69, 0xf2, 0x59, 0x39, 0xa9, 0x93, 0x65, 0x3a, 0x1a, 0x5c, 0x97, 0x6c,
+	0x90, 0xaa, 0x0b, 0x98, 0x3a, 0x78, 0x90, 0xf4, 0x04, 0xe6, 0xc6, 0xb5, 0xd3, 0x08, 0x3e, 0xde,
+	0xe7, 0xf1, 0x3b, 0x4e, 0x9f, 0x02, 0x08, 0xd3, 0xec, 0xb0, 0xd5, 0x12, 0xb5, 0x8f, 0x3a, 0x67,
+	0xb1, 0x30, 0x1f, 0x06, 0xe2, 0xf9, 0x4b, 0x80, 0xfb, 0x5e, 0x69, 0x08, 0xc1, 0xe7, 0x3e, 0x3b,
+	0xa2, 0x09, 0x44, 0x1f, 0x37, 0x9b, 0x9d, 0x90, 0x98, 0x11, 0x7a, 0x0c, 0xf1, 0x27, 0xd5, 0xad,
+	0x8c, 0x55, 0x12, 0xb3, 0xe0, 0x2c, 0xfb, 0xb5, 0x2f, 0xc8, 0xef, 0x7d, 0x41, 0xfe, 0xec, 0x0b,
+	0xf2, 0xe3, 0x6f, 0x71, 0xb4, 0x0a, 0xfd, 0x5b, 0x79, 0xf5, 0x6f, 0x00, 0x43, 0x1a, 0xa3, 0x92,
+	0x59, 0x02, 0x00, 0x00,
 }
@@ -12,7 +12,6 @@ import (
 
 	eraftpb "github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
 	errorpb "github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
-
 	metapb "github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 )
 
@@ -56,7 +55,7 @@ func (x CmdType) String() string {
 	return proto.EnumName(CmdType_name, int32(x))
 }
 func (CmdType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{0}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{0}
 }
 
 type AdminCmdType int32
@@ -67,6 +66,7 @@ const (
 	AdminCmdType_CompactLog     AdminCmdType = 3
 	AdminCmdType_TransferLeader AdminCmdType = 4
 	AdminCmdType_Split          AdminCmdType = 10
+	AdminCmdType_ResolvedTs     AdminCmdType = 11
 )
 
 var AdminCmdType_name = map[int32]string{
@@ -75,6 +75,7 @@ var AdminCmdType_name = map[int32]string{
 	3:  "CompactLog",
 	4:  "TransferLeader",
 	10: "Split",
+	11: "ResolvedTs",
 }
 var AdminCmdType_value = map[string]int32{
 	"InvalidAdmin":   0,
@@ -82,13 +83,14 @@ var AdminCmdType_value = map[string]int32{
 	"CompactLog":     3,
 	"TransferLeader": 4,
 	"Split":          10,
+	"ResolvedTs":     11,
 }
 
 func (x AdminCmdType) String() string {
 	return proto.EnumName(AdminCmdType_name, int32(x))
 }
 func (AdminCmdType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{1}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{1}
 }
 
 type GetRequest struct {
@@ -103,7 +105,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{0}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{0}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +159,7 @@ func (m *GetResponse) Reset()         { *m = GetResponse{} }
 func (m *GetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()    {}
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{1}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{1}
 }
 func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +208,7 @@ func (m *PutRequest) Reset()         { *m = PutRequest{} }
 func (m *PutRequest) String() string { return proto.CompactTextString(m) }
 func (*PutRequest) ProtoMessage()    {}
 func (*PutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{2}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{2}
 }
 func (m *PutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +268,7 @@ func (m *PutResponse) Reset()         { *m = PutResponse{} }
 func (m *PutResponse) String() string { return proto.CompactTextString(m) }
 func (*PutResponse) ProtoMessage()    {}
 func (*PutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{3}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{3}
 }
 func (m *PutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,7 +309,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{4}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{4}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +362,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{5}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{5}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,7 +401,7 @@ func (m *SnapRequest) Reset()         { *m = SnapRequest{} }
 func (m *SnapRequest) String() string { return proto.CompactTextString(m) }
 func (*SnapRequest) ProtoMessage()    {}
 func (*SnapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{6}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{6}
 }
 func (m *SnapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +441,7 @@ func (m *SnapResponse) Reset()         { *m = SnapResponse{} }
 func (m *SnapResponse) String() string { return proto.CompactTextString(m) }
 func (*SnapResponse) ProtoMessage()    {}
 func (*SnapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{7}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{7}
 }
 func (m *SnapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +492,7 @@ func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{8}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{8}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +571,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{9}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{9}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -646,7 +648,7 @@ func (m *ChangePeerRequest) Reset()         { *m = ChangePeerRequest{} }
 func (m *ChangePeerRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePeerRequest) ProtoMessage()    {}
 func (*ChangePeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{10}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{10}
 }
 func (m *ChangePeerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -700,7 +702,7 @@ func (m *ChangePeerResponse) Reset()         { *m = ChangePeerResponse{} }
 func (m *ChangePeerResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePeerResponse) ProtoMessage()    {}
 func (*ChangePeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{11}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{11}
 }
 func (m *ChangePeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +757,7 @@ func (m *SplitRequest) Reset()         { *m = SplitRequest{} }
 func (m *SplitRequest) String() string { return proto.CompactTextString(m) }
 func (*SplitRequest) ProtoMessage()    {}
 func (*SplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{12}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{12}
 }
 func (m *SplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +819,7 @@ func (m *SplitResponse) Reset()         { *m = SplitResponse{} }
 func (m *SplitResponse) String() string { return proto.CompactTextString(m) }
 func (*SplitResponse) ProtoMessage()    {}
 func (*SplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{13}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{13}
 }
 func (m *SplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -865,7 +867,7 @@ func (m *CompactLogRequest) Reset()         { *m = CompactLogRequest{} }
 func (m *CompactLogRequest) String() string { return proto.CompactTextString(m) }
 func (*CompactLogRequest) ProtoMessage()    {}
 func (*CompactLogRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{14}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{14}
 }
 func (m *CompactLogRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +920,7 @@ func (m *CompactLogResponse) Reset()         { *m = CompactLogResponse{} }
 func (m *CompactLogResponse) String() string { return proto.CompactTextString(m) }
 func (*CompactLogResponse) ProtoMessage()    {}
 func (*CompactLogResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{15}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{15}
 }
 func (m *CompactLogResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -958,7 +960,7 @@ func (m *TransferLeaderRequest) Reset()         { *m = TransferLeaderRequest{} }
 func (m *TransferLeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*TransferLeaderRequest) ProtoMessage()    {}
 func (*TransferLeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{16}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{16}
 }
 func (m *TransferLeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,7 +1006,7 @@ func (m *TransferLeaderResponse) Reset()         { *m = TransferLeaderResponse{}
 func (m *TransferLeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*TransferLeaderResponse) ProtoMessage()    {}
 func (*TransferLeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{17}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{17}
 }
 func (m *TransferLeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1033,12 +1035,101 @@ func (m *TransferLeaderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TransferLeaderResponse proto.InternalMessageInfo
 
+// ResolvedTsRequest advances the safe ts of all replicas, no transaction in the region
+// commits at or below resolved_ts after the entry is applied.
+type ResolvedTsRequest struct {
+	ResolvedTs           uint64   `protobuf:"varint,1,opt,name=resolved_ts,json=resolvedTs,proto3" json:"resolved_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolvedTsRequest) Reset()         { *m = ResolvedTsRequest{} }
+func (m *ResolvedTsRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolvedTsRequest) ProtoMessage()    {}
+func (*ResolvedTsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{18}
+}
+func (m *ResolvedTsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolvedTsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolvedTsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResolvedTsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolvedTsRequest.Merge(dst, src)
+}
+func (m *ResolvedTsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolvedTsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolvedTsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolvedTsRequest proto.InternalMessageInfo
+
+func (m *ResolvedTsRequest) GetResolvedTs() uint64 {
+	if m != nil {
+		return m.ResolvedTs
+	}
+	return 0
+}
+
+type ResolvedTsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolvedTsResponse) Reset()         { *m = ResolvedTsResponse{} }
+func (m *ResolvedTsResponse) String() string { return proto.CompactTextString(m) }
+func (*ResolvedTsResponse) ProtoMessage()    {}
+func (*ResolvedTsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{19}
+}
+func (m *ResolvedTsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolvedTsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolvedTsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResolvedTsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolvedTsResponse.Merge(dst, src)
+}
+func (m *ResolvedTsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolvedTsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolvedTsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolvedTsResponse proto.InternalMessageInfo
+
 type AdminRequest struct {
 	CmdType              AdminCmdType           `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
 	ChangePeer           *ChangePeerRequest     `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
 	CompactLog           *CompactLogRequest     `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
 	TransferLeader       *TransferLeaderRequest `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
 	Split                *SplitRequest          `protobuf:"bytes,10,opt,name=split" json:"split,omitempty"`
+	ResolvedTs           *ResolvedTsRequest     `protobuf:"bytes,11,opt,name=resolved_ts,json=resolvedTs" json:"resolved_ts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -1048,7 +1139,7 @@ func (m *AdminRequest) Reset()         { *m = AdminRequest{} }
 func (m *AdminRequest) String() string { return proto.CompactTextString(m) }
 func (*AdminRequest) ProtoMessage()    {}
 func (*AdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{18}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{20}
 }
 func (m *AdminRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1112,12 +1203,20 @@ func (m *AdminRequest) GetSplit() *SplitRequest {
 	return nil
 }
 
+func (m *AdminRequest) GetResolvedTs() *ResolvedTsRequest {
+	if m != nil {
+		return m.ResolvedTs
+	}
+	return nil
+}
+
 type AdminResponse struct {
 	CmdType              AdminCmdType            `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
 	ChangePeer           *ChangePeerResponse     `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
 	CompactLog           *CompactLogResponse     `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
 	TransferLeader       *TransferLeaderResponse `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
 	Split                *SplitResponse          `protobuf:"bytes,10,opt,name=split" json:"split,omitempty"`
+	ResolvedTs           *ResolvedTsResponse     `protobuf:"bytes,11,opt,name=resolved_ts,json=resolvedTs" json:"resolved_ts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1127,7 +1226,7 @@ func (m *AdminResponse) Reset()         { *m = AdminResponse{} }
 func (m *AdminResponse) String() string { return proto.CompactTextString(m) }
 func (*AdminResponse) ProtoMessage()    {}
 func (*AdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{19}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{21}
 }
 func (m *AdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1191,11 +1290,21 @@ func (m *AdminResponse) GetSplit() *SplitResponse {
 	return nil
 }
 
+func (m *AdminResponse) GetResolvedTs() *ResolvedTsResponse {
+	if m != nil {
+		return m.ResolvedTs
+	}
+	return nil
+}
+
 type RaftRequestHeader struct {
 	RegionId             uint64              `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
 	Peer                 *metapb.Peer        `protobuf:"bytes,2,opt,name=peer" json:"peer,omitempty"`
 	RegionEpoch          *metapb.RegionEpoch `protobuf:"bytes,4,opt,name=region_epoch,json=regionEpoch" json:"region_epoch,omitempty"`
 	Term                 uint64              `protobuf:"varint,5,opt,name=term,proto3" json:"term,omitempty"`
+	ReplicaRead          bool                `protobuf:"varint,6,opt,name=replica_read,json=replicaRead,proto3" json:"replica_read,omitempty"`
+	StaleRead            bool                `protobuf:"varint,7,opt,name=stale_read,json=staleRead,proto3" json:"stale_read,omitempty"`
+	ReadTs               uint64              `protobuf:"varint,8,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -1205,7 +1314,7 @@ func (m *RaftRequestHeader) Reset()         { *m = RaftRequestHeader{} }
 func (m *RaftRequestHeader) String() string { return proto.CompactTextString(m) }
 func (*RaftRequestHeader) ProtoMessage()    {}
 func (*RaftRequestHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{20}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{22}
 }
 func (m *RaftRequestHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1262,6 +1371,27 @@ func (m *RaftRequestHeader) GetTerm() uint64 {
 	return 0
 }
 
+func (m *RaftRequestHeader) GetReplicaRead() bool {
+	if m != nil {
+		return m.ReplicaRead
+	}
+	return false
+}
+
+func (m *RaftRequestHeader) GetStaleRead() bool {
+	if m != nil {
+		return m.StaleRead
+	}
+	return false
+}
+
+func (m *RaftRequestHeader) GetReadTs() uint64 {
+	if m != nil {
+		return m.ReadTs
+	}
+	return 0
+}
+
 type RaftResponseHeader struct {
 	Error                *errorpb.Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 	Uuid                 []byte         `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
@@ -1275,7 +1405,7 @@ func (m *RaftResponseHeader) Reset()         { *m = RaftResponseHeader{} }
 func (m *RaftResponseHeader) String() string { return proto.CompactTextString(m) }
 func (*RaftResponseHeader) ProtoMessage()    {}
 func (*RaftResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{21}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{23}
 }
 func (m *RaftResponseHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1340,7 +1470,7 @@ func (m *RaftCmdRequest) Reset()         { *m = RaftCmdRequest{} }
 func (m *RaftCmdRequest) String() string { return proto.CompactTextString(m) }
 func (*RaftCmdRequest) ProtoMessage()    {}
 func (*RaftCmdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{22}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{24}
 }
 func (m *RaftCmdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1403,7 +1533,7 @@ func (m *RaftCmdResponse) Reset()         { *m = RaftCmdResponse{} }
 func (m *RaftCmdResponse) String() string { return proto.CompactTextString(m) }
 func (*RaftCmdResponse) ProtoMessage()    {}
 func (*RaftCmdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{23}
+	return fileDescriptor_raft_cmdpb_3d663e41a8277ed9, []int{25}
 }
 func (m *RaftCmdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,6 +1602,8 @@ func init() {
 	proto.RegisterType((*CompactLogResponse)(nil), "raft_cmdpb.CompactLogResponse")
 	proto.RegisterType((*TransferLeaderRequest)(nil), "raft_cmdpb.TransferLeaderRequest")
 	proto.RegisterType((*TransferLeaderResponse)(nil), "raft_cmdpb.TransferLeaderResponse")
+	proto.RegisterType((*ResolvedTsRequest)(nil), "raft_cmdpb.ResolvedTsRequest")
+	proto.RegisterType((*ResolvedTsResponse)(nil), "raft_cmdpb.ResolvedTsResponse")
 	proto.RegisterType((*AdminRequest)(nil), "raft_cmdpb.AdminRequest")
 	proto.RegisterType((*AdminResponse)(nil), "raft_cmdpb.AdminResponse")
 	proto.RegisterType((*RaftRequestHeader)(nil), "raft_cmdpb.RaftRequestHeader")
@@ -2092,6 +2224,53 @@ func (m *TransferLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ResolvedTsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolvedTsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedTs != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ResolvedTs))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResolvedTsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolvedTsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *AdminRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2152,6 +2331,16 @@ func (m *AdminRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n18
 	}
+	if m.ResolvedTs != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ResolvedTs.Size()))
+		n19, err := m.ResolvedTs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2182,41 +2371,51 @@ func (m *AdminResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n19, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		n20, err := m.ChangePeer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n20
 	}
 	if m.CompactLog != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactLog.Size()))
-		n20, err := m.CompactLog.MarshalTo(dAtA[i:])
+		n21, err := m.CompactLog.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n21
 	}
 	if m.TransferLeader != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n21, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		n22, err := m.TransferLeader.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	if m.Split != nil {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Split.Size()))
-		n22, err := m.Split.MarshalTo(dAtA[i:])
+		n23, err := m.Split.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n23
+	}
+	if m.ResolvedTs != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ResolvedTs.Size()))
+		n24, err := m.ResolvedTs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2248,27 +2447,52 @@ func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
-		n23, err := m.Peer.MarshalTo(dAtA[i:])
+		n25, err := m.Peer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n25
 	}
 	if m.RegionEpoch != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionEpoch.Size()))
-		n24, err := m.RegionEpoch.MarshalTo(dAtA[i:])
+		n26, err := m.RegionEpoch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n26
 	}
 	if m.Term != 0 {
 		dAtA[i] = 0x28
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Term))
 	}
+	if m.ReplicaRead {
+		dAtA[i] = 0x30
+		i++
+		if m.ReplicaRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.StaleRead {
+		dAtA[i] = 0x38
+		i++
+		if m.StaleRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ReadTs != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ReadTs))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2294,11 +2518,11 @@ func (m *RaftResponseHeader) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Error.Size()))
-		n25, err := m.Error.MarshalTo(dAtA[i:])
+		n27, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n27
 	}
 	if len(m.Uuid) > 0 {
 		dAtA[i] = 0x12
@@ -2336,11 +2560,11 @@ func (m *RaftCmdRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Header.Size()))
-		n26, err := m.Header.MarshalTo(dAtA[i:])
+		n28, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n28
 	}
 	if len(m.Requests) > 0 {
 		for _, msg := range m.Requests {
@@ -2358,11 +2582,11 @@ func (m *RaftCmdRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AdminRequest.Size()))
-		n27, err := m.AdminRequest.MarshalTo(dAtA[i:])
+		n29, err := m.AdminRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n29
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2389,11 +2613,11 @@ func (m *RaftCmdResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Header.Size()))
-		n28, err := m.Header.MarshalTo(dAtA[i:])
+		n30, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n30
 	}
 	if len(m.Responses) > 0 {
 		for _, msg := range m.Responses {
@@ -2411,11 +2635,11 @@ func (m *RaftCmdResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AdminResponse.Size()))
-		n29, err := m.AdminResponse.MarshalTo(dAtA[i:])
+		n31, err := m.AdminResponse.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n31
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2709,6 +2933,27 @@ func (m *TransferLeaderResponse) Size() (n int) {
 	return n
 }
 
+func (m *ResolvedTsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ResolvedTs != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.ResolvedTs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResolvedTsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AdminRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -2731,6 +2976,10 @@ func (m *AdminRequest) Size() (n int) {
 		l = m.Split.Size()
 		n += 1 + l + sovRaftCmdpb(uint64(l))
 	}
+	if m.ResolvedTs != nil {
+		l = m.ResolvedTs.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2759,6 +3008,10 @@ func (m *AdminResponse) Size() (n int) {
 		l = m.Split.Size()
 		n += 1 + l + sovRaftCmdpb(uint64(l))
 	}
+	if m.ResolvedTs != nil {
+		l = m.ResolvedTs.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2782,6 +3035,15 @@ func (m *RaftRequestHeader) Size() (n int) {
 	if m.Term != 0 {
 		n += 1 + sovRaftCmdpb(uint64(m.Term))
 	}
+	if m.ReplicaRead {
+		n += 2
+	}
+	if m.StaleRead {
+		n += 2
+	}
+	if m.ReadTs != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.ReadTs))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4661,6 +4923,127 @@ func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResolvedTsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolvedTsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolvedTsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedTs", wireType)
+			}
+			m.ResolvedTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolvedTsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolvedTsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolvedTsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4841,6 +5224,39 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResolvedTs == nil {
+				m.ResolvedTs = &ResolvedTsRequest{}
+			}
+			if err := m.ResolvedTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -5043,6 +5459,39 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResolvedTs == nil {
+				m.ResolvedTs = &ResolvedTsResponse{}
+			}
+			if err := m.ResolvedTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -5198,6 +5647,65 @@ func (m *RaftRequestHeader) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaRead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReplicaRead = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleRead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StaleRead = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -5755,75 +6263,82 @@ var (
 	ErrIntOverflowRaftCmdpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("raft_cmdpb.proto", fileDescriptor_raft_cmdpb_409ff26e8ae8c248) }
-
-var fileDescriptor_raft_cmdpb_409ff26e8ae8c248 = []byte{
-	// 1068 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x0e, 0x45, 0xea, 0xc7, 0x43, 0x52, 0xa1, 0x37, 0x6e, 0x4c, 0x3b, 0xa8, 0xa0, 0x30, 0x45,
-	0xe1, 0xa4, 0x85, 0x82, 0x38, 0xa8, 0xd1, 0x00, 0x6d, 0xd2, 0xd6, 0x09, 0x52, 0x37, 0x39, 0x18,
-	0x1b, 0xdf, 0x7a, 0x20, 0x18, 0x72, 0x25, 0x0b, 0x95, 0x48, 0x9a, 0xa4, 0xe2, 0xfa, 0x4d, 0x7a,
-	0xea, 0x6b, 0xf4, 0x98, 0x4b, 0x0f, 0x3d, 0xf6, 0x11, 0x0a, 0xf7, 0xdc, 0x4b, 0x9f, 0xa0, 0xd8,
-	0xdd, 0x59, 0xfe, 0x48, 0x72, 0x9b, 0xf4, 0x24, 0xee, 0xec, 0xcc, 0xc7, 0x6f, 0xbe, 0x9d, 0x6f,
-	0x45, 0x70, 0xb2, 0x60, 0x5c, 0xf8, 0xe1, 0x3c, 0x4a, 0x5f, 0x8f, 0xd2, 0x2c, 0x29, 0x12, 0x02,
-	0x55, 0x64, 0xd7, 0x9a, 0xb3, 0x22, 0x50, 0x3b, 0xbb, 0x36, 0xcb, 0xb2, 0x24, 0xab, 0x2f, 0x83,
-	0x71, 0xa1, 0x96, 0xde, 0x08, 0xe0, 0x39, 0x2b, 0x28, 0x3b, 0x5b, 0xb0, 0xbc, 0x20, 0x7d, 0x68,
-	0x85, 0x63, 0x57, 0x1b, 0x6a, 0x7b, 0x1b, 0xb4, 0x15, 0x8e, 0x89, 0x03, 0xfa, 0x0f, 0xec, 0xc2,
-	0x6d, 0x0d, 0xb5, 0x3d, 0x8b, 0xf2, 0x47, 0xef, 0x0e, 0x98, 0x22, 0x3f, 0x4f, 0x93, 0x38, 0x67,
-	0x64, 0x0b, 0xda, 0x6f, 0x82, 0xd9, 0x82, 0x89, 0x1a, 0x8b, 0xca, 0x85, 0xf7, 0x14, 0xe0, 0x78,
-	0xf1, 0xee, 0xa0, 0x15, 0x8a, 0x5e, 0x47, 0xb1, 0xc1, 0x14, 0x28, 0xf2, 0x55, 0xde, 0x03, 0xb0,
-	0x9f, 0xb2, 0x19, 0x2b, 0xd8, 0xbb, 0x93, 0x75, 0xa0, 0xaf, 0x4a, 0x10, 0xc4, 0x06, 0xf3, 0x55,
-	0x1c, 0xa4, 0x08, 0xe1, 0x1d, 0x80, 0x25, 0x97, 0xd8, 0xce, 0xc7, 0xd0, 0xc9, 0xd8, 0x64, 0x9a,
-	0xc4, 0x02, 0xd6, 0xdc, 0xef, 0x8f, 0x50, 0x4a, 0x2a, 0xa2, 0x14, 0x77, 0xbd, 0xbf, 0x34, 0xe8,
-	0x2a, 0x1a, 0x23, 0xe8, 0x85, 0xf3, 0xc8, 0x2f, 0x2e, 0x52, 0xa9, 0x42, 0x7f, 0xff, 0xc6, 0xa8,
-	0x76, 0x3c, 0x87, 0xf3, 0xe8, 0xe4, 0x22, 0x65, 0xb4, 0x1b, 0xca, 0x07, 0xb2, 0x07, 0xfa, 0x84,
-	0x15, 0x82, 0xa6, 0xb9, 0x7f, 0xb3, 0x9e, 0x5a, 0x1d, 0x04, 0xe5, 0x29, 0x3c, 0x33, 0x5d, 0x14,
-	0xae, 0xb1, 0x9a, 0x59, 0xa9, 0x4b, 0x79, 0x0a, 0x79, 0x00, 0x9d, 0x48, 0x34, 0xea, 0xb6, 0x45,
-	0xf2, 0x4e, 0x3d, 0xb9, 0xa1, 0x1a, 0xc5, 0x44, 0xf2, 0x09, 0x18, 0x79, 0x1c, 0xa4, 0x6e, 0x47,
-	0x14, 0x6c, 0xd7, 0x0b, 0x6a, 0x0a, 0x51, 0x91, 0xe4, 0xfd, 0xad, 0x41, 0xaf, 0x14, 0xe9, 0x7d,
-	0x1b, 0xbe, 0x5b, 0x6f, 0x78, 0x7b, 0xa5, 0x61, 0x89, 0x2a, 0x3b, 0xbe, 0x5b, 0xef, 0x78, 0x7b,
-	0xa5, 0x63, 0x95, 0xca, 0x5b, 0xde, 0x5f, 0x6a, 0x79, 0x77, 0x5d, 0xcb, 0x58, 0xa0, 0x7a, 0xfe,
-	0xb4, 0xd1, 0xb3, 0xbb, 0xda, 0x33, 0xe6, 0xcb, 0xa6, 0x13, 0xd8, 0x3c, 0x3c, 0x0d, 0xe2, 0x09,
-	0x3b, 0x66, 0x2c, 0x53, 0xa7, 0xfd, 0x39, 0x98, 0xa1, 0x08, 0xd6, 0xfb, 0xdf, 0x1e, 0x29, 0x53,
-	0x1d, 0x26, 0xf1, 0x58, 0x16, 0x09, 0x0d, 0x20, 0x2c, 0x9f, 0xc9, 0x10, 0x8c, 0x94, 0xb1, 0x0c,
-	0x75, 0xb0, 0xd4, 0x64, 0x09, 0x70, 0xb1, 0xe3, 0x7d, 0x01, 0xa4, 0xfe, 0xc2, 0xf7, 0x9c, 0xc9,
-	0x33, 0xb0, 0x5e, 0xa5, 0xb3, 0x69, 0x69, 0xbb, 0x5b, 0xb0, 0x91, 0xf3, 0xb5, 0xcf, 0x4d, 0x21,
-	0xed, 0xd9, 0x13, 0x81, 0x17, 0xec, 0x82, 0x78, 0x60, 0xc7, 0xec, 0xdc, 0x97, 0xa5, 0xfe, 0x34,
-	0x12, 0xac, 0x0c, 0x6a, 0xc6, 0xec, 0x5c, 0xc2, 0x1e, 0x45, 0x64, 0x08, 0x16, 0xcf, 0xe1, 0xd4,
-	0xfc, 0x69, 0x94, 0xbb, 0xfa, 0x50, 0xdf, 0x33, 0x28, 0xc4, 0xec, 0x9c, 0xf3, 0x3b, 0x8a, 0x72,
-	0xef, 0x11, 0xd8, 0xf8, 0x4a, 0xe4, 0xba, 0x07, 0x5d, 0x09, 0x99, 0xbb, 0xda, 0x50, 0x5f, 0x43,
-	0x56, 0x6d, 0x7b, 0xdf, 0xc3, 0xe6, 0x61, 0x32, 0x4f, 0x83, 0xb0, 0x78, 0x99, 0x4c, 0x14, 0xe5,
-	0x3b, 0x60, 0x87, 0x32, 0xe8, 0x4f, 0xe3, 0x88, 0xfd, 0x28, 0x68, 0x1b, 0xd4, 0xc2, 0xe0, 0x11,
-	0x8f, 0x91, 0xdb, 0xa0, 0xd6, 0x7e, 0xc1, 0xb2, 0xb9, 0x62, 0x8e, 0xb1, 0x13, 0x96, 0xcd, 0xbd,
-	0x2d, 0x20, 0x75, 0x70, 0xf4, 0xfe, 0x23, 0xf8, 0xe0, 0x24, 0x0b, 0xe2, 0x7c, 0xcc, 0xb2, 0x97,
-	0x2c, 0x88, 0xaa, 0x33, 0x55, 0x27, 0xa3, 0x5d, 0x79, 0x32, 0x2e, 0xdc, 0x5c, 0x2e, 0x45, 0xd0,
-	0xb7, 0x2d, 0xb0, 0xbe, 0x8e, 0xe6, 0xd3, 0x58, 0x81, 0x3d, 0x5c, 0x71, 0x47, 0x63, 0xce, 0x44,
-	0xee, 0x8a, 0x45, 0x1e, 0x97, 0x53, 0x55, 0x1b, 0x91, 0x0f, 0x1b, 0xae, 0x5a, 0x9e, 0x44, 0x35,
-	0x5b, 0x3c, 0x24, 0xea, 0x51, 0x93, 0x59, 0x32, 0x41, 0xff, 0x34, 0xeb, 0x97, 0xc5, 0xa6, 0x10,
-	0x96, 0x21, 0xf2, 0x1d, 0x5c, 0x2f, 0xb0, 0x3f, 0x7f, 0x26, 0x1a, 0x44, 0x57, 0xdd, 0xae, 0x63,
-	0xac, 0x55, 0x8f, 0xf6, 0x8b, 0x46, 0x98, 0x8c, 0xa0, 0x2d, 0xc6, 0xcc, 0x85, 0x35, 0x2e, 0xab,
-	0x0d, 0x28, 0x95, 0x69, 0xde, 0xaf, 0x2d, 0xb0, 0x51, 0x41, 0x9c, 0xa2, 0xff, 0x25, 0xe1, 0x93,
-	0x75, 0x12, 0x0e, 0xae, 0x92, 0x10, 0x8d, 0x5e, 0xd7, 0xf0, 0xc9, 0x3a, 0x0d, 0x07, 0x57, 0x69,
-	0x58, 0x02, 0x54, 0x22, 0xbe, 0xb8, 0x4a, 0x44, 0xef, 0xdf, 0x44, 0x44, 0xa0, 0x65, 0x15, 0xef,
-	0x37, 0x55, 0xdc, 0x59, 0xa3, 0x22, 0x56, 0xa2, 0x8c, 0x3f, 0x6b, 0xb0, 0x49, 0x83, 0xb1, 0x52,
-	0xf7, 0x5b, 0x09, 0x73, 0x0b, 0x36, 0x2a, 0x8f, 0x4b, 0x37, 0xf5, 0xb2, 0xca, 0xe0, 0xff, 0x71,
-	0x23, 0x91, 0x03, 0xb0, 0xb0, 0x9c, 0xa5, 0x49, 0x78, 0x8a, 0xa2, 0xdc, 0x68, 0x9a, 0xfa, 0x19,
-	0xdf, 0xa2, 0x66, 0x56, 0x2d, 0x08, 0x01, 0x43, 0x78, 0xb3, 0x2d, 0xde, 0x28, 0x9e, 0xbd, 0x33,
-	0x20, 0x92, 0x9f, 0xe4, 0x8d, 0x04, 0x3f, 0x82, 0xb6, 0xf8, 0x3e, 0x29, 0x2f, 0x37, 0xf5, 0xb5,
-	0xf2, 0x8c, 0xff, 0x52, 0xb9, 0xc9, 0xf1, 0x16, 0x0b, 0xbc, 0xa5, 0x2c, 0x2a, 0x9e, 0xc5, 0x3d,
-	0xb0, 0xc8, 0x32, 0x16, 0xe3, 0x3d, 0xa0, 0xe3, 0x3d, 0x20, 0x63, 0xe2, 0x1e, 0xf8, 0x45, 0x83,
-	0x3e, 0x7f, 0xe7, 0xe1, 0x3c, 0x52, 0xf6, 0xfc, 0x0c, 0x3a, 0xa7, 0xf2, 0x6c, 0xb4, 0x55, 0x93,
-	0xac, 0xe8, 0x47, 0x31, 0x99, 0xdc, 0x87, 0x5e, 0x26, 0x37, 0x72, 0xb7, 0x25, 0x6e, 0xb6, 0xc6,
-	0x7f, 0x9e, 0x1a, 0xe9, 0x32, 0x89, 0x7c, 0x09, 0x76, 0xc0, 0xe7, 0xd4, 0xc7, 0x88, 0xa0, 0x67,
-	0xae, 0x19, 0x64, 0x55, 0x6a, 0x05, 0xb5, 0x95, 0xf7, 0x56, 0x83, 0xeb, 0x25, 0x73, 0xb4, 0xc5,
-	0xc1, 0x12, 0xf5, 0xc1, 0x2a, 0xf5, 0xba, 0xb4, 0x25, 0xf7, 0x7d, 0x3e, 0x03, 0x72, 0x47, 0x91,
-	0xdf, 0x6a, 0x92, 0xc7, 0x49, 0xaa, 0xd2, 0xc8, 0x57, 0xd0, 0x57, 0xf4, 0x65, 0x08, 0xf9, 0xef,
-	0xac, 0xe1, 0x8f, 0xd5, 0x76, 0x50, 0x5f, 0xde, 0x7b, 0x0c, 0x5d, 0xf4, 0x28, 0x31, 0xa1, 0x7b,
-	0x14, 0xbf, 0x09, 0x66, 0xd3, 0xc8, 0xb9, 0x46, 0xba, 0xa0, 0x3f, 0x67, 0x85, 0xa3, 0xf1, 0x87,
-	0xe3, 0x45, 0xe1, 0xe8, 0x04, 0xa0, 0x23, 0xff, 0xaf, 0x1d, 0x83, 0xf4, 0xc0, 0xe0, 0xff, 0xc4,
-	0x4e, 0xfb, 0x9e, 0x8f, 0xf7, 0xaa, 0x02, 0x71, 0xc0, 0x42, 0x10, 0x11, 0x76, 0xae, 0x91, 0x3e,
-	0x40, 0x65, 0x69, 0x47, 0x13, 0xeb, 0xd2, 0x8d, 0x8e, 0x4e, 0x08, 0xf4, 0x9b, 0x66, 0x73, 0x0c,
-	0xb2, 0x01, 0x6d, 0xe1, 0x1e, 0x07, 0xbe, 0x71, 0x7e, 0xbb, 0x1c, 0x68, 0xbf, 0x5f, 0x0e, 0xb4,
-	0x3f, 0x2e, 0x07, 0xda, 0x4f, 0x7f, 0x0e, 0xae, 0xbd, 0xee, 0x88, 0x4f, 0xe2, 0x87, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x26, 0xb1, 0x93, 0xfc, 0x5e, 0x0b, 0x00, 0x00,
+func init() { proto.RegisterFile("raft_cmdpb.proto", fileDescriptor_raft_cmdpb_3d663e41a8277ed9) }
+
+var fileDescriptor_raft_cmdpb_3d663e41a8277ed9 = []byte{
+	// 1174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x72, 0xdc, 0x44,
+	0x10, 0xb6, 0x2c, 0xed, 0x8f, 0x5b, 0xda, 0x8d, 0x3c, 0x31, 0xb1, 0xe2, 0x54, 0x96, 0x8d, 0x42,
+	0x51, 0x4e, 0xa0, 0x36, 0x15, 0x07, 0x5c, 0xa4, 0x0a, 0x62, 0xc0, 0x49, 0x05, 0x93, 0x1c, 0x5c,
+	0x13, 0xdf, 0x38, 0xa8, 0x14, 0x69, 0xd6, 0xde, 0x62, 0x57, 0x92, 0x47, 0x5a, 0x1b, 0x3f, 0x00,
+	0xef, 0xc0, 0x9b, 0x70, 0xe4, 0xca, 0x91, 0x47, 0xa0, 0xcc, 0x99, 0x0b, 0x37, 0x4e, 0x50, 0xf3,
+	0x27, 0x8d, 0x56, 0x6b, 0x48, 0x72, 0xda, 0xe9, 0x9e, 0xee, 0xd6, 0xd7, 0xfd, 0x75, 0xb7, 0xb4,
+	0xe0, 0xd2, 0x70, 0x5c, 0x04, 0xd1, 0x2c, 0xce, 0x5e, 0x8f, 0x32, 0x9a, 0x16, 0x29, 0x82, 0x4a,
+	0xb3, 0xe5, 0xcc, 0x48, 0x11, 0xaa, 0x9b, 0xad, 0x1e, 0xa1, 0x34, 0xa5, 0xba, 0x18, 0x8e, 0x0b,
+	0x25, 0xfa, 0x23, 0x80, 0xe7, 0xa4, 0xc0, 0xe4, 0x74, 0x4e, 0xf2, 0x02, 0xf5, 0x61, 0x35, 0x1a,
+	0x7b, 0xc6, 0xd0, 0xd8, 0x5e, 0xc3, 0xab, 0xd1, 0x18, 0xb9, 0x60, 0x7e, 0x4f, 0x2e, 0xbc, 0xd5,
+	0xa1, 0xb1, 0xed, 0x60, 0x76, 0xf4, 0xef, 0x82, 0xcd, 0xed, 0xf3, 0x2c, 0x4d, 0x72, 0x82, 0x36,
+	0xa0, 0x75, 0x16, 0x4e, 0xe7, 0x84, 0xfb, 0x38, 0x58, 0x08, 0xfe, 0x53, 0x80, 0xc3, 0xf9, 0x9b,
+	0x07, 0xad, 0xa2, 0x98, 0x7a, 0x94, 0x1e, 0xd8, 0x87, 0xf3, 0xf2, 0x51, 0xfe, 0x43, 0xe8, 0x3d,
+	0x25, 0x53, 0x52, 0x90, 0x37, 0x07, 0xeb, 0x42, 0x5f, 0xb9, 0xc8, 0x20, 0x3d, 0xb0, 0x5f, 0x25,
+	0x61, 0x26, 0x43, 0xf8, 0xbb, 0xe0, 0x08, 0x51, 0xa6, 0xf3, 0x21, 0xb4, 0x29, 0x39, 0x9e, 0xa4,
+	0x09, 0x0f, 0x6b, 0xef, 0xf4, 0x47, 0xb2, 0x94, 0x98, 0x6b, 0xb1, 0xbc, 0xf5, 0xff, 0x34, 0xa0,
+	0xa3, 0x60, 0x8c, 0xa0, 0x1b, 0xcd, 0xe2, 0xa0, 0xb8, 0xc8, 0x44, 0x15, 0xfa, 0x3b, 0xd7, 0x47,
+	0x1a, 0x3d, 0xfb, 0xb3, 0xf8, 0xe8, 0x22, 0x23, 0xb8, 0x13, 0x89, 0x03, 0xda, 0x06, 0xf3, 0x98,
+	0x14, 0x1c, 0xa6, 0xbd, 0x73, 0x43, 0x37, 0xad, 0x88, 0xc0, 0xcc, 0x84, 0x59, 0x66, 0xf3, 0xc2,
+	0xb3, 0x9a, 0x96, 0x55, 0x75, 0x31, 0x33, 0x41, 0x0f, 0xa1, 0x1d, 0xf3, 0x44, 0xbd, 0x16, 0x37,
+	0xbe, 0xa9, 0x1b, 0xd7, 0xaa, 0x86, 0xa5, 0x21, 0xfa, 0x08, 0xac, 0x3c, 0x09, 0x33, 0xaf, 0xcd,
+	0x1d, 0x36, 0x75, 0x07, 0xad, 0x42, 0x98, 0x1b, 0xf9, 0x7f, 0x19, 0xd0, 0x2d, 0x8b, 0xf4, 0xb6,
+	0x09, 0xdf, 0xd3, 0x13, 0xde, 0x6c, 0x24, 0x2c, 0xa2, 0x8a, 0x8c, 0xef, 0xe9, 0x19, 0x6f, 0x36,
+	0x32, 0x56, 0xa6, 0x2c, 0xe5, 0x9d, 0x85, 0x94, 0xb7, 0x96, 0xa5, 0x2c, 0x1d, 0x54, 0xce, 0x1f,
+	0xd7, 0x72, 0xf6, 0x9a, 0x39, 0x4b, 0x7b, 0x91, 0x74, 0x0a, 0xeb, 0xfb, 0x27, 0x61, 0x72, 0x4c,
+	0x0e, 0x09, 0xa1, 0x8a, 0xed, 0xcf, 0xc0, 0x8e, 0xb8, 0x52, 0xcf, 0x7f, 0x73, 0xa4, 0x86, 0x6a,
+	0x3f, 0x4d, 0xc6, 0xc2, 0x89, 0xd7, 0x00, 0xa2, 0xf2, 0x8c, 0x86, 0x60, 0x65, 0x84, 0x50, 0x59,
+	0x07, 0x47, 0x75, 0x16, 0x0f, 0xce, 0x6f, 0xfc, 0xcf, 0x01, 0xe9, 0x0f, 0x7c, 0xcb, 0x9e, 0x3c,
+	0x05, 0xe7, 0x55, 0x36, 0x9d, 0x94, 0x63, 0x77, 0x0b, 0xd6, 0x72, 0x26, 0x07, 0x6c, 0x28, 0xc4,
+	0x78, 0x76, 0xb9, 0xe2, 0x05, 0xb9, 0x40, 0x3e, 0xf4, 0x12, 0x72, 0x1e, 0x08, 0xd7, 0x60, 0x12,
+	0x73, 0x54, 0x16, 0xb6, 0x13, 0x72, 0x2e, 0xc2, 0x1e, 0xc4, 0x68, 0x08, 0x0e, 0xb3, 0x61, 0xd0,
+	0x82, 0x49, 0x9c, 0x7b, 0xe6, 0xd0, 0xdc, 0xb6, 0x30, 0x24, 0xe4, 0x9c, 0xe1, 0x3b, 0x88, 0x73,
+	0xff, 0x31, 0xf4, 0xe4, 0x23, 0x25, 0xd6, 0x6d, 0xe8, 0x88, 0x90, 0xb9, 0x67, 0x0c, 0xcd, 0x25,
+	0x60, 0xd5, 0xb5, 0xff, 0x1d, 0xac, 0xef, 0xa7, 0xb3, 0x2c, 0x8c, 0x8a, 0x97, 0xe9, 0xb1, 0x82,
+	0x7c, 0x17, 0x7a, 0x91, 0x50, 0x06, 0x93, 0x24, 0x26, 0x3f, 0x70, 0xd8, 0x16, 0x76, 0xa4, 0xf2,
+	0x80, 0xe9, 0xd0, 0x1d, 0x50, 0x72, 0x50, 0x10, 0x3a, 0x53, 0xc8, 0xa5, 0xee, 0x88, 0xd0, 0x99,
+	0xbf, 0x01, 0x48, 0x0f, 0x2e, 0x67, 0xff, 0x31, 0xbc, 0x77, 0x44, 0xc3, 0x24, 0x1f, 0x13, 0xfa,
+	0x92, 0x84, 0x71, 0xc5, 0xa9, 0x62, 0xc6, 0xb8, 0x92, 0x19, 0x0f, 0x6e, 0x2c, 0xba, 0xca, 0xa0,
+	0x9f, 0xc0, 0x3a, 0x26, 0x79, 0x3a, 0x3d, 0x23, 0xf1, 0x51, 0xae, 0x02, 0xbe, 0x0f, 0x36, 0x95,
+	0xca, 0xa0, 0xc8, 0x65, 0x16, 0x40, 0x4b, 0x3b, 0x06, 0x50, 0xf7, 0x92, 0xb1, 0xfe, 0x59, 0x05,
+	0xe7, 0xab, 0x78, 0x36, 0x49, 0x54, 0x9c, 0x47, 0x8d, 0x49, 0xab, 0xf5, 0x2c, 0xb7, 0x6d, 0x8c,
+	0xdb, 0x93, 0xb2, 0x43, 0xb5, 0x76, 0xbb, 0x5d, 0x9b, 0xd0, 0xc5, 0xae, 0x56, 0x7d, 0xca, 0x54,
+	0xdc, 0x5f, 0xd6, 0x77, 0x9a, 0x1e, 0x7b, 0xd6, 0x12, 0xff, 0x45, 0xe2, 0x30, 0x44, 0xa5, 0x0a,
+	0x7d, 0x0b, 0xd7, 0x0a, 0x59, 0xab, 0x60, 0xca, 0x8b, 0x25, 0x27, 0xf4, 0x8e, 0x1e, 0x63, 0x29,
+	0x13, 0xb8, 0x5f, 0xd4, 0xd4, 0x68, 0x04, 0x2d, 0xde, 0xb2, 0x1e, 0x2c, 0x99, 0x58, 0xad, 0xd9,
+	0xb1, 0x30, 0x63, 0xd8, 0xf5, 0xc2, 0xdb, 0x4d, 0xec, 0x0d, 0xb2, 0x6a, 0xbc, 0xfc, 0x68, 0x42,
+	0x4f, 0x32, 0x20, 0x3b, 0xfa, 0x9d, 0x28, 0xd8, 0x5b, 0x46, 0xc1, 0xe0, 0x2a, 0x0a, 0xe4, 0xd2,
+	0xd1, 0x39, 0xd8, 0x5b, 0xc6, 0xc1, 0xe0, 0x2a, 0x0e, 0xca, 0x00, 0x15, 0x09, 0x2f, 0xae, 0x22,
+	0xc1, 0xff, 0x2f, 0x12, 0x64, 0xa0, 0x45, 0x16, 0x1e, 0xd4, 0x59, 0xb8, 0xb9, 0x84, 0x05, 0xe9,
+	0x29, 0x69, 0xd8, 0x5b, 0x46, 0xc3, 0xe0, 0x2a, 0x1a, 0x14, 0x7c, 0x8d, 0x87, 0xbf, 0x0d, 0x58,
+	0xc7, 0xe1, 0x58, 0xd1, 0xfb, 0x8d, 0xc0, 0x71, 0x0b, 0xd6, 0xaa, 0x85, 0x25, 0x86, 0xaa, 0x4b,
+	0xab, 0x6d, 0xf5, 0x3f, 0xeb, 0x15, 0xed, 0x82, 0x23, 0xdd, 0x49, 0x96, 0x46, 0x27, 0xb2, 0xaa,
+	0xd7, 0xeb, 0x1b, 0xea, 0x19, 0xbb, 0xc2, 0x36, 0xad, 0x04, 0x84, 0xc0, 0xe2, 0x8b, 0xa6, 0xc5,
+	0x9f, 0xc8, 0xcf, 0x6c, 0x09, 0x51, 0x92, 0x4d, 0x27, 0x51, 0x18, 0x50, 0x12, 0xc6, 0xfc, 0x8d,
+	0xd2, 0xc5, 0xb6, 0xd4, 0x61, 0x12, 0xc6, 0xe8, 0x36, 0x40, 0x5e, 0x84, 0x53, 0x22, 0x0c, 0x3a,
+	0xdc, 0x60, 0x8d, 0x6b, 0xf8, 0xf5, 0x26, 0x5b, 0x95, 0x21, 0xaf, 0x4f, 0x97, 0x07, 0x6e, 0x33,
+	0xf1, 0x28, 0xf7, 0x4f, 0x01, 0x89, 0xd4, 0x45, 0x5d, 0x64, 0xee, 0x1f, 0x40, 0x8b, 0x7f, 0xc7,
+	0x95, 0x2f, 0x01, 0xf5, 0x55, 0xf7, 0x8c, 0xfd, 0x62, 0x71, 0xc9, 0xa0, 0xce, 0xe7, 0x72, 0x9b,
+	0x3b, 0x98, 0x9f, 0xf9, 0xbe, 0x9c, 0x53, 0x4a, 0x12, 0xb9, 0x2f, 0x4d, 0xb9, 0x2f, 0x85, 0x8e,
+	0xef, 0xcb, 0x9f, 0x0d, 0xe8, 0xb3, 0x67, 0xee, 0xcf, 0x62, 0xb5, 0x7a, 0x3e, 0x85, 0xf6, 0x89,
+	0xe8, 0x1b, 0x63, 0xc9, 0x10, 0x2d, 0x52, 0x83, 0xa5, 0x31, 0x7a, 0x00, 0x5d, 0x2a, 0x2e, 0x72,
+	0x6f, 0x95, 0xbf, 0x01, 0x6a, 0xdf, 0x06, 0x6a, 0xe6, 0x4a, 0x23, 0xf4, 0x05, 0xf4, 0x42, 0x36,
+	0x43, 0x81, 0xd4, 0x78, 0x66, 0x73, 0xd2, 0xf5, 0x9d, 0x88, 0x9d, 0x50, 0x93, 0xfc, 0x5f, 0x0c,
+	0xb8, 0x56, 0x22, 0x97, 0x23, 0xbb, 0xbb, 0x00, 0x7d, 0xd0, 0x84, 0xae, 0x97, 0xb6, 0xc4, 0xbe,
+	0xc3, 0xda, 0x4b, 0xdc, 0x28, 0xf0, 0x1b, 0x0b, 0x3d, 0xcb, 0x2f, 0x71, 0x65, 0x86, 0xbe, 0x84,
+	0xbe, 0x82, 0x2f, 0x54, 0x9e, 0xd9, 0x9c, 0x91, 0xda, 0x46, 0xc1, 0xbd, 0x50, 0x17, 0xef, 0x3f,
+	0x81, 0x8e, 0xdc, 0x1f, 0xc8, 0x86, 0xce, 0x41, 0x72, 0x16, 0x4e, 0x27, 0xb1, 0xbb, 0x82, 0x3a,
+	0x60, 0x3e, 0x27, 0x85, 0x6b, 0xb0, 0xc3, 0xe1, 0xbc, 0x70, 0x4d, 0x04, 0xd0, 0x16, 0xdf, 0x35,
+	0xae, 0x85, 0xba, 0x60, 0xb1, 0x2f, 0x16, 0xb7, 0x75, 0x3f, 0x95, 0xef, 0x0c, 0x15, 0xc4, 0x05,
+	0x47, 0x06, 0xe1, 0x6a, 0x77, 0x05, 0xf5, 0x01, 0xaa, 0x75, 0xe3, 0x1a, 0x5c, 0x2e, 0x37, 0x85,
+	0x6b, 0x22, 0x04, 0xfd, 0xfa, 0x22, 0x70, 0x2d, 0xb4, 0x06, 0x2d, 0x3e, 0xd9, 0x2e, 0x30, 0xf3,
+	0x6a, 0x5a, 0x5d, 0xfb, 0x6b, 0xf7, 0xd7, 0xcb, 0x81, 0xf1, 0xdb, 0xe5, 0xc0, 0xf8, 0xfd, 0x72,
+	0x60, 0xfc, 0xf4, 0xc7, 0x60, 0xe5, 0x75, 0x9b, 0xff, 0x95, 0x78, 0xf4, 0xef, 0x00, 0xa8, 0x67,
+	0xcd, 0x4a, 0x96, 0x0c, 0x00, 0x00,
 }
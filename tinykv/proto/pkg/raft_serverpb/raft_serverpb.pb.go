@@ -47,7 +47,7 @@ func (x PeerState) String() string {
 	return proto.EnumName(PeerState_name, int32(x))
 }
 func (PeerState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{0}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{0}
 }
 
 // The message sent between Raft peer, it wraps the raft meessage with some meta information.
@@ -71,7 +71,7 @@ func (m *RaftMessage) Reset()         { *m = RaftMessage{} }
 func (m *RaftMessage) String() string { return proto.CompactTextString(m) }
 func (*RaftMessage) ProtoMessage()    {}
 func (*RaftMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{0}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{0}
 }
 func (m *RaftMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -170,7 +170,7 @@ func (m *RaftLocalState) Reset()         { *m = RaftLocalState{} }
 func (m *RaftLocalState) String() string { return proto.CompactTextString(m) }
 func (*RaftLocalState) ProtoMessage()    {}
 func (*RaftLocalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{1}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{1}
 }
 func (m *RaftLocalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,17 +226,20 @@ type RaftApplyState struct {
 	// not apply any index twice after restart.
 	AppliedIndex uint64 `protobuf:"varint,1,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
 	// Record the index and term of the last raft log that have been truncated. (Used in 2C)
-	TruncatedState       *RaftTruncatedState `protobuf:"bytes,2,opt,name=truncated_state,json=truncatedState" json:"truncated_state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	TruncatedState *RaftTruncatedState `protobuf:"bytes,2,opt,name=truncated_state,json=truncatedState" json:"truncated_state,omitempty"`
+	// The index of the last entry which wrote data, the data of the region doesn't change until the next one. Admin
+	// commands don't write data, so unlike the applied index it isn't moved by them.
+	DataVersion          uint64   `protobuf:"varint,3,opt,name=data_version,json=dataVersion,proto3" json:"data_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RaftApplyState) Reset()         { *m = RaftApplyState{} }
 func (m *RaftApplyState) String() string { return proto.CompactTextString(m) }
 func (*RaftApplyState) ProtoMessage()    {}
 func (*RaftApplyState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{2}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{2}
 }
 func (m *RaftApplyState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,6 +282,13 @@ func (m *RaftApplyState) GetTruncatedState() *RaftTruncatedState {
 	return nil
 }
 
+func (m *RaftApplyState) GetDataVersion() uint64 {
+	if m != nil {
+		return m.DataVersion
+	}
+	return 0
+}
+
 // The truncated state for Raft log compaction.
 type RaftTruncatedState struct {
 	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
@@ -292,7 +302,7 @@ func (m *RaftTruncatedState) Reset()         { *m = RaftTruncatedState{} }
 func (m *RaftTruncatedState) String() string { return proto.CompactTextString(m) }
 func (*RaftTruncatedState) ProtoMessage()    {}
 func (*RaftTruncatedState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{3}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{3}
 }
 func (m *RaftTruncatedState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,7 +358,7 @@ func (m *RegionLocalState) Reset()         { *m = RegionLocalState{} }
 func (m *RegionLocalState) String() string { return proto.CompactTextString(m) }
 func (*RegionLocalState) ProtoMessage()    {}
 func (*RegionLocalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{4}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{4}
 }
 func (m *RegionLocalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +415,7 @@ func (m *StoreIdent) Reset()         { *m = StoreIdent{} }
 func (m *StoreIdent) String() string { return proto.CompactTextString(m) }
 func (*StoreIdent) ProtoMessage()    {}
 func (*StoreIdent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{5}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{5}
 }
 func (m *StoreIdent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +472,7 @@ func (m *KeyValue) Reset()         { *m = KeyValue{} }
 func (m *KeyValue) String() string { return proto.CompactTextString(m) }
 func (*KeyValue) ProtoMessage()    {}
 func (*KeyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{6}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{6}
 }
 func (m *KeyValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +529,7 @@ func (m *RaftSnapshotData) Reset()         { *m = RaftSnapshotData{} }
 func (m *RaftSnapshotData) String() string { return proto.CompactTextString(m) }
 func (*RaftSnapshotData) ProtoMessage()    {}
 func (*RaftSnapshotData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{7}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{7}
 }
 func (m *RaftSnapshotData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +599,7 @@ func (m *SnapshotCFFile) Reset()         { *m = SnapshotCFFile{} }
 func (m *SnapshotCFFile) String() string { return proto.CompactTextString(m) }
 func (*SnapshotCFFile) ProtoMessage()    {}
 func (*SnapshotCFFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{8}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{8}
 }
 func (m *SnapshotCFFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,7 +660,7 @@ func (m *SnapshotMeta) Reset()         { *m = SnapshotMeta{} }
 func (m *SnapshotMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotMeta) ProtoMessage()    {}
 func (*SnapshotMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{9}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{9}
 }
 func (m *SnapshotMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -698,7 +708,7 @@ func (m *SnapshotChunk) Reset()         { *m = SnapshotChunk{} }
 func (m *SnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*SnapshotChunk) ProtoMessage()    {}
 func (*SnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{10}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{10}
 }
 func (m *SnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +761,7 @@ func (m *Done) Reset()         { *m = Done{} }
 func (m *Done) String() string { return proto.CompactTextString(m) }
 func (*Done) ProtoMessage()    {}
 func (*Done) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{11}
+	return fileDescriptor_raft_serverpb_e86e4ffaca140b15, []int{11}
 }
 func (m *Done) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -954,6 +964,11 @@ func (m *RaftApplyState) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n6
 	}
+	if m.DataVersion != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.DataVersion))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1354,6 +1369,9 @@ func (m *RaftApplyState) Size() (n int) {
 		l = m.TruncatedState.Size()
 		n += 1 + l + sovRaftServerpb(uint64(l))
 	}
+	if m.DataVersion != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.DataVersion))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2009,6 +2027,25 @@ func (m *RaftApplyState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataVersion", wireType)
+			}
+			m.DataVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftServerpb(dAtA[iNdEx:])
@@ -3063,55 +3100,56 @@ var (
 	ErrIntOverflowRaftServerpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("raft_serverpb.proto", fileDescriptor_raft_serverpb_9d4bf28a94e26664) }
+func init() { proto.RegisterFile("raft_serverpb.proto", fileDescriptor_raft_serverpb_e86e4ffaca140b15) }
 
-var fileDescriptor_raft_serverpb_9d4bf28a94e26664 = []byte{
-	// 751 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_raft_serverpb_e86e4ffaca140b15 = []byte{
+	// 766 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xeb, 0x44,
-	0x10, 0x3e, 0x4e, 0xdc, 0xc4, 0x9e, 0x38, 0x21, 0xda, 0x83, 0x74, 0x4c, 0x8e, 0x4e, 0x94, 0x1a,
-	0x51, 0x85, 0x22, 0x05, 0x11, 0x10, 0xe2, 0x0a, 0x09, 0x28, 0x55, 0x43, 0x29, 0xaa, 0x36, 0x15,
-	0x12, 0x57, 0xd6, 0xd6, 0x1e, 0x37, 0xa6, 0x8e, 0x6d, 0xed, 0x6e, 0x2a, 0xd2, 0x1b, 0xc4, 0x5b,
-	0xf0, 0x22, 0x5c, 0xf0, 0x06, 0x5c, 0xf2, 0x08, 0xa8, 0xbc, 0x08, 0xda, 0x5d, 0x3b, 0x3f, 0x6d,
-	0x39, 0x57, 0xd9, 0x99, 0xef, 0xf3, 0xec, 0x37, 0xdf, 0x4c, 0x16, 0x5e, 0x72, 0x96, 0xc8, 0x50,
-	0x20, 0xbf, 0x43, 0x5e, 0x5e, 0x4f, 0x4a, 0x5e, 0xc8, 0x82, 0x74, 0xf7, 0x92, 0x83, 0x2e, 0xaa,
-	0xb8, 0x46, 0x07, 0xde, 0x12, 0x25, 0xab, 0xa3, 0xe0, 0xcf, 0x06, 0x74, 0x28, 0x4b, 0xe4, 0x05,
-	0x0a, 0xc1, 0x6e, 0x90, 0xbc, 0x06, 0x97, 0xe3, 0x4d, 0x5a, 0xe4, 0x61, 0x1a, 0xfb, 0xd6, 0xc8,
-	0x1a, 0xdb, 0xd4, 0x31, 0x89, 0x59, 0x4c, 0x3e, 0x04, 0x37, 0xe1, 0xc5, 0x32, 0x2c, 0x11, 0xb9,
-	0xdf, 0x18, 0x59, 0xe3, 0xce, 0xd4, 0x9b, 0x54, 0xe5, 0x2e, 0x11, 0x39, 0x75, 0x14, 0xac, 0x4e,
-	0xe4, 0x03, 0x68, 0xcb, 0xc2, 0x10, 0x9b, 0xcf, 0x10, 0x5b, 0xb2, 0xd0, 0xb4, 0x63, 0x68, 0x2f,
-	0xcd, 0xcd, 0xbe, 0xad, 0x69, 0xfd, 0x49, 0xad, 0xb6, 0x52, 0x44, 0x6b, 0x02, 0xf9, 0x1c, 0xbc,
-	0x4a, 0x1a, 0x96, 0x45, 0xb4, 0xf0, 0x0f, 0xf4, 0x07, 0x2f, 0xeb, 0xba, 0x54, 0x63, 0xdf, 0x2a,
-	0x88, 0x76, 0xf8, 0x36, 0x20, 0x87, 0xe0, 0xa5, 0x22, 0x94, 0xc5, 0xf2, 0x5a, 0xc8, 0x22, 0x47,
-	0xbf, 0x35, 0xb2, 0xc6, 0x0e, 0xed, 0xa4, 0xe2, 0xaa, 0x4e, 0xa9, 0xae, 0x85, 0x64, 0x5c, 0x86,
-	0xb7, 0xb8, 0xf6, 0xdb, 0x23, 0x6b, 0xec, 0x51, 0x47, 0x27, 0xce, 0x71, 0x4d, 0x5e, 0x41, 0x1b,
-	0xf3, 0x58, 0x43, 0x8e, 0x86, 0x5a, 0x98, 0xc7, 0xe7, 0xb8, 0x0e, 0x7e, 0x85, 0x9e, 0xb2, 0xee,
-	0xfb, 0x22, 0x62, 0xd9, 0x5c, 0x32, 0x89, 0xe4, 0x13, 0x80, 0x05, 0xe3, 0x71, 0x28, 0x54, 0xa4,
-	0xed, 0xeb, 0x4c, 0xc9, 0xa6, 0xa3, 0x33, 0xc6, 0x63, 0xcd, 0xa3, 0xee, 0xa2, 0x3e, 0x92, 0x37,
-	0x00, 0x19, 0x13, 0x32, 0x4c, 0xf3, 0x18, 0x7f, 0xd1, 0xa6, 0xda, 0xd4, 0x55, 0x99, 0x99, 0x4a,
-	0x28, 0x65, 0x1a, 0x96, 0xc8, 0x97, 0xda, 0x49, 0x9b, 0x3a, 0x2a, 0x71, 0x85, 0x7c, 0x19, 0xfc,
-	0x66, 0x19, 0x05, 0x5f, 0x95, 0x65, 0xb6, 0x36, 0xe5, 0xde, 0x87, 0x2e, 0x2b, 0xcb, 0x2c, 0xc5,
-	0xb8, 0xaa, 0x68, 0x66, 0xe8, 0x55, 0x49, 0x53, 0xf4, 0x3b, 0x78, 0x47, 0xf2, 0x55, 0x1e, 0x31,
-	0x89, 0xb5, 0x56, 0x33, 0xcd, 0xc3, 0xc9, 0xfe, 0x3e, 0xa9, 0xe2, 0x57, 0x35, 0xd3, 0x48, 0xef,
-	0xc9, 0xbd, 0x38, 0xf8, 0x12, 0xc8, 0x53, 0x16, 0x79, 0x17, 0x0e, 0x76, 0xaf, 0x37, 0x01, 0x21,
-	0x60, 0xeb, 0x3e, 0x4c, 0x97, 0xfa, 0x1c, 0xfc, 0x0c, 0x7d, 0x33, 0xb9, 0x1d, 0x1b, 0x27, 0x70,
-	0xb0, 0x75, 0xb0, 0x37, 0xf5, 0x1f, 0xa9, 0x52, 0x9b, 0x63, 0xc4, 0x18, 0x1a, 0x39, 0x82, 0x96,
-	0x19, 0x78, 0xd5, 0x46, 0x6f, 0x7f, 0x27, 0x68, 0x85, 0x06, 0xa7, 0x00, 0x73, 0x59, 0x70, 0x9c,
-	0xc5, 0x98, 0x4b, 0xe5, 0x7c, 0x94, 0xad, 0x84, 0x44, 0xbe, 0xdd, 0x75, 0xb7, 0xca, 0xcc, 0x62,
-	0xf2, 0x1e, 0x38, 0x42, 0x91, 0x15, 0x68, 0x04, 0xb7, 0x85, 0xf9, 0x38, 0x98, 0x82, 0x73, 0x8e,
-	0xeb, 0x1f, 0x59, 0xb6, 0x42, 0xd2, 0x87, 0xa6, 0xda, 0x0c, 0x4b, 0x6f, 0x86, 0x3a, 0xaa, 0xde,
-	0xef, 0x14, 0xa4, 0xbf, 0xf2, 0xa8, 0x09, 0x82, 0x3f, 0x2c, 0xe8, 0x2b, 0xa3, 0xe6, 0x39, 0x2b,
-	0xc5, 0xa2, 0x90, 0x27, 0x4c, 0xb2, 0x1d, 0xe1, 0xd6, 0xdb, 0x84, 0xab, 0x2d, 0x48, 0xd2, 0x0c,
-	0x43, 0x91, 0xde, 0x63, 0x25, 0xc6, 0x51, 0x89, 0x79, 0x7a, 0x8f, 0xe4, 0x23, 0xb0, 0x63, 0x26,
-	0x99, 0xdf, 0x1c, 0x35, 0xc7, 0x9d, 0xe9, 0xab, 0x47, 0x66, 0xd5, 0x42, 0xa9, 0x26, 0x91, 0x8f,
-	0xc1, 0x56, 0x57, 0x54, 0x7f, 0x9e, 0xd7, 0x8f, 0xc8, 0xb5, 0xb8, 0x0b, 0x94, 0x8c, 0x6a, 0x62,
-	0x70, 0x09, 0xbd, 0x3a, 0xfb, 0xcd, 0xe9, 0x69, 0x9a, 0x21, 0xe9, 0x41, 0x23, 0x4a, 0xb4, 0x60,
-	0x97, 0x36, 0xa2, 0x44, 0x4d, 0x75, 0x47, 0x97, 0x3e, 0x93, 0x01, 0x38, 0xd1, 0x02, 0xa3, 0x5b,
-	0xb1, 0x32, 0x5b, 0xdb, 0xa5, 0x9b, 0x38, 0x38, 0x03, 0x6f, 0xf7, 0x1e, 0xf2, 0x05, 0x38, 0x51,
-	0x12, 0xaa, 0x76, 0x84, 0x6f, 0xe9, 0x1e, 0xde, 0xfc, 0x8f, 0x2c, 0x23, 0x80, 0xb6, 0xa3, 0x44,
-	0xfd, 0x8a, 0xe0, 0x27, 0xe8, 0x6e, 0xa0, 0xc5, 0x2a, 0xbf, 0x25, 0x9f, 0x6d, 0x9f, 0x13, 0x63,
-	0xe8, 0xe0, 0x99, 0x85, 0x7e, 0xf2, 0xb0, 0x90, 0xca, 0x40, 0x33, 0x2f, 0x7d, 0x0e, 0x5a, 0x60,
-	0x9f, 0x14, 0x39, 0x1e, 0x1f, 0x81, 0xbb, 0x59, 0x37, 0x02, 0xd0, 0xfa, 0xa1, 0xe0, 0x4b, 0x96,
-	0xf5, 0x5f, 0x90, 0x2e, 0xb8, 0x9b, 0xf7, 0xa3, 0xdf, 0xf8, 0xba, 0xff, 0xd7, 0xc3, 0xd0, 0xfa,
-	0xfb, 0x61, 0x68, 0xfd, 0xf3, 0x30, 0xb4, 0x7e, 0xff, 0x77, 0xf8, 0xe2, 0xba, 0xa5, 0x1f, 0xd8,
-	0x4f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x79, 0xcc, 0xfd, 0xcf, 0xa3, 0x05, 0x00, 0x00,
+	0x10, 0x3e, 0x4e, 0xdc, 0xc4, 0x9e, 0x38, 0x21, 0xda, 0x83, 0x74, 0x4c, 0x8e, 0x4e, 0x94, 0x63,
+	0x44, 0x15, 0x8a, 0x14, 0x44, 0x40, 0x88, 0x2b, 0x24, 0xa0, 0x54, 0x0d, 0xa5, 0xa8, 0xda, 0x54,
+	0x95, 0xb8, 0xb2, 0xb6, 0xf6, 0xb8, 0x31, 0x75, 0x6c, 0x6b, 0x77, 0x53, 0x91, 0xde, 0xf0, 0x1a,
+	0x3c, 0x00, 0xaf, 0xc0, 0x05, 0x6f, 0xc0, 0x25, 0x8f, 0x80, 0xca, 0x8b, 0xa0, 0xdd, 0xb5, 0xf3,
+	0xd3, 0x16, 0xae, 0xb2, 0xf3, 0xcd, 0xe7, 0xd9, 0x6f, 0xbe, 0x99, 0x2c, 0xbc, 0xe4, 0x2c, 0x91,
+	0xa1, 0x40, 0x7e, 0x87, 0xbc, 0xbc, 0x9e, 0x94, 0xbc, 0x90, 0x05, 0xe9, 0xee, 0x81, 0x83, 0x2e,
+	0xaa, 0xb8, 0xce, 0x0e, 0xbc, 0x25, 0x4a, 0x56, 0x47, 0xc1, 0x1f, 0x0d, 0xe8, 0x50, 0x96, 0xc8,
+	0x73, 0x14, 0x82, 0xdd, 0x20, 0x79, 0x0d, 0x2e, 0xc7, 0x9b, 0xb4, 0xc8, 0xc3, 0x34, 0xf6, 0xad,
+	0x91, 0x35, 0xb6, 0xa9, 0x63, 0x80, 0x59, 0x4c, 0x3e, 0x04, 0x37, 0xe1, 0xc5, 0x32, 0x2c, 0x11,
+	0xb9, 0xdf, 0x18, 0x59, 0xe3, 0xce, 0xd4, 0x9b, 0x54, 0xe5, 0x2e, 0x10, 0x39, 0x75, 0x54, 0x5a,
+	0x9d, 0xc8, 0x07, 0xd0, 0x96, 0x85, 0x21, 0x36, 0x9f, 0x21, 0xb6, 0x64, 0xa1, 0x69, 0x47, 0xd0,
+	0x5e, 0x9a, 0x9b, 0x7d, 0x5b, 0xd3, 0xfa, 0x93, 0x5a, 0x6d, 0xa5, 0x88, 0xd6, 0x04, 0xf2, 0x39,
+	0x78, 0x95, 0x34, 0x2c, 0x8b, 0x68, 0xe1, 0x1f, 0xe8, 0x0f, 0x5e, 0xd6, 0x75, 0xa9, 0xce, 0x7d,
+	0xab, 0x52, 0xb4, 0xc3, 0xb7, 0x01, 0x79, 0x0b, 0x5e, 0x2a, 0x42, 0x59, 0x2c, 0xaf, 0x85, 0x2c,
+	0x72, 0xf4, 0x5b, 0x23, 0x6b, 0xec, 0xd0, 0x4e, 0x2a, 0x2e, 0x6b, 0x48, 0x75, 0x2d, 0x24, 0xe3,
+	0x32, 0xbc, 0xc5, 0xb5, 0xdf, 0x1e, 0x59, 0x63, 0x8f, 0x3a, 0x1a, 0x38, 0xc3, 0x35, 0x79, 0x05,
+	0x6d, 0xcc, 0x63, 0x9d, 0x72, 0x74, 0xaa, 0x85, 0x79, 0x7c, 0x86, 0xeb, 0xe0, 0x17, 0xe8, 0x29,
+	0xeb, 0xbe, 0x2f, 0x22, 0x96, 0xcd, 0x25, 0x93, 0x48, 0x3e, 0x01, 0x58, 0x30, 0x1e, 0x87, 0x42,
+	0x45, 0xda, 0xbe, 0xce, 0x94, 0x6c, 0x3a, 0x3a, 0x65, 0x3c, 0xd6, 0x3c, 0xea, 0x2e, 0xea, 0x23,
+	0x79, 0x03, 0x90, 0x31, 0x21, 0xc3, 0x34, 0x8f, 0xf1, 0x67, 0x6d, 0xaa, 0x4d, 0x5d, 0x85, 0xcc,
+	0x14, 0xa0, 0x94, 0xe9, 0xb4, 0x44, 0xbe, 0xd4, 0x4e, 0xda, 0xd4, 0x51, 0xc0, 0x25, 0xf2, 0x65,
+	0xf0, 0x9b, 0x65, 0x14, 0x7c, 0x55, 0x96, 0xd9, 0xda, 0x94, 0x7b, 0x1f, 0xba, 0xac, 0x2c, 0xb3,
+	0x14, 0xe3, 0xaa, 0xa2, 0x99, 0xa1, 0x57, 0x81, 0xa6, 0xe8, 0x77, 0xf0, 0x8e, 0xe4, 0xab, 0x3c,
+	0x62, 0x12, 0x6b, 0xad, 0x66, 0x9a, 0x6f, 0x27, 0xfb, 0xfb, 0xa4, 0x8a, 0x5f, 0xd6, 0x4c, 0x23,
+	0xbd, 0x27, 0xf7, 0x62, 0xe5, 0x6e, 0xcc, 0x24, 0x0b, 0xef, 0x90, 0x8b, 0xb4, 0xc8, 0x2b, 0x8d,
+	0x1d, 0x85, 0x5d, 0x19, 0x28, 0xf8, 0x12, 0xc8, 0xd3, 0x42, 0xe4, 0x5d, 0x38, 0xd8, 0x55, 0x68,
+	0x02, 0x42, 0xc0, 0xd6, 0xad, 0x1a, 0x23, 0xf4, 0x39, 0xf8, 0x09, 0xfa, 0x66, 0xb8, 0x3b, 0x4e,
+	0x4f, 0xe0, 0x60, 0x6b, 0x72, 0x6f, 0xea, 0x3f, 0x12, 0xae, 0x96, 0xcb, 0xe8, 0x35, 0x34, 0x72,
+	0x08, 0x2d, 0xb3, 0x13, 0x55, 0xa7, 0xbd, 0xfd, 0xb5, 0xa1, 0x55, 0x36, 0x38, 0x01, 0x98, 0xcb,
+	0x82, 0xe3, 0x2c, 0xc6, 0x5c, 0xaa, 0xe1, 0x44, 0xd9, 0x4a, 0x48, 0xe4, 0xdb, 0xbf, 0x83, 0x5b,
+	0x21, 0xb3, 0x98, 0xbc, 0x07, 0x8e, 0x50, 0x64, 0x95, 0x34, 0x82, 0xdb, 0xc2, 0x7c, 0x1c, 0x4c,
+	0xc1, 0x39, 0xc3, 0xf5, 0x15, 0xcb, 0x56, 0x48, 0xfa, 0xd0, 0x54, 0xcb, 0x63, 0xe9, 0xe5, 0x51,
+	0x47, 0xd5, 0xfb, 0x9d, 0x4a, 0xe9, 0xaf, 0x3c, 0x6a, 0x82, 0xe0, 0x77, 0x0b, 0xfa, 0xca, 0xa8,
+	0x79, 0xce, 0x4a, 0xb1, 0x28, 0xe4, 0x31, 0x93, 0x6c, 0x47, 0xb8, 0xf5, 0x7f, 0xc2, 0xd5, 0xa2,
+	0x24, 0x69, 0x86, 0xa1, 0x48, 0xef, 0xb1, 0x12, 0xe3, 0x28, 0x60, 0x9e, 0xde, 0x23, 0xf9, 0x08,
+	0x6c, 0x35, 0x10, 0xbf, 0x39, 0x6a, 0x8e, 0x3b, 0xd3, 0x57, 0x8f, 0xcc, 0xaa, 0x85, 0x52, 0x4d,
+	0x22, 0x1f, 0x83, 0xad, 0xae, 0xa8, 0xfe, 0x5f, 0xaf, 0x1f, 0x91, 0x6b, 0x71, 0xe7, 0x28, 0x19,
+	0xd5, 0xc4, 0xe0, 0x02, 0x7a, 0x35, 0xfa, 0xcd, 0xc9, 0x49, 0x9a, 0x21, 0xe9, 0x41, 0x23, 0x4a,
+	0xb4, 0x60, 0x97, 0x36, 0xa2, 0x44, 0x4d, 0x75, 0x47, 0x97, 0x3e, 0x93, 0x01, 0x38, 0xd1, 0x02,
+	0xa3, 0x5b, 0xb1, 0x32, 0x8b, 0xdd, 0xa5, 0x9b, 0x38, 0x38, 0x05, 0x6f, 0xf7, 0x1e, 0xf2, 0x05,
+	0x38, 0x51, 0x12, 0xaa, 0x76, 0x84, 0x6f, 0xe9, 0x1e, 0xde, 0xfc, 0x87, 0x2c, 0x23, 0x80, 0xb6,
+	0xa3, 0x44, 0xfd, 0x8a, 0xe0, 0x47, 0xe8, 0x6e, 0x52, 0x8b, 0x55, 0x7e, 0x4b, 0x3e, 0xdb, 0xbe,
+	0x38, 0xc6, 0xd0, 0xc1, 0x33, 0x3b, 0xff, 0xe4, 0xed, 0x21, 0x95, 0x81, 0x66, 0x5e, 0xfa, 0x1c,
+	0xb4, 0xc0, 0x3e, 0x2e, 0x72, 0x3c, 0x3a, 0x04, 0x77, 0xb3, 0x6e, 0x04, 0xa0, 0xf5, 0x43, 0xc1,
+	0x97, 0x2c, 0xeb, 0xbf, 0x20, 0x5d, 0x70, 0x37, 0x4f, 0x4c, 0xbf, 0xf1, 0x75, 0xff, 0xcf, 0x87,
+	0xa1, 0xf5, 0xd7, 0xc3, 0xd0, 0xfa, 0xfb, 0x61, 0x68, 0xfd, 0xfa, 0xcf, 0xf0, 0xc5, 0x75, 0x4b,
+	0xbf, 0xc1, 0x9f, 0xfe, 0x3b, 0x00, 0xec, 0x88, 0x7e, 0xfe, 0xc6, 0x05, 0x00, 0x00,
 }
@@ -10,15 +10,11 @@ import (
 	proto "github.com/golang/protobuf/proto"
 
 	_ "github.com/gogo/protobuf/gogoproto"
-
 	coprocessor "github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
-
 	kvrpcpb "github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
-
 	raft_serverpb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
 
 	context "golang.org/x/net/context"
-
 	grpc "google.golang.org/grpc"
 )
 
@@ -46,17 +42,36 @@ const _ = grpc.SupportPackageIsVersion4
 type TinyKvClient interface {
 	// KV commands with mvcc/txn supported.
 	KvGet(ctx context.Context, in *kvrpcpb.GetRequest, opts ...grpc.CallOption) (*kvrpcpb.GetResponse, error)
+	KvBatchGet(ctx context.Context, in *kvrpcpb.BatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchGetResponse, error)
 	KvScan(ctx context.Context, in *kvrpcpb.ScanRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanResponse, error)
 	KvPrewrite(ctx context.Context, in *kvrpcpb.PrewriteRequest, opts ...grpc.CallOption) (*kvrpcpb.PrewriteResponse, error)
 	KvCommit(ctx context.Context, in *kvrpcpb.CommitRequest, opts ...grpc.CallOption) (*kvrpcpb.CommitResponse, error)
 	KvCheckTxnStatus(ctx context.Context, in *kvrpcpb.CheckTxnStatusRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckTxnStatusResponse, error)
+	KvTxnHeartBeat(ctx context.Context, in *kvrpcpb.TxnHeartBeatRequest, opts ...grpc.CallOption) (*kvrpcpb.TxnHeartBeatResponse, error)
+	KvCheckSecondaryLocks(ctx context.Context, in *kvrpcpb.CheckSecondaryLocksRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckSecondaryLocksResponse, error)
 	KvBatchRollback(ctx context.Context, in *kvrpcpb.BatchRollbackRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchRollbackResponse, error)
 	KvResolveLock(ctx context.Context, in *kvrpcpb.ResolveLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ResolveLockResponse, error)
+	KvPessimisticLock(ctx context.Context, in *kvrpcpb.PessimisticLockRequest, opts ...grpc.CallOption) (*kvrpcpb.PessimisticLockResponse, error)
+	KvPessimisticRollback(ctx context.Context, in *kvrpcpb.PessimisticRollbackRequest, opts ...grpc.CallOption) (*kvrpcpb.PessimisticRollbackResponse, error)
+	KvScanLock(ctx context.Context, in *kvrpcpb.ScanLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanLockResponse, error)
+	KvGC(ctx context.Context, in *kvrpcpb.GCRequest, opts ...grpc.CallOption) (*kvrpcpb.GCResponse, error)
+	KvDeleteRange(ctx context.Context, in *kvrpcpb.DeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.DeleteRangeResponse, error)
+	// Change data capture.
+	ChangeData(ctx context.Context, in *kvrpcpb.ChangeDataRequest, opts ...grpc.CallOption) (TinyKv_ChangeDataClient, error)
+	// Backup and restore.
+	Backup(ctx context.Context, in *kvrpcpb.BackupRequest, opts ...grpc.CallOption) (*kvrpcpb.BackupResponse, error)
+	Restore(ctx context.Context, in *kvrpcpb.RestoreRequest, opts ...grpc.CallOption) (*kvrpcpb.RestoreResponse, error)
 	// RawKV commands.
 	RawGet(ctx context.Context, in *kvrpcpb.RawGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetResponse, error)
 	RawPut(ctx context.Context, in *kvrpcpb.RawPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawPutResponse, error)
 	RawDelete(ctx context.Context, in *kvrpcpb.RawDeleteRequest, opts ...grpc.CallOption) (*kvrpcpb.RawDeleteResponse, error)
 	RawScan(ctx context.Context, in *kvrpcpb.RawScanRequest, opts ...grpc.CallOption) (*kvrpcpb.RawScanResponse, error)
+	RawBatchGet(ctx context.Context, in *kvrpcpb.RawBatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchGetResponse, error)
+	RawBatchPut(ctx context.Context, in *kvrpcpb.RawBatchPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchPutResponse, error)
+	RawBatchDelete(ctx context.Context, in *kvrpcpb.RawBatchDeleteRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchDeleteResponse, error)
+	RawDeleteRange(ctx context.Context, in *kvrpcpb.RawDeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.RawDeleteRangeResponse, error)
+	RawCompareAndSwap(ctx context.Context, in *kvrpcpb.RawCASRequest, opts ...grpc.CallOption) (*kvrpcpb.RawCASResponse, error)
+	RawGetKeyTTL(ctx context.Context, in *kvrpcpb.RawGetKeyTTLRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetKeyTTLResponse, error)
 	// Raft commands (tinykv <-> tinykv).
 	Raft(ctx context.Context, opts ...grpc.CallOption) (TinyKv_RaftClient, error)
 	Snapshot(ctx context.Context, opts ...grpc.CallOption) (TinyKv_SnapshotClient, error)
@@ -81,6 +96,15 @@ func (c *tinyKvClient) KvGet(ctx context.Context, in *kvrpcpb.GetRequest, opts .
 	return out, nil
 }
 
+func (c *tinyKvClient) KvBatchGet(ctx context.Context, in *kvrpcpb.BatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchGetResponse, error) {
+	out := new(kvrpcpb.BatchGetResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvBatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tinyKvClient) KvScan(ctx context.Context, in *kvrpcpb.ScanRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanResponse, error) {
 	out := new(kvrpcpb.ScanResponse)
 	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvScan", in, out, opts...)
@@ -117,6 +141,24 @@ func (c *tinyKvClient) KvCheckTxnStatus(ctx context.Context, in *kvrpcpb.CheckTx
 	return out, nil
 }
 
+func (c *tinyKvClient) KvTxnHeartBeat(ctx context.Context, in *kvrpcpb.TxnHeartBeatRequest, opts ...grpc.CallOption) (*kvrpcpb.TxnHeartBeatResponse, error) {
+	out := new(kvrpcpb.TxnHeartBeatResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvTxnHeartBeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvCheckSecondaryLocks(ctx context.Context, in *kvrpcpb.CheckSecondaryLocksRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckSecondaryLocksResponse, error) {
+	out := new(kvrpcpb.CheckSecondaryLocksResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvCheckSecondaryLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tinyKvClient) KvBatchRollback(ctx context.Context, in *kvrpcpb.BatchRollbackRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchRollbackResponse, error) {
 	out := new(kvrpcpb.BatchRollbackResponse)
 	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvBatchRollback", in, out, opts...)
@@ -135,6 +177,101 @@ func (c *tinyKvClient) KvResolveLock(ctx context.Context, in *kvrpcpb.ResolveLoc
 	return out, nil
 }
 
+func (c *tinyKvClient) KvPessimisticLock(ctx context.Context, in *kvrpcpb.PessimisticLockRequest, opts ...grpc.CallOption) (*kvrpcpb.PessimisticLockResponse, error) {
+	out := new(kvrpcpb.PessimisticLockResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvPessimisticLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvPessimisticRollback(ctx context.Context, in *kvrpcpb.PessimisticRollbackRequest, opts ...grpc.CallOption) (*kvrpcpb.PessimisticRollbackResponse, error) {
+	out := new(kvrpcpb.PessimisticRollbackResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvPessimisticRollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvScanLock(ctx context.Context, in *kvrpcpb.ScanLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanLockResponse, error) {
+	out := new(kvrpcpb.ScanLockResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvScanLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvGC(ctx context.Context, in *kvrpcpb.GCRequest, opts ...grpc.CallOption) (*kvrpcpb.GCResponse, error) {
+	out := new(kvrpcpb.GCResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvGC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvDeleteRange(ctx context.Context, in *kvrpcpb.DeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.DeleteRangeResponse, error) {
+	out := new(kvrpcpb.DeleteRangeResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvDeleteRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) ChangeData(ctx context.Context, in *kvrpcpb.ChangeDataRequest, opts ...grpc.CallOption) (TinyKv_ChangeDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[0], "/tinykvpb.TinyKv/ChangeData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tinyKvChangeDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TinyKv_ChangeDataClient interface {
+	Recv() (*kvrpcpb.ChangeDataEvent, error)
+	grpc.ClientStream
+}
+
+type tinyKvChangeDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *tinyKvChangeDataClient) Recv() (*kvrpcpb.ChangeDataEvent, error) {
+	m := new(kvrpcpb.ChangeDataEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tinyKvClient) Backup(ctx context.Context, in *kvrpcpb.BackupRequest, opts ...grpc.CallOption) (*kvrpcpb.BackupResponse, error) {
+	out := new(kvrpcpb.BackupResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/Backup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) Restore(ctx context.Context, in *kvrpcpb.RestoreRequest, opts ...grpc.CallOption) (*kvrpcpb.RestoreResponse, error) {
+	out := new(kvrpcpb.RestoreResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tinyKvClient) RawGet(ctx context.Context, in *kvrpcpb.RawGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetResponse, error) {
 	out := new(kvrpcpb.RawGetResponse)
 	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawGet", in, out, opts...)
@@ -171,8 +308,62 @@ func (c *tinyKvClient) RawScan(ctx context.Context, in *kvrpcpb.RawScanRequest,
 	return out, nil
 }
 
+func (c *tinyKvClient) RawBatchGet(ctx context.Context, in *kvrpcpb.RawBatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchGetResponse, error) {
+	out := new(kvrpcpb.RawBatchGetResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawBatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) RawBatchPut(ctx context.Context, in *kvrpcpb.RawBatchPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchPutResponse, error) {
+	out := new(kvrpcpb.RawBatchPutResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawBatchPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) RawBatchDelete(ctx context.Context, in *kvrpcpb.RawBatchDeleteRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchDeleteResponse, error) {
+	out := new(kvrpcpb.RawBatchDeleteResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawBatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) RawDeleteRange(ctx context.Context, in *kvrpcpb.RawDeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.RawDeleteRangeResponse, error) {
+	out := new(kvrpcpb.RawDeleteRangeResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawDeleteRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) RawCompareAndSwap(ctx context.Context, in *kvrpcpb.RawCASRequest, opts ...grpc.CallOption) (*kvrpcpb.RawCASResponse, error) {
+	out := new(kvrpcpb.RawCASResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawCompareAndSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) RawGetKeyTTL(ctx context.Context, in *kvrpcpb.RawGetKeyTTLRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetKeyTTLResponse, error) {
+	out := new(kvrpcpb.RawGetKeyTTLResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawGetKeyTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tinyKvClient) Raft(ctx context.Context, opts ...grpc.CallOption) (TinyKv_RaftClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[0], "/tinykvpb.TinyKv/Raft", opts...)
+	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[1], "/tinykvpb.TinyKv/Raft", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -206,7 +397,7 @@ func (x *tinyKvRaftClient) CloseAndRecv() (*raft_serverpb.Done, error) {
 }
 
 func (c *tinyKvClient) Snapshot(ctx context.Context, opts ...grpc.CallOption) (TinyKv_SnapshotClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[1], "/tinykvpb.TinyKv/Snapshot", opts...)
+	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[2], "/tinykvpb.TinyKv/Snapshot", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -253,17 +444,36 @@ func (c *tinyKvClient) Coprocessor(ctx context.Context, in *coprocessor.Request,
 type TinyKvServer interface {
 	// KV commands with mvcc/txn supported.
 	KvGet(context.Context, *kvrpcpb.GetRequest) (*kvrpcpb.GetResponse, error)
+	KvBatchGet(context.Context, *kvrpcpb.BatchGetRequest) (*kvrpcpb.BatchGetResponse, error)
 	KvScan(context.Context, *kvrpcpb.ScanRequest) (*kvrpcpb.ScanResponse, error)
 	KvPrewrite(context.Context, *kvrpcpb.PrewriteRequest) (*kvrpcpb.PrewriteResponse, error)
 	KvCommit(context.Context, *kvrpcpb.CommitRequest) (*kvrpcpb.CommitResponse, error)
 	KvCheckTxnStatus(context.Context, *kvrpcpb.CheckTxnStatusRequest) (*kvrpcpb.CheckTxnStatusResponse, error)
+	KvTxnHeartBeat(context.Context, *kvrpcpb.TxnHeartBeatRequest) (*kvrpcpb.TxnHeartBeatResponse, error)
+	KvCheckSecondaryLocks(context.Context, *kvrpcpb.CheckSecondaryLocksRequest) (*kvrpcpb.CheckSecondaryLocksResponse, error)
 	KvBatchRollback(context.Context, *kvrpcpb.BatchRollbackRequest) (*kvrpcpb.BatchRollbackResponse, error)
 	KvResolveLock(context.Context, *kvrpcpb.ResolveLockRequest) (*kvrpcpb.ResolveLockResponse, error)
+	KvPessimisticLock(context.Context, *kvrpcpb.PessimisticLockRequest) (*kvrpcpb.PessimisticLockResponse, error)
+	KvPessimisticRollback(context.Context, *kvrpcpb.PessimisticRollbackRequest) (*kvrpcpb.PessimisticRollbackResponse, error)
+	KvScanLock(context.Context, *kvrpcpb.ScanLockRequest) (*kvrpcpb.ScanLockResponse, error)
+	KvGC(context.Context, *kvrpcpb.GCRequest) (*kvrpcpb.GCResponse, error)
+	KvDeleteRange(context.Context, *kvrpcpb.DeleteRangeRequest) (*kvrpcpb.DeleteRangeResponse, error)
+	// Change data capture.
+	ChangeData(*kvrpcpb.ChangeDataRequest, TinyKv_ChangeDataServer) error
+	// Backup and restore.
+	Backup(context.Context, *kvrpcpb.BackupRequest) (*kvrpcpb.BackupResponse, error)
+	Restore(context.Context, *kvrpcpb.RestoreRequest) (*kvrpcpb.RestoreResponse, error)
 	// RawKV commands.
 	RawGet(context.Context, *kvrpcpb.RawGetRequest) (*kvrpcpb.RawGetResponse, error)
 	RawPut(context.Context, *kvrpcpb.RawPutRequest) (*kvrpcpb.RawPutResponse, error)
 	RawDelete(context.Context, *kvrpcpb.RawDeleteRequest) (*kvrpcpb.RawDeleteResponse, error)
 	RawScan(context.Context, *kvrpcpb.RawScanRequest) (*kvrpcpb.RawScanResponse, error)
+	RawBatchGet(context.Context, *kvrpcpb.RawBatchGetRequest) (*kvrpcpb.RawBatchGetResponse, error)
+	RawBatchPut(context.Context, *kvrpcpb.RawBatchPutRequest) (*kvrpcpb.RawBatchPutResponse, error)
+	RawBatchDelete(context.Context, *kvrpcpb.RawBatchDeleteRequest) (*kvrpcpb.RawBatchDeleteResponse, error)
+	RawDeleteRange(context.Context, *kvrpcpb.RawDeleteRangeRequest) (*kvrpcpb.RawDeleteRangeResponse, error)
+	RawCompareAndSwap(context.Context, *kvrpcpb.RawCASRequest) (*kvrpcpb.RawCASResponse, error)
+	RawGetKeyTTL(context.Context, *kvrpcpb.RawGetKeyTTLRequest) (*kvrpcpb.RawGetKeyTTLResponse, error)
 	// Raft commands (tinykv <-> tinykv).
 	Raft(TinyKv_RaftServer) error
 	Snapshot(TinyKv_SnapshotServer) error
@@ -293,6 +503,24 @@ func _TinyKv_KvGet_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TinyKv_KvBatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.BatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvBatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvBatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvBatchGet(ctx, req.(*kvrpcpb.BatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TinyKv_KvScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(kvrpcpb.ScanRequest)
 	if err := dec(in); err != nil {
@@ -365,6 +593,42 @@ func _TinyKv_KvCheckTxnStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TinyKv_KvTxnHeartBeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.TxnHeartBeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvTxnHeartBeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvTxnHeartBeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvTxnHeartBeat(ctx, req.(*kvrpcpb.TxnHeartBeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvCheckSecondaryLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.CheckSecondaryLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvCheckSecondaryLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvCheckSecondaryLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvCheckSecondaryLocks(ctx, req.(*kvrpcpb.CheckSecondaryLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TinyKv_KvBatchRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(kvrpcpb.BatchRollbackRequest)
 	if err := dec(in); err != nil {
@@ -401,6 +665,153 @@ func _TinyKv_KvResolveLock_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TinyKv_KvPessimisticLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.PessimisticLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvPessimisticLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvPessimisticLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvPessimisticLock(ctx, req.(*kvrpcpb.PessimisticLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvPessimisticRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.PessimisticRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvPessimisticRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvPessimisticRollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvPessimisticRollback(ctx, req.(*kvrpcpb.PessimisticRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvScanLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.ScanLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvScanLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvScanLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvScanLock(ctx, req.(*kvrpcpb.ScanLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.GCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvGC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvGC(ctx, req.(*kvrpcpb.GCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvDeleteRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.DeleteRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvDeleteRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvDeleteRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvDeleteRange(ctx, req.(*kvrpcpb.DeleteRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_ChangeData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(kvrpcpb.ChangeDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TinyKvServer).ChangeData(m, &tinyKvChangeDataServer{stream})
+}
+
+type TinyKv_ChangeDataServer interface {
+	Send(*kvrpcpb.ChangeDataEvent) error
+	grpc.ServerStream
+}
+
+type tinyKvChangeDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *tinyKvChangeDataServer) Send(m *kvrpcpb.ChangeDataEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TinyKv_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).Backup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/Backup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).Backup(ctx, req.(*kvrpcpb.BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).Restore(ctx, req.(*kvrpcpb.RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TinyKv_RawGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(kvrpcpb.RawGetRequest)
 	if err := dec(in); err != nil {
@@ -473,6 +884,114 @@ func _TinyKv_RawScan_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TinyKv_RawBatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawBatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).RawBatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/RawBatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).RawBatchGet(ctx, req.(*kvrpcpb.RawBatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_RawBatchPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawBatchPutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).RawBatchPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/RawBatchPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).RawBatchPut(ctx, req.(*kvrpcpb.RawBatchPutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_RawBatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawBatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).RawBatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/RawBatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).RawBatchDelete(ctx, req.(*kvrpcpb.RawBatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_RawDeleteRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawDeleteRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).RawDeleteRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/RawDeleteRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).RawDeleteRange(ctx, req.(*kvrpcpb.RawDeleteRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_RawCompareAndSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawCASRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).RawCompareAndSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/RawCompareAndSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).RawCompareAndSwap(ctx, req.(*kvrpcpb.RawCASRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_RawGetKeyTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawGetKeyTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).RawGetKeyTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/RawGetKeyTTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).RawGetKeyTTL(ctx, req.(*kvrpcpb.RawGetKeyTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TinyKv_Raft_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(TinyKvServer).Raft(&tinyKvRaftServer{stream})
 }
@@ -551,6 +1070,10 @@ var _TinyKv_serviceDesc = grpc.ServiceDesc{
 			MethodName: "KvGet",
 			Handler:    _TinyKv_KvGet_Handler,
 		},
+		{
+			MethodName: "KvBatchGet",
+			Handler:    _TinyKv_KvBatchGet_Handler,
+		},
 		{
 			MethodName: "KvScan",
 			Handler:    _TinyKv_KvScan_Handler,
@@ -567,6 +1090,14 @@ var _TinyKv_serviceDesc = grpc.ServiceDesc{
 			MethodName: "KvCheckTxnStatus",
 			Handler:    _TinyKv_KvCheckTxnStatus_Handler,
 		},
+		{
+			MethodName: "KvTxnHeartBeat",
+			Handler:    _TinyKv_KvTxnHeartBeat_Handler,
+		},
+		{
+			MethodName: "KvCheckSecondaryLocks",
+			Handler:    _TinyKv_KvCheckSecondaryLocks_Handler,
+		},
 		{
 			MethodName: "KvBatchRollback",
 			Handler:    _TinyKv_KvBatchRollback_Handler,
@@ -575,6 +1106,34 @@ var _TinyKv_serviceDesc = grpc.ServiceDesc{
 			MethodName: "KvResolveLock",
 			Handler:    _TinyKv_KvResolveLock_Handler,
 		},
+		{
+			MethodName: "KvPessimisticLock",
+			Handler:    _TinyKv_KvPessimisticLock_Handler,
+		},
+		{
+			MethodName: "KvPessimisticRollback",
+			Handler:    _TinyKv_KvPessimisticRollback_Handler,
+		},
+		{
+			MethodName: "KvScanLock",
+			Handler:    _TinyKv_KvScanLock_Handler,
+		},
+		{
+			MethodName: "KvGC",
+			Handler:    _TinyKv_KvGC_Handler,
+		},
+		{
+			MethodName: "KvDeleteRange",
+			Handler:    _TinyKv_KvDeleteRange_Handler,
+		},
+		{
+			MethodName: "Backup",
+			Handler:    _TinyKv_Backup_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _TinyKv_Restore_Handler,
+		},
 		{
 			MethodName: "RawGet",
 			Handler:    _TinyKv_RawGet_Handler,
@@ -591,12 +1150,41 @@ var _TinyKv_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RawScan",
 			Handler:    _TinyKv_RawScan_Handler,
 		},
+		{
+			MethodName: "RawBatchGet",
+			Handler:    _TinyKv_RawBatchGet_Handler,
+		},
+		{
+			MethodName: "RawBatchPut",
+			Handler:    _TinyKv_RawBatchPut_Handler,
+		},
+		{
+			MethodName: "RawBatchDelete",
+			Handler:    _TinyKv_RawBatchDelete_Handler,
+		},
+		{
+			MethodName: "RawDeleteRange",
+			Handler:    _TinyKv_RawDeleteRange_Handler,
+		},
+		{
+			MethodName: "RawCompareAndSwap",
+			Handler:    _TinyKv_RawCompareAndSwap_Handler,
+		},
+		{
+			MethodName: "RawGetKeyTTL",
+			Handler:    _TinyKv_RawGetKeyTTL_Handler,
+		},
 		{
 			MethodName: "Coprocessor",
 			Handler:    _TinyKv_Coprocessor_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ChangeData",
+			Handler:       _TinyKv_ChangeData_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Raft",
 			Handler:       _TinyKv_Raft_Handler,
@@ -611,37 +1199,56 @@ var _TinyKv_serviceDesc = grpc.ServiceDesc{
 	Metadata: "tinykvpb.proto",
 }
 
-func init() { proto.RegisterFile("tinykvpb.proto", fileDescriptor_tinykvpb_71a6ae942ac295c5) }
-
-var fileDescriptor_tinykvpb_71a6ae942ac295c5 = []byte{
-	// 452 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xdb, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x5b, 0x09, 0xba, 0x62, 0x34, 0x18, 0x6e, 0x81, 0x2d, 0x8c, 0x20, 0xed, 0x8a, 0xab,
-	0x22, 0x01, 0x12, 0x17, 0x1c, 0x24, 0x96, 0x4a, 0xbb, 0xf0, 0x90, 0x2a, 0x77, 0x5c, 0x23, 0xd7,
-	0xfa, 0xd6, 0x56, 0xe9, 0xec, 0x60, 0x3b, 0x2e, 0x7d, 0x13, 0x9e, 0x83, 0xa7, 0xe0, 0x92, 0x47,
-	0x40, 0xe5, 0x45, 0x50, 0x92, 0xd9, 0x39, 0x34, 0xbd, 0xb3, 0x7f, 0xff, 0x83, 0x5b, 0xc7, 0x1f,
-	0x7a, 0x60, 0x96, 0x62, 0x13, 0xdb, 0x64, 0x36, 0x4a, 0x94, 0x34, 0x12, 0xf7, 0xdd, 0x3e, 0x38,
-	0x8c, 0xad, 0x4a, 0xb8, 0x13, 0x82, 0x81, 0x62, 0xd7, 0xe6, 0x9b, 0x06, 0x65, 0x41, 0x79, 0xf8,
-	0x88, 0xcb, 0x44, 0x49, 0x0e, 0x5a, 0x4b, 0x75, 0x8b, 0x86, 0x73, 0x39, 0x97, 0xf9, 0xf2, 0x55,
-	0xb6, 0x2a, 0xe8, 0xeb, 0x5f, 0x07, 0xa8, 0x77, 0xb5, 0x14, 0x1b, 0x62, 0xf1, 0x5b, 0x74, 0x97,
-	0xd8, 0x0b, 0x30, 0x78, 0x30, 0x72, 0x27, 0x5c, 0x80, 0xa1, 0xf0, 0x3d, 0x05, 0x6d, 0x82, 0x61,
-	0x1d, 0xea, 0x44, 0x0a, 0x0d, 0x67, 0x1d, 0xfc, 0x0e, 0xf5, 0x88, 0x9d, 0x72, 0x26, 0x70, 0xe9,
-	0xc8, 0xb6, 0x2e, 0xf7, 0xb8, 0x41, 0x7d, 0x30, 0x42, 0x88, 0xd8, 0x89, 0x82, 0xb5, 0x5a, 0x1a,
-	0xc0, 0xc7, 0xde, 0xe6, 0x90, 0x2b, 0x38, 0x69, 0x51, 0x7c, 0xc9, 0x47, 0xd4, 0x27, 0x36, 0x92,
-	0x37, 0x37, 0x4b, 0x83, 0x9f, 0x78, 0x63, 0x01, 0x5c, 0xc1, 0xd3, 0x1d, 0xee, 0xe3, 0x5f, 0xd1,
-	0x11, 0xb1, 0xd1, 0x02, 0x78, 0x7c, 0xf5, 0x43, 0x4c, 0x0d, 0x33, 0xa9, 0xc6, 0x61, 0x69, 0xaf,
-	0x09, 0xae, 0xee, 0xc5, 0x5e, 0xdd, 0xd7, 0x52, 0xf4, 0x90, 0xd8, 0x73, 0x66, 0xf8, 0x82, 0xca,
-	0xd5, 0x6a, 0xc6, 0x78, 0x8c, 0x9f, 0xfb, 0x54, 0x8d, 0xbb, 0xd2, 0x70, 0x9f, 0xec, 0x3b, 0x2f,
-	0xd1, 0x21, 0xb1, 0x14, 0xb4, 0x5c, 0x59, 0xb8, 0x94, 0x3c, 0xc6, 0xcf, 0x7c, 0xa4, 0x42, 0x5d,
-	0xdf, 0x69, 0xbb, 0xe8, 0xdb, 0xde, 0xa3, 0x1e, 0x65, 0xeb, 0xec, 0x63, 0x97, 0xb7, 0x56, 0x80,
-	0xdd, 0x5b, 0x73, 0xbc, 0x11, 0x9e, 0xa4, 0x8d, 0xf0, 0x24, 0x6d, 0x0f, 0xe7, 0xdc, 0x87, 0xc7,
-	0xe8, 0x1e, 0x65, 0xeb, 0x31, 0xac, 0xc0, 0x00, 0x3e, 0xa9, 0xfa, 0x0a, 0xe6, 0x2a, 0x82, 0x36,
-	0xc9, 0xb7, 0x7c, 0x42, 0x07, 0x94, 0xad, 0xf3, 0x67, 0x57, 0x3b, 0xab, 0xfa, 0xf2, 0x8e, 0x77,
-	0x85, 0xca, 0x5f, 0xb8, 0x43, 0xd9, 0xb5, 0xc1, 0xc1, 0xa8, 0x3e, 0x3d, 0x19, 0xfc, 0x02, 0x5a,
-	0xb3, 0x39, 0x04, 0x83, 0x86, 0x36, 0x96, 0x02, 0xce, 0x3a, 0x2f, 0xbb, 0xf8, 0x33, 0xea, 0x4f,
-	0x05, 0x4b, 0xf4, 0x42, 0x1a, 0x7c, 0xda, 0x30, 0x39, 0x21, 0x5a, 0xa4, 0x22, 0xde, 0x5f, 0xf1,
-	0x01, 0xdd, 0x8f, 0xca, 0x09, 0xc5, 0xc3, 0x51, 0x75, 0x5e, 0xcb, 0xd1, 0xa9, 0x53, 0xf7, 0xeb,
-	0xcf, 0x8f, 0x7e, 0x6f, 0xc3, 0xee, 0x9f, 0x6d, 0xd8, 0xfd, 0xbb, 0x0d, 0xbb, 0x3f, 0xff, 0x85,
-	0x9d, 0x59, 0x2f, 0x9f, 0xe6, 0x37, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x6c, 0xc6, 0xd2,
-	0x36, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("tinykvpb.proto", fileDescriptor_tinykvpb_dffc9c1e0f58114b) }
+
+var fileDescriptor_tinykvpb_dffc9c1e0f58114b = []byte{
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x6e, 0xa5, 0x51, 0x86, 0xc7, 0xc6, 0xea, 0x6e, 0xac, 0xeb, 0xba, 0x0c, 0x6d, 0xbb, 0xe0,
+	0xaa, 0xfc, 0x4a, 0x5c, 0x0c, 0x90, 0xd6, 0x14, 0x86, 0xc8, 0x26, 0xaa, 0xb4, 0x48, 0xdc, 0x21,
+	0x2f, 0x3b, 0x6b, 0xab, 0xb6, 0x71, 0x88, 0x1d, 0x77, 0x7d, 0x13, 0x1e, 0x89, 0x4b, 0xc4, 0x13,
+	0xa0, 0xf1, 0x22, 0x28, 0x6d, 0xed, 0xd8, 0x49, 0xba, 0xbb, 0xe6, 0xfb, 0xce, 0xf7, 0x1d, 0xf7,
+	0xf8, 0x1c, 0xdb, 0x68, 0x83, 0x0f, 0xfc, 0xe9, 0x50, 0x04, 0x97, 0x8d, 0x20, 0xa4, 0x9c, 0xe2,
+	0x55, 0xf9, 0x5d, 0x5b, 0x1f, 0x8a, 0x30, 0xf0, 0x24, 0x51, 0xab, 0x84, 0xe4, 0x9a, 0x7f, 0x67,
+	0x10, 0x0a, 0x08, 0x15, 0x58, 0xf6, 0x68, 0x10, 0x52, 0x0f, 0x18, 0xa3, 0xe1, 0x02, 0xda, 0xea,
+	0xd1, 0x1e, 0x9d, 0xfd, 0x7c, 0x16, 0xff, 0x9a, 0xa3, 0x2f, 0xff, 0x94, 0x51, 0xa9, 0x3b, 0xf0,
+	0xa7, 0x8e, 0xc0, 0xaf, 0xd1, 0x3d, 0x47, 0x9c, 0x01, 0xc7, 0x95, 0x86, 0xcc, 0x70, 0x06, 0xdc,
+	0x85, 0x1f, 0x11, 0x30, 0x5e, 0xdb, 0x32, 0x41, 0x16, 0x50, 0x9f, 0xc1, 0x61, 0x01, 0xdb, 0x08,
+	0x39, 0xa2, 0x49, 0xb8, 0xd7, 0x8f, 0xa5, 0x55, 0x15, 0x25, 0x21, 0xa9, 0xdf, 0xcd, 0x61, 0x94,
+	0xc9, 0x1b, 0x54, 0x72, 0x44, 0xc7, 0x23, 0x3e, 0x4e, 0xd2, 0xc4, 0x9f, 0x52, 0xbc, 0x9d, 0x42,
+	0xcd, 0xec, 0xed, 0x10, 0x26, 0xe1, 0x80, 0x83, 0x96, 0x5d, 0x42, 0xd9, 0xec, 0x09, 0xa3, 0x4c,
+	0xde, 0xa1, 0x55, 0x47, 0xd8, 0x74, 0x3c, 0x1e, 0x70, 0xfc, 0x58, 0x05, 0xce, 0x01, 0x69, 0xb0,
+	0x93, 0xc1, 0x95, 0xfc, 0x2b, 0xda, 0x74, 0x84, 0xdd, 0x07, 0x6f, 0xd8, 0xbd, 0xf1, 0x3b, 0x9c,
+	0xf0, 0x88, 0x61, 0x2b, 0x09, 0x37, 0x08, 0x69, 0x77, 0xb0, 0x94, 0x57, 0xb6, 0x5f, 0xd0, 0x86,
+	0x23, 0xba, 0x37, 0xfe, 0x27, 0x20, 0x21, 0x6f, 0x02, 0xe1, 0xb8, 0xae, 0x44, 0x3a, 0x2c, 0x2d,
+	0xf7, 0x97, 0xb0, 0xca, 0xf0, 0x0a, 0x6d, 0x2f, 0xd6, 0xd9, 0x01, 0x8f, 0xfa, 0x57, 0x24, 0x9c,
+	0x9e, 0x53, 0x6f, 0xc8, 0xf0, 0x91, 0xb9, 0x18, 0x93, 0x95, 0xf6, 0xc7, 0x77, 0x07, 0xa9, 0x2c,
+	0x2e, 0x7a, 0xb4, 0xe8, 0x07, 0x97, 0x8e, 0x46, 0x97, 0xc4, 0x1b, 0xe2, 0x7d, 0x73, 0xeb, 0x25,
+	0x2e, 0x9d, 0xad, 0x65, 0xb4, 0xf2, 0x3c, 0x47, 0xeb, 0x8e, 0x70, 0x81, 0xd1, 0x91, 0x80, 0x38,
+	0x1f, 0xde, 0x53, 0x12, 0x0d, 0x95, 0x7e, 0xf5, 0x7c, 0x52, 0xb9, 0x7d, 0x43, 0x65, 0x47, 0xb4,
+	0x81, 0xb1, 0xc1, 0x78, 0xc0, 0xf8, 0xc0, 0x9b, 0x39, 0x26, 0x1b, 0x92, 0x62, 0xa4, 0xeb, 0x93,
+	0xe5, 0x01, 0x66, 0x85, 0x35, 0x5a, 0x55, 0xe0, 0x28, 0x4f, 0x9c, 0xae, 0xc3, 0xf1, 0xdd, 0x41,
+	0x66, 0xcf, 0xc7, 0x73, 0x30, 0x5b, 0x78, 0xd5, 0x18, 0x0d, 0x7d, 0xc5, 0xbb, 0x39, 0x8c, 0x32,
+	0x79, 0x81, 0x56, 0x1c, 0x71, 0x66, 0x63, 0x9c, 0x8c, 0xb5, 0x2d, 0x85, 0x15, 0x03, 0x33, 0x77,
+	0xa1, 0x05, 0x23, 0xe0, 0xe0, 0x12, 0xbf, 0x07, 0xda, 0x2e, 0x68, 0x68, 0x76, 0x17, 0x0c, 0x52,
+	0xb9, 0x7d, 0x44, 0xc8, 0xee, 0xc7, 0x58, 0x8b, 0x70, 0x82, 0x6b, 0x5a, 0x77, 0x49, 0x50, 0x3a,
+	0x55, 0x73, 0xb8, 0x0f, 0x02, 0x7c, 0x7e, 0x58, 0x78, 0x5e, 0xc4, 0x27, 0xa8, 0xd4, 0x24, 0xde,
+	0x30, 0x0a, 0xb4, 0xd1, 0x9d, 0x03, 0xd9, 0xd1, 0x95, 0xb8, 0x5a, 0xc4, 0x7b, 0x74, 0xdf, 0x05,
+	0xc6, 0x69, 0x08, 0x78, 0x47, 0xef, 0x9a, 0x18, 0xc9, 0xa6, 0x57, 0x84, 0xd2, 0x9f, 0xa0, 0x92,
+	0x4b, 0x26, 0xf1, 0xc1, 0x97, 0x24, 0x9f, 0x03, 0xd9, 0xe4, 0x12, 0x4f, 0x89, 0xdb, 0x51, 0x4a,
+	0xdc, 0x8e, 0xf2, 0xc5, 0xed, 0x48, 0x17, 0xb7, 0xd0, 0x03, 0x97, 0x4c, 0xe6, 0xa5, 0xc5, 0xbb,
+	0x7a, 0xdc, 0xa2, 0xdc, 0x0b, 0x8b, 0x5a, 0x1e, 0x65, 0xfc, 0x7f, 0x32, 0x99, 0x1d, 0xbc, 0x46,
+	0x2e, 0xfd, 0xec, 0xad, 0x66, 0x09, 0xa5, 0xff, 0x8c, 0xd6, 0x5c, 0x32, 0x51, 0xa7, 0xff, 0x9e,
+	0x1e, 0x9a, 0xbe, 0x00, 0xea, 0xf9, 0x64, 0x9e, 0x57, 0x3b, 0xca, 0xf3, 0x6a, 0x47, 0x77, 0x78,
+	0x99, 0xd5, 0xe9, 0xa0, 0x0d, 0x49, 0x2c, 0x4a, 0x64, 0x65, 0x14, 0x66, 0x9d, 0x0e, 0x96, 0xf2,
+	0x29, 0x53, 0x7d, 0x00, 0xac, 0x9c, 0xe2, 0xea, 0x33, 0x70, 0xb0, 0x94, 0xd7, 0xc6, 0xa0, 0xec,
+	0x92, 0x89, 0x4d, 0xc7, 0x01, 0x09, 0xe1, 0xd4, 0xbf, 0xea, 0x4c, 0x48, 0x60, 0xf6, 0x83, 0x7d,
+	0xda, 0xc9, 0xed, 0x87, 0x19, 0xae, 0x7c, 0x2e, 0xd0, 0xc3, 0x79, 0x83, 0x39, 0x30, 0xed, 0x76,
+	0xcf, 0x71, 0x3d, 0xd5, 0x77, 0x73, 0x38, 0x7b, 0x57, 0x98, 0xac, 0xd6, 0x9b, 0x2b, 0x2e, 0xb9,
+	0xe6, 0xb8, 0xd6, 0x30, 0x5f, 0x17, 0x31, 0x78, 0x01, 0x8c, 0x91, 0x1e, 0xd4, 0x2a, 0x29, 0xae,
+	0x45, 0x7d, 0x38, 0x2c, 0x3c, 0x2d, 0xe2, 0x53, 0xb4, 0xda, 0xf1, 0x49, 0xc0, 0xfa, 0x34, 0xbe,
+	0xb3, 0xcc, 0x20, 0x49, 0xd8, 0xfd, 0xc8, 0x1f, 0x2e, 0xb7, 0x78, 0x8b, 0xd6, 0xec, 0xe4, 0x05,
+	0x83, 0xb7, 0x1a, 0xfa, 0x7b, 0x26, 0x79, 0x15, 0x98, 0xa8, 0x5c, 0x7d, 0x73, 0xf3, 0xd7, 0xad,
+	0x55, 0xfc, 0x7d, 0x6b, 0x15, 0xff, 0xde, 0x5a, 0xc5, 0x9f, 0xff, 0xac, 0xc2, 0x65, 0x69, 0xf6,
+	0xda, 0x79, 0xf5, 0x7f, 0x00, 0x67, 0x0e, 0x92, 0x63, 0x56, 0x09, 0x00, 0x00,
 }
@@ -0,0 +1,304 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raft
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	pb "github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap/log"
+)
+
+// confChangeV1ToV2 converts a ConfChange into the equivalent simple ConfChangeV2.
+func confChangeV1ToV2(cc pb.ConfChange) pb.ConfChangeV2 {
+	return pb.ConfChangeV2{
+		Changes: []*pb.ConfChangeSingle{{ChangeType: cc.ChangeType, NodeId: cc.NodeId}},
+		Context: cc.Context,
+	}
+}
+
+// enterJoint returns two bools. The second bool is true if and only if the change
+// must be carried out with joint consensus, in which case the first bool indicates
+// whether the joint configuration should be left automatically.
+func enterJoint(cc pb.ConfChangeV2) (autoLeave bool, ok bool) {
+	if cc.Transition == pb.ConfChangeTransition_ConfChangeTransitionAuto && len(cc.Changes) <= 1 {
+		return false, false
+	}
+	return cc.Transition != pb.ConfChangeTransition_ConfChangeTransitionJointExplicit, true
+}
+
+// leaveJoint returns true if cc is the empty change which leaves a joint configuration.
+func leaveJoint(cc pb.ConfChangeV2) bool {
+	return len(cc.Changes) == 0
+}
+
+// isLeaveJointEntry returns true if the conf change entry e leaves a joint configuration.
+func isLeaveJointEntry(e *pb.Entry) bool {
+	if e.EntryType != pb.EntryType_EntryConfChangeV2 {
+		return false
+	}
+	var cc pb.ConfChangeV2
+	if err := cc.Unmarshal(e.Data); err != nil {
+		panic(err)
+	}
+	return leaveJoint(cc)
+}
+
+// applyConfChange changes the configuration of the group and returns the resulting
+// ConfState. It panics if the change is invalid, e.g. it changes more than one voter
+// without joint consensus.
+func (r *Raft) applyConfChange(cc pb.ConfChangeV2) pb.ConfState {
+	var err error
+	if autoLeave, ok := enterJoint(cc); ok {
+		err = r.enterJointConf(autoLeave, cc.Changes)
+	} else if leaveJoint(cc) {
+		r.leaveJointConf()
+	} else {
+		err = r.simpleConfChange(cc.Changes)
+	}
+	if err != nil {
+		panic(fmt.Sprintf("%d failed to apply conf change %s: %v", r.id, cc.String(), err))
+	}
+
+	if r.State == StateLeader {
+		// The quorum may have changed, so see if any pending entries can be committed.
+		if len(r.voters[0]) > 0 && r.maybeCommit() {
+			r.bcastAppend()
+		}
+		// If the transferee was removed or demoted, abort the leadership transferring.
+		if r.leadTransferee != None {
+			if pr := r.Prs[r.leadTransferee]; pr == nil || pr.IsLearner {
+				r.abortLeaderTransfer()
+			}
+		}
+		r.maybeLeaveJoint()
+	}
+	return r.confState()
+}
+
+// simpleConfChange applies changes which touch at most one voter, so that the old and the
+// new majorities always overlap.
+func (r *Raft) simpleConfChange(changes []*pb.ConfChangeSingle) error {
+	if r.voters.isJoint() {
+		return errors.New("can't apply simple config change in joint config")
+	}
+	old := make(map[uint64]struct{}, len(r.voters[0]))
+	for id := range r.voters[0] {
+		old[id] = struct{}{}
+	}
+	if err := r.applyConfChanges(changes); err != nil {
+		return err
+	}
+	n := 0
+	for id := range old {
+		if _, ok := r.voters[0][id]; !ok {
+			n++
+		}
+	}
+	for id := range r.voters[0] {
+		if _, ok := old[id]; !ok {
+			n++
+		}
+	}
+	if n > 1 {
+		return errors.New("more than one voter changed without entering joint config")
+	}
+	return nil
+}
+
+// enterJointConf copies the voters into the outgoing config and applies the changes to
+// the incoming one. Decisions need a majority of both until the joint config is left.
+func (r *Raft) enterJointConf(autoLeave bool, changes []*pb.ConfChangeSingle) error {
+	if r.voters.isJoint() {
+		return errors.New("config is already joint")
+	}
+	if len(r.voters[0]) == 0 {
+		return errors.New("can't make a zero-voter config joint")
+	}
+	for id := range r.voters[0] {
+		r.voters[1][id] = struct{}{}
+	}
+	if err := r.applyConfChanges(changes); err != nil {
+		return err
+	}
+	r.autoLeave = autoLeave
+	log.Info(fmt.Sprintf("%d entered joint config [incoming: %v, outgoing: %v, autoleave: %v]",
+		r.id, r.voters[0].ids(), r.voters[1].ids(), autoLeave))
+	return nil
+}
+
+// leaveJointConf drops the outgoing config. Outgoing voters which are demoted become
+// learners and the ones which are no longer members lose their progress.
+func (r *Raft) leaveJointConf() {
+	if !r.voters.isJoint() {
+		// Another leader may have proposed leaving the joint config already.
+		log.Info(fmt.Sprintf("%d ignored leaving joint config since config is not joint", r.id))
+		return
+	}
+	for id := range r.learnersNext {
+		r.Prs[id].IsLearner = true
+	}
+	r.learnersNext = make(map[uint64]struct{})
+	for id := range r.voters[1] {
+		if _, ok := r.voters[0][id]; !ok && !r.Prs[id].IsLearner {
+			delete(r.Prs, id)
+		}
+	}
+	r.voters[1] = make(majorityConfig)
+	r.autoLeave = false
+	log.Info(fmt.Sprintf("%d left joint config [voters: %v]", r.id, r.voters[0].ids()))
+}
+
+func (r *Raft) applyConfChanges(changes []*pb.ConfChangeSingle) error {
+	for _, cc := range changes {
+		if cc.NodeId == None {
+			continue
+		}
+		switch cc.ChangeType {
+		case pb.ConfChangeType_AddNode:
+			r.makeVoter(cc.NodeId)
+		case pb.ConfChangeType_AddLearnerNode:
+			r.makeLearner(cc.NodeId)
+		case pb.ConfChangeType_RemoveNode:
+			r.removeMember(cc.NodeId)
+		default:
+			return fmt.Errorf("unexpected conf change type %d", cc.ChangeType)
+		}
+	}
+	return nil
+}
+
+// makeVoter adds id as a voter of the incoming config, promoting it if it is a learner.
+func (r *Raft) makeVoter(id uint64) {
+	if pr := r.Prs[id]; pr == nil {
+		r.initProgress(id, false)
+	} else {
+		pr.IsLearner = false
+	}
+	delete(r.learnersNext, id)
+	r.voters[0][id] = struct{}{}
+}
+
+// makeLearner adds id as a learner, demoting it if it is a voter. A voter of the outgoing
+// config keeps voting until the joint config is left and only becomes a learner then.
+func (r *Raft) makeLearner(id uint64) {
+	pr := r.Prs[id]
+	if pr != nil && pr.IsLearner {
+		return
+	}
+	delete(r.voters[0], id)
+	if _, ok := r.voters[1][id]; ok {
+		r.learnersNext[id] = struct{}{}
+		return
+	}
+	if pr == nil {
+		r.initProgress(id, true)
+	} else {
+		pr.IsLearner = true
+	}
+}
+
+// removeMember removes id from the incoming config. A voter of the outgoing config keeps
+// its progress until the joint config is left.
+func (r *Raft) removeMember(id uint64) {
+	if _, ok := r.Prs[id]; !ok {
+		return
+	}
+	delete(r.voters[0], id)
+	delete(r.learnersNext, id)
+	if _, ok := r.voters[1][id]; !ok {
+		delete(r.Prs, id)
+	}
+}
+
+// initProgress adds the progress of a new member.
+func (r *Raft) initProgress(id uint64, isLearner bool) {
+	r.Prs[id] = &Progress{
+		Next:      r.RaftLog.LastIndex() + 1,
+		IsLearner: isLearner,
+		ins:       newInflights(r.maxInflight),
+		// When a node is first added, we should mark it as recently active.
+		// Otherwise, CheckQuorum may cause us to step down if it is invoked
+		// before the added node has a chance to communicate with us.
+		RecentActive: true,
+	}
+}
+
+// maybeLeaveJoint makes the leader propose leaving the joint config if it is expected to
+// do so on its own.
+func (r *Raft) maybeLeaveJoint() {
+	if r.State != StateLeader || !r.voters.isJoint() || !r.autoLeave {
+		return
+	}
+	log.Info(fmt.Sprintf("%d initiating automatic transition out of joint config [outgoing: %v]", r.id, r.voters[1].ids()))
+	r.appendEntry(pb.Entry{EntryType: pb.EntryType_EntryConfChangeV2})
+	r.PendingConfIndex = r.RaftLog.LastIndex()
+	r.bcastAppend()
+}
+
+// restoreConf resets the configuration and the progresses to the given ConfState.
+func (r *Raft) restoreConf(cs pb.ConfState) {
+	r.Prs = make(map[uint64]*Progress)
+	r.voters = jointConfig{make(majorityConfig), make(majorityConfig)}
+	r.learnersNext = make(map[uint64]struct{})
+	next := r.RaftLog.LastIndex() + 1
+	add := func(id uint64, isLearner bool) {
+		if _, ok := r.Prs[id]; ok {
+			return
+		}
+		pr := &Progress{Next: next, IsLearner: isLearner, ins: newInflights(r.maxInflight)}
+		if id == r.id {
+			pr.Match = next - 1
+		}
+		r.Prs[id] = pr
+		log.Debug(fmt.Sprintf("%d restored progress of %d [%+v]", r.id, id, pr))
+	}
+	for _, id := range cs.Nodes {
+		r.voters[0][id] = struct{}{}
+		add(id, false)
+	}
+	for _, id := range cs.VotersOutgoing {
+		r.voters[1][id] = struct{}{}
+		add(id, false)
+	}
+	for _, id := range cs.Learners {
+		add(id, true)
+	}
+	for _, id := range cs.LearnersNext {
+		r.learnersNext[id] = struct{}{}
+	}
+	r.autoLeave = cs.AutoLeave
+}
+
+// confState returns the ConfState describing the current configuration.
+func (r *Raft) confState() pb.ConfState {
+	cs := pb.ConfState{Nodes: r.voters[0].ids(), AutoLeave: r.autoLeave}
+	if r.voters.isJoint() {
+		cs.VotersOutgoing = r.voters[1].ids()
+	}
+	for id, pr := range r.Prs {
+		if pr.IsLearner {
+			cs.Learners = append(cs.Learners, id)
+		}
+	}
+	for id := range r.learnersNext {
+		cs.LearnersNext = append(cs.LearnersNext, id)
+	}
+	sort.Sort(uint64Slice(cs.Learners))
+	sort.Sort(uint64Slice(cs.LearnersNext))
+	return cs
+}
@@ -0,0 +1,124 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raft
+
+import (
+	"math"
+	"sort"
+)
+
+// voteResult indicates the outcome of a vote.
+type voteResult uint8
+
+const (
+	// votePending indicates that the decision of the vote depends on future
+	// votes, i.e. neither "yes" or "no" has reached quorum yet.
+	votePending voteResult = 1 + iota
+	// voteLost indicates that the quorum has voted "no".
+	voteLost
+	// voteWon indicates that the quorum has voted "yes".
+	voteWon
+)
+
+// majorityConfig is a set of voter ids which make decisions by majority.
+type majorityConfig map[uint64]struct{}
+
+// ids returns the sorted voter ids of the config.
+func (c majorityConfig) ids() []uint64 {
+	ids := make([]uint64, 0, len(c))
+	for id := range c {
+		ids = append(ids, id)
+	}
+	sort.Sort(uint64Slice(ids))
+	return ids
+}
+
+// committedIndex returns the largest index acknowledged by a majority of the voters. An
+// empty config returns math.MaxUint64 so that it doesn't restrict a joint config.
+func (c majorityConfig) committedIndex(match func(id uint64) uint64) uint64 {
+	if len(c) == 0 {
+		return math.MaxUint64
+	}
+	srt := make(uint64Slice, 0, len(c))
+	for id := range c {
+		srt = append(srt, match(id))
+	}
+	sort.Sort(srt)
+	return srt[len(srt)-(len(c)/2+1)]
+}
+
+// voteResult takes a mapping of voters to yes/no (true/false) votes and returns the
+// outcome of the vote. Voters missing from the mapping haven't voted yet. An empty config
+// always wins, again so that it doesn't affect a joint config.
+func (c majorityConfig) voteResult(votes map[uint64]bool) voteResult {
+	if len(c) == 0 {
+		return voteWon
+	}
+	var granted, missing int
+	for id := range c {
+		v, ok := votes[id]
+		if !ok {
+			missing++
+			continue
+		}
+		if v {
+			granted++
+		}
+	}
+	q := len(c)/2 + 1
+	if granted >= q {
+		return voteWon
+	}
+	if granted+missing >= q {
+		return votePending
+	}
+	return voteLost
+}
+
+// jointConfig is the voter configuration of a group. The first config is the incoming
+// one, the second is the outgoing one and is only non-empty while the group moves from
+// one configuration to another by joint consensus. A decision needs a majority of both.
+type jointConfig [2]majorityConfig
+
+// isJoint returns true if the group is in a joint configuration.
+func (c jointConfig) isJoint() bool {
+	return len(c[1]) > 0
+}
+
+// contains returns true if id is a voter of either config.
+func (c jointConfig) contains(id uint64) bool {
+	_, in := c[0][id]
+	_, out := c[1][id]
+	return in || out
+}
+
+// committedIndex returns the largest index acknowledged by both majorities.
+func (c jointConfig) committedIndex(match func(id uint64) uint64) uint64 {
+	return min(c[0].committedIndex(match), c[1].committedIndex(match))
+}
+
+// voteResult returns the outcome of the vote, which is won only if it is won in both
+// configs and lost as soon as it is lost in either of them.
+func (c jointConfig) voteResult(votes map[uint64]bool) voteResult {
+	r1 := c[0].voteResult(votes)
+	r2 := c[1].voteResult(votes)
+	if r1 == r2 {
+		return r1
+	}
+	if r1 == voteLost || r2 == voteLost {
+		return voteLost
+	}
+	return votePending
+}
@@ -15,10 +15,11 @@
 package raft
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
-	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -30,6 +31,8 @@ import (
 // None is a placeholder node ID used when there is no leader.
 const None uint64 = 0
 
+const noLimit = math.MaxUint64
+
 // StateType represents the role of a node in a cluster.
 type StateType uint64
 
@@ -37,18 +40,36 @@ const (
 	StateFollower StateType = iota
 	StateCandidate
 	StateLeader
+	StatePreCandidate
 )
 
 var stmap = [...]string{
 	"StateFollower",
 	"StateCandidate",
 	"StateLeader",
+	"StatePreCandidate",
 }
 
 func (st StateType) String() string {
 	return stmap[uint64(st)]
 }
 
+// CampaignType represents the type of campaigning
+// the reason we use the type of string instead of uint64
+// is because it's simpler to compare and fill in raft entries
+type CampaignType string
+
+const (
+	// campaignPreElection represents the first phase of a normal election when
+	// Config.PreVote is true.
+	campaignPreElection CampaignType = "CampaignPreElection"
+	// campaignElection represents a normal (time-based) election (the second phase
+	// of the election when Config.PreVote is true).
+	campaignElection CampaignType = "CampaignElection"
+	// campaignTransfer represents the type of leader transfer
+	campaignTransfer CampaignType = "CampaignTransfer"
+)
+
 // ErrProposalDropped is returned when the proposal is ignored by some cases,
 // so that the proposer can be notified and fail fast.
 var ErrProposalDropped = errors.New("raft proposal dropped")
@@ -105,6 +126,40 @@ type Config struct {
 	// Applied. If Applied is unset when restarting, raft might return previous
 	// applied entries. This is a very application dependent configuration.
 	Applied uint64
+
+	// MaxSizePerMsg limits the max byte size of each append message. Smaller
+	// value lowers the raft recovery cost(initial probing and message lost
+	// during normal operation). On the other side, it might affect the
+	// throughput during normal replication. Note: math.MaxUint64 for unlimited,
+	// 0 for at most one entry per message.
+	MaxSizePerMsg uint64
+	// MaxInflightMsgs limits the max number of in-flight append messages during
+	// optimistic replication phase. The application transportation layer usually
+	// has its own sending buffer over TCP/UDP. Setting MaxInflightMsgs to avoid
+	// overflowing that sending buffer.
+	MaxInflightMsgs int
+
+	// CheckQuorum specifies if the leader should check quorum activity. Leader
+	// steps down when quorum is not active for an electionTimeout.
+	CheckQuorum bool
+
+	// PreVote enables the Pre-Vote algorithm described in raft thesis section
+	// 9.6. This prevents disruption when a node that has been partitioned away
+	// rejoins the cluster.
+	PreVote bool
+
+	// ReadOnlyOption specifies how the read only request is processed.
+	//
+	// ReadOnlySafe guarantees the linearizability of the read only request by
+	// communicating with the quorum. It is the default and suggested option.
+	//
+	// ReadOnlyLeaseBased ensures linearizability of the read only request by
+	// relying on the leader lease. It can be affected by clock drift.
+	// If the clock drift is unbounded, leader might keep the lease longer than it
+	// should (clock can move backward/pause without any bound). ReadIndex is not safe
+	// in that case.
+	// CheckQuorum MUST be enabled if ReadOnlyOption is ReadOnlyLeaseBased.
+	ReadOnlyOption ReadOnlyOption
 }
 
 func (c *Config) validate() error {
@@ -124,6 +179,14 @@ func (c *Config) validate() error {
 		return errors.New("storage cannot be nil")
 	}
 
+	if c.MaxInflightMsgs <= 0 {
+		return errors.New("max inflight messages must be greater than 0")
+	}
+
+	if c.ReadOnlyOption == ReadOnlyLeaseBased && !c.CheckQuorum {
+		return errors.New("CheckQuorum must be enabled when ReadOnlyOption is ReadOnlyLeaseBased")
+	}
+
 	return nil
 }
 
@@ -136,9 +199,18 @@ type Raft struct {
 	// the log
 	RaftLog *RaftLog
 
-	// log replication progress of each peers
+	// log replication progress of each peers, learners included
 	Prs map[uint64]*Progress
 
+	// voters is the voter configuration of the group. Every voter has a progress in Prs.
+	voters jointConfig
+	// learnersNext are the outgoing voters which become learners once the group leaves the
+	// joint configuration.
+	learnersNext map[uint64]struct{}
+	// autoLeave is true if the leader leaves the joint configuration on its own once it
+	// has been applied.
+	autoLeave bool
+
 	// this peer's role
 	State StateType
 
@@ -151,6 +223,18 @@ type Raft struct {
 	// the leader id
 	Lead uint64
 
+	checkQuorum bool
+	preVote     bool
+
+	// the max byte size and the max number of inflight append messages to a follower
+	maxMsgSize  uint64
+	maxInflight int
+
+	// read only requests waiting for the leader to confirm its leadership
+	readOnly *readOnly
+	// read states to be returned to the application with the next Ready
+	readStates []ReadState
+
 	// heartbeat interval
 	heartbeatTimeout int
 	// baseline of election interval
@@ -189,24 +273,25 @@ func newRaft(c *Config) *Raft {
 	if err != nil {
 		panic(err)
 	}
-	peers := c.peers
-	if len(cs.Nodes) > 0 {
-		if len(peers) > 0 {
+	if len(c.peers) > 0 {
+		if len(cs.Nodes) > 0 || len(cs.Learners) > 0 {
 			panic("cannot specify both newRaft (peers) and ConfState.(Nodes)")
 		}
-		peers = cs.Nodes
+		cs.Nodes = c.peers
 	}
 	r := &Raft{
 		id:               c.ID,
 		Lead:             None,
 		RaftLog:          raftlog,
-		Prs:              make(map[uint64]*Progress),
 		electionTimeout:  c.ElectionTick,
 		heartbeatTimeout: c.HeartbeatTick,
+		maxMsgSize:       c.MaxSizePerMsg,
+		maxInflight:      c.MaxInflightMsgs,
+		checkQuorum:      c.CheckQuorum,
+		preVote:          c.PreVote,
+		readOnly:         newReadOnly(c.ReadOnlyOption),
 	}
-	for _, p := range peers {
-		r.Prs[p] = &Progress{Next: 1}
-	}
+	r.restoreConf(cs)
 
 	if !IsEmptyHardState(hs) {
 		r.loadState(hs)
@@ -220,6 +305,9 @@ func newRaft(c *Config) *Raft {
 	for _, n := range nodes(r) {
 		nodesStrs = append(nodesStrs, fmt.Sprintf("%d", n))
 	}
+	for _, n := range r.confState().Learners {
+		nodesStrs = append(nodesStrs, fmt.Sprintf("%d(learner)", n))
+	}
 
 	log.Info(fmt.Sprintf("newRaft %d [peers: [%s], term: %d, commit: %d, applied: %d, lastindex: %d, lastterm: %d]",
 		r.id, strings.Join(nodesStrs, ","), r.Term, r.RaftLog.committed, r.RaftLog.applied, r.RaftLog.LastIndex(), r.RaftLog.lastTerm()))
@@ -244,28 +332,34 @@ func (r *Raft) hardState() pb.HardState {
 	}
 }
 
-func (r *Raft) quorum() int { return len(r.Prs)/2 + 1 }
-
 // send persists state to stable storage and then sends to its mailbox.
 func (r *Raft) send(m pb.Message) {
 	m.From = r.id
-	if m.MsgType == pb.MessageType_MsgRequestVote || m.MsgType == pb.MessageType_MsgRequestVoteResponse {
+	if m.MsgType == pb.MessageType_MsgRequestVote || m.MsgType == pb.MessageType_MsgRequestVoteResponse ||
+		m.MsgType == pb.MessageType_MsgPreVote || m.MsgType == pb.MessageType_MsgPreVoteResponse {
 		if m.Term == 0 {
 			// All campaign messages need to have the term set when sending.
 			// - MessageType_MsgRequestVote: m.Term is the term the node is campaigning for,
 			//   non-zero as we increment the term when campaigning.
 			// - MessageType_MsgRequestVoteResponse: m.Term is the new r.Term if the MessageType_MsgRequestVote was
 			//   granted, non-zero for the same reason MessageType_MsgRequestVote is
+			// - MessageType_MsgPreVote: m.Term is the term the node will campaign,
+			//   non-zero as we use m.Term to indicate the next term we'll be
+			//   campaigning for
+			// - MessageType_MsgPreVoteResponse: m.Term is the term received in the original
+			//   MessageType_MsgPreVote if the pre-vote was granted, non-zero for the
+			//   same reasons MessageType_MsgPreVote is
 			panic(fmt.Sprintf("term should be set when sending %s", m.MsgType))
 		}
 	} else {
 		if m.Term != 0 {
 			panic(fmt.Sprintf("term should not be set when sending %s (was %d)", m.MsgType, m.Term))
 		}
-		// do not attach term to MessageType_MsgPropose
+		// do not attach term to MessageType_MsgPropose, MessageType_MsgReadIndex
 		// proposals are a way to forward to the leader and
 		// should be treated as local message.
-		if m.MsgType != pb.MessageType_MsgPropose {
+		// MessageType_MsgReadIndex is also forwarded to leader.
+		if m.MsgType != pb.MessageType_MsgPropose && m.MsgType != pb.MessageType_MsgReadIndex {
 			m.Term = r.Term
 		}
 	}
@@ -279,14 +373,34 @@ func (r *Raft) getProgress(id uint64) *Progress {
 // sendAppend sends an append RPC with new entries (if any) and the
 // current commit index to the given peer. Returns true if a message was sent.
 func (r *Raft) sendAppend(to uint64) bool {
+	return r.maybeSendAppend(to, true)
+}
+
+// maybeSendAppend sends an append RPC with new entries to the given peer,
+// if necessary. Returns true if a message was sent. The sendIfEmpty
+// argument controls whether messages with no entries will be sent
+// ("empty" messages are useful to convey updated Commit indexes, but
+// are undesirable when we're sending multiple messages in a batch).
+func (r *Raft) maybeSendAppend(to uint64, sendIfEmpty bool) bool {
 	pr := r.getProgress(to)
+	if pr.IsPaused() {
+		return false
+	}
 	m := pb.Message{}
 	m.To = to
 
 	term, errt := r.RaftLog.Term(pr.Next - 1)
 	ents, erre := r.RaftLog.Entries(pr.Next)
+	if len(ents) == 0 && !sendIfEmpty {
+		return false
+	}
 
 	if errt != nil || erre != nil { // send snapshot if we failed to get term or entries
+		if !pr.RecentActive {
+			log.Debug(fmt.Sprintf("ignore sending snapshot to %d since it is not recently active", to))
+			return false
+		}
+
 		m.MsgType = pb.MessageType_MsgSnapshot
 		snapshot, err := r.RaftLog.snapshot()
 		if err != nil {
@@ -303,25 +417,40 @@ func (r *Raft) sendAppend(to uint64) bool {
 		sindex, sterm := snapshot.Metadata.Index, snapshot.Metadata.Term
 		log.Debug(fmt.Sprintf("%d [firstindex: %d, commit: %d] sent snapshot[index: %d, term: %d] to %d [%v]",
 			r.id, r.RaftLog.firstIndex(), r.RaftLog.committed, sindex, sterm, to, pr))
+		pr.becomeSnapshot(sindex)
 		log.Debug(fmt.Sprintf("%d paused sending replication messages to %d [%v]", r.id, to, pr))
 	} else {
 		m.MsgType = pb.MessageType_MsgAppend
 		m.Index = pr.Next - 1
 		m.LogTerm = term
 
+		ents = limitSize(ents, r.maxMsgSize)
 		entries := make([]*pb.Entry, 0, len(ents))
 		for i := range ents {
 			entries = append(entries, &ents[i])
 		}
 		m.Entries = entries
 		m.Commit = r.RaftLog.committed
+		if n := len(m.Entries); n != 0 {
+			switch pr.State {
+			// optimistically increase the next when in ProgressStateReplicate
+			case ProgressStateReplicate:
+				last := m.Entries[n-1].Index
+				pr.optimisticUpdate(last)
+				pr.ins.add(last)
+			case ProgressStateProbe:
+				pr.pause()
+			default:
+				log.Panic(fmt.Sprintf("%d is sending append in unhandled state %s", r.id, pr.State))
+			}
+		}
 	}
 	r.send(m)
 	return true
 }
 
 // sendHeartbeat sends a heartbeat RPC to the given peer.
-func (r *Raft) sendHeartbeat(to uint64) {
+func (r *Raft) sendHeartbeat(to uint64, ctx []byte) {
 	// Attach the commit as min(to.matched, r.committed).
 	// When the leader sends out heartbeat message,
 	// the receiver(follower) might not be matched with the leader
@@ -333,6 +462,7 @@ func (r *Raft) sendHeartbeat(to uint64) {
 		To:      to,
 		MsgType: pb.MessageType_MsgHeartbeat,
 		Commit:  commit,
+		Context: ctx,
 	}
 
 	r.send(m)
@@ -358,11 +488,20 @@ func (r *Raft) bcastAppend() {
 
 // bcastHeartbeat sends RPC, without entries to all the peers.
 func (r *Raft) bcastHeartbeat() {
+	lastCtx := r.readOnly.lastPendingRequestCtx()
+	if len(lastCtx) == 0 {
+		r.bcastHeartbeatWithCtx(nil)
+	} else {
+		r.bcastHeartbeatWithCtx([]byte(lastCtx))
+	}
+}
+
+func (r *Raft) bcastHeartbeatWithCtx(ctx []byte) {
 	r.forEachProgress(func(id uint64, _ *Progress) {
 		if id == r.id {
 			return
 		}
-		r.sendHeartbeat(id)
+		r.sendHeartbeat(id, ctx)
 	})
 }
 
@@ -370,14 +509,7 @@ func (r *Raft) bcastHeartbeat() {
 // the commit index changed (in which case the caller should call
 // r.bcastAppend).
 func (r *Raft) maybeCommit() bool {
-	matchIndex := make(uint64Slice, len(r.Prs))
-	idx := 0
-	for _, p := range r.Prs {
-		matchIndex[idx] = p.Match
-		idx++
-	}
-	sort.Sort(matchIndex)
-	mci := matchIndex[len(matchIndex)-r.quorum()]
+	mci := r.voters.committedIndex(func(id uint64) uint64 { return r.Prs[id].Match })
 	return r.RaftLog.maybeCommit(mci, r.Term)
 }
 
@@ -396,13 +528,14 @@ func (r *Raft) reset(term uint64) {
 
 	r.votes = make(map[uint64]bool)
 	r.forEachProgress(func(id uint64, pr *Progress) {
-		*pr = Progress{Next: r.RaftLog.LastIndex() + 1}
+		*pr = Progress{Next: r.RaftLog.LastIndex() + 1, IsLearner: pr.IsLearner, ins: newInflights(r.maxInflight)}
 		if id == r.id {
 			pr.Match = r.RaftLog.LastIndex()
 		}
 	})
 
 	r.PendingConfIndex = 0
+	r.readOnly = newReadOnly(r.readOnly.option)
 }
 
 func (r *Raft) appendEntry(es ...pb.Entry) {
@@ -421,7 +554,7 @@ func (r *Raft) appendEntry(es ...pb.Entry) {
 // tick advances the internal logical clock by a single tick.
 func (r *Raft) tick() {
 	switch r.State {
-	case StateFollower, StateCandidate:
+	case StateFollower, StateCandidate, StatePreCandidate:
 		r.tickElection()
 	case StateLeader:
 		r.tickHeartbeat()
@@ -445,6 +578,10 @@ func (r *Raft) tickHeartbeat() {
 
 	if r.electionElapsed >= r.electionTimeout {
 		r.electionElapsed = 0
+		if r.checkQuorum && !r.checkQuorumActive() {
+			log.Warn(fmt.Sprintf("%d stepped down to follower since quorum is not active", r.id))
+			r.becomeFollower(r.Term, None)
+		}
 		// If current leader cannot transfer leadership in electionTimeout, it becomes leader again.
 		if r.State == StateLeader && r.leadTransferee != None {
 			r.abortLeaderTransfer()
@@ -477,12 +614,30 @@ func (r *Raft) becomeCandidate() {
 	log.Info(fmt.Sprintf("%d became candidate at term %d", r.id, r.Term))
 }
 
+// becomePreCandidate transform this peer's state to pre-candidate
+func (r *Raft) becomePreCandidate() {
+	if r.State == StateLeader {
+		panic("invalid transition [leader -> pre-candidate]")
+	}
+	// Becoming a pre-candidate changes our state, but doesn't change
+	// anything else. In particular it does not increase r.Term or
+	// change r.Vote.
+	r.votes = make(map[uint64]bool)
+	r.Lead = None
+	r.State = StatePreCandidate
+	log.Info(fmt.Sprintf("%d became pre-candidate at term %d", r.id, r.Term))
+}
+
 // becomeLeader transform this peer's state to leader
 func (r *Raft) becomeLeader() {
 	// NOTE: Leader should propose a noop entry on its term
 	r.reset(r.Term)
 	r.Lead = r.id
 	r.State = StateLeader
+	// Followers enter replicate mode when they've been successfully probed
+	// (perhaps after having received a snapshot as a result). The leader is
+	// trivially in this state.
+	r.getProgress(r.id).becomeReplicate()
 
 	// Conservatively set the PendingConfIndex to the last index in the
 	// log. There may or may not be a pending config change, but it's
@@ -494,31 +649,50 @@ func (r *Raft) becomeLeader() {
 	emptyEnt := pb.Entry{Data: nil}
 	r.appendEntry(emptyEnt)
 	log.Info(fmt.Sprintf("%d became leader at term %d", r.id, r.Term))
+	// The previous leader may have stepped down before leaving the joint configuration.
+	r.maybeLeaveJoint()
 }
 
-func (r *Raft) campaign() {
-	r.becomeCandidate()
-	voteMsg := pb.MessageType_MsgRequestVote
-	term := r.Term
+func (r *Raft) campaign(t CampaignType) {
+	var term uint64
+	var voteMsg pb.MessageType
+	if t == campaignPreElection {
+		r.becomePreCandidate()
+		voteMsg = pb.MessageType_MsgPreVote
+		// PreVote RPCs are sent for the next term before we've incremented r.Term.
+		term = r.Term + 1
+	} else {
+		r.becomeCandidate()
+		voteMsg = pb.MessageType_MsgRequestVote
+		term = r.Term
+	}
 
-	if r.quorum() == r.poll(r.id, pb.MessageType_MsgRequestVoteResponse, true) {
+	if _, _, res := r.poll(r.id, voteRespMsgType(voteMsg), true); res == voteWon {
 		// We won the election after voting for ourselves (which must mean that
 		// this is a single-node cluster). Advance to the next state.
-		r.becomeLeader()
+		if t == campaignPreElection {
+			r.campaign(campaignElection)
+		} else {
+			r.becomeLeader()
+		}
 		return
 	}
-	for id := range r.Prs {
-		if id == r.id {
+	for id, pr := range r.Prs {
+		if id == r.id || pr.IsLearner {
 			continue
 		}
 		log.Info(fmt.Sprintf("%d [logterm: %d, index: %d] sent %s request to %d at term %d",
 			r.id, r.RaftLog.lastTerm(), r.RaftLog.LastIndex(), voteMsg, id, r.Term))
 
-		r.send(pb.Message{Term: term, To: id, MsgType: voteMsg, Index: r.RaftLog.LastIndex(), LogTerm: r.RaftLog.lastTerm()})
+		var ctx []byte
+		if t == campaignTransfer {
+			ctx = []byte(t)
+		}
+		r.send(pb.Message{Term: term, To: id, MsgType: voteMsg, Index: r.RaftLog.LastIndex(), LogTerm: r.RaftLog.lastTerm(), Context: ctx})
 	}
 }
 
-func (r *Raft) poll(id uint64, t pb.MessageType, v bool) (granted int) {
+func (r *Raft) poll(id uint64, t pb.MessageType, v bool) (granted int, rejected int, result voteResult) {
 	if v {
 		log.Info(fmt.Sprintf("%d received %s from %d at term %d", r.id, t, id, r.Term))
 	} else {
@@ -530,9 +704,11 @@ func (r *Raft) poll(id uint64, t pb.MessageType, v bool) (granted int) {
 	for _, vv := range r.votes {
 		if vv {
 			granted++
+		} else {
+			rejected++
 		}
 	}
-	return granted
+	return granted, rejected, r.voters.voteResult(r.votes)
 }
 
 // Step the entrance of handle message, see `MessageType`
@@ -543,20 +719,80 @@ func (r *Raft) Step(m pb.Message) error {
 	case m.Term == 0:
 		// local message
 	case m.Term > r.Term:
-		log.Info(fmt.Sprintf("%d [term: %d] received a %s message with higher term from %d [term: %d]",
-			r.id, r.Term, m.MsgType, m.From, m.Term))
-		if m.MsgType == pb.MessageType_MsgAppend || m.MsgType == pb.MessageType_MsgHeartbeat || m.MsgType == pb.MessageType_MsgSnapshot {
-			r.becomeFollower(m.Term, m.From)
-		} else {
-			r.becomeFollower(m.Term, None)
+		if m.MsgType == pb.MessageType_MsgRequestVote || m.MsgType == pb.MessageType_MsgPreVote {
+			force := bytes.Equal(m.Context, []byte(campaignTransfer))
+			inLease := r.checkQuorum && r.Lead != None && r.electionElapsed < r.electionTimeout
+			if !force && inLease {
+				// If a server receives a RequestVote request within the minimum election timeout
+				// of hearing from a current leader, it does not update its term or grant its vote
+				log.Info(fmt.Sprintf("%d [logterm: %d, index: %d, vote: %d] ignored %s from %d [logterm: %d, index: %d] at term %d: lease is not expired (remaining ticks: %d)",
+					r.id, r.RaftLog.lastTerm(), r.RaftLog.LastIndex(), r.Vote, m.MsgType, m.From, m.LogTerm, m.Index, r.Term, r.electionTimeout-r.electionElapsed))
+				return nil
+			}
+		}
+		switch {
+		case m.MsgType == pb.MessageType_MsgPreVote:
+			// Never change our term in response to a PreVote
+		case m.MsgType == pb.MessageType_MsgPreVoteResponse && !m.Reject:
+			// We send pre-vote requests with a term in our future. If the
+			// pre-vote is granted, we will increment our term when we get a
+			// quorum. If it is not, the term comes from the node that
+			// rejected our vote so we should become a follower at the new
+			// term.
+		default:
+			log.Info(fmt.Sprintf("%d [term: %d] received a %s message with higher term from %d [term: %d]",
+				r.id, r.Term, m.MsgType, m.From, m.Term))
+			if m.MsgType == pb.MessageType_MsgAppend || m.MsgType == pb.MessageType_MsgHeartbeat || m.MsgType == pb.MessageType_MsgSnapshot {
+				r.becomeFollower(m.Term, m.From)
+			} else {
+				r.becomeFollower(m.Term, None)
+			}
 		}
 	case m.Term < r.Term:
-		log.Info(fmt.Sprintf("%d [term: %d] ignored a %s message with lower term from %d [term: %d]", r.id, r.Term, m.MsgType, m.From, m.Term))
+		if (r.checkQuorum || r.preVote) && (m.MsgType == pb.MessageType_MsgHeartbeat || m.MsgType == pb.MessageType_MsgAppend) {
+			// We have received messages from a leader at a lower term. It is possible
+			// that these messages were simply delayed in the network, but this could
+			// also mean that this node has advanced its term number during a network
+			// partition, and it is now unable to either win an election or to rejoin
+			// the majority on the old term. If checkQuorum is false, this will be
+			// handled by incrementing term numbers in response to MessageType_MsgRequestVote with a
+			// higher term, but if checkQuorum is true we may not advance the term on
+			// MessageType_MsgRequestVote and must generate other messages to advance the term. The net
+			// result of these two features is to minimize the disruption caused by
+			// nodes that have been removed from the cluster's configuration: a
+			// removed node will send MessageType_MsgRequestVote (or MessageType_MsgPreVote) which will be
+			// ignored, but it will not receive MessageType_MsgAppend or MessageType_MsgHeartbeat, so it will not
+			// create disruptive term increases, by notifying leader of this node's
+			// activeness.
+			// The above comments also true for Pre-Vote
+			//
+			// When follower gets isolated, it soon starts an election ending
+			// up with a higher term than leader, although it won't receive enough
+			// votes to win the election. When it regains connectivity, this response
+			// with "pb.MessageType_MsgAppendResponse" of higher term would force leader to step down.
+			// However, this disruption is inevitable to free this stuck node with
+			// fresh election. This can be prevented with Pre-Vote phase.
+			r.send(pb.Message{To: m.From, MsgType: pb.MessageType_MsgAppendResponse})
+		} else if m.MsgType == pb.MessageType_MsgPreVote {
+			// Before Pre-Vote enable, there may have candidate with higher term,
+			// but less log. After update to Pre-Vote, the cluster may deadlock if
+			// we drop messages with a lower term.
+			log.Info(fmt.Sprintf("%d [logterm: %d, index: %d, vote: %d] rejected %s from %d [logterm: %d, index: %d] at term %d",
+				r.id, r.RaftLog.lastTerm(), r.RaftLog.LastIndex(), r.Vote, m.MsgType, m.From, m.LogTerm, m.Index, r.Term))
+			r.send(pb.Message{To: m.From, Term: r.Term, MsgType: pb.MessageType_MsgPreVoteResponse, Reject: true})
+		} else {
+			// ignore other cases
+			log.Info(fmt.Sprintf("%d [term: %d] ignored a %s message with lower term from %d [term: %d]", r.id, r.Term, m.MsgType, m.From, m.Term))
+		}
 		return nil
 	}
 
 	switch m.MsgType {
 	case pb.MessageType_MsgHup:
+		if !r.promotable() {
+			log.Warn(fmt.Sprintf("%d is unpromotable and can not campaign", r.id))
+			return nil
+		}
 		if r.State != StateLeader {
 			ents, err := r.RaftLog.slice(r.RaftLog.applied+1, r.RaftLog.committed+1)
 			if err != nil {
@@ -568,29 +804,45 @@ func (r *Raft) Step(m pb.Message) error {
 			}
 
 			log.Info(fmt.Sprintf("%d is starting a new election at term %d", r.id, r.Term))
-
-			r.campaign()
+			if r.preVote {
+				r.campaign(campaignPreElection)
+			} else {
+				r.campaign(campaignElection)
+			}
 		} else {
 			log.Debug(fmt.Sprintf("%d ignoring MessageType_MsgHup because already leader", r.id))
 		}
 
-	case pb.MessageType_MsgRequestVote:
+	case pb.MessageType_MsgRequestVote, pb.MessageType_MsgPreVote:
 		// We can vote if this is a repeat of a vote we've already cast...
 		canVote := r.Vote == m.From ||
 			// ...we haven't voted and we don't think there's a leader yet in this term...
-			(r.Vote == None && r.Lead == None)
+			(r.Vote == None && r.Lead == None) ||
+			// ...or this is a PreVote for a future term...
+			(m.MsgType == pb.MessageType_MsgPreVote && m.Term > r.Term)
 		// ...and we believe the candidate is up to date.
 		if canVote && r.RaftLog.isUpToDate(m.Index, m.LogTerm) {
+			// When responding to Msg{Pre,}Vote messages we include the term
+			// from the message, not the local term. To see why, consider the
+			// case where a single node was previously partitioned away and
+			// it's local term is now out of date. If we include the local term
+			// (recall that for pre-votes we don't update the local term), the
+			// (pre-)campaigning node on the other end will proceed to ignore
+			// the message (it ignores all out of date messages).
+			// The term in the original message and current local term are the
+			// same in the case of regular votes, but different for pre-votes.
 			log.Info(fmt.Sprintf("%d [logterm: %d, index: %d, vote: %d] cast %s for %d [logterm: %d, index: %d] at term %d",
 				r.id, r.RaftLog.lastTerm(), r.RaftLog.LastIndex(), r.Vote, m.MsgType, m.From, m.LogTerm, m.Index, r.Term))
-			r.send(pb.Message{To: m.From, Term: m.Term, MsgType: pb.MessageType_MsgRequestVoteResponse})
-			// Only record real votes.
-			r.electionElapsed = 0
-			r.Vote = m.From
+			r.send(pb.Message{To: m.From, Term: m.Term, MsgType: voteRespMsgType(m.MsgType)})
+			if m.MsgType == pb.MessageType_MsgRequestVote {
+				// Only record real votes.
+				r.electionElapsed = 0
+				r.Vote = m.From
+			}
 		} else {
 			log.Info(fmt.Sprintf("%d [logterm: %d, index: %d, vote: %d] rejected %s from %d [logterm: %d, index: %d] at term %d",
 				r.id, r.RaftLog.lastTerm(), r.RaftLog.LastIndex(), r.Vote, m.MsgType, m.From, m.LogTerm, m.Index, r.Term))
-			r.send(pb.Message{To: m.From, Term: r.Term, MsgType: pb.MessageType_MsgRequestVoteResponse, Reject: true})
+			r.send(pb.Message{To: m.From, Term: r.Term, MsgType: voteRespMsgType(m.MsgType), Reject: true})
 		}
 
 	default:
@@ -600,7 +852,7 @@ func (r *Raft) Step(m pb.Message) error {
 			if err != nil {
 				return err
 			}
-		case StateCandidate:
+		case StateCandidate, StatePreCandidate:
 			err := r.stepCandidate(m)
 			if err != nil {
 				return err
@@ -620,7 +872,8 @@ type stepFunc func(r *Raft, m pb.Message) error
 // stepLeader handle leader's message
 func (r *Raft) stepLeader(m pb.Message) error {
 	pr := r.getProgress(m.From)
-	if pr == nil && m.MsgType != pb.MessageType_MsgBeat && m.MsgType != pb.MessageType_MsgPropose {
+	if pr == nil && m.MsgType != pb.MessageType_MsgBeat && m.MsgType != pb.MessageType_MsgPropose &&
+		m.MsgType != pb.MessageType_MsgReadIndex {
 		log.Debug(fmt.Sprintf("%d no progress available for %d", r.id, m.From))
 		return nil
 	}
@@ -644,10 +897,17 @@ func (r *Raft) stepLeader(m pb.Message) error {
 		}
 
 		for i, e := range m.Entries {
-			if e.EntryType == pb.EntryType_EntryConfChange {
+			if e.EntryType == pb.EntryType_EntryConfChange || e.EntryType == pb.EntryType_EntryConfChangeV2 {
+				var refused string
 				if r.PendingConfIndex > r.RaftLog.applied {
-					log.Info(fmt.Sprintf("propose conf %s ignored since pending unapplied configuration [index %d, applied %d]",
-						e.String(), r.PendingConfIndex, r.RaftLog.applied))
+					refused = fmt.Sprintf("pending unapplied configuration [index %d, applied %d]", r.PendingConfIndex, r.RaftLog.applied)
+				} else if wantsLeave := isLeaveJointEntry(e); r.voters.isJoint() && !wantsLeave {
+					refused = "it must transition out of joint config first"
+				} else if !r.voters.isJoint() && wantsLeave {
+					refused = "it is not in joint config"
+				}
+				if refused != "" {
+					log.Info(fmt.Sprintf("propose conf %s ignored since %s", e.String(), refused))
 					m.Entries[i] = &pb.Entry{EntryType: pb.EntryType_EntryNormal}
 				} else {
 					r.PendingConfIndex = r.RaftLog.LastIndex() + uint64(i) + 1
@@ -664,17 +924,50 @@ func (r *Raft) stepLeader(m pb.Message) error {
 		r.bcastAppend()
 		return nil
 	case pb.MessageType_MsgAppendResponse:
+		pr.RecentActive = true
 		if m.Reject {
 			log.Debug(fmt.Sprintf("%d received MessageType_MsgAppend rejection(lastindex: %d) from %d for index %d",
 				r.id, m.RejectHint, m.From, m.Index))
 			if pr.maybeDecrTo(m.Index, m.RejectHint) {
+				log.Debug(fmt.Sprintf("%d decreased progress of %d to [%s]", r.id, m.From, pr))
+				if pr.State == ProgressStateReplicate {
+					pr.becomeProbe()
+				}
 				r.sendAppend(m.From)
 			}
 		} else {
+			oldPaused := pr.IsPaused()
 			if pr.maybeUpdate(m.Index) {
+				switch {
+				case pr.State == ProgressStateProbe:
+					pr.becomeReplicate()
+				case pr.needSnapshotAbort():
+					log.Debug(fmt.Sprintf("%d snapshot aborted, resumed sending replication messages to %d [%s]", r.id, m.From, pr))
+					// Transition back to replicating state via probing state
+					// (which takes the snapshot into account). If we didn't
+					// move to replicating state, that would only happen with
+					// the next round of appends (but there may not be a next
+					// round for a while, exposing an inconsistent RaftStatus).
+					pr.becomeProbe()
+					pr.becomeReplicate()
+				case pr.State == ProgressStateReplicate:
+					pr.ins.freeTo(m.Index)
+				}
 
 				if r.maybeCommit() {
 					r.bcastAppend()
+				} else if oldPaused {
+					// If we were paused before, this node may be missing the
+					// latest commit index, so send it.
+					r.sendAppend(m.From)
+				}
+				// We've updated flow control information above, which may
+				// allow us to send multiple (size-limited) in-flight messages
+				// at once (such as when transitioning from probe to
+				// replicate, or when freeTo() covers multiple messages). If
+				// we have more entries to send, send as many messages as we
+				// can (without sending empty messages for the commit index)
+				for r.maybeSendAppend(m.From, false) {
 				}
 				// Transfer leadership is in progress.
 				if m.From == r.leadTransferee && pr.Match == r.RaftLog.LastIndex() {
@@ -684,9 +977,74 @@ func (r *Raft) stepLeader(m pb.Message) error {
 			}
 		}
 	case pb.MessageType_MsgHeartbeatResponse:
+		pr.RecentActive = true
+		pr.resume()
+
+		// free one slot for the full inflights window to allow progress.
+		if pr.State == ProgressStateReplicate && pr.ins.full() {
+			pr.ins.freeFirstOne()
+		}
 		if pr.Match < r.RaftLog.LastIndex() {
 			r.sendAppend(m.From)
 		}
+
+		if r.readOnly.option != ReadOnlySafe || len(m.Context) == 0 {
+			return nil
+		}
+
+		if r.voters.voteResult(r.readOnly.recvAck(m.From, m.Context)) != voteWon {
+			return nil
+		}
+
+		rss := r.readOnly.advance(m)
+		for _, rs := range rss {
+			r.respondReadIndex(rs.req, rs.index)
+		}
+	case pb.MessageType_MsgReadIndex:
+		if !r.isSingleton() {
+			if !r.CommittedEntryInCurrentTerm() {
+				// Reject read only request when this leader has not committed any log entry at its term.
+				return nil
+			}
+
+			// thinking: use an internally defined context instead of the user given context.
+			// We can express this in terms of the term and index instead of a user-supplied value.
+			// This would allow multiple reads to piggyback on the same message.
+			switch r.readOnly.option {
+			case ReadOnlySafe:
+				r.readOnly.addRequest(r.RaftLog.committed, m)
+				r.readOnly.recvAck(r.id, m.Entries[0].Data)
+				r.bcastHeartbeatWithCtx(m.Entries[0].Data)
+			case ReadOnlyLeaseBased:
+				r.respondReadIndex(m, r.RaftLog.committed)
+			}
+		} else {
+			// A single node group is always up to date.
+			r.respondReadIndex(m, r.RaftLog.committed)
+		}
+	case pb.MessageType_MsgSnapStatus:
+		if pr.State != ProgressStateSnapshot {
+			return nil
+		}
+		if !m.Reject {
+			pr.becomeProbe()
+			log.Debug(fmt.Sprintf("%d snapshot succeeded, resumed sending replication messages to %d [%s]", r.id, m.From, pr))
+		} else {
+			pr.snapshotFailure()
+			pr.becomeProbe()
+			log.Debug(fmt.Sprintf("%d snapshot failed, resumed sending replication messages to %d [%s]", r.id, m.From, pr))
+		}
+		// If snapshot finish, wait for the MessageType_MsgAppendResponse from the remote node before sending
+		// out the next MessageType_MsgAppend.
+		// If snapshot failure, wait for a heartbeat interval before next try
+		pr.pause()
+	case pb.MessageType_MsgUnreachable:
+		// During optimistic replication, if the remote becomes unreachable,
+		// there is huge probability that a MessageType_MsgAppend is lost.
+		if pr.State == ProgressStateReplicate {
+			pr.becomeProbe()
+		}
+		log.Debug(fmt.Sprintf("%d failed to send message to %d because it is unreachable [%s]", r.id, m.From, pr))
 	case pb.MessageType_MsgTransferLeader:
 		leadTransferee := m.From
 		lastLeadTransferee := r.leadTransferee
@@ -703,6 +1061,10 @@ func (r *Raft) stepLeader(m pb.Message) error {
 			log.Debug(fmt.Sprintf("%d is already leader. Ignored transferring leadership to self", r.id))
 			return nil
 		}
+		if pr.IsLearner {
+			log.Debug(fmt.Sprintf("%d is learner. Ignored transferring leadership", leadTransferee))
+			return nil
+		}
 		// Transfer leadership to third party.
 		log.Info(fmt.Sprintf("%d [term %d] starts to transfer leadership to %d", r.id, r.Term, leadTransferee))
 		// Transfer leadership should be finished in one electionTimeout, so reset r.electionElapsed.
@@ -718,8 +1080,18 @@ func (r *Raft) stepLeader(m pb.Message) error {
 	return nil
 }
 
-// stepCandidate handle candidate's message
+// stepCandidate is shared by StateCandidate and StatePreCandidate; the difference is
+// whether they respond to MessageType_MsgRequestVoteResponse or MessageType_MsgPreVoteResponse.
 func (r *Raft) stepCandidate(m pb.Message) error {
+	// Only handle vote responses corresponding to our candidacy (while in
+	// StateCandidate, we may get stale MessageType_MsgPreVoteResponse messages in this term from
+	// our pre-candidate state).
+	var myVoteRespType pb.MessageType
+	if r.State == StatePreCandidate {
+		myVoteRespType = pb.MessageType_MsgPreVoteResponse
+	} else {
+		myVoteRespType = pb.MessageType_MsgRequestVoteResponse
+	}
 	switch m.MsgType {
 	case pb.MessageType_MsgPropose:
 		log.Info(fmt.Sprintf("%d no leader at term %d; dropping proposal", r.id, r.Term))
@@ -733,14 +1105,19 @@ func (r *Raft) stepCandidate(m pb.Message) error {
 	case pb.MessageType_MsgSnapshot:
 		r.becomeFollower(m.Term, m.From) // always m.Term == r.Term
 		r.handleSnapshot(m)
-	case pb.MessageType_MsgRequestVoteResponse:
-		gr := r.poll(m.From, m.MsgType, !m.Reject)
-		log.Info(fmt.Sprintf("%d [quorum:%d] has received %d %s votes and %d vote rejections", r.id, r.quorum(), gr, m.MsgType, len(r.votes)-gr))
-		switch r.quorum() {
-		case gr:
-			r.becomeLeader()
-			r.bcastAppend()
-		case len(r.votes) - gr:
+	case myVoteRespType:
+		gr, rj, res := r.poll(m.From, m.MsgType, !m.Reject)
+		log.Info(fmt.Sprintf("%d has received %d %s votes and %d vote rejections", r.id, gr, m.MsgType, rj))
+		switch res {
+		case voteWon:
+			if r.State == StatePreCandidate {
+				r.campaign(campaignElection)
+			} else {
+				r.becomeLeader()
+				r.bcastAppend()
+			}
+		case voteLost:
+			// pb.MessageType_MsgPreVoteResponse contains future term of pre-candidate
 			// m.Term > r.Term; reuse r.Term
 			r.becomeFollower(r.Term, None)
 		}
@@ -775,10 +1152,26 @@ func (r *Raft) stepFollower(m pb.Message) error {
 		}
 		m.To = r.Lead
 		r.send(m)
+	case pb.MessageType_MsgReadIndex:
+		if r.Lead == None {
+			log.Info(fmt.Sprintf("%d no leader at term %d; dropping index reading msg", r.id, r.Term))
+			return nil
+		}
+		m.To = r.Lead
+		r.send(m)
+	case pb.MessageType_MsgReadIndexResp:
+		if len(m.Entries) != 1 {
+			log.Error(fmt.Sprintf("%d invalid format of MessageType_MsgReadIndexResp from %d, entries count: %d", r.id, m.From, len(m.Entries)))
+			return nil
+		}
+		r.readStates = append(r.readStates, ReadState{Index: m.Index, RequestCtx: m.Entries[0].Data})
 	case pb.MessageType_MsgTimeoutNow:
 		if r.promotable() {
 			log.Info(fmt.Sprintf("%d [term %d] received MessageType_MsgTimeoutNow from %d and starts an election to get leadership.", r.id, r.Term, m.From))
-			r.campaign()
+			// Leadership transfers never use pre-vote even if r.preVote is true; we
+			// know we are not recovering from a partition so there is no need for the
+			// extra round trip.
+			r.campaign(campaignTransfer)
 		} else {
 			log.Info(fmt.Sprintf("%d received MessageType_MsgTimeoutNow from %d but is not promotable", r.id, m.From))
 		}
@@ -809,7 +1202,7 @@ func (r *Raft) handleAppendEntries(m pb.Message) {
 // handleHeartbeat handle Heartbeat RPC request
 func (r *Raft) handleHeartbeat(m pb.Message) {
 	r.RaftLog.commitTo(m.Commit)
-	r.send(pb.Message{To: m.From, MsgType: pb.MessageType_MsgHeartbeatResponse})
+	r.send(pb.Message{To: m.From, MsgType: pb.MessageType_MsgHeartbeatResponse, Context: m.Context})
 }
 
 // handleSnapshot handle Snapshot RPC request
@@ -843,61 +1236,35 @@ func (r *Raft) restore(s pb.Snapshot) bool {
 		r.id, r.RaftLog.committed, r.RaftLog.LastIndex(), r.RaftLog.lastTerm(), s.Metadata.Index, s.Metadata.Term))
 
 	r.RaftLog.restore(s)
-	r.Prs = make(map[uint64]*Progress)
-	r.restoreNode(s.Metadata.ConfState.Nodes)
+	r.restoreConf(*s.Metadata.ConfState)
 	return true
 }
 
-func (r *Raft) restoreNode(nodes []uint64) {
-	for _, n := range nodes {
-		match, next := uint64(0), r.RaftLog.LastIndex()+1
-		if n == r.id {
-			match = next - 1
-		}
-		r.setProgress(n, match, next)
-		log.Info(fmt.Sprintf("%d restored progress of %d [%+v]", r.id, n, r.getProgress(n)))
-	}
-}
-
 // promotable indicates whether state machine can be promoted to Leader,
-// which is true when its own id is in progress list.
+// which is true when it is a voter of the group.
 func (r *Raft) promotable() bool {
-	_, ok := r.Prs[r.id]
-	return ok
+	return r.voters.contains(r.id)
 }
 
-// addNode add a new node to raft group
-func (r *Raft) addNode(id uint64) {
-	if r.getProgress(id) == nil {
-		r.setProgress(id, 0, r.RaftLog.LastIndex()+1)
-	} else {
-		return
-	}
+// isSingleton returns true if the group has a single voter and isn't changing its
+// configuration.
+func (r *Raft) isSingleton() bool {
+	return len(r.voters[0]) == 1 && !r.voters.isJoint()
 }
 
-// removeNode remove a node from raft group
-func (r *Raft) removeNode(id uint64) {
-	delete(r.Prs, id)
-
-	// do not try to commit or abort transferring if there is no nodes in the cluster.
-	if len(r.Prs) == 0 {
-		return
-	}
+// addNode add a new node to raft group, or promotes it if it is a learner
+func (r *Raft) addNode(id uint64) {
+	r.applyConfChange(confChangeV1ToV2(pb.ConfChange{ChangeType: pb.ConfChangeType_AddNode, NodeId: id}))
+}
 
-	// The quorum size is now smaller, so see if any pending entries can
-	// be committed.
-	if r.maybeCommit() {
-		r.bcastAppend()
-	}
-	// If the removed node is the leadTransferee, then abort the leadership transferring.
-	if r.State == StateLeader && r.leadTransferee == id {
-		r.abortLeaderTransfer()
-	}
+// addLearner add a new learner to raft group, or demotes it if it is a voter
+func (r *Raft) addLearner(id uint64) {
+	r.applyConfChange(confChangeV1ToV2(pb.ConfChange{ChangeType: pb.ConfChangeType_AddLearnerNode, NodeId: id}))
 }
 
-func (r *Raft) setProgress(id, match, next uint64) {
-	r.Prs[id] = &Progress{Next: next, Match: match}
-	return
+// removeNode remove a node from raft group
+func (r *Raft) removeNode(id uint64) {
+	r.applyConfChange(confChangeV1ToV2(pb.ConfChange{ChangeType: pb.ConfChangeType_RemoveNode, NodeId: id}))
 }
 
 func (r *Raft) loadState(state pb.HardState) {
@@ -920,6 +1287,43 @@ func (r *Raft) resetRandomizedElectionTimeout() {
 	r.randomizedElectionTimeout = r.electionTimeout + globalRand.Intn(r.electionTimeout)
 }
 
+// checkQuorumActive returns true if the quorum is active from
+// the view of the local raft state machine. Otherwise, it returns
+// false.
+// checkQuorumActive also resets all RecentActive to false.
+func (r *Raft) checkQuorumActive() bool {
+	active := make(map[uint64]bool)
+
+	r.forEachProgress(func(id uint64, pr *Progress) {
+		if id == r.id { // self is always active
+			active[id] = true
+			return
+		}
+
+		active[id] = pr.RecentActive
+		pr.RecentActive = false
+	})
+
+	return r.voters.voteResult(active) == voteWon
+}
+
+// CommittedEntryInCurrentTerm returns true if the peer has committed an entry of its current
+// term. A new leader doesn't know the commit index of the group until it does, so it can't
+// serve read only requests before.
+func (r *Raft) CommittedEntryInCurrentTerm() bool {
+	return r.RaftLog.zeroTermOnRangeErr(r.RaftLog.Term(r.RaftLog.committed)) == r.Term
+}
+
+// respondReadIndex answers the read only request m with the read index, as a read state if
+// it was stepped locally and as a MessageType_MsgReadIndexResp if a follower forwarded it.
+func (r *Raft) respondReadIndex(m pb.Message, index uint64) {
+	if m.From == None || m.From == r.id {
+		r.readStates = append(r.readStates, ReadState{Index: index, RequestCtx: m.Entries[0].Data})
+		return
+	}
+	r.send(pb.Message{To: m.From, MsgType: pb.MessageType_MsgReadIndexResp, Index: index, Entries: m.Entries})
+}
+
 func (r *Raft) sendTimeoutNow(to uint64) {
 	r.send(pb.Message{To: to, MsgType: pb.MessageType_MsgTimeoutNow})
 }
@@ -931,43 +1335,9 @@ func (r *Raft) abortLeaderTransfer() {
 func numOfPendingConf(ents []pb.Entry) int {
 	n := 0
 	for i := range ents {
-		if ents[i].EntryType == pb.EntryType_EntryConfChange {
+		if ents[i].EntryType == pb.EntryType_EntryConfChange || ents[i].EntryType == pb.EntryType_EntryConfChangeV2 {
 			n++
 		}
 	}
 	return n
 }
-
-// Progress represents a follower’s progress in the view of the leader. Leader maintains
-// progresses of all followers, and sends entries to the follower based on its progress.
-type Progress struct {
-	Match, Next uint64
-}
-
-// maybeUpdate returns false if the given n index comes from an outdated message.
-// Otherwise it updates the progress and returns true.
-func (pr *Progress) maybeUpdate(n uint64) bool {
-	var updated bool
-	if pr.Match < n {
-		pr.Match = n
-		updated = true
-	}
-	if pr.Next < n+1 {
-		pr.Next = n + 1
-	}
-	return updated
-}
-
-// maybeDecrTo returns false if the given to index comes from an out of order message.
-// Otherwise it decreases the progress next index to min(rejected, last) and returns true.
-func (pr *Progress) maybeDecrTo(rejected, last uint64) bool {
-	// the rejection must be stale if the progress has matched and "rejected"
-	// is smaller than "match".
-	if rejected <= pr.Match {
-		return false
-	}
-	if pr.Next = min(rejected, last+1); pr.Next < 1 {
-		pr.Next = 1
-	}
-	return true
-}
@@ -869,6 +869,357 @@ func TestDisruptiveFollower2A(t *testing.T) {
 	}
 }
 
+// TestDisruptiveFollowerPreVote tests isolated follower,
+// with slow network incoming from leader, election times out
+// to become a pre-candidate with less log than current leader.
+// Then pre-vote phase prevents this isolated node from forcing
+// current leader to step down, thus less disruptions.
+func TestDisruptiveFollowerPreVote2A(t *testing.T) {
+	n1 := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	n2 := newTestRaft(2, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	n3 := newTestRaft(3, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+
+	n1.becomeFollower(1, None)
+	n2.becomeFollower(1, None)
+	n3.becomeFollower(1, None)
+
+	nt := newNetwork(n1, n2, n3)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	if n1.State != StateLeader {
+		t.Fatalf("node 1 state: %s, want %s", n1.State, StateLeader)
+	}
+
+	nt.isolate(3)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+	n1.preVote = true
+	n2.preVote = true
+	n3.preVote = true
+	nt.recover()
+	nt.send(pb.Message{From: 3, To: 3, MsgType: pb.MessageType_MsgHup})
+
+	// check state
+	if n1.State != StateLeader {
+		t.Fatalf("node 1 state: %s, want %s", n1.State, StateLeader)
+	}
+	if n2.State != StateFollower {
+		t.Fatalf("node 2 state: %s, want %s", n2.State, StateFollower)
+	}
+	// both peers reject the pre-vote of the lagging node, so it falls back to
+	// follower without ever bumping its term
+	if n3.State != StateFollower {
+		t.Fatalf("node 3 state: %s, want %s", n3.State, StateFollower)
+	}
+
+	// check term
+	if n1.Term != 2 {
+		t.Fatalf("node 1 term: %d, want %d", n1.Term, 2)
+	}
+	if n2.Term != 2 {
+		t.Fatalf("node 2 term: %d, want %d", n2.Term, 2)
+	}
+	if n3.Term != 2 {
+		t.Fatalf("node 3 term: %d, want %d", n3.Term, 2)
+	}
+
+	// delayed leader heartbeat does not force current leader to step down
+	nt.send(pb.Message{From: 1, To: 3, Term: n1.Term, MsgType: pb.MessageType_MsgHeartbeat})
+	if n1.State != StateLeader {
+		t.Fatalf("node 1 state: %s, want %s", n1.State, StateLeader)
+	}
+}
+
+func TestLeaderElectionPreVote2A(t *testing.T) {
+	cfg := preVoteConfig
+	tests := []struct {
+		*network
+		state   StateType
+		expTerm uint64
+	}{
+		{newNetworkWithConfig(cfg, nil, nil, nil), StateLeader, 1},
+		{newNetworkWithConfig(cfg, nil, nil, nopStepper), StateLeader, 1},
+		// The pre-candidate doesn't get a quorum, so the term is never bumped.
+		{newNetworkWithConfig(cfg, nil, nopStepper, nopStepper), StatePreCandidate, 0},
+		{newNetworkWithConfig(cfg, nil, nopStepper, nopStepper, nil), StatePreCandidate, 0},
+		{newNetworkWithConfig(cfg, nil, nopStepper, nopStepper, nil, nil), StateLeader, 1},
+	}
+
+	for i, tt := range tests {
+		tt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+		sm := tt.network.peers[1].(*Raft)
+		if sm.State != tt.state {
+			t.Errorf("#%d: state = %s, want %s", i, sm.State, tt.state)
+		}
+		if g := sm.Term; g != tt.expTerm {
+			t.Errorf("#%d: term = %d, want %d", i, g, tt.expTerm)
+		}
+	}
+}
+
+// TestPreVoteWithSplitVote verifies that after split vote, cluster can complete
+// election in next round.
+func TestPreVoteWithSplitVote2A(t *testing.T) {
+	n1 := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	n2 := newTestRaft(2, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	n3 := newTestRaft(3, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+
+	n1.becomeFollower(1, None)
+	n2.becomeFollower(1, None)
+	n3.becomeFollower(1, None)
+
+	n1.preVote = true
+	n2.preVote = true
+	n3.preVote = true
+
+	nt := newNetwork(n1, n2, n3)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	// simulate leader down. followers start split vote.
+	nt.isolate(1)
+	nt.send([]pb.Message{
+		{From: 2, To: 2, MsgType: pb.MessageType_MsgHup},
+		{From: 3, To: 3, MsgType: pb.MessageType_MsgHup},
+	}...)
+
+	// check whether the term values are expected
+	// n2.Term == 3
+	// n3.Term == 3
+	if n2.Term != 3 {
+		t.Errorf("peer 2 term: %d, want %d", n2.Term, 3)
+	}
+	if n3.Term != 3 {
+		t.Errorf("peer 3 term: %d, want %d", n3.Term, 3)
+	}
+
+	// check state
+	// n2 == candidate
+	// n3 == candidate
+	if n2.State != StateCandidate {
+		t.Errorf("peer 2 state: %s, want %s", n2.State, StateCandidate)
+	}
+	if n3.State != StateCandidate {
+		t.Errorf("peer 3 state: %s, want %s", n3.State, StateCandidate)
+	}
+
+	// node 2 election timeout first
+	nt.send(pb.Message{From: 2, To: 2, MsgType: pb.MessageType_MsgHup})
+
+	// n2.Term == 4
+	// n3.Term == 4
+	if n2.Term != 4 {
+		t.Errorf("peer 2 term: %d, want %d", n2.Term, 4)
+	}
+	if n3.Term != 4 {
+		t.Errorf("peer 3 term: %d, want %d", n3.Term, 4)
+	}
+
+	// n2 == leader
+	// n3 == follower
+	if n2.State != StateLeader {
+		t.Errorf("peer 2 state: %s, want %s", n2.State, StateLeader)
+	}
+	if n3.State != StateFollower {
+		t.Errorf("peer 3 state: %s, want %s", n3.State, StateFollower)
+	}
+}
+
+// TestLeaderStepdownWhenQuorumActive tests that the leader keeps its leadership
+// while the quorum is active.
+func TestLeaderStepdownWhenQuorumActive2A(t *testing.T) {
+	sm := newTestRaft(1, []uint64{1, 2, 3}, 5, 1, NewMemoryStorage())
+	sm.checkQuorum = true
+
+	sm.becomeCandidate()
+	sm.becomeLeader()
+
+	for i := 0; i < sm.electionTimeout+1; i++ {
+		sm.Step(pb.Message{From: 2, MsgType: pb.MessageType_MsgHeartbeatResponse, Term: sm.Term})
+		sm.tick()
+	}
+
+	if sm.State != StateLeader {
+		t.Errorf("state = %v, want %v", sm.State, StateLeader)
+	}
+}
+
+// TestLeaderStepdownWhenQuorumLost tests that the partitioned leader steps
+// down once it hasn't heard from the quorum for an election timeout.
+func TestLeaderStepdownWhenQuorumLost2A(t *testing.T) {
+	sm := newTestRaft(1, []uint64{1, 2, 3}, 5, 1, NewMemoryStorage())
+	sm.checkQuorum = true
+
+	sm.becomeCandidate()
+	sm.becomeLeader()
+
+	for i := 0; i < sm.electionTimeout+1; i++ {
+		sm.tick()
+	}
+
+	if sm.State != StateFollower {
+		t.Errorf("state = %v, want %v", sm.State, StateFollower)
+	}
+}
+
+func TestLeaderSupersedingWithCheckQuorum2A(t *testing.T) {
+	a := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	b := newTestRaft(2, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	c := newTestRaft(3, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+
+	a.checkQuorum = true
+	b.checkQuorum = true
+	c.checkQuorum = true
+
+	nt := newNetwork(a, b, c)
+	b.randomizedElectionTimeout = b.electionTimeout + 1
+
+	for i := 0; i < b.electionTimeout; i++ {
+		b.tick()
+	}
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	if a.State != StateLeader {
+		t.Errorf("state = %s, want %s", a.State, StateLeader)
+	}
+
+	if c.State != StateFollower {
+		t.Errorf("state = %s, want %s", c.State, StateFollower)
+	}
+
+	nt.send(pb.Message{From: 3, To: 3, MsgType: pb.MessageType_MsgHup})
+
+	// Peer b rejected c's vote since its electionElapsed had not reached to electionTimeout
+	if c.State != StateCandidate {
+		t.Errorf("state = %s, want %s", c.State, StateCandidate)
+	}
+
+	// Letting b's electionElapsed reach to electionTimeout
+	for i := 0; i < b.electionTimeout; i++ {
+		b.tick()
+	}
+	nt.send(pb.Message{From: 3, To: 3, MsgType: pb.MessageType_MsgHup})
+
+	if c.State != StateLeader {
+		t.Errorf("state = %s, want %s", c.State, StateLeader)
+	}
+}
+
+// TestFreeStuckCandidateWithCheckQuorum ensures that a candidate with a higher term
+// can disrupt the leader even if the leader still "officially" holds the lease, The
+// leader is expected to step down and adopt the candidate's term
+func TestFreeStuckCandidateWithCheckQuorum2A(t *testing.T) {
+	a := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	b := newTestRaft(2, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	c := newTestRaft(3, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+
+	a.checkQuorum = true
+	b.checkQuorum = true
+	c.checkQuorum = true
+
+	nt := newNetwork(a, b, c)
+	b.randomizedElectionTimeout = b.electionTimeout + 1
+
+	for i := 0; i < b.electionTimeout; i++ {
+		b.tick()
+	}
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	nt.isolate(1)
+	nt.send(pb.Message{From: 3, To: 3, MsgType: pb.MessageType_MsgHup})
+
+	if b.State != StateFollower {
+		t.Errorf("state = %s, want %s", b.State, StateFollower)
+	}
+
+	if c.State != StateCandidate {
+		t.Errorf("state = %s, want %s", c.State, StateCandidate)
+	}
+
+	if c.Term != b.Term+1 {
+		t.Errorf("term = %d, want %d", c.Term, b.Term+1)
+	}
+
+	// Vote again for safety
+	nt.send(pb.Message{From: 3, To: 3, MsgType: pb.MessageType_MsgHup})
+
+	if b.State != StateFollower {
+		t.Errorf("state = %s, want %s", b.State, StateFollower)
+	}
+
+	if c.State != StateCandidate {
+		t.Errorf("state = %s, want %s", c.State, StateCandidate)
+	}
+
+	if c.Term != b.Term+2 {
+		t.Errorf("term = %d, want %d", c.Term, b.Term+2)
+	}
+
+	nt.recover()
+	nt.send(pb.Message{From: 1, To: 3, MsgType: pb.MessageType_MsgHeartbeat, Term: a.Term})
+
+	// Disrupt the leader so that the stuck peer is freed
+	if a.State != StateFollower {
+		t.Errorf("state = %s, want %s", a.State, StateFollower)
+	}
+
+	if c.Term != a.Term {
+		t.Errorf("term = %d, want %d", c.Term, a.Term)
+	}
+
+	// Vote again, should become leader this time
+	nt.send(pb.Message{From: 3, To: 3, MsgType: pb.MessageType_MsgHup})
+
+	if c.State != StateLeader {
+		t.Errorf("peer 3 state: %s, want %s", c.State, StateLeader)
+	}
+}
+
+// TestRejoinWithPreVoteAndCheckQuorum ensures that a partitioned follower which
+// rejoins the cluster doesn't disrupt the healthy leader when both PreVote and
+// CheckQuorum are enabled, and that it catches up with the leader afterwards.
+func TestRejoinWithPreVoteAndCheckQuorum2A(t *testing.T) {
+	nt := newNetworkWithConfig(func(c *Config) {
+		c.PreVote = true
+		c.CheckQuorum = true
+	}, nil, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	a := nt.peers[1].(*Raft)
+	c := nt.peers[3].(*Raft)
+	if a.State != StateLeader {
+		t.Fatalf("state = %s, want %s", a.State, StateLeader)
+	}
+
+	nt.isolate(3)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+	// The isolated follower keeps timing out without ever bumping its term.
+	for i := 0; i < 3; i++ {
+		nt.send(pb.Message{From: 3, To: 3, MsgType: pb.MessageType_MsgHup})
+		if c.State != StatePreCandidate {
+			t.Fatalf("state = %s, want %s", c.State, StatePreCandidate)
+		}
+		if c.Term != a.Term {
+			t.Fatalf("term = %d, want %d", c.Term, a.Term)
+		}
+	}
+
+	nt.recover()
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgBeat})
+	if a.State != StateLeader {
+		t.Errorf("state = %s, want %s", a.State, StateLeader)
+	}
+	if c.State != StateFollower {
+		t.Errorf("state = %s, want %s", c.State, StateFollower)
+	}
+	if c.Lead != 1 {
+		t.Errorf("lead = %d, want 1", c.Lead)
+	}
+	if c.RaftLog.committed != a.RaftLog.committed {
+		t.Errorf("committed = %d, want %d", c.RaftLog.committed, a.RaftLog.committed)
+	}
+}
+
 func TestHeartbeatUpdateCommit2AB(t *testing.T) {
 	tests := []struct {
 		failCnt    int
@@ -977,118 +1328,759 @@ func TestRestoreSnapshot2B(t *testing.T) {
 	sm := newTestRaft(1, []uint64{1, 2}, 10, 1, storage)
 	sm.handleSnapshot(pb.Message{Snapshot: &s})
 
-	if sm.RaftLog.LastIndex() != s.Metadata.Index {
-		t.Errorf("log.lastIndex = %d, want %d", sm.RaftLog.LastIndex(), s.Metadata.Index)
-	}
-	if mustTerm(sm.RaftLog.Term(s.Metadata.Index)) != s.Metadata.Term {
-		t.Errorf("log.lastTerm = %d, want %d", mustTerm(sm.RaftLog.Term(s.Metadata.Index)), s.Metadata.Term)
-	}
-	sg := nodes(sm)
-	if !reflect.DeepEqual(sg, s.Metadata.ConfState.Nodes) {
-		t.Errorf("sm.Nodes = %+v, want %+v", sg, s.Metadata.ConfState.Nodes)
+	if sm.RaftLog.LastIndex() != s.Metadata.Index {
+		t.Errorf("log.lastIndex = %d, want %d", sm.RaftLog.LastIndex(), s.Metadata.Index)
+	}
+	if mustTerm(sm.RaftLog.Term(s.Metadata.Index)) != s.Metadata.Term {
+		t.Errorf("log.lastTerm = %d, want %d", mustTerm(sm.RaftLog.Term(s.Metadata.Index)), s.Metadata.Term)
+	}
+	sg := nodes(sm)
+	if !reflect.DeepEqual(sg, s.Metadata.ConfState.Nodes) {
+		t.Errorf("sm.Nodes = %+v, want %+v", sg, s.Metadata.ConfState.Nodes)
+	}
+}
+
+func TestRestoreIgnoreSnapshot2B(t *testing.T) {
+	previousEnts := []pb.Entry{{Term: 1, Index: 1}, {Term: 1, Index: 2}, {Term: 1, Index: 3}}
+	storage := NewMemoryStorage()
+	storage.Append(previousEnts)
+	sm := newTestRaft(1, []uint64{1, 2}, 10, 1, storage)
+	sm.RaftLog.committed = 3
+
+	commit := uint64(1)
+	s := pb.Snapshot{
+		Metadata: &pb.SnapshotMetadata{
+			Index:     commit,
+			Term:      1,
+			ConfState: &pb.ConfState{Nodes: []uint64{1, 2}},
+		},
+	}
+
+	// ignore snapshot
+	sm.handleSnapshot(pb.Message{Snapshot: &s})
+	if sm.RaftLog.committed == commit {
+		t.Errorf("commit = %d, want %d", sm.RaftLog.committed, commit)
+	}
+}
+
+func TestProvideSnap2B(t *testing.T) {
+	// restore the state machine from a snapshot so it has a compacted log and a snapshot
+	s := pb.Snapshot{
+		Metadata: &pb.SnapshotMetadata{
+			Index:     11, // magic number
+			Term:      11, // magic number
+			ConfState: &pb.ConfState{Nodes: []uint64{1, 2}},
+		},
+	}
+	storage := NewMemoryStorage()
+	sm := newTestRaft(1, []uint64{1}, 10, 1, storage)
+	sm.handleSnapshot(pb.Message{Snapshot: &s})
+
+	sm.becomeCandidate()
+	sm.becomeLeader()
+	sm.readMessages() // clear message
+
+	// force set the next of node 2, so that node 2 needs a snapshot
+	sm.Prs[2].Next = 0
+	sm.Step(pb.Message{From: 2, To: 1, Term: 1, MsgType: pb.MessageType_MsgAppendResponse, Index: sm.Prs[2].Next - 1, Reject: true})
+
+	msgs := sm.readMessages()
+	if len(msgs) != 1 {
+		t.Fatalf("len(msgs) = %d, want 1", len(msgs))
+	}
+	m := msgs[0]
+	if m.MsgType != pb.MessageType_MsgSnapshot {
+		t.Errorf("m.MsgType = %v, want %v", m.MsgType, pb.MessageType_MsgSnapshot)
+	}
+}
+
+func TestRestoreFromSnapMsg2B(t *testing.T) {
+	s := pb.Snapshot{
+		Metadata: &pb.SnapshotMetadata{
+			Index:     11, // magic number
+			Term:      11, // magic number
+			ConfState: &pb.ConfState{Nodes: []uint64{1, 2}},
+		},
+	}
+	m := pb.Message{MsgType: pb.MessageType_MsgSnapshot, From: 1, Term: 2, Snapshot: &s}
+
+	sm := newTestRaft(2, []uint64{1, 2}, 10, 1, NewMemoryStorage())
+	sm.Step(m)
+
+	if sm.Lead != uint64(1) {
+		t.Errorf("sm.Lead = %d, want 1", sm.Lead)
+	}
+}
+
+func TestSlowNodeRestore2B(t *testing.T) {
+	nt := newNetwork(nil, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	nt.isolate(3)
+	for j := 0; j <= 100; j++ {
+		nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+	}
+	lead := nt.peers[1].(*Raft)
+	nextEnts(lead, nt.storage[1])
+	nt.storage[1].CreateSnapshot(lead.RaftLog.applied, &pb.ConfState{Nodes: nodes(lead)}, nil)
+	nt.storage[1].Compact(lead.RaftLog.applied)
+
+	nt.recover()
+
+	// send heartbeats so that the leader can learn everyone is active.
+	// node 3 will only be considered as active when node 1 receives a reply from it.
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgBeat})
+
+	// trigger a snapshot
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+
+	follower := nt.peers[3].(*Raft)
+
+	// trigger a commit
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+	if follower.RaftLog.committed != lead.RaftLog.committed {
+		t.Errorf("follower.committed = %d, want %d", follower.RaftLog.committed, lead.RaftLog.committed)
+	}
+}
+
+func TestSendAppendForProgressProbe2B(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2}, 10, 1, NewMemoryStorage())
+	r.becomeCandidate()
+	r.becomeLeader()
+	r.readMessages()
+	r.Prs[2].becomeProbe()
+
+	// each round is a heartbeat
+	for i := 0; i < 3; i++ {
+		if i == 0 {
+			// we expect that raft will only send out one MessageType_MsgAppend on the first
+			// loop. After that, the follower is paused until a heartbeat response is
+			// received.
+			r.appendEntry(pb.Entry{Data: []byte("somedata")})
+			r.sendAppend(2)
+			msg := r.readMessages()
+			if len(msg) != 1 {
+				t.Errorf("len(msg) = %d, want %d", len(msg), 1)
+			}
+			if msg[0].Index != 0 {
+				t.Errorf("index = %d, want %d", msg[0].Index, 0)
+			}
+		}
+
+		if !r.Prs[2].Paused {
+			t.Errorf("paused = %v, want true", r.Prs[2].Paused)
+		}
+		for j := 0; j < 10; j++ {
+			r.appendEntry(pb.Entry{Data: []byte("somedata")})
+			r.sendAppend(2)
+			if l := len(r.readMessages()); l != 0 {
+				t.Errorf("len(msg) = %d, want %d", l, 0)
+			}
+		}
+
+		// do a heartbeat
+		for j := 0; j < r.heartbeatTimeout; j++ {
+			r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgBeat})
+		}
+		if !r.Prs[2].Paused {
+			t.Errorf("paused = %v, want true", r.Prs[2].Paused)
+		}
+
+		// consume the heartbeat
+		msg := r.readMessages()
+		if len(msg) != 1 {
+			t.Errorf("len(msg) = %d, want %d", len(msg), 1)
+		}
+		if msg[0].MsgType != pb.MessageType_MsgHeartbeat {
+			t.Errorf("type = %v, want %v", msg[0].MsgType, pb.MessageType_MsgHeartbeat)
+		}
+	}
+
+	// a heartbeat response will allow another message to be sent
+	r.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgHeartbeatResponse})
+	msg := r.readMessages()
+	if len(msg) != 1 {
+		t.Errorf("len(msg) = %d, want %d", len(msg), 1)
+	}
+	if msg[0].Index != 0 {
+		t.Errorf("index = %d, want %d", msg[0].Index, 0)
+	}
+	if !r.Prs[2].Paused {
+		t.Errorf("paused = %v, want true", r.Prs[2].Paused)
+	}
+}
+
+func TestSendAppendForProgressReplicate2B(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2}, 10, 1, NewMemoryStorage())
+	r.becomeCandidate()
+	r.becomeLeader()
+	r.readMessages()
+	r.Prs[2].becomeReplicate()
+
+	for i := 0; i < 10; i++ {
+		r.appendEntry(pb.Entry{Data: []byte("somedata")})
+		r.sendAppend(2)
+		msgs := r.readMessages()
+		if len(msgs) != 1 {
+			t.Errorf("len(msg) = %d, want %d", len(msgs), 1)
+		}
+	}
+}
+
+func TestSendAppendForProgressSnapshot2B(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2}, 10, 1, NewMemoryStorage())
+	r.becomeCandidate()
+	r.becomeLeader()
+	r.readMessages()
+	r.Prs[2].becomeSnapshot(10)
+
+	for i := 0; i < 10; i++ {
+		r.appendEntry(pb.Entry{Data: []byte("somedata")})
+		r.sendAppend(2)
+		msgs := r.readMessages()
+		if len(msgs) != 0 {
+			t.Errorf("len(msg) = %d, want %d", len(msgs), 0)
+		}
+	}
+}
+
+// TestMsgAppFlowControlFull ensures:
+// 1. msgApp can fill the sending window until full
+// 2. when the window is full, no more msgApp can be sent.
+func TestMsgAppFlowControlFull2B(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2}, 5, 1, NewMemoryStorage())
+	r.becomeCandidate()
+	r.becomeLeader()
+
+	pr2 := r.Prs[2]
+	// force the progress to be in replicate state
+	pr2.becomeReplicate()
+	// fill in the inflights window
+	for i := 0; i < r.maxInflight; i++ {
+		r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+		ms := r.readMessages()
+		if len(ms) != 1 {
+			t.Fatalf("#%d: len(ms) = %d, want 1", i, len(ms))
+		}
+	}
+
+	// ensure 1
+	if !pr2.ins.full() {
+		t.Fatalf("inflights.full = %t, want %t", pr2.ins.full(), true)
+	}
+
+	// ensure 2
+	for i := 0; i < 10; i++ {
+		r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+		ms := r.readMessages()
+		if len(ms) != 0 {
+			t.Fatalf("#%d: len(ms) = %d, want 0", i, len(ms))
+		}
+	}
+}
+
+// TestMsgAppFlowControlMoveForward ensures msgAppResp can move
+// forward the sending window correctly:
+// 1. valid msgAppResp.index moves the windows to pass all smaller or equal index.
+// 2. out-of-dated msgAppResp has no effect on the sliding window.
+func TestMsgAppFlowControlMoveForward2B(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2}, 5, 1, NewMemoryStorage())
+	r.becomeCandidate()
+	r.becomeLeader()
+
+	pr2 := r.Prs[2]
+	// force the progress to be in replicate state
+	pr2.becomeReplicate()
+	// fill in the inflights window
+	for i := 0; i < r.maxInflight; i++ {
+		r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+		r.readMessages()
+	}
+
+	// 1 is noop, 2 is the first proposal we just sent.
+	// so we start with 2.
+	for tt := 2; tt < r.maxInflight; tt++ {
+		// move forward the window
+		r.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgAppendResponse, Term: r.Term, Index: uint64(tt)})
+		r.readMessages()
+
+		// fill in the inflights window again
+		r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+		ms := r.readMessages()
+		if len(ms) != 1 {
+			t.Fatalf("#%d: len(ms) = %d, want 1", tt, len(ms))
+		}
+
+		// ensure 1
+		if !pr2.ins.full() {
+			t.Fatalf("inflights.full = %t, want %t", pr2.ins.full(), true)
+		}
+
+		// ensure 2
+		for i := 0; i < tt; i++ {
+			r.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgAppendResponse, Term: r.Term, Index: uint64(i)})
+			if !pr2.ins.full() {
+				t.Fatalf("#%d: inflights.full = %t, want %t", tt, pr2.ins.full(), true)
+			}
+		}
+	}
+}
+
+// TestMsgAppFlowControlRecvHeartbeat ensures a heartbeat response
+// frees one slot if the window is full.
+func TestMsgAppFlowControlRecvHeartbeat2B(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2}, 5, 1, NewMemoryStorage())
+	r.becomeCandidate()
+	r.becomeLeader()
+
+	pr2 := r.Prs[2]
+	// force the progress to be in replicate state
+	pr2.becomeReplicate()
+	// fill in the inflights window
+	for i := 0; i < r.maxInflight; i++ {
+		r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+		r.readMessages()
+	}
+
+	for tt := 1; tt < 5; tt++ {
+		if !pr2.ins.full() {
+			t.Fatalf("#%d: inflights.full = %t, want %t", tt, pr2.ins.full(), true)
+		}
+
+		// recv tt msgHeartbeatResp and expect one free slot
+		for i := 0; i < tt; i++ {
+			r.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgHeartbeatResponse, Term: r.Term})
+			r.readMessages()
+			if pr2.ins.full() {
+				t.Fatalf("#%d.%d: inflights.full = %t, want %t", tt, i, pr2.ins.full(), false)
+			}
+		}
+
+		// one slot
+		r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+		ms := r.readMessages()
+		if len(ms) != 1 {
+			t.Fatalf("#%d: free slot = 0, want 1", tt)
+		}
+
+		// and just one slot
+		for i := 0; i < 10; i++ {
+			r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+			ms1 := r.readMessages()
+			if len(ms1) != 0 {
+				t.Fatalf("#%d.%d: len(ms) = %d, want 0", tt, i, len(ms1))
+			}
+		}
+
+		// clear all pending messages.
+		r.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgHeartbeatResponse, Term: r.Term})
+		r.readMessages()
+	}
+}
+
+// TestMsgAppLimitedBySize ensures an append message doesn't carry more entries than
+// MaxSizePerMsg allows, but always at least one.
+func TestMsgAppLimitedBySize2B(t *testing.T) {
+	data := make([]byte, 100)
+	ent := pb.Entry{Term: 1, Index: 10, Data: data}
+	tests := []struct {
+		maxSize uint64
+		wn      int
+	}{
+		{0, 1},
+		{uint64(ent.Size()) - 1, 1},
+		{uint64(ent.Size()) * 3, 3},
+		{noLimit, 11},
+	}
+	for i, tt := range tests {
+		cfg := newTestConfig(1, []uint64{1, 2}, 10, 1, NewMemoryStorage())
+		cfg.MaxSizePerMsg = tt.maxSize
+		r := newRaft(cfg)
+		r.becomeCandidate()
+		r.becomeLeader()
+		for j := 0; j < 10; j++ {
+			r.appendEntry(pb.Entry{Data: data})
+		}
+		r.readMessages()
+
+		r.sendAppend(2)
+		msgs := r.readMessages()
+		if len(msgs) != 1 {
+			t.Fatalf("#%d: len(msgs) = %d, want 1", i, len(msgs))
+		}
+		if n := len(msgs[0].Entries); n != tt.wn {
+			t.Errorf("#%d: len(entries) = %d, want %d", i, n, tt.wn)
+		}
+	}
+}
+
+// TestLaggingFollowerCatchUpInBoundedRounds ensures that once a lagging follower has been
+// probed, the leader pipelines size limited appends to it, so that it catches up in about
+// lag / (entries per message * MaxInflightMsgs) round trips instead of one per message.
+func TestLaggingFollowerCatchUpInBoundedRounds2B(t *testing.T) {
+	const (
+		lag         = 200
+		perMsg      = 10
+		maxInflight = 4
+	)
+	data := make([]byte, 100)
+	// every entry carries the same data, which is much larger than its index and term
+	ent := pb.Entry{Term: 1, Index: lag, Data: data}
+	nt := newNetworkWithConfig(func(c *Config) {
+		c.MaxSizePerMsg = uint64(ent.Size()) * perMsg
+		c.MaxInflightMsgs = maxInflight
+	}, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	nt.isolate(2)
+	for i := 0; i < lag; i++ {
+		nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: data}}})
+	}
+	nt.recover()
+
+	lead := nt.peers[1].(*Raft)
+	follower := nt.peers[2].(*Raft)
+	if follower.RaftLog.LastIndex()+lag > lead.RaftLog.LastIndex() {
+		t.Fatalf("follower last index = %d, want at most %d", follower.RaftLog.LastIndex(), lead.RaftLog.LastIndex()-lag)
+	}
+
+	// The heartbeat, the rejection of the optimistic append and the probe take a round each.
+	bound := 3 + (lag+perMsg*maxInflight-1)/(perMsg*maxInflight)
+	lead.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgBeat})
+	rounds := 0
+	for follower.RaftLog.LastIndex() < lead.RaftLog.LastIndex() {
+		rounds++
+		if rounds > bound {
+			t.Fatalf("follower last index = %d after %d rounds, want %d", follower.RaftLog.LastIndex(), bound, lead.RaftLog.LastIndex())
+		}
+		apps := 0
+		for _, m := range lead.readMessages() {
+			if m.MsgType == pb.MessageType_MsgAppend {
+				apps++
+			}
+			follower.Step(m)
+		}
+		if apps > maxInflight {
+			t.Errorf("round %d: sent %d appends, want at most %d", rounds, apps, maxInflight)
+		}
+		for _, m := range follower.readMessages() {
+			lead.Step(m)
+		}
+	}
+	if pr := lead.Prs[2]; pr.State != ProgressStateReplicate {
+		t.Errorf("state = %s, want %s", pr.State, ProgressStateReplicate)
+	}
+}
+
+var testingSnap = pb.Snapshot{
+	Metadata: &pb.SnapshotMetadata{
+		Index:     11, // magic number
+		Term:      11, // magic number
+		ConfState: &pb.ConfState{Nodes: []uint64{1, 2}},
+	},
+}
+
+func TestSnapshotFailure2B(t *testing.T) {
+	storage := NewMemoryStorage()
+	sm := newTestRaft(1, []uint64{1, 2}, 10, 1, storage)
+	sm.restore(testingSnap)
+
+	sm.becomeCandidate()
+	sm.becomeLeader()
+
+	sm.Prs[2].Next = 1
+	sm.Prs[2].becomeSnapshot(11)
+
+	sm.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgSnapStatus, Reject: true})
+	if sm.Prs[2].PendingSnapshot != 0 {
+		t.Fatalf("PendingSnapshot = %d, want 0", sm.Prs[2].PendingSnapshot)
+	}
+	if sm.Prs[2].Next != 1 {
+		t.Fatalf("Next = %d, want 1", sm.Prs[2].Next)
+	}
+	if !sm.Prs[2].Paused {
+		t.Errorf("Paused = %v, want true", sm.Prs[2].Paused)
+	}
+}
+
+func TestSnapshotSucceed2B(t *testing.T) {
+	storage := NewMemoryStorage()
+	sm := newTestRaft(1, []uint64{1, 2}, 10, 1, storage)
+	sm.restore(testingSnap)
+
+	sm.becomeCandidate()
+	sm.becomeLeader()
+
+	sm.Prs[2].Next = 1
+	sm.Prs[2].becomeSnapshot(11)
+
+	sm.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgSnapStatus, Reject: false})
+	if sm.Prs[2].PendingSnapshot != 0 {
+		t.Fatalf("PendingSnapshot = %d, want 0", sm.Prs[2].PendingSnapshot)
+	}
+	if sm.Prs[2].Next != 12 {
+		t.Fatalf("Next = %d, want 12", sm.Prs[2].Next)
+	}
+	if !sm.Prs[2].Paused {
+		t.Errorf("Paused = %v, want true", sm.Prs[2].Paused)
+	}
+}
+
+func TestSnapshotAbort2B(t *testing.T) {
+	storage := NewMemoryStorage()
+	sm := newTestRaft(1, []uint64{1, 2}, 10, 1, storage)
+	sm.restore(testingSnap)
+
+	sm.becomeCandidate()
+	sm.becomeLeader()
+
+	sm.Prs[2].Next = 1
+	sm.Prs[2].becomeSnapshot(11)
+
+	// A successful msgAppResp that has a higher/equal index than the
+	// pending snapshot should abort the pending snapshot.
+	sm.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgAppendResponse, Term: sm.Term, Index: 11})
+	if sm.Prs[2].PendingSnapshot != 0 {
+		t.Fatalf("PendingSnapshot = %d, want 0", sm.Prs[2].PendingSnapshot)
+	}
+	// The follower entered ProgressStateReplicate and the leader sent an append for the
+	// noop entry, which optimistically increased Next.
+	if sm.Prs[2].Next != 13 {
+		t.Fatalf("Next = %d, want 13", sm.Prs[2].Next)
+	}
+}
+
+// TestUnreachableFollowerProbed ensures the leader stops replicating optimistically to a
+// follower which is reported unreachable.
+func TestUnreachableFollowerProbed2B(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2}, 10, 1, NewMemoryStorage())
+	r.becomeCandidate()
+	r.becomeLeader()
+	r.Prs[2].becomeReplicate()
+
+	r.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgUnreachable})
+	if pr := r.Prs[2]; pr.State != ProgressStateProbe {
+		t.Errorf("state = %s, want %s", pr.State, ProgressStateProbe)
+	}
+}
+
+func TestReadOnlyOptionSafe2B(t *testing.T) {
+	a := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	b := newTestRaft(2, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	c := newTestRaft(3, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+
+	nt := newNetwork(a, b, c)
+	b.randomizedElectionTimeout = b.electionTimeout + 1
+
+	for i := 0; i < b.electionTimeout; i++ {
+		b.tick()
+	}
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	if a.State != StateLeader {
+		t.Fatalf("state = %s, want %s", a.State, StateLeader)
+	}
+
+	tests := []struct {
+		sm        *Raft
+		proposals int
+		wri       uint64
+		wctx      []byte
+	}{
+		{a, 10, 11, []byte("ctx1")},
+		{b, 10, 21, []byte("ctx2")},
+		{c, 10, 31, []byte("ctx3")},
+		{a, 10, 41, []byte("ctx4")},
+		{b, 10, 51, []byte("ctx5")},
+		{c, 10, 61, []byte("ctx6")},
+	}
+
+	for i, tt := range tests {
+		for j := 0; j < tt.proposals; j++ {
+			nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+		}
+
+		nt.send(pb.Message{From: tt.sm.id, To: tt.sm.id, MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: tt.wctx}}})
+
+		r := tt.sm
+		if len(r.readStates) == 0 {
+			t.Errorf("#%d: len(readStates) = 0, want non-zero", i)
+		}
+		rs := r.readStates[0]
+		if rs.Index != tt.wri {
+			t.Errorf("#%d: readIndex = %d, want %d", i, rs.Index, tt.wri)
+		}
+
+		if !bytes.Equal(rs.RequestCtx, tt.wctx) {
+			t.Errorf("#%d: requestCtx = %v, want %v", i, rs.RequestCtx, tt.wctx)
+		}
+		r.readStates = nil
 	}
 }
 
-func TestRestoreIgnoreSnapshot2B(t *testing.T) {
-	previousEnts := []pb.Entry{{Term: 1, Index: 1}, {Term: 1, Index: 2}, {Term: 1, Index: 3}}
-	storage := NewMemoryStorage()
-	storage.Append(previousEnts)
-	sm := newTestRaft(1, []uint64{1, 2}, 10, 1, storage)
-	sm.RaftLog.committed = 3
+func TestReadOnlyOptionLease2B(t *testing.T) {
+	a := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	b := newTestRaft(2, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	c := newTestRaft(3, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	a.readOnly.option = ReadOnlyLeaseBased
+	b.readOnly.option = ReadOnlyLeaseBased
+	c.readOnly.option = ReadOnlyLeaseBased
+	a.checkQuorum = true
+	b.checkQuorum = true
+	c.checkQuorum = true
 
-	commit := uint64(1)
-	s := pb.Snapshot{
-		Metadata: &pb.SnapshotMetadata{
-			Index:     commit,
-			Term:      1,
-			ConfState: &pb.ConfState{Nodes: []uint64{1, 2}},
-		},
+	nt := newNetwork(a, b, c)
+	b.randomizedElectionTimeout = b.electionTimeout + 1
+
+	for i := 0; i < b.electionTimeout; i++ {
+		b.tick()
 	}
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
 
-	// ignore snapshot
-	sm.handleSnapshot(pb.Message{Snapshot: &s})
-	if sm.RaftLog.committed == commit {
-		t.Errorf("commit = %d, want %d", sm.RaftLog.committed, commit)
+	if a.State != StateLeader {
+		t.Fatalf("state = %s, want %s", a.State, StateLeader)
 	}
-}
 
-func TestProvideSnap2B(t *testing.T) {
-	// restore the state machine from a snapshot so it has a compacted log and a snapshot
-	s := pb.Snapshot{
-		Metadata: &pb.SnapshotMetadata{
-			Index:     11, // magic number
-			Term:      11, // magic number
-			ConfState: &pb.ConfState{Nodes: []uint64{1, 2}},
-		},
+	tests := []struct {
+		sm        *Raft
+		proposals int
+		wri       uint64
+		wctx      []byte
+	}{
+		{a, 10, 11, []byte("ctx1")},
+		{b, 10, 21, []byte("ctx2")},
+		{c, 10, 31, []byte("ctx3")},
+		{a, 10, 41, []byte("ctx4")},
+		{b, 10, 51, []byte("ctx5")},
+		{c, 10, 61, []byte("ctx6")},
 	}
-	storage := NewMemoryStorage()
-	sm := newTestRaft(1, []uint64{1}, 10, 1, storage)
-	sm.handleSnapshot(pb.Message{Snapshot: &s})
 
-	sm.becomeCandidate()
-	sm.becomeLeader()
-	sm.readMessages() // clear message
+	for i, tt := range tests {
+		for j := 0; j < tt.proposals; j++ {
+			nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+		}
 
-	// force set the next of node 2, so that node 2 needs a snapshot
-	sm.Prs[2].Next = 0
-	sm.Step(pb.Message{From: 2, To: 1, Term: 1, MsgType: pb.MessageType_MsgAppendResponse, Index: sm.Prs[2].Next - 1, Reject: true})
+		// The lease read is answered without a round of heartbeats.
+		nt.ignore(pb.MessageType_MsgHeartbeat)
+		nt.send(pb.Message{From: tt.sm.id, To: tt.sm.id, MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: tt.wctx}}})
+		nt.recover()
 
-	msgs := sm.readMessages()
-	if len(msgs) != 1 {
-		t.Fatalf("len(msgs) = %d, want 1", len(msgs))
-	}
-	m := msgs[0]
-	if m.MsgType != pb.MessageType_MsgSnapshot {
-		t.Errorf("m.MsgType = %v, want %v", m.MsgType, pb.MessageType_MsgSnapshot)
+		r := tt.sm
+		if len(r.readStates) == 0 {
+			t.Fatalf("#%d: len(readStates) = 0, want non-zero", i)
+		}
+		rs := r.readStates[0]
+		if rs.Index != tt.wri {
+			t.Errorf("#%d: readIndex = %d, want %d", i, rs.Index, tt.wri)
+		}
+		if !bytes.Equal(rs.RequestCtx, tt.wctx) {
+			t.Errorf("#%d: requestCtx = %v, want %v", i, rs.RequestCtx, tt.wctx)
+		}
+		r.readStates = nil
 	}
 }
 
-func TestRestoreFromSnapMsg2B(t *testing.T) {
-	s := pb.Snapshot{
-		Metadata: &pb.SnapshotMetadata{
-			Index:     11, // magic number
-			Term:      11, // magic number
-			ConfState: &pb.ConfState{Nodes: []uint64{1, 2}},
-		},
+// TestReadOnlyWithPartitionedLeader ensures that a leader which has been
+// partitioned away can't confirm a read index, as the quorum never acks
+// its heartbeats.
+func TestReadOnlyWithPartitionedLeader2B(t *testing.T) {
+	nt := newNetwork(nil, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	a := nt.peers[1].(*Raft)
+	if a.State != StateLeader {
+		t.Fatalf("state = %s, want %s", a.State, StateLeader)
 	}
-	m := pb.Message{MsgType: pb.MessageType_MsgSnapshot, From: 1, Term: 2, Snapshot: &s}
 
-	sm := newTestRaft(2, []uint64{1, 2}, 10, 1, NewMemoryStorage())
-	sm.Step(m)
+	nt.isolate(1)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: []byte("ctx")}}})
+	if len(a.readStates) != 0 {
+		t.Fatalf("len(readStates) = %d, want 0", len(a.readStates))
+	}
 
-	if sm.Lead != uint64(1) {
-		t.Errorf("sm.Lead = %d, want 1", sm.Lead)
+	// The pending read is confirmed by the next heartbeat once the partition heals.
+	nt.recover()
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgBeat})
+	if len(a.readStates) != 1 {
+		t.Fatalf("len(readStates) = %d, want 1", len(a.readStates))
+	}
+	if rs := a.readStates[0]; rs.Index != a.RaftLog.committed || !bytes.Equal(rs.RequestCtx, []byte("ctx")) {
+		t.Errorf("readState = %+v, want index %d ctx %v", rs, a.RaftLog.committed, []byte("ctx"))
 	}
 }
 
-func TestSlowNodeRestore2B(t *testing.T) {
-	nt := newNetwork(nil, nil, nil)
+// TestReadOnlyForNewLeader ensures that a leader only accepts MessageType_MsgReadIndex message
+// when it commits at least one log entry at it term.
+func TestReadOnlyForNewLeader2B(t *testing.T) {
+	nodeConfigs := []struct {
+		id           uint64
+		committed    uint64
+		applied      uint64
+		compactIndex uint64
+	}{
+		{1, 1, 1, 0},
+		{2, 2, 2, 2},
+		{3, 2, 2, 2},
+	}
+	peers := make([]stateMachine, 0)
+	for _, c := range nodeConfigs {
+		storage := NewMemoryStorage()
+		storage.Append([]pb.Entry{{Index: 1, Term: 1}, {Index: 2, Term: 1}})
+		storage.SetHardState(pb.HardState{Term: 1, Commit: c.committed})
+		if c.compactIndex != 0 {
+			storage.Compact(c.compactIndex)
+		}
+		cfg := newTestConfig(c.id, []uint64{1, 2, 3}, 10, 1, storage)
+		cfg.Applied = c.applied
+		raft := newRaft(cfg)
+		peers = append(peers, raft)
+	}
+	nt := newNetwork(peers...)
+
+	// Drop MessageType_MsgAppend to forbid peer a to commit any log entry at its term after it becomes leader.
+	nt.ignore(pb.MessageType_MsgAppend)
+	// Force peer a to become leader.
 	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
 
-	nt.isolate(3)
-	for j := 0; j <= 100; j++ {
-		nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+	sm := nt.peers[1].(*Raft)
+	if sm.State != StateLeader {
+		t.Fatalf("state = %s, want %s", sm.State, StateLeader)
 	}
-	lead := nt.peers[1].(*Raft)
-	nextEnts(lead, nt.storage[1])
-	nt.storage[1].CreateSnapshot(lead.RaftLog.applied, &pb.ConfState{Nodes: nodes(lead)}, nil)
-	nt.storage[1].Compact(lead.RaftLog.applied)
 
-	nt.recover()
+	// Ensure peer a drops read only request.
+	var windex uint64 = 4
+	wctx := []byte("ctx")
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: wctx}}})
+	if len(sm.readStates) != 0 {
+		t.Fatalf("len(readStates) = %d, want zero", len(sm.readStates))
+	}
 
-	// send heartbeats so that the leader can learn everyone is active.
-	// node 3 will only be considered as active when node 1 receives a reply from it.
-	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgBeat})
+	nt.recover()
 
-	// trigger a snapshot
+	// Force peer a to commit a log entry at its term
+	for i := 0; i < sm.heartbeatTimeout; i++ {
+		sm.tick()
+	}
 	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+	if sm.RaftLog.committed != 4 {
+		t.Fatalf("committed = %d, want 4", sm.RaftLog.committed)
+	}
+	lastLogTerm := sm.RaftLog.zeroTermOnRangeErr(sm.RaftLog.Term(sm.RaftLog.committed))
+	if lastLogTerm != sm.Term {
+		t.Fatalf("last log term = %d, want %d", lastLogTerm, sm.Term)
+	}
 
-	follower := nt.peers[3].(*Raft)
-
-	// trigger a commit
-	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
-	if follower.RaftLog.committed != lead.RaftLog.committed {
-		t.Errorf("follower.committed = %d, want %d", follower.RaftLog.committed, lead.RaftLog.committed)
+	// Ensure peer a accepts read only request after it commits a entry at its term.
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: wctx}}})
+	if len(sm.readStates) != 1 {
+		t.Fatalf("len(readStates) = %d, want 1", len(sm.readStates))
+	}
+	rs := sm.readStates[0]
+	if rs.Index != windex {
+		t.Fatalf("readIndex = %d, want %d", rs.Index, windex)
+	}
+	if !bytes.Equal(rs.RequestCtx, wctx) {
+		t.Fatalf("requestCtx = %v, want %v", rs.RequestCtx, wctx)
 	}
 }
 
@@ -1121,6 +2113,242 @@ func TestRemoveNode3A(t *testing.T) {
 	}
 }
 
+// TestAddLearner tests that addLearner could update learners correctly.
+func TestAddLearner3A(t *testing.T) {
+	r := newTestRaft(1, []uint64{1}, 10, 1, NewMemoryStorage())
+	r.addLearner(2)
+	if g, w := nodes(r), []uint64{1}; !reflect.DeepEqual(g, w) {
+		t.Errorf("nodes = %v, want %v", g, w)
+	}
+	if g, w := r.confState().Learners, []uint64{2}; !reflect.DeepEqual(g, w) {
+		t.Errorf("learners = %v, want %v", g, w)
+	}
+	if !r.Prs[2].IsLearner {
+		t.Errorf("node 2 is learner = %v, want %v", r.Prs[2].IsLearner, true)
+	}
+
+	// adding the learner as a node promotes it
+	r.addNode(2)
+	if g, w := nodes(r), []uint64{1, 2}; !reflect.DeepEqual(g, w) {
+		t.Errorf("nodes = %v, want %v", g, w)
+	}
+	if r.Prs[2].IsLearner {
+		t.Errorf("node 2 is learner = %v, want %v", r.Prs[2].IsLearner, false)
+	}
+}
+
+// TestRemoveLearner tests that removeNode could remove a learner.
+func TestRemoveLearner3A(t *testing.T) {
+	r := newTestLearnerRaft(1, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+	r.removeNode(2)
+	if g, w := nodes(r), []uint64{1}; !reflect.DeepEqual(g, w) {
+		t.Errorf("nodes = %v, want %v", g, w)
+	}
+	if _, ok := r.Prs[2]; ok {
+		t.Errorf("progress of removed learner 2 is still kept")
+	}
+}
+
+// TestLearnerElectionTimeout verifies that a learner never starts an election.
+func TestLearnerElectionTimeout3A(t *testing.T) {
+	n1 := newTestLearnerRaft(1, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+	n2 := newTestLearnerRaft(2, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+
+	n1.becomeFollower(1, None)
+	n2.becomeFollower(1, None)
+
+	for i := 0; i < 2*n2.electionTimeout; i++ {
+		n2.tick()
+	}
+	if n2.State != StateFollower {
+		t.Errorf("peer 2 state: %s, want %s", n2.State, StateFollower)
+	}
+	if msgs := n2.readMessages(); len(msgs) != 0 {
+		t.Errorf("unexpected messages from learner: %v", msgs)
+	}
+}
+
+// TestLearnerPromotion verifies that a learner can campaign once it is promoted.
+func TestLearnerPromotion3A(t *testing.T) {
+	n1 := newTestLearnerRaft(1, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+	n2 := newTestLearnerRaft(2, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+	nt := newNetwork(n1, n2)
+
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+	if n1.State != StateLeader {
+		t.Fatalf("peer 1 state: %s, want %s", n1.State, StateLeader)
+	}
+	if n2.promotable() {
+		t.Fatalf("learner 2 is promotable")
+	}
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgBeat})
+
+	n1.addNode(2)
+	n2.addNode(2)
+	if !n2.promotable() {
+		t.Fatalf("promoted node 2 is not promotable")
+	}
+
+	nt.send(pb.Message{From: 2, To: 2, MsgType: pb.MessageType_MsgHup})
+	if n1.State != StateFollower {
+		t.Errorf("peer 1 state: %s, want %s", n1.State, StateFollower)
+	}
+	if n2.State != StateLeader {
+		t.Errorf("peer 2 state: %s, want %s", n2.State, StateLeader)
+	}
+}
+
+// TestLearnerLogReplication tests that a learner receives the log although it doesn't
+// count towards the commit quorum.
+func TestLearnerLogReplication3A(t *testing.T) {
+	n1 := newTestLearnerRaft(1, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+	n2 := newTestLearnerRaft(2, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+	nt := newNetwork(n1, n2)
+
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+	if n1.State != StateLeader {
+		t.Fatalf("peer 1 state: %s, want %s", n1.State, StateLeader)
+	}
+
+	nt.isolate(2)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+	if n1.RaftLog.committed != n1.RaftLog.LastIndex() {
+		t.Errorf("peer 1 committed = %d, want %d", n1.RaftLog.committed, n1.RaftLog.LastIndex())
+	}
+
+	nt.recover()
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgBeat})
+	if n2.RaftLog.committed != n1.RaftLog.committed {
+		t.Errorf("peer 2 committed = %d, want %d", n2.RaftLog.committed, n1.RaftLog.committed)
+	}
+	if match := n1.Prs[2].Match; match != n2.RaftLog.committed {
+		t.Errorf("progress 2 of leader 1 wants match %d, but got %d", n2.RaftLog.committed, match)
+	}
+}
+
+// TestJointConfigCommit tests that an entry needs a majority of both the incoming and
+// the outgoing voters to be committed while the group is in a joint configuration.
+func TestJointConfigCommit3A(t *testing.T) {
+	s := NewMemoryStorage()
+	r := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, s)
+	r.becomeCandidate()
+	r.becomeLeader()
+	commitNoopEntry(r, s)
+
+	// replace 3 by 4 and 5: incoming {1, 2, 4, 5}, outgoing {1, 2, 3}
+	r.applyConfChange(pb.ConfChangeV2{
+		Transition: pb.ConfChangeTransition_ConfChangeTransitionJointExplicit,
+		Changes: []*pb.ConfChangeSingle{
+			{ChangeType: pb.ConfChangeType_RemoveNode, NodeId: 3},
+			{ChangeType: pb.ConfChangeType_AddNode, NodeId: 4},
+			{ChangeType: pb.ConfChangeType_AddNode, NodeId: 5},
+		},
+	})
+	cs := r.confState()
+	if w := []uint64{1, 2, 4, 5}; !reflect.DeepEqual(cs.Nodes, w) {
+		t.Errorf("nodes = %v, want %v", cs.Nodes, w)
+	}
+	if w := []uint64{1, 2, 3}; !reflect.DeepEqual(cs.VotersOutgoing, w) {
+		t.Errorf("outgoing voters = %v, want %v", cs.VotersOutgoing, w)
+	}
+	if cs.AutoLeave {
+		t.Errorf("auto leave = %v, want %v", cs.AutoLeave, false)
+	}
+
+	r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+	r.readMessages()
+	li := r.RaftLog.LastIndex()
+
+	// 1, 4 and 5 are a majority of the incoming voters only
+	for _, id := range []uint64{4, 5} {
+		r.Step(pb.Message{From: id, To: 1, Term: r.Term, MsgType: pb.MessageType_MsgAppendResponse, Index: li})
+	}
+	if r.RaftLog.committed == li {
+		t.Fatalf("committed = %d, want < %d", r.RaftLog.committed, li)
+	}
+
+	// 3 completes the majority of the outgoing voters
+	r.Step(pb.Message{From: 3, To: 1, Term: r.Term, MsgType: pb.MessageType_MsgAppendResponse, Index: li})
+	if r.RaftLog.committed != li {
+		t.Fatalf("committed = %d, want %d", r.RaftLog.committed, li)
+	}
+
+	r.applyConfChange(pb.ConfChangeV2{})
+	if _, ok := r.Prs[3]; ok {
+		t.Errorf("progress of removed node 3 is still kept")
+	}
+	if cs := r.confState(); len(cs.VotersOutgoing) != 0 {
+		t.Errorf("outgoing voters = %v, want none", cs.VotersOutgoing)
+	}
+}
+
+// TestJointConfigElection tests that a candidate needs the votes of a majority of both
+// the incoming and the outgoing voters.
+func TestJointConfigElection3A(t *testing.T) {
+	cs := pb.ConfState{Nodes: []uint64{1, 4, 5}, VotersOutgoing: []uint64{1, 2, 3}}
+	s := NewMemoryStorage()
+	s.snapshot.Metadata.ConfState = &cs
+	r := newTestRaft(1, nil, 10, 1, s)
+
+	r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+	for _, id := range []uint64{4, 5} {
+		r.Step(pb.Message{From: id, To: 1, Term: r.Term, MsgType: pb.MessageType_MsgRequestVoteResponse})
+	}
+	if r.State != StateCandidate {
+		t.Fatalf("state = %s, want %s", r.State, StateCandidate)
+	}
+	r.Step(pb.Message{From: 2, To: 1, Term: r.Term, MsgType: pb.MessageType_MsgRequestVoteResponse})
+	if r.State != StateLeader {
+		t.Fatalf("state = %s, want %s", r.State, StateLeader)
+	}
+}
+
+// TestLeaveJointConfigDemotesLearnersNext tests that a voter demoted while the group is
+// joint keeps voting until the joint config is left.
+func TestLeaveJointConfigDemotesLearnersNext3A(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	r.applyConfChange(pb.ConfChangeV2{
+		Changes: []*pb.ConfChangeSingle{
+			{ChangeType: pb.ConfChangeType_AddLearnerNode, NodeId: 3},
+			{ChangeType: pb.ConfChangeType_AddNode, NodeId: 4},
+		},
+	})
+	cs := r.confState()
+	if w := []uint64{3}; !reflect.DeepEqual(cs.LearnersNext, w) {
+		t.Errorf("learners next = %v, want %v", cs.LearnersNext, w)
+	}
+	if !cs.AutoLeave {
+		t.Errorf("auto leave = %v, want %v", cs.AutoLeave, true)
+	}
+	if r.Prs[3].IsLearner {
+		t.Errorf("node 3 is learner before leaving the joint config")
+	}
+
+	cs = r.applyConfChange(pb.ConfChangeV2{})
+	if w := []uint64{1, 2, 4}; !reflect.DeepEqual(cs.Nodes, w) {
+		t.Errorf("nodes = %v, want %v", cs.Nodes, w)
+	}
+	if w := []uint64{3}; !reflect.DeepEqual(cs.Learners, w) {
+		t.Errorf("learners = %v, want %v", cs.Learners, w)
+	}
+	if len(cs.LearnersNext) != 0 || cs.AutoLeave {
+		t.Errorf("unexpected joint state left: %v", cs)
+	}
+}
+
+// TestSimpleConfChangeMultipleVoters tests that changing more than one voter without
+// joint consensus is refused.
+func TestSimpleConfChangeMultipleVoters3A(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	err := r.simpleConfChange([]*pb.ConfChangeSingle{
+		{ChangeType: pb.ConfChangeType_AddNode, NodeId: 4},
+		{ChangeType: pb.ConfChangeType_RemoveNode, NodeId: 2},
+	})
+	if err == nil {
+		t.Errorf("expected error changing two voters without joint consensus")
+	}
+}
+
 func TestCampaignWhileLeader2A(t *testing.T) {
 	cfg := newTestConfig(1, []uint64{1}, 5, 1, NewMemoryStorage())
 	r := newRaft(cfg)
@@ -1233,6 +2461,43 @@ func TestLeaderTransferToUpToDateNode3C(t *testing.T) {
 	checkLeaderTransferState(t, lead, StateLeader, 1)
 }
 
+// TestLeaderTransferWithCheckQuorum ensures transferring leader still works
+// even the current leader is still under its leader lease
+func TestLeaderTransferWithCheckQuorum3C(t *testing.T) {
+	nt := newNetwork(nil, nil, nil)
+	for i := 1; i < 4; i++ {
+		r := nt.peers[uint64(i)].(*Raft)
+		r.checkQuorum = true
+		r.randomizedElectionTimeout = r.electionTimeout + i
+	}
+
+	// Letting peer 2 electionElapsed reach to timeout so that it can vote for peer 1
+	f := nt.peers[2].(*Raft)
+	for i := 0; i < f.electionTimeout; i++ {
+		f.tick()
+	}
+
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	lead := nt.peers[1].(*Raft)
+
+	if lead.Lead != 1 {
+		t.Fatalf("after election leader is %d, want 1", lead.Lead)
+	}
+
+	// Transfer leadership to 2.
+	nt.send(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgTransferLeader})
+
+	checkLeaderTransferState(t, lead, StateFollower, 2)
+
+	// After some log replication, transfer leadership back to 1.
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+
+	nt.send(pb.Message{From: 1, To: 2, MsgType: pb.MessageType_MsgTransferLeader})
+
+	checkLeaderTransferState(t, lead, StateLeader, 1)
+}
+
 // TestLeaderTransferToUpToDateNodeFromFollower verifies transferring should succeed
 // if the transferee has the most up-to-date log entries when transfer starts.
 // Not like TestLeaderTransferToUpToDateNode, where the leader transfer message
@@ -1510,6 +2775,10 @@ func votedWithConfig(configFunc func(*Config), vote, term uint64) *Raft {
 	return sm
 }
 
+func preVoteConfig(c *Config) {
+	c.PreVote = true
+}
+
 type network struct {
 	peers   map[uint64]stateMachine
 	storage map[uint64]*MemoryStorage
@@ -1650,14 +2919,21 @@ func idsBySize(size int) []uint64 {
 
 func newTestConfig(id uint64, peers []uint64, election, heartbeat int, storage Storage) *Config {
 	return &Config{
-		ID:            id,
-		peers:         peers,
-		ElectionTick:  election,
-		HeartbeatTick: heartbeat,
-		Storage:       storage,
+		ID:              id,
+		peers:           peers,
+		ElectionTick:    election,
+		HeartbeatTick:   heartbeat,
+		Storage:         storage,
+		MaxSizePerMsg:   noLimit,
+		MaxInflightMsgs: 256,
 	}
 }
 
 func newTestRaft(id uint64, peers []uint64, election, heartbeat int, storage Storage) *Raft {
 	return newRaft(newTestConfig(id, peers, election, heartbeat, storage))
 }
+
+func newTestLearnerRaft(id uint64, peers []uint64, learners []uint64, election, heartbeat int, storage *MemoryStorage) *Raft {
+	storage.snapshot.Metadata.ConfState = &pb.ConfState{Nodes: peers, Learners: learners}
+	return newTestRaft(id, nil, election, heartbeat, storage)
+}
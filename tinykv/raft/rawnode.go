@@ -27,6 +27,16 @@ var ErrStepLocalMsg = errors.New("raft: cannot step raft local message")
 // but there is no peer found in raft.Prs for that node.
 var ErrStepPeerNotFound = errors.New("raft: cannot step as peer not found")
 
+// SnapshotStatus is the result of sending a snapshot, reported by ReportSnapshot.
+type SnapshotStatus int
+
+const (
+	// SnapshotFinish means the snapshot was delivered to the follower.
+	SnapshotFinish SnapshotStatus = 1
+	// SnapshotFailure means the snapshot couldn't be delivered and has to be sent again.
+	SnapshotFailure SnapshotStatus = 2
+)
+
 // SoftState provides state that is volatile and does not need to be persisted to the WAL.
 type SoftState struct {
 	Lead      uint64
@@ -68,6 +78,12 @@ type Ready struct {
 	// If it contains a MessageType_MsgSnapshot message, the application MUST report back to raft
 	// when the snapshot has been received or has failed by calling ReportSnapshot.
 	Messages []pb.Message
+
+	// ReadStates can be used for node to serve linearizable read requests locally
+	// when its applied index is greater than the index in ReadState.
+	// Note that the readState will be returned when raft receives msgReadIndex.
+	// The returned is only valid for the request that requested to read.
+	ReadStates []ReadState
 }
 
 func newReady(r *Raft, prevSoftSt *SoftState, prevHardSt pb.HardState) Ready {
@@ -79,6 +95,10 @@ func newReady(r *Raft, prevSoftSt *SoftState, prevHardSt pb.HardState) Ready {
 		rd.Messages = r.msgs
 		r.msgs = nil
 	}
+	if len(r.readStates) != 0 {
+		rd.ReadStates = r.readStates
+		r.readStates = nil
+	}
 	if softSt := r.softState(); !softSt.equal(prevSoftSt) {
 		rd.SoftState = softSt
 	}
@@ -146,20 +166,35 @@ func (rn *RawNode) ProposeConfChange(cc pb.ConfChange) error {
 	})
 }
 
+// ProposeConfChangeV2 proposes a config change which may change several members at
+// once by joint consensus. An empty change leaves a joint configuration.
+func (rn *RawNode) ProposeConfChangeV2(cc pb.ConfChangeV2) error {
+	data, err := cc.Marshal()
+	if err != nil {
+		return err
+	}
+	ent := pb.Entry{EntryType: pb.EntryType_EntryConfChangeV2, Data: data}
+	return rn.Raft.Step(pb.Message{
+		MsgType: pb.MessageType_MsgPropose,
+		Entries: []*pb.Entry{&ent},
+	})
+}
+
 // ApplyConfChange applies a config change to the local node.
 func (rn *RawNode) ApplyConfChange(cc pb.ConfChange) *pb.ConfState {
 	if cc.NodeId == None {
-		return &pb.ConfState{Nodes: nodes(rn.Raft)}
+		cs := rn.Raft.confState()
+		return &cs
 	}
-	switch cc.ChangeType {
-	case pb.ConfChangeType_AddNode:
-		rn.Raft.addNode(cc.NodeId)
-	case pb.ConfChangeType_RemoveNode:
-		rn.Raft.removeNode(cc.NodeId)
-	default:
-		panic("unexpected conf type")
-	}
-	return &pb.ConfState{Nodes: nodes(rn.Raft)}
+	return rn.ApplyConfChangeV2(confChangeV1ToV2(cc))
+}
+
+// ApplyConfChangeV2 applies a config change proposed by ProposeConfChangeV2 to the local
+// node. If the change enters a joint configuration that is left automatically, the
+// leader proposes leaving it.
+func (rn *RawNode) ApplyConfChangeV2(cc pb.ConfChangeV2) *pb.ConfState {
+	cs := rn.Raft.applyConfChange(cc)
+	return &cs
 }
 
 // Step advances the state machine using the given message.
@@ -196,6 +231,9 @@ func (rn *RawNode) HasReady() bool {
 	if len(r.msgs) > 0 || len(r.RaftLog.unstableEntries()) > 0 || r.RaftLog.hasNextEnts() {
 		return true
 	}
+	if len(r.readStates) != 0 {
+		return true
+	}
 	return false
 }
 
@@ -236,6 +274,25 @@ func (rn *RawNode) GetSnap() *pb.Snapshot {
 	return rn.Raft.GetSnap()
 }
 
+// ReadIndex requests a read state. The read state will be set in ready.
+// Read State has a read index. Once the application advances further than the read
+// index, any linearizable read requests issued before the read request can be
+// processed safely. The read state will have the same rctx attached.
+func (rn *RawNode) ReadIndex(rctx []byte) {
+	_ = rn.Raft.Step(pb.Message{MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: rctx}}})
+}
+
+// ReportUnreachable reports the given node is not reachable for the last send.
+func (rn *RawNode) ReportUnreachable(id uint64) {
+	_ = rn.Raft.Step(pb.Message{MsgType: pb.MessageType_MsgUnreachable, From: id})
+}
+
+// ReportSnapshot reports the status of the sent snapshot.
+func (rn *RawNode) ReportSnapshot(id uint64, status SnapshotStatus) {
+	rej := status == SnapshotFailure
+	_ = rn.Raft.Step(pb.Message{MsgType: pb.MessageType_MsgSnapStatus, From: id, Reject: rej})
+}
+
 // TransferLeader tries to transfer leadership to the given transferee.
 func (rn *RawNode) TransferLeader(transferee uint64) {
 	_ = rn.Raft.Step(pb.Message{MsgType: pb.MessageType_MsgTransferLeader, From: transferee})
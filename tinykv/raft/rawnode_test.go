@@ -155,6 +155,83 @@ func TestRawNodeProposeAddDuplicateNode3A(t *testing.T) {
 	}
 }
 
+// TestRawNodeJointAutoLeave ensures that the leader leaves a joint configuration on its
+// own once it has been applied, and refuses other changes in the meantime.
+func TestRawNodeJointAutoLeave3A(t *testing.T) {
+	s := NewMemoryStorage()
+	rawNode, err := NewRawNode(newTestConfig(1, []uint64{1}, 10, 1, s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rd := rawNode.Ready()
+	s.Append(rd.Entries)
+	rawNode.Advance(rd)
+
+	rawNode.Campaign()
+	for {
+		rd = rawNode.Ready()
+		s.Append(rd.Entries)
+		if rd.SoftState.Lead == rawNode.Raft.id {
+			rawNode.Advance(rd)
+			break
+		}
+		rawNode.Advance(rd)
+	}
+
+	cc := pb.ConfChangeV2{Changes: []*pb.ConfChangeSingle{
+		{ChangeType: pb.ConfChangeType_AddNode, NodeId: 2},
+		{ChangeType: pb.ConfChangeType_AddLearnerNode, NodeId: 3},
+	}}
+	if err := rawNode.ProposeConfChangeV2(cc); err != nil {
+		t.Fatal(err)
+	}
+	rd = rawNode.Ready()
+	s.Append(rd.Entries)
+	var cs *pb.ConfState
+	for _, entry := range rd.CommittedEntries {
+		if entry.EntryType == pb.EntryType_EntryConfChangeV2 {
+			var cc pb.ConfChangeV2
+			if err := cc.Unmarshal(entry.Data); err != nil {
+				t.Fatal(err)
+			}
+			cs = rawNode.ApplyConfChangeV2(cc)
+		}
+	}
+	rawNode.Advance(rd)
+	wcs := &pb.ConfState{Nodes: []uint64{1, 2}, Learners: []uint64{3}, VotersOutgoing: []uint64{1}, AutoLeave: true}
+	if !reflect.DeepEqual(cs, wcs) {
+		t.Fatalf("conf state = %v, want %v", cs, wcs)
+	}
+
+	// the leader proposes leaving the joint config by itself
+	li := rawNode.Raft.RaftLog.LastIndex()
+	ents, err := rawNode.Raft.RaftLog.slice(li, li+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ents[0].EntryType != pb.EntryType_EntryConfChangeV2 || len(ents[0].Data) != 0 {
+		t.Fatalf("last entry = %v, want an empty EntryType_EntryConfChangeV2", ents[0])
+	}
+
+	// other changes are refused until the joint config is left
+	rawNode.ProposeConfChangeV2(pb.ConfChangeV2{Changes: []*pb.ConfChangeSingle{
+		{ChangeType: pb.ConfChangeType_AddNode, NodeId: 4},
+	}})
+	ents, err = rawNode.Raft.RaftLog.slice(li+1, li+2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ents[0].EntryType != pb.EntryType_EntryNormal {
+		t.Fatalf("entry type = %v, want %v", ents[0].EntryType, pb.EntryType_EntryNormal)
+	}
+
+	cs = rawNode.ApplyConfChangeV2(pb.ConfChangeV2{})
+	wcs = &pb.ConfState{Nodes: []uint64{1, 2}, Learners: []uint64{3}}
+	if !reflect.DeepEqual(cs, wcs) {
+		t.Fatalf("conf state = %v, want %v", cs, wcs)
+	}
+}
+
 // TestRawNodeStart ensures that a node can be started correctly, and can accept and commit
 // proposals.
 func TestRawNodeStart2C(t *testing.T) {
@@ -184,6 +261,35 @@ func TestRawNodeStart2C(t *testing.T) {
 	}
 }
 
+// TestRawNodeReadIndex ensures that Rawnode.ReadIndex sends the MessageType_MsgReadIndex message
+// to the underlying raft. It also ensures that ReadState can be read out.
+func TestRawNodeReadIndex2C(t *testing.T) {
+	storage := NewMemoryStorage()
+	rawNode, err := NewRawNode(newTestConfig(1, []uint64{1}, 10, 1, storage))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawNode.Campaign()
+	rd := rawNode.Ready()
+	storage.Append(rd.Entries)
+	rawNode.Advance(rd)
+
+	wrequestCtx := []byte("somedata")
+	rawNode.ReadIndex(wrequestCtx)
+	if !rawNode.HasReady() {
+		t.Fatalf("HasReady() returns false, want true")
+	}
+	rd = rawNode.Ready()
+	wrs := []ReadState{{Index: rawNode.Raft.RaftLog.committed, RequestCtx: wrequestCtx}}
+	if !reflect.DeepEqual(rd.ReadStates, wrs) {
+		t.Errorf("ReadStates = %+v, want %+v", rd.ReadStates, wrs)
+	}
+	rawNode.Advance(rd)
+	if rawNode.HasReady() {
+		t.Errorf("unexpected Ready: %+v", rawNode.Ready())
+	}
+}
+
 func TestRawNodeRestart2C(t *testing.T) {
 	entries := []pb.Entry{
 		{Term: 1, Index: 1},
@@ -20,7 +20,6 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
-	"sort"
 	"strings"
 
 	pb "github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
@@ -60,13 +59,26 @@ func mustTerm(term uint64, err error) uint64 {
 	return term
 }
 
-func nodes(r *Raft) []uint64 {
-	nodes := make([]uint64, 0, len(r.Prs))
-	for id := range r.Prs {
-		nodes = append(nodes, id)
+// limitSize returns the longest prefix of ents whose total byte size doesn't exceed
+// maxSize. It always keeps at least one entry so that replication can make progress.
+func limitSize(ents []pb.Entry, maxSize uint64) []pb.Entry {
+	if len(ents) == 0 {
+		return ents
+	}
+	size := ents[0].Size()
+	var limit int
+	for limit = 1; limit < len(ents); limit++ {
+		size += ents[limit].Size()
+		if uint64(size) > maxSize {
+			break
+		}
 	}
-	sort.Sort(uint64Slice(nodes))
-	return nodes
+	return ents[:limit]
+}
+
+// nodes returns the sorted voters of the (incoming) configuration.
+func nodes(r *Raft) []uint64 {
+	return r.voters[0].ids()
 }
 
 func diffu(a, b string) string {
@@ -118,11 +130,26 @@ func (p uint64Slice) Less(i, j int) bool { return p[i] < p[j] }
 func (p uint64Slice) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
 
 func IsLocalMsg(msgt pb.MessageType) bool {
-	return msgt == pb.MessageType_MsgHup || msgt == pb.MessageType_MsgBeat
+	return msgt == pb.MessageType_MsgHup || msgt == pb.MessageType_MsgBeat ||
+		msgt == pb.MessageType_MsgSnapStatus || msgt == pb.MessageType_MsgUnreachable
 }
 
 func IsResponseMsg(msgt pb.MessageType) bool {
-	return msgt == pb.MessageType_MsgAppendResponse || msgt == pb.MessageType_MsgRequestVoteResponse || msgt == pb.MessageType_MsgHeartbeatResponse
+	return msgt == pb.MessageType_MsgAppendResponse || msgt == pb.MessageType_MsgRequestVoteResponse ||
+		msgt == pb.MessageType_MsgHeartbeatResponse || msgt == pb.MessageType_MsgPreVoteResponse ||
+		msgt == pb.MessageType_MsgReadIndexResp
+}
+
+// voteRespMsgType maps vote and prevote message types to their corresponding responses.
+func voteRespMsgType(msgt pb.MessageType) pb.MessageType {
+	switch msgt {
+	case pb.MessageType_MsgRequestVote:
+		return pb.MessageType_MsgRequestVoteResponse
+	case pb.MessageType_MsgPreVote:
+		return pb.MessageType_MsgPreVoteResponse
+	default:
+		panic(fmt.Sprintf("not a vote message: %s", msgt))
+	}
 }
 
 func isHardStateEqual(a, b pb.HardState) bool {
@@ -21,8 +21,9 @@ import (
 // CheckAddPeer checks if the operator is to add peer on specified store.
 func CheckAddPeer(c *check.C, op *operator.Operator, kind operator.OpKind, storeID uint64) {
 	c.Assert(op, check.NotNil)
-	c.Assert(op.Len(), check.Equals, 1)
-	c.Assert(op.Step(0).(operator.AddPeer).ToStore, check.Equals, storeID)
+	c.Assert(op.Len(), check.Equals, 2)
+	c.Assert(op.Step(0).(operator.AddLearner).ToStore, check.Equals, storeID)
+	c.Assert(op.Step(1).(operator.PromoteLearner).ToStore, check.Equals, storeID)
 	kind |= operator.OpRegion
 	c.Assert(op.Kind()&kind, check.Equals, kind)
 }
@@ -50,14 +51,16 @@ func CheckTransferLeader(c *check.C, op *operator.Operator, kind operator.OpKind
 // CheckTransferPeer checks if the operator is to transfer peer between the specified source and target stores.
 func CheckTransferPeer(c *check.C, op *operator.Operator, kind operator.OpKind, sourceID, targetID uint64) {
 	c.Assert(op, check.NotNil)
-	if op.Len() == 2 {
-		c.Assert(op.Step(0).(operator.AddPeer).ToStore, check.Equals, targetID)
-		c.Assert(op.Step(1).(operator.RemovePeer).FromStore, check.Equals, sourceID)
-	} else {
-		c.Assert(op.Len(), check.Equals, 3)
-		c.Assert(op.Step(0).(operator.AddPeer).ToStore, check.Equals, targetID)
-		c.Assert(op.Step(1).(operator.TransferLeader).FromStore, check.Equals, sourceID)
+	if op.Len() == 3 {
+		c.Assert(op.Step(0).(operator.AddLearner).ToStore, check.Equals, targetID)
+		c.Assert(op.Step(1).(operator.PromoteLearner).ToStore, check.Equals, targetID)
 		c.Assert(op.Step(2).(operator.RemovePeer).FromStore, check.Equals, sourceID)
+	} else {
+		c.Assert(op.Len(), check.Equals, 4)
+		c.Assert(op.Step(0).(operator.AddLearner).ToStore, check.Equals, targetID)
+		c.Assert(op.Step(1).(operator.PromoteLearner).ToStore, check.Equals, targetID)
+		c.Assert(op.Step(2).(operator.TransferLeader).FromStore, check.Equals, sourceID)
+		c.Assert(op.Step(3).(operator.RemovePeer).FromStore, check.Equals, sourceID)
 		kind |= operator.OpLeader
 	}
 	kind |= operator.OpRegion
@@ -69,6 +72,6 @@ func CheckTransferPeer(c *check.C, op *operator.Operator, kind operator.OpKind,
 // transfers the leader out of source store.
 func CheckTransferPeerWithLeaderTransfer(c *check.C, op *operator.Operator, kind operator.OpKind, sourceID, targetID uint64) {
 	c.Assert(op, check.NotNil)
-	c.Assert(op.Len(), check.Equals, 3)
+	c.Assert(op.Len(), check.Equals, 4)
 	CheckTransferPeer(c, op, kind, sourceID, targetID)
 }
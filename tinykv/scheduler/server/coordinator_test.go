@@ -245,7 +245,9 @@ func (s *testCoordinatorSuite) TestDispatch(c *C) {
 	// Transfer peer.
 	region := tc.GetRegion(1).Clone()
 	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
-	region = waitAddPeer(c, stream, region, 1)
+	region = waitAddLearner(c, stream, region, 1)
+	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
+	region = waitPromoteLearner(c, stream, region, 1)
 	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
 	region = waitRemovePeer(c, stream, region, 4)
 	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
@@ -297,7 +299,9 @@ func (s *testCoordinatorSuite) TestReplica(c *C) {
 	c.Assert(tc.addLeaderRegion(1, 2, 3), IsNil)
 	region := tc.GetRegion(1)
 	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
-	region = waitAddPeer(c, stream, region, 1)
+	region = waitAddLearner(c, stream, region, 1)
+	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
+	region = waitPromoteLearner(c, stream, region, 1)
 	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
 	waitNoResponse(c, stream)
 
@@ -348,7 +352,7 @@ func (s *testCoordinatorSuite) TestPeerState(c *C) {
 
 	// Add new peer.
 	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
-	region = waitAddPeer(c, stream, region, 1)
+	region = waitAddLearner(c, stream, region, 1)
 
 	// If the new peer is pending, the operator will not finish.
 	region = region.Clone(core.WithPendingPeers(append(region.GetPendingPeers(), region.GetStorePeer(1))))
@@ -356,10 +360,12 @@ func (s *testCoordinatorSuite) TestPeerState(c *C) {
 	waitNoResponse(c, stream)
 	c.Assert(co.opController.GetOperator(region.GetID()), NotNil)
 
-	// The new peer is not pending now, the operator will finish.
+	// The new peer is not pending now, it will be promoted.
 	// And we will proceed to remove peer in store 4.
 	region = region.Clone(core.WithPendingPeers(nil))
 	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
+	region = waitPromoteLearner(c, stream, region, 1)
+	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
 	waitRemovePeer(c, stream, region, 4)
 	c.Assert(tc.addLeaderRegion(1, 1, 2, 3), IsNil)
 	region = tc.GetRegion(1).Clone()
@@ -439,7 +445,9 @@ func (s *testCoordinatorSuite) TestRestart(c *C) {
 	co.run()
 	stream := mockhbstream.NewHeartbeatStream()
 	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
-	region = waitAddPeer(c, stream, region, 2)
+	region = waitAddLearner(c, stream, region, 2)
+	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
+	region = waitPromoteLearner(c, stream, region, 2)
 	co.stop()
 	co.wg.Wait()
 
@@ -447,7 +455,9 @@ func (s *testCoordinatorSuite) TestRestart(c *C) {
 	co = newCoordinator(s.ctx, tc.RaftCluster, hbStreams)
 	co.run()
 	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
-	region = waitAddPeer(c, stream, region, 3)
+	region = waitAddLearner(c, stream, region, 3)
+	c.Assert(dispatchHeartbeat(c, co, region, stream), IsNil)
+	waitPromoteLearner(c, stream, region, 3)
 	co.stop()
 	co.wg.Wait()
 }
@@ -628,7 +638,23 @@ func (s *testScheduleControllerSuite) TestInterval(c *C) {
 	}
 }
 
-func waitAddPeer(c *C, stream mockhbstream.HeartbeatStream, region *core.RegionInfo, storeID uint64) *core.RegionInfo {
+func waitAddLearner(c *C, stream mockhbstream.HeartbeatStream, region *core.RegionInfo, storeID uint64) *core.RegionInfo {
+	var res *schedulerpb.RegionHeartbeatResponse
+	testutil.WaitUntil(c, func(c *C) bool {
+		if res = stream.Recv(); res != nil {
+			return res.GetRegionId() == region.GetID() &&
+				res.GetChangePeer().GetChangeType() == eraftpb.ConfChangeType_AddLearnerNode &&
+				res.GetChangePeer().GetPeer().GetStoreId() == storeID
+		}
+		return false
+	})
+	return region.Clone(
+		core.WithAddPeer(res.GetChangePeer().GetPeer()),
+		core.WithIncConfVer(),
+	)
+}
+
+func waitPromoteLearner(c *C, stream mockhbstream.HeartbeatStream, region *core.RegionInfo, storeID uint64) *core.RegionInfo {
 	var res *schedulerpb.RegionHeartbeatResponse
 	testutil.WaitUntil(c, func(c *C) bool {
 		if res = stream.Recv(); res != nil {
@@ -638,7 +664,9 @@ func waitAddPeer(c *C, stream mockhbstream.HeartbeatStream, region *core.RegionI
 		}
 		return false
 	})
+	// Remove learner than add voter.
 	return region.Clone(
+		core.WithRemoveStorePeer(storeID),
 		core.WithAddPeer(res.GetChangePeer().GetPeer()),
 		core.WithIncConfVer(),
 	)
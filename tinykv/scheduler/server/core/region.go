@@ -52,10 +52,16 @@ func NewRegionInfo(region *metapb.Region, leader *metapb.Peer, opts ...RegionCre
 
 // classifyVoterAndLearner sorts out voter and learner from peers into different slice.
 func classifyVoterAndLearner(region *RegionInfo) {
+	learners := make([]*metapb.Peer, 0, 1)
 	voters := make([]*metapb.Peer, 0, len(region.meta.Peers))
 	for _, p := range region.meta.Peers {
-		voters = append(voters, p)
+		if p.IsLearner {
+			learners = append(learners, p)
+		} else {
+			voters = append(voters, p)
+		}
 	}
+	region.learners = learners
 	region.voters = voters
 }
 
@@ -151,6 +157,14 @@ func (r *RegionInfo) GetPendingVoter(peerID uint64) *metapb.Peer {
 
 // GetPendingLearner returns the pending learner peer with specified peer id.
 func (r *RegionInfo) GetPendingLearner(peerID uint64) *metapb.Peer {
+	for _, peer := range r.pendingPeers {
+		if peer.GetId() != peerID {
+			continue
+		}
+		if learner := r.GetStoreLearner(peer.GetStoreId()); learner != nil && learner.GetId() == peerID {
+			return peer
+		}
+	}
 	return nil
 }
 
@@ -51,10 +51,20 @@ func WithLeader(leader *metapb.Peer) RegionCreateOption {
 	}
 }
 
-// WithLearners adds learner to the region
-func WithLearners(learner []*metapb.Peer) RegionCreateOption {
+// WithLearners marks the given peers of the region as learners
+func WithLearners(learners []*metapb.Peer) RegionCreateOption {
 	return func(region *RegionInfo) {
-		region.learners = append(region.learners, learner...)
+		peers := make([]*metapb.Peer, 0, len(region.meta.GetPeers()))
+		for _, p := range region.meta.GetPeers() {
+			for _, l := range learners {
+				if p.GetId() == l.GetId() {
+					p = &metapb.Peer{Id: l.GetId(), StoreId: l.GetStoreId(), IsLearner: true}
+					break
+				}
+			}
+			peers = append(peers, p)
+		}
+		region.meta.Peers = peers
 	}
 }
 
@@ -119,6 +129,17 @@ func SetPeers(peers []*metapb.Peer) RegionCreateOption {
 	}
 }
 
+// WithPromoteLearner promotes the learner.
+func WithPromoteLearner(peerID uint64) RegionCreateOption {
+	return func(region *RegionInfo) {
+		for _, p := range region.meta.GetPeers() {
+			if p.GetId() == peerID {
+				p.IsLearner = false
+			}
+		}
+	}
+}
+
 // WithAddPeer adds a peer for the region.
 func WithAddPeer(peer *metapb.Peer) RegionCreateOption {
 	return func(region *RegionInfo) {
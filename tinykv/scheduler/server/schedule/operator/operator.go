@@ -95,6 +95,60 @@ func (ap AddPeer) IsFinish(region *core.RegionInfo) bool {
 	return false
 }
 
+// AddLearner is an OpStep that adds a region learner peer.
+type AddLearner struct {
+	ToStore, PeerID uint64
+}
+
+// ConfVerChanged returns true if the conf version has been changed by this step
+func (al AddLearner) ConfVerChanged(region *core.RegionInfo) bool {
+	if p := region.GetStorePeer(al.ToStore); p != nil {
+		return p.GetId() == al.PeerID
+	}
+	return false
+}
+
+func (al AddLearner) String() string {
+	return fmt.Sprintf("add learner peer %v on store %v", al.PeerID, al.ToStore)
+}
+
+// IsFinish checks if current step is finished.
+func (al AddLearner) IsFinish(region *core.RegionInfo) bool {
+	if p := region.GetStoreLearner(al.ToStore); p != nil {
+		if p.GetId() != al.PeerID {
+			log.Warn("obtain unexpected peer", zap.String("expect", al.String()), zap.Uint64("obtain-learner", p.GetId()))
+			return false
+		}
+		return region.GetPendingLearner(p.GetId()) == nil
+	}
+	return false
+}
+
+// PromoteLearner is an OpStep that promotes a region learner peer to normal voter.
+type PromoteLearner struct {
+	ToStore, PeerID uint64
+}
+
+// ConfVerChanged returns true if the conf version has been changed by this step
+func (pl PromoteLearner) ConfVerChanged(region *core.RegionInfo) bool {
+	return region.GetStoreVoter(pl.ToStore).GetId() == pl.PeerID
+}
+
+func (pl PromoteLearner) String() string {
+	return fmt.Sprintf("promote learner peer %v on store %v to voter", pl.PeerID, pl.ToStore)
+}
+
+// IsFinish checks if current step is finished.
+func (pl PromoteLearner) IsFinish(region *core.RegionInfo) bool {
+	if p := region.GetStoreVoter(pl.ToStore); p != nil {
+		if p.GetId() != pl.PeerID {
+			log.Warn("obtain unexpected peer", zap.String("expect", pl.String()), zap.Uint64("obtain-voter", p.GetId()))
+		}
+		return p.GetId() == pl.PeerID
+	}
+	return false
+}
+
 // RemovePeer is an OpStep that removes a region peer.
 type RemovePeer struct {
 	FromStore uint64
@@ -314,10 +368,13 @@ func CreateRemovePeerOperator(desc string, cluster Cluster, kind OpKind, region
 	return NewOperator(desc, brief, region.GetID(), region.GetRegionEpoch(), removeKind|kind, steps...), nil
 }
 
-// CreateAddPeerSteps creates an OpStep list that add a new peer.
+// CreateAddPeerSteps creates an OpStep list that add a new peer. The peer is added as a
+// learner first and only promoted to a voter once it has caught up, so that the new peer
+// doesn't count towards the quorum while it is still receiving the snapshot.
 func CreateAddPeerSteps(newStore uint64, peerID uint64) []OpStep {
 	st := []OpStep{
-		AddPeer{ToStore: newStore, PeerID: peerID},
+		AddLearner{ToStore: newStore, PeerID: peerID},
+		PromoteLearner{ToStore: newStore, PeerID: peerID},
 	}
 	return st
 }
@@ -105,6 +105,14 @@ func (s *testOperatorSuite) TestOperatorStep(c *C) {
 	c.Assert(AddPeer{ToStore: 1, PeerID: 1}.IsFinish(region), IsTrue)
 	c.Assert(RemovePeer{FromStore: 1}.IsFinish(region), IsFalse)
 	c.Assert(RemovePeer{FromStore: 3}.IsFinish(region), IsTrue)
+
+	learner := &metapb.Peer{Id: 3, StoreId: 3, IsLearner: true}
+	region = region.Clone(core.WithAddPeer(learner))
+	c.Assert(AddLearner{ToStore: 3, PeerID: 3}.IsFinish(region), IsTrue)
+	c.Assert(PromoteLearner{ToStore: 3, PeerID: 3}.IsFinish(region), IsFalse)
+	c.Assert(AddLearner{ToStore: 3, PeerID: 3}.IsFinish(region.Clone(core.WithPendingPeers([]*metapb.Peer{learner}))), IsFalse)
+	region = region.Clone(core.WithPromoteLearner(3))
+	c.Assert(PromoteLearner{ToStore: 3, PeerID: 3}.IsFinish(region), IsTrue)
 }
 
 func (s *testOperatorSuite) newTestOperator(regionID uint64, kind OpKind, steps ...OpStep) *Operator {
@@ -275,6 +275,34 @@ func (oc *OperatorController) SendScheduleCommand(region *core.RegionInfo, step
 			},
 		}
 		oc.hbStreams.SendMsg(region, cmd)
+	case operator.AddLearner:
+		if region.GetStorePeer(st.ToStore) != nil {
+			// The newly added learner is pending.
+			return
+		}
+		cmd := &schedulerpb.RegionHeartbeatResponse{
+			ChangePeer: &schedulerpb.ChangePeer{
+				ChangeType: eraftpb.ConfChangeType_AddLearnerNode,
+				Peer: &metapb.Peer{
+					Id:        st.PeerID,
+					StoreId:   st.ToStore,
+					IsLearner: true,
+				},
+			},
+		}
+		oc.hbStreams.SendMsg(region, cmd)
+	case operator.PromoteLearner:
+		cmd := &schedulerpb.RegionHeartbeatResponse{
+			ChangePeer: &schedulerpb.ChangePeer{
+				// reuse AddNode to promote learner
+				ChangeType: eraftpb.ConfChangeType_AddNode,
+				Peer: &metapb.Peer{
+					Id:      st.PeerID,
+					StoreId: st.ToStore,
+				},
+			},
+		}
+		oc.hbStreams.SendMsg(region, cmd)
 	case operator.RemovePeer:
 		cmd := &schedulerpb.RegionHeartbeatResponse{
 			ChangePeer: &schedulerpb.ChangePeer{
@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tidb/store/mockstore"
 	"github.com/pingcap/tidb/store/mockstore/mocktikv"
 	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/admin"
 	"github.com/pingcap/tidb/util/mock"
@@ -569,6 +570,64 @@ LOOP:
 	tk.MustExec("drop table test_drop_index")
 }
 
+// TestDropDeletesData tests that the data of dropped indexes and tables is deleted in the background.
+func (s *testDBSuite2) TestDropDeletesData(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use " + s.schemaName)
+	tk.MustExec("drop table if exists t_drop_data")
+	tk.MustExec("create table t_drop_data (a int primary key, b int, key idx_b(b))")
+	for i := 0; i < 10; i++ {
+		tk.MustExec(fmt.Sprintf("insert into t_drop_data values (%d, %d)", i, i))
+	}
+	t := testGetTableByName(c, tk.Se, s.schemaName, "t_drop_data")
+	tableID := t.Meta().ID
+	indexID := t.Meta().Indices[0].ID
+	indexPrefix := tablecodec.EncodeTableIndexPrefix(tableID, indexID)
+	tablePrefix := tablecodec.EncodeTablePrefix(tableID)
+	c.Assert(s.countKeys(c, indexPrefix, indexPrefix.PrefixNext()), Equals, 10)
+
+	tk.MustExec("alter table t_drop_data drop index idx_b")
+	s.waitKeysDeleted(c, indexPrefix, indexPrefix.PrefixNext())
+	c.Assert(s.countKeys(c, tablePrefix, tablePrefix.PrefixNext()), Equals, 10)
+
+	tk.MustExec("drop table t_drop_data")
+	s.waitKeysDeleted(c, tablePrefix, tablePrefix.PrefixNext())
+	// The ranges are recorded as done after their keys are deleted.
+	sql := fmt.Sprintf("select count(*) from mysql.gc_delete_range_done where element_id in (%d, %d)", tableID, indexID)
+	for i := 0; i < 100; i++ {
+		if tk.MustQuery(sql).Rows()[0][0] == "2" {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	tk.MustQuery(sql).Check(testkit.Rows("2"))
+}
+
+func (s *testDBSuite) countKeys(c *C, startKey, endKey kv.Key) int {
+	txn, err := s.store.Begin()
+	c.Assert(err, IsNil)
+	defer txn.Rollback()
+	iter, err := txn.Iter(startKey, endKey)
+	c.Assert(err, IsNil)
+	defer iter.Close()
+	count := 0
+	for iter.Valid() {
+		count++
+		c.Assert(iter.Next(), IsNil)
+	}
+	return count
+}
+
+func (s *testDBSuite) waitKeysDeleted(c *C, startKey, endKey kv.Key) {
+	for i := 0; i < 100; i++ {
+		if s.countKeys(c, startKey, endKey) == 0 {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	c.Fatalf("keys in [%v, %v) are not deleted", startKey, endKey)
+}
+
 func (s *testDBSuite4) TestAddIndexWithDupCols(c *C) {
 	s.tk = testkit.NewTestKit(c, s.store)
 	s.tk.MustExec("use " + s.schemaName)
@@ -159,6 +159,8 @@ type DDL interface {
 	CreateSchema(ctx sessionctx.Context, name model.CIStr, charsetInfo *ast.CharsetOpt) error
 	DropSchema(ctx sessionctx.Context, schema model.CIStr) error
 	CreateTable(ctx sessionctx.Context, stmt *ast.CreateTableStmt) error
+	// CreateTableWithInfo creates a table from its info, like the info of a backed up table. The table gets a new ID.
+	CreateTableWithInfo(ctx sessionctx.Context, schema model.CIStr, info *model.TableInfo) error
 	DropTable(ctx sessionctx.Context, tableIdent ast.Ident) (err error)
 	CreateIndex(ctx sessionctx.Context, tableIdent ast.Ident, keyType ast.IndexKeyType, indexName model.CIStr,
 		columnNames []*ast.IndexPartSpecification, indexOption *ast.IndexOption, ifNotExists bool) error
@@ -191,8 +193,9 @@ type ddl struct {
 	quitCh chan struct{}
 
 	*ddlCtx
-	workers  map[workerType]*worker
-	sessPool *sessionPool
+	workers     map[workerType]*worker
+	sessPool    *sessionPool
+	delRangeMgr delRangeManager
 }
 
 // ddlCtx is the context when we use worker to handle DDL jobs.
@@ -280,6 +283,17 @@ func (d *ddl) Stop() error {
 	return nil
 }
 
+func (d *ddl) newDeleteRangeManager(mock bool) delRangeManager {
+	var delRangeMgr delRangeManager
+	if !mock {
+		delRangeMgr = newDelRangeManager(d.store, d.sessPool)
+		logutil.BgLogger().Info("[ddl] start delRangeManager OK", zap.Bool("is a emulator", !d.store.SupportDeleteRange()))
+	} else {
+		delRangeMgr = newMockDelRangeManager()
+	}
+	return delRangeMgr
+}
+
 // start campaigns the owner and starts workers.
 // ctxPool is used for the worker's delRangeManager and creates sessions.
 func (d *ddl) start(ctx context.Context, ctxPool *pools.ResourcePool) {
@@ -291,8 +305,9 @@ func (d *ddl) start(ctx context.Context, ctxPool *pools.ResourcePool) {
 
 	d.workers = make(map[workerType]*worker, 2)
 	d.sessPool = newSessionPool(ctxPool)
-	d.workers[generalWorker] = newWorker(generalWorker, d.sessPool)
-	d.workers[addIdxWorker] = newWorker(addIdxWorker, d.sessPool)
+	d.delRangeMgr = d.newDeleteRangeManager(ctxPool == nil)
+	d.workers[generalWorker] = newWorker(generalWorker, d.sessPool, d.delRangeMgr)
+	d.workers[addIdxWorker] = newWorker(addIdxWorker, d.sessPool, d.delRangeMgr)
 	for _, worker := range d.workers {
 		worker.wg.Add(1)
 		w := worker
@@ -309,6 +324,7 @@ func (d *ddl) start(ctx context.Context, ctxPool *pools.ResourcePool) {
 		// checks owner firstly and try to find whether a job exists and run.
 		asyncNotify(worker.ddlJobCh)
 	}
+	d.delRangeMgr.start()
 
 	go tidbutil.WithRecovery(
 		func() { d.schemaSyncer.StartCleanWork() },
@@ -339,6 +355,11 @@ func (d *ddl) close() {
 	for _, worker := range d.workers {
 		worker.close()
 	}
+	// delRangeMgr using sessions from d.sessPool.
+	// Put it before d.sessPool.close to reduce the time spent by d.sessPool.close.
+	if d.delRangeMgr != nil {
+		d.delRangeMgr.clear()
+	}
 	if d.sessPool != nil {
 		d.sessPool.close()
 	}
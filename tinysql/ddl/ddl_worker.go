@@ -64,19 +64,21 @@ type worker struct {
 	quitCh   chan struct{}
 	wg       sync.WaitGroup
 
-	sessPool *sessionPool // sessPool is used to new sessions to execute SQL in ddl package.
-	reorgCtx *reorgCtx    // reorgCtx is used for reorganization.
-	logCtx   context.Context
+	sessPool    *sessionPool    // sessPool is used to new sessions to execute SQL in ddl package.
+	reorgCtx    *reorgCtx       // reorgCtx is used for reorganization.
+	delRangeMgr delRangeManager // delRangeMgr records the ranges dropped by finished jobs.
+	logCtx      context.Context
 }
 
-func newWorker(tp workerType, sessPool *sessionPool) *worker {
+func newWorker(tp workerType, sessPool *sessionPool, delRangeMgr delRangeManager) *worker {
 	worker := &worker{
-		id:       atomic.AddInt32(&ddlWorkerID, 1),
-		tp:       tp,
-		ddlJobCh: make(chan struct{}, 1),
-		quitCh:   make(chan struct{}),
-		reorgCtx: &reorgCtx{notifyCancelReorgJob: 0},
-		sessPool: sessPool,
+		id:          atomic.AddInt32(&ddlWorkerID, 1),
+		tp:          tp,
+		ddlJobCh:    make(chan struct{}, 1),
+		quitCh:      make(chan struct{}),
+		reorgCtx:    &reorgCtx{notifyCancelReorgJob: 0},
+		sessPool:    sessPool,
+		delRangeMgr: delRangeMgr,
 	}
 
 	worker.logCtx = logutil.WithKeyValue(context.Background(), "worker", worker.String())
@@ -255,6 +257,22 @@ func (w *worker) updateDDLJob(t *meta.Meta, job *model.Job, meetErr bool) error
 // finishDDLJob deletes the finished DDL job in the ddl queue and puts it to history queue.
 // If the DDL job need to handle in background, it will prepare a background job.
 func (w *worker) finishDDLJob(t *meta.Meta, job *model.Job) (err error) {
+	if !job.IsCancelled() {
+		switch job.Type {
+		case model.ActionAddIndex, model.ActionAddPrimaryKey:
+			if job.State != model.JobStateRollbackDone {
+				break
+			}
+			// After rolling back an AddIndex operation, we need to use delete-range to delete the half-done index data.
+			err = w.delRangeMgr.addDelRangeJob(job)
+		case model.ActionDropSchema, model.ActionDropTable, model.ActionDropIndex, model.ActionDropPrimaryKey:
+			err = w.delRangeMgr.addDelRangeJob(job)
+		}
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+
 	_, err = t.DeQueueDDLJob()
 	if err != nil {
 		return errors.Trace(err)
@@ -0,0 +1,284 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/ddl/util"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+)
+
+const (
+	insertDeleteRangeSQL = `REPLACE HIGH_PRIORITY INTO mysql.gc_delete_range VALUES (%d, %d, "%s", "%s", %d)`
+
+	// delBatchSize is the number of keys the emulator deletes in one transaction.
+	delBatchSize = 65536
+	// delBackLog is the number of pending notifications of the emulator.
+	delBackLog = 128
+	// emulatorInterval is how often the emulator looks for ranges it missed, for example because of an error.
+	emulatorInterval = 10 * time.Minute
+)
+
+// delRangeManager records the key ranges of dropped schema elements, which are deleted later.
+type delRangeManager interface {
+	// addDelRangeJob adds the ranges dropped by a finished DDL job into the gc_delete_range table.
+	addDelRangeJob(job *model.Job) error
+	start()
+	clear()
+}
+
+// delRange records the dropped ranges in the gc_delete_range table. If the store supports delete range, the GC worker
+// deletes the ranges once the GC safe point passes the time they were dropped. Otherwise delRange deletes them itself
+// with transactions, which is called the emulator.
+type delRange struct {
+	store        kv.Storage
+	sessPool     *sessionPool
+	storeSupport bool
+	emulatorCh   chan struct{}
+	quitCh       chan struct{}
+	wait         sync.WaitGroup
+}
+
+// newDelRangeManager returns a delRangeManager.
+func newDelRangeManager(store kv.Storage, sessPool *sessionPool) delRangeManager {
+	dr := &delRange{
+		store:        store,
+		sessPool:     sessPool,
+		storeSupport: store.SupportDeleteRange(),
+		quitCh:       make(chan struct{}),
+	}
+	if !dr.storeSupport {
+		dr.emulatorCh = make(chan struct{}, delBackLog)
+	}
+	return dr
+}
+
+// addDelRangeJob implements delRangeManager interface.
+func (dr *delRange) addDelRangeJob(job *model.Job) error {
+	ctx, err := dr.sessPool.get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer dr.sessPool.put(ctx)
+
+	err = insertJobIntoDeleteRangeTable(ctx, job)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !dr.storeSupport {
+		// The emulator loads all the pending ranges each time, so one notification is as good as many.
+		select {
+		case dr.emulatorCh <- struct{}{}:
+		default:
+		}
+	}
+	logutil.BgLogger().Info("[ddl] add job into delete-range table", zap.Int64("jobID", job.ID), zap.String("jobType", job.Type.String()))
+	return nil
+}
+
+// start implements delRangeManager interface.
+func (dr *delRange) start() {
+	if !dr.storeSupport {
+		dr.wait.Add(1)
+		go dr.startEmulator()
+	}
+}
+
+// clear implements delRangeManager interface.
+func (dr *delRange) clear() {
+	logutil.BgLogger().Info("[ddl] closing delRange")
+	close(dr.quitCh)
+	dr.wait.Wait()
+}
+
+// startEmulator is only used for those storage engines which don't support delete-range. The emulator fetches
+// the ranges from the gc_delete_range table and deletes all keys in each range.
+func (dr *delRange) startEmulator() {
+	defer dr.wait.Done()
+	logutil.BgLogger().Info("[ddl] start delRange emulator")
+	ticker := time.NewTicker(emulatorInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-dr.emulatorCh:
+		case <-ticker.C:
+		case <-dr.quitCh:
+			return
+		}
+		err := dr.doDelRangeWork()
+		if err != nil {
+			logutil.BgLogger().Error("[ddl] delRange emulator do task failed", zap.Error(err))
+		}
+	}
+}
+
+func (dr *delRange) doDelRangeWork() error {
+	ctx, err := dr.sessPool.get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer dr.sessPool.put(ctx)
+
+	// The ranges are deleted at once, there is no need to wait for any safe point.
+	ranges, err := util.LoadDeleteRanges(ctx, math.MaxInt64)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, r := range ranges {
+		if err := dr.doTask(ctx, r); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (dr *delRange) doTask(ctx sessionctx.Context, r util.DelRangeTask) error {
+	startKey, endKey := kv.Key(r.StartKey), kv.Key(r.EndKey)
+	for {
+		keys := make([]kv.Key, 0, delBatchSize)
+		err := kv.RunInNewTxn(dr.store, false, func(txn kv.Transaction) error {
+			keys = keys[:0]
+			iter, err := txn.Iter(startKey, endKey)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			defer iter.Close()
+
+			for len(keys) < delBatchSize && iter.Valid() {
+				keys = append(keys, iter.Key().Clone())
+				if err := iter.Next(); err != nil {
+					return errors.Trace(err)
+				}
+			}
+			for _, key := range keys {
+				if err := txn.Delete(key); err != nil {
+					return errors.Trace(err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(keys) < delBatchSize {
+			break
+		}
+		startKey = keys[len(keys)-1].Next()
+	}
+
+	if err := util.CompleteDeleteRange(ctx, r); err != nil {
+		return errors.Trace(err)
+	}
+	logutil.BgLogger().Info("[ddl] delRange emulator complete task", zap.Int64("jobID", r.JobID), zap.Int64("elementID", r.ElementID))
+	return nil
+}
+
+// insertJobIntoDeleteRangeTable parses the job into delete-range arguments,
+// and inserts a new record into gc_delete_range table. A job may be finished
+// again after its transaction fails, so the record replaces an existing one.
+func insertJobIntoDeleteRangeTable(ctx sessionctx.Context, job *model.Job) error {
+	ver, err := ctx.GetStore().CurrentVersion()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ts := ver.Ver
+
+	s := ctx.(sqlexec.SQLExecutor)
+	switch job.Type {
+	case model.ActionDropSchema:
+		var tableIDs []int64
+		if err := job.DecodeArgs(&tableIDs); err != nil {
+			return errors.Trace(err)
+		}
+		for _, tableID := range tableIDs {
+			startKey := tablecodec.EncodeTablePrefix(tableID)
+			endKey := tablecodec.EncodeTablePrefix(tableID + 1)
+			if err := doInsert(s, job.ID, tableID, startKey, endKey, ts); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	case model.ActionDropTable:
+		tableID := job.TableID
+		var startKey kv.Key
+		if err := job.DecodeArgs(&startKey); err != nil {
+			return errors.Trace(err)
+		}
+		endKey := tablecodec.EncodeTablePrefix(tableID + 1)
+		return doInsert(s, job.ID, tableID, startKey, endKey, ts)
+	// ActionAddIndex and ActionAddPrimaryKey need to do it, because they need to be rolled back when they are
+	// canceled, and the index data written so far has to be deleted.
+	case model.ActionAddIndex, model.ActionAddPrimaryKey:
+		tableID := job.TableID
+		var indexID int64
+		if err := job.DecodeArgs(&indexID); err != nil {
+			return errors.Trace(err)
+		}
+		startKey := tablecodec.EncodeTableIndexPrefix(tableID, indexID)
+		endKey := tablecodec.EncodeTableIndexPrefix(tableID, indexID+1)
+		return doInsert(s, job.ID, indexID, startKey, endKey, ts)
+	case model.ActionDropIndex, model.ActionDropPrimaryKey:
+		tableID := job.TableID
+		var indexName interface{}
+		var indexID int64
+		if err := job.DecodeArgs(&indexName, &indexID); err != nil {
+			return errors.Trace(err)
+		}
+		startKey := tablecodec.EncodeTableIndexPrefix(tableID, indexID)
+		endKey := tablecodec.EncodeTableIndexPrefix(tableID, indexID+1)
+		return doInsert(s, job.ID, indexID, startKey, endKey, ts)
+	}
+	return nil
+}
+
+func doInsert(s sqlexec.SQLExecutor, jobID int64, elementID int64, startKey, endKey kv.Key, ts uint64) error {
+	logutil.BgLogger().Info("[ddl] insert into delete-range table", zap.Int64("jobID", jobID), zap.Int64("elementID", elementID))
+	startKeyEncoded := hex.EncodeToString(startKey)
+	endKeyEncoded := hex.EncodeToString(endKey)
+	sql := fmt.Sprintf(insertDeleteRangeSQL, jobID, elementID, startKeyEncoded, endKeyEncoded, ts)
+	_, err := s.Execute(context.Background(), sql)
+	return errors.Trace(err)
+}
+
+// mockDelRange is used when the DDL has no session pool to write the gc_delete_range table with,
+// dropped ranges are left as they are.
+type mockDelRange struct{}
+
+// newMockDelRangeManager creates a mock delRangeManager only used for test.
+func newMockDelRangeManager() delRangeManager {
+	return &mockDelRange{}
+}
+
+// addDelRangeJob implements delRangeManager interface.
+func (dr *mockDelRange) addDelRangeJob(job *model.Job) error {
+	return nil
+}
+
+// start implements delRangeManager interface.
+func (dr *mockDelRange) start() {}
+
+// clear implements delRangeManager interface.
+func (dr *mockDelRange) clear() {}
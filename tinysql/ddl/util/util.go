@@ -14,14 +14,36 @@
 package util
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/terror"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/sqlexec"
 )
 
+const (
+	loadDeleteRangeSQL = `SELECT HIGH_PRIORITY job_id, element_id, start_key, end_key FROM mysql.gc_delete_range WHERE ts < %v`
+	// There is no INSERT IGNORE, a range which was recorded as done before is simply replaced.
+	recordDoneDeletedRangeSQL = `REPLACE INTO mysql.gc_delete_range_done SELECT * FROM mysql.gc_delete_range WHERE job_id = %d AND element_id = %d`
+	completeDeleteRangeSQL    = `DELETE FROM mysql.gc_delete_range WHERE job_id = %d AND element_id = %d`
+)
+
+// DelRangeTask is a range of a dropped schema element, recorded in mysql.gc_delete_range, which is to be deleted.
+type DelRangeTask struct {
+	JobID, ElementID int64
+	StartKey, EndKey []byte
+}
+
+// Range returns the range [start, end) to delete.
+func (t DelRangeTask) Range() ([]byte, []byte) {
+	return t.StartKey, t.EndKey
+}
+
 // LoadDDLReorgVars loads ddl reorg variable from mysql.global_variables.
 func LoadDDLReorgVars(ctx sessionctx.Context) error {
 	return LoadGlobalVars(ctx, []string{variable.TiDBDDLReorgWorkerCount, variable.TiDBDDLReorgBatchSize})
@@ -57,3 +79,71 @@ func LoadGlobalVars(ctx sessionctx.Context, varNames []string) error {
 	}
 	return nil
 }
+
+// LoadDeleteRanges loads the ranges which were dropped before safePoint and are to be deleted.
+func LoadDeleteRanges(ctx sessionctx.Context, safePoint uint64) (ranges []DelRangeTask, _ error) {
+	sql := fmt.Sprintf(loadDeleteRangeSQL, safePoint)
+	rss, err := ctx.(sqlexec.SQLExecutor).Execute(context.TODO(), sql)
+	if len(rss) > 0 {
+		defer terror.Call(rss[0].Close)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	rs := rss[0]
+	req := rs.NewChunk()
+	it := chunk.NewIterator4Chunk(req)
+	for {
+		err = rs.Next(context.TODO(), req)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if req.NumRows() == 0 {
+			break
+		}
+
+		for row := it.Begin(); row != it.End(); row = it.Next() {
+			startKey, err := hex.DecodeString(row.GetString(2))
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			endKey, err := hex.DecodeString(row.GetString(3))
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			ranges = append(ranges, DelRangeTask{
+				JobID:     row.GetInt64(0),
+				ElementID: row.GetInt64(1),
+				StartKey:  startKey,
+				EndKey:    endKey,
+			})
+		}
+	}
+	return ranges, nil
+}
+
+// CompleteDeleteRange moves a range which has been deleted from mysql.gc_delete_range to
+// mysql.gc_delete_range_done.
+func CompleteDeleteRange(ctx sessionctx.Context, dr DelRangeTask) error {
+	exec := ctx.(sqlexec.SQLExecutor)
+	_, err := exec.Execute(context.TODO(), "BEGIN")
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	sql := fmt.Sprintf(recordDoneDeletedRangeSQL, dr.JobID, dr.ElementID)
+	_, err = exec.Execute(context.TODO(), sql)
+	if err == nil {
+		sql = fmt.Sprintf(completeDeleteRangeSQL, dr.JobID, dr.ElementID)
+		_, err = exec.Execute(context.TODO(), sql)
+	}
+	if err != nil {
+		_, rollbackErr := exec.Execute(context.TODO(), "ROLLBACK")
+		terror.Log(errors.Trace(rollbackErr))
+		return errors.Trace(err)
+	}
+
+	_, err = exec.Execute(context.TODO(), "COMMIT")
+	return errors.Trace(err)
+}
@@ -117,12 +117,15 @@ func (builder *RequestBuilder) getIsolationLevel() kv.IsoLevel {
 }
 
 // SetFromSessionVars sets the following fields for "kv.Request" from session variables:
-// "Concurrency", "IsolationLevel", "NotFillCache", "ReplicaRead".
+// "Concurrency", "IsolationLevel", "NotFillCache", "ReplicaRead", "StaleRead", "Paging", "Cacheable".
 func (builder *RequestBuilder) SetFromSessionVars(sv *variable.SessionVars) *RequestBuilder {
 	builder.Request.Concurrency = sv.DistSQLScanConcurrency
 	builder.Request.IsolationLevel = builder.getIsolationLevel()
 	builder.Request.NotFillCache = sv.StmtCtx.NotFillCache
 	builder.Request.ReplicaRead = sv.GetReplicaRead()
+	builder.Request.StaleRead = sv.StmtCtx.StaleReadTS != 0
+	builder.Request.Paging = sv.EnablePaging
+	builder.Request.Cacheable = sv.EnableCoprCache
 	return builder
 }
 
@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
@@ -218,6 +219,9 @@ func (a *ExecStmt) handleNoDelay(ctx context.Context, e Executor) (bool, sqlexec
 
 	// If the executor doesn't return any result to the client, we execute it without delay.
 	if toCheck.Schema().Len() == 0 {
+		if a.Ctx.GetSessionVars().TxnCtx.IsPessimistic {
+			return true, nil, a.handlePessimisticDML(ctx, e)
+		}
 		r, err := a.handleNoDelayExecutor(ctx, e)
 		return true, r, err
 	}
@@ -225,6 +229,78 @@ func (a *ExecStmt) handleNoDelay(ctx context.Context, e Executor) (bool, sqlexec
 	return false, nil, nil
 }
 
+// maxPessimisticRetryCount is the number of times a statement of a pessimistic transaction is retried when the keys
+// it writes have been written by other transactions since its for_update_ts.
+const maxPessimisticRetryCount = 256
+
+type pessimisticTxn interface {
+	kv.Transaction
+	// KeysNeedToLock returns the keys need to be locked.
+	KeysNeedToLock() ([]kv.Key, error)
+}
+
+// handlePessimisticDML executes a statement of a pessimistic transaction and locks the keys it writes at the
+// for_update_ts of the transaction. If another transaction has written one of the keys since, the statement is
+// executed again with a newer for_update_ts, so that only the statement is retried instead of the transaction.
+func (a *ExecStmt) handlePessimisticDML(ctx context.Context, e Executor) error {
+	sctx := a.Ctx
+	for retryCount := 0; ; retryCount++ {
+		if _, err := a.handleNoDelayExecutor(ctx, e); err != nil {
+			return err
+		}
+		txn, err := sctx.Txn(false)
+		if err != nil {
+			return err
+		}
+		if !txn.Valid() {
+			return nil
+		}
+		keys, err := txn.(pessimisticTxn).KeysNeedToLock()
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		sessVars := sctx.GetSessionVars()
+		lockCtx := &kv.LockCtx{Killed: &sessVars.Killed, ForUpdateTS: sessVars.TxnCtx.GetForUpdateTS()}
+		err = txn.LockKeys(ctx, lockCtx, keys...)
+		if err == nil || !kv.ErrWriteConflict.Equal(err) || retryCount >= maxPessimisticRetryCount {
+			return err
+		}
+		if e, err = a.retryPessimisticDML(ctx, txn); err != nil {
+			return err
+		}
+	}
+}
+
+// retryPessimisticDML discards the writes of the statement which failed to lock its keys, and builds it again to
+// read the data at a new for_update_ts.
+func (a *ExecStmt) retryPessimisticDML(ctx context.Context, txn kv.Transaction) (Executor, error) {
+	sctx := a.Ctx
+	ver, err := sctx.GetStore().CurrentVersion()
+	if err != nil {
+		return nil, err
+	}
+	sessVars := sctx.GetSessionVars()
+	sessVars.TxnCtx.SetForUpdateTS(ver.Ver)
+	txn.SetOption(kv.SnapshotTS, sessVars.TxnCtx.GetForUpdateTS())
+	sctx.StmtRollback()
+	sessVars.StmtCtx.ResetForRetry()
+	logutil.Logger(ctx).Debug("retry the statement of a pessimistic transaction",
+		zap.Uint64("txnStartTS", txn.StartTS()), zap.Uint64("forUpdateTS", ver.Ver), zap.String("sql", a.Text))
+
+	e, err := a.buildExecutor()
+	if err != nil {
+		return nil, err
+	}
+	if err = e.Open(ctx); err != nil {
+		terror.Call(e.Close)
+		return nil, err
+	}
+	return e, nil
+}
+
 func (a *ExecStmt) handleNoDelayExecutor(ctx context.Context, e Executor) (sqlexec.RecordSet, error) {
 	var err error
 	defer func() {
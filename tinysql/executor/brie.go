@@ -0,0 +1,250 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cznic/mathutil"
+	kvbackup "github.com/pingcap-incubator/tinykv/kv/backup"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/store/tikv"
+	"github.com/pingcap/tidb/store/tikv/backup"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+// BRIEExec executes BACKUP and RESTORE statements. It returns a row with the checksum of each backed up or restored
+// table.
+type BRIEExec struct {
+	baseExecutor
+
+	stmt   *ast.BRIEStmt
+	result *chunk.Chunk
+	cursor int
+}
+
+// Next implements the Executor Next interface.
+func (e *BRIEExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.GrowAndReset(e.maxChunkSize)
+	if e.result == nil {
+		e.result = newFirstChunk(e)
+		if err := e.execute(ctx); err != nil {
+			return err
+		}
+	}
+	if e.cursor >= e.result.NumRows() {
+		return nil
+	}
+	numCurBatch := mathutil.Min(req.Capacity(), e.result.NumRows()-e.cursor)
+	req.Append(e.result, e.cursor, e.cursor+numCurBatch)
+	e.cursor += numCurBatch
+	return nil
+}
+
+func (e *BRIEExec) execute(ctx context.Context) error {
+	store, ok := e.ctx.GetStore().(tikv.Storage)
+	if !ok {
+		return errors.Errorf("%s is not supported by the store", e.stmt.Kind)
+	}
+	dir, err := backup.ParseStorage(e.stmt.Storage)
+	if err != nil {
+		return err
+	}
+	switch e.stmt.Kind {
+	case ast.BRIEKindBackup:
+		return e.backup(ctx, store, dir)
+	case ast.BRIEKindRestore:
+		return e.restore(ctx, store, dir)
+	}
+	return nil
+}
+
+// wantSchema tells whether the statement is about a database. All the databases leave out the system ones.
+func (e *BRIEExec) wantSchema(name model.CIStr) bool {
+	if len(e.stmt.Schemas) == 0 {
+		return !util.IsMemOrSysDB(name.L)
+	}
+	for _, schema := range e.stmt.Schemas {
+		if model.NewCIStr(schema).L == name.L {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSchemas checks that the databases named by the statement are in dbs.
+func (e *BRIEExec) checkSchemas(dbs []*model.DBInfo) error {
+	for _, schema := range e.stmt.Schemas {
+		name := model.NewCIStr(schema)
+		found := false
+		for _, db := range dbs {
+			found = found || db.Name.L == name.L
+		}
+		if !found {
+			return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(name)
+		}
+	}
+	return nil
+}
+
+// backup backs up the tables of the databases at the backup ts, table by table. The schemas are read at the
+// backup ts too, so that the data matches them.
+func (e *BRIEExec) backup(ctx context.Context, store tikv.Storage, dir string) error {
+	backupTs := e.stmt.Snapshot
+	if backupTs == 0 {
+		ver, err := store.CurrentVersion()
+		if err != nil {
+			return err
+		}
+		backupTs = ver.Ver
+	}
+	snapshot, err := store.GetSnapshot(kv.Version{Ver: backupTs})
+	if err != nil {
+		return err
+	}
+	m := meta.NewSnapshotMeta(snapshot)
+	dbs, err := m.ListDatabases()
+	if err != nil {
+		return err
+	}
+	if err = e.checkSchemas(dbs); err != nil {
+		return err
+	}
+	if err = backup.CreateDir(dir); err != nil {
+		return err
+	}
+
+	backupMeta := &backup.Meta{BackupTs: backupTs}
+	for _, db := range dbs {
+		if !e.wantSchema(db.Name) {
+			continue
+		}
+		tables, err := m.ListTables(db.ID)
+		if err != nil {
+			return err
+		}
+		schema := &backup.Schema{Info: db}
+		for _, tblInfo := range tables {
+			if tblInfo.State != model.StatePublic {
+				continue
+			}
+			autoID, err := m.GetAutoTableID(db.ID, tblInfo.ID)
+			if err != nil {
+				return err
+			}
+			files, err := backup.BackupRange(ctx, store, dir,
+				tablecodec.GenTablePrefix(tblInfo.ID), tablecodec.GenTablePrefix(tblInfo.ID+1), backupTs)
+			if err != nil {
+				return err
+			}
+			table := backup.NewTable(tblInfo, autoID, files)
+			schema.Tables = append(schema.Tables, table)
+			e.appendRow(db.Name, tblInfo.Name, table)
+		}
+		backupMeta.Schemas = append(backupMeta.Schemas, schema)
+	}
+	return backup.WriteMeta(dir, backupMeta)
+}
+
+// restore creates the tables of the backed up databases with new IDs, then restores their data with the keys
+// rewritten to the new IDs. It fails if a table already exists, or if the checksum of the restored data doesn't match
+// the checksum of the backup.
+func (e *BRIEExec) restore(ctx context.Context, store tikv.Storage, dir string) error {
+	backupMeta, err := backup.ReadMeta(dir)
+	if err != nil {
+		return err
+	}
+	dbs := make([]*model.DBInfo, 0, len(backupMeta.Schemas))
+	for _, schema := range backupMeta.Schemas {
+		dbs = append(dbs, schema.Info)
+	}
+	if err = e.checkSchemas(dbs); err != nil {
+		return err
+	}
+
+	dom := domain.GetDomain(e.ctx)
+	for _, schema := range backupMeta.Schemas {
+		if !e.wantSchema(schema.Info.Name) {
+			continue
+		}
+		if _, ok := dom.InfoSchema().SchemaByName(schema.Info.Name); !ok {
+			opt := &ast.CharsetOpt{Chs: schema.Info.Charset, Col: schema.Info.Collate}
+			if err = dom.DDL().CreateSchema(e.ctx, schema.Info.Name, opt); err != nil {
+				return err
+			}
+		}
+		for _, table := range schema.Tables {
+			if err = dom.DDL().CreateTableWithInfo(e.ctx, schema.Info.Name, table.Info); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Restore at a ts after the tables are created, so that the restored data is newer than the schemas.
+	ver, err := store.CurrentVersion()
+	if err != nil {
+		return err
+	}
+	is := dom.InfoSchema()
+	for _, schema := range backupMeta.Schemas {
+		if !e.wantSchema(schema.Info.Name) {
+			continue
+		}
+		for _, table := range schema.Tables {
+			tbl, err := is.TableByName(schema.Info.Name, table.Info.Name)
+			if err != nil {
+				return err
+			}
+			rules := []*kvrpcpb.RewriteRule{{
+				OldKeyPrefix: tablecodec.GenTablePrefix(table.Info.ID),
+				NewKeyPrefix: tablecodec.GenTablePrefix(tbl.Meta().ID),
+			}}
+			restored := new(kvbackup.Checksum)
+			for _, file := range table.Files {
+				checksum, err := backup.RestoreFile(ctx, store, dir, file, rules, ver.Ver)
+				if err != nil {
+					return err
+				}
+				restored.Merge(checksum)
+			}
+			if *restored != table.Checksum {
+				return errors.Errorf("restore %s.%s: checksum mismatch, restored %+v, backed up %+v",
+					schema.Info.Name, table.Info.Name, *restored, table.Checksum)
+			}
+			if err = tbl.RebaseAutoID(e.ctx, table.AutoID, false); err != nil {
+				return err
+			}
+			e.appendRow(schema.Info.Name, table.Info.Name, table)
+		}
+	}
+	return nil
+}
+
+func (e *BRIEExec) appendRow(db, tbl model.CIStr, table *backup.Table) {
+	e.result.AppendString(0, db.O)
+	e.result.AppendString(1, tbl.O)
+	e.result.AppendString(2, fmt.Sprintf("%016x", table.Checksum.Crc64Xor))
+	e.result.AppendInt64(3, int64(table.Checksum.TotalKvs))
+	e.result.AppendInt64(4, int64(table.Checksum.TotalBytes))
+}
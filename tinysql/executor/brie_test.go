@@ -0,0 +1,75 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"fmt"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util/testkit"
+)
+
+func (s *testSuite) TestBackupRestore(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("create database brie")
+	defer tk.MustExec("drop database if exists brie")
+	tk.MustExec("use brie")
+	tk.MustExec("create table t (id int primary key auto_increment, v int, key idx_v (v))")
+	tk.MustExec("insert into t (v) values (1), (2), (3), (4)")
+	tk.MustExec("create table empty (id int)")
+	tk.MustExec("create table u (id int)")
+	tk.MustExec("insert into u values (1), (2)")
+	tbl, err := s.domain.InfoSchema().TableByName(model.NewCIStr("brie"), model.NewCIStr("t"))
+	c.Assert(err, IsNil)
+	s.cluster.SplitTable(s.mvccStore, tbl.Meta().ID, 2)
+	ver, err := s.store.CurrentVersion()
+	c.Assert(err, IsNil)
+	// The row inserted after the snapshot isn't backed up.
+	tk.MustExec("insert into t (v) values (5)")
+
+	dir := c.MkDir()
+	backupSQL := fmt.Sprintf("backup database brie to 'local://%s' snapshot = %d", dir, ver.Ver)
+	backupResult := tk.MustQuery(backupSQL).Sort()
+	rows := backupResult.Rows()
+	c.Assert(rows, HasLen, 3)
+	// A row and an index entry for each of the 4 rows of t.
+	c.Assert(rows[0][:2], DeepEquals, []interface{}{"brie", "empty"})
+	c.Assert(rows[0][3:], DeepEquals, []interface{}{"0", "0"})
+	c.Assert(rows[1][:2], DeepEquals, []interface{}{"brie", "t"})
+	c.Assert(rows[1][3], Equals, "8")
+	c.Assert(rows[2][:2], DeepEquals, []interface{}{"brie", "u"})
+	c.Assert(rows[2][3], Equals, "2")
+	err = tk.QueryToErr(backupSQL)
+	c.Assert(err, ErrorMatches, ".*already holds a backup.*")
+
+	restoreSQL := fmt.Sprintf("restore database * from 'local://%s'", dir)
+	err = tk.QueryToErr(restoreSQL)
+	c.Assert(infoschema.ErrTableExists.Equal(err), IsTrue, Commentf("%v", err))
+	tk.MustExec("drop database brie")
+	tk.MustQuery(restoreSQL).Sort().Check(backupResult.Rows())
+	tk.MustQuery("select id, v from brie.t").Check(testkit.Rows("1 1", "2 2", "3 3", "4 4"))
+	tk.MustQuery("select v from brie.t use index(idx_v) where v > 2").Check(testkit.Rows("3", "4"))
+	tk.MustQuery("select count(*) from brie.empty").Check(testkit.Rows("0"))
+	tk.MustQuery("select id from brie.u").Sort().Check(testkit.Rows("1", "2"))
+	// The restored table allocates the IDs after the backed up ones.
+	tk.MustExec("insert into brie.t (v) values (6)")
+	tk.MustQuery("select id > 4 from brie.t where v = 6").Check(testkit.Rows("1"))
+
+	err = tk.QueryToErr(fmt.Sprintf("backup database nosuchdb to 'local://%s'", c.MkDir()))
+	c.Assert(infoschema.ErrDatabaseNotExists.Equal(err), IsTrue, Commentf("%v", err))
+	err = tk.QueryToErr("backup database brie to 's3://bucket/backup'")
+	c.Assert(err, ErrorMatches, ".*not supported.*")
+}
@@ -202,6 +202,9 @@ func (b *executorBuilder) buildShow(v *plannercore.PhysicalShow) Executor {
 }
 
 func (b *executorBuilder) buildSimple(v *plannercore.Simple) Executor {
+	if s, ok := v.Statement.(*ast.BRIEStmt); ok {
+		return b.buildBRIE(s, v)
+	}
 	base := newBaseExecutor(b.ctx, v.Schema(), v.ExplainID())
 	base.initCap = chunk.ZeroCapacity
 	e := &SimpleExec{
@@ -212,6 +215,13 @@ func (b *executorBuilder) buildSimple(v *plannercore.Simple) Executor {
 	return e
 }
 
+func (b *executorBuilder) buildBRIE(s *ast.BRIEStmt, v *plannercore.Simple) Executor {
+	return &BRIEExec{
+		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ExplainID()),
+		stmt:         s,
+	}
+}
+
 func (b *executorBuilder) buildSet(v *plannercore.Set) Executor {
 	base := newBaseExecutor(b.ctx, v.Schema(), v.ExplainID())
 	base.initCap = chunk.ZeroCapacity
@@ -592,6 +602,11 @@ func (b *executorBuilder) getStartTS() (uint64, error) {
 		// Return the cached value.
 		return b.startTS, nil
 	}
+	if ts := b.ctx.GetSessionVars().StmtCtx.StaleReadTS; ts != 0 {
+		// "AS OF TIMESTAMP" reads the data committed before ts.
+		b.startTS = ts
+		return b.startTS, nil
+	}
 
 	txn, err := b.ctx.Txn(true)
 	if err != nil {
@@ -19,11 +19,18 @@ import (
 	"fmt"
 	"runtime/pprof"
 	"strings"
+	"sync/atomic"
+	"time"
 
+	"github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
 	. "github.com/pingcap/check"
 	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/session"
+	"github.com/pingcap/tidb/store/mockstore"
+	"github.com/pingcap/tidb/store/mockstore/mocktikv"
 	"github.com/pingcap/tidb/store/tikv"
+	"github.com/pingcap/tidb/store/tikv/tikvrpc"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/util/testkit"
 )
@@ -158,3 +165,129 @@ func (s *testSuite3) TestPushLimitDownIndexLookUpReader(c *C) {
 	tk.MustQuery("select * from tbl use index(idx_b_c) where b > 1 order by b desc limit 2,1").Check(testkit.Rows("3 3 3"))
 	tk.MustQuery("select * from tbl use index(idx_b_c) where b > 1 and c > 1 limit 2,1").Check(testkit.Rows("4 4 4"))
 }
+
+func (s *testSuite3) TestStaleRead(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int primary key, b int, key idx_b(b))")
+	tk.MustExec("insert into t values(1, 1)")
+	ver, err := s.store.CurrentVersion()
+	c.Assert(err, IsNil)
+	tk.MustExec("insert into t values(2, 2)")
+
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 1", "2 2"))
+	tk.MustQuery(fmt.Sprintf("select * from t as of timestamp %d", ver.Ver)).Check(testkit.Rows("1 1"))
+	tk.MustQuery(fmt.Sprintf("select b from t as of timestamp %d use index(idx_b) where b > 0", ver.Ver)).Check(testkit.Rows("1"))
+	tk.MustQuery(fmt.Sprintf("select t1.a from t as of timestamp %[1]d t1 join t as of timestamp %[1]d t2 on t1.a = t2.b", ver.Ver)).Check(testkit.Rows("1"))
+	// The table is read with the current schema, it has no data in the past.
+	tk.MustQuery("select * from t as of timestamp '2000-01-01 00:00:00'").Check(testkit.Rows())
+
+	_, err = tk.Exec("select * from t as of timestamp '3000-01-01'")
+	c.Assert(err, NotNil)
+	_, err = tk.Exec(fmt.Sprintf("select * from t as of timestamp %d t1, t as of timestamp %d t2", ver.Ver, ver.Ver-1))
+	c.Assert(err, NotNil)
+	_, err = tk.Exec(fmt.Sprintf("insert into t select a + 10, b from t as of timestamp %d", ver.Ver))
+	c.Assert(err, NotNil)
+	tk.MustExec("begin")
+	_, err = tk.Exec(fmt.Sprintf("select * from t as of timestamp %d", ver.Ver))
+	c.Assert(err, NotNil)
+	tk.MustExec("rollback")
+}
+
+// pageCountClient counts the coprocessor responses which leave the rest of their task to another page.
+type pageCountClient struct {
+	tikv.Client
+	pages int64
+}
+
+func (c *pageCountClient) SendRequest(ctx context.Context, addr string, req *tikvrpc.Request, timeout time.Duration) (*tikvrpc.Response, error) {
+	resp, err := c.Client.SendRequest(ctx, addr, req, timeout)
+	if err == nil && req.Type == tikvrpc.CmdCop && resp.Resp.(*coprocessor.Response).Range != nil {
+		atomic.AddInt64(&c.pages, 1)
+	}
+	return resp, err
+}
+
+func (s *testSuite3) TestPaging(c *C) {
+	cluster := mocktikv.NewCluster()
+	mocktikv.BootstrapWithSingleStore(cluster)
+	mvccStore := mocktikv.MustNewMVCCStore()
+	client := &pageCountClient{}
+	store, err := mockstore.NewMockTikvStore(
+		mockstore.WithCluster(cluster),
+		mockstore.WithMVCCStore(mvccStore),
+		mockstore.WithHijackClient(func(inner tikv.Client) tikv.Client {
+			client.Client = inner
+			return client
+		}),
+	)
+	c.Assert(err, IsNil)
+	defer store.Close()
+	dom, err := session.BootstrapSession(store)
+	c.Assert(err, IsNil)
+	defer dom.Close()
+
+	tk := testkit.NewTestKit(c, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int primary key, b int, c int, key idx_b(b))")
+	var values []string
+	for i := 0; i < 1000; i++ {
+		values = append(values, fmt.Sprintf("(%d, %d, %d)", i, 1000-i, i%7))
+	}
+	tk.MustExec("insert t values " + strings.Join(values, ","))
+	tbl, err := dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	c.Assert(err, IsNil)
+	cluster.SplitTable(mvccStore, tbl.Meta().ID, 3)
+
+	queries := []string{
+		"select * from t",
+		"select a from t order by a desc",
+		"select * from t where c = 3 order by a",
+		"select b from t use index(idx_b) where b > 100 order by b desc",
+		"select * from t use index(idx_b) where b between 100 and 800 order by b",
+		"select * from t where c < 5 limit 300",
+		"select count(*), sum(c) from t",
+	}
+	tk.MustExec("set @@tidb_enable_paging = 0")
+	expected := make([][][]interface{}, len(queries))
+	for i, sql := range queries {
+		expected[i] = tk.MustQuery(sql).Sort().Rows()
+	}
+	c.Assert(atomic.LoadInt64(&client.pages), Equals, int64(0))
+
+	tk.MustExec("set @@tidb_enable_paging = 1")
+	for i, sql := range queries {
+		tk.MustQuery(sql).Sort().Check(expected[i])
+	}
+	c.Assert(atomic.LoadInt64(&client.pages) > 0, IsTrue)
+}
+
+func (s *testSuite3) TestProjectionPushDown(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int primary key, b int, c varchar(10), d double)")
+	tk.MustExec("insert t values (1, 2, 'a', 1.5), (2, null, 'bcd', 2.25), (3, 4, null, null), (4, 8, 'ef', 0)")
+	tbl, err := domain.GetDomain(tk.Se).InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	c.Assert(err, IsNil)
+	s.cluster.SplitTable(s.mvccStore, tbl.Meta().ID, 2)
+
+	tk.MustExec("set @@tidb_opt_projection_push_down = 1")
+	tk.MustQuery("explain select a + b * 2 from t").Check(testkit.Rows(
+		"TableReader_8 10000.00 root data:Projection_4",
+		"└─Projection_4 10000.00 cop plus(test.t.a, mul(test.t.b, 2))->Column#5",
+		"  └─TableScan_7 10000.00 cop table:t, range:[-inf,+inf], keep order:false, stats:pseudo",
+	))
+	tk.MustQuery("select a + b * 2 from t").Sort().Check(testkit.Rows("11", "20", "5", "<nil>"))
+	tk.MustQuery("select a, length(c), ifnull(b, -1), d + d, if(b > 2, b, a) from t where a > 1 order by a desc").Check(testkit.Rows(
+		"4 2 8 0 8",
+		"3 <nil> 4 <nil> 4",
+		"2 3 -1 4.5 2",
+	))
+	tk.MustQuery("select b - a, c from t where b is not null order by a").Check(testkit.Rows(
+		"1 a",
+		"1 <nil>",
+		"4 ef",
+	))
+}
@@ -36,6 +36,17 @@ func (e *InsertExec) exec(ctx context.Context, rows [][]types.Datum) error {
 	if err != nil {
 		return err
 	}
+	if sessVars.ConstraintCheckInPlace {
+		// The constraint check reads the handle key and the unique keys of every row, read them all with one
+		// BatchGet so that the check finds them in the snapshot cache. The lazy check doesn't read them at all.
+		toBeCheckedRows, err := getKeysNeedCheck(ctx, e.ctx, e.Table, rows)
+		if err != nil {
+			return err
+		}
+		if _, err = prefetchUniqueIndices(ctx, txn, toBeCheckedRows); err != nil {
+			return err
+		}
+	}
 	sessVars.GetWriteStmtBufs().BufStore = kv.NewBufferStore(txn, kv.TempTxnMemBufCap)
 	sessVars.StmtCtx.AddRecordRows(uint64(len(rows)))
 	for _, row := range rows {
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
@@ -90,9 +91,18 @@ func (e *SimpleExec) executeBegin(ctx context.Context, s *ast.BeginStmt) error {
 	// the transaction with COMMIT or ROLLBACK. The autocommit mode then
 	// reverts to its previous state.
 	e.ctx.GetSessionVars().SetStatusFlag(mysql.ServerStatusInTrans, true)
+	// NewTxn may have replaced the transaction context.
+	txnCtx = e.ctx.GetSessionVars().TxnCtx
+	txnCtx.IsPessimistic = e.ctx.GetSessionVars().TxnMode == variable.TxnModePessimistic
 	// Call ctx.Txn(true) to active pending txn.
-	_, err := e.ctx.Txn(true)
-	return err
+	txn, err := e.ctx.Txn(true)
+	if err != nil {
+		return err
+	}
+	if txnCtx.IsPessimistic {
+		txn.SetOption(kv.Pessimistic, true)
+	}
+	return nil
 }
 
 func (e *SimpleExec) executeCommit(s *ast.CommitStmt) {
@@ -314,6 +314,37 @@ func (s *testSuite4) TestInsertSetWithDefault(c *C) {
 	tk.MustQuery("select * from t1;").Check(testkit.Rows("30 20"))
 }
 
+func (s *testSuite4) TestInsertConstraintCheckInPlace(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (id int primary key, c1 int, unique key uk (c1))")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+	tk.MustExec("set @@tidb_constraint_check_in_place = 1")
+
+	// The keys of all the rows are read at once, the duplicates are still found in the storage and in the statement.
+	_, err := tk.Exec("insert into t values (3, 3), (1, 4)")
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "[kv:1062]Duplicate entry '1' for key 'PRIMARY'")
+	_, err = tk.Exec("insert into t values (3, 3), (4, 2)")
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "[kv:1062]Duplicate entry '2' for key 'uk'")
+	_, err = tk.Exec("insert into t values (3, 3), (3, 4)")
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "[kv:1062]Duplicate entry '3' for key 'PRIMARY'")
+	tk.MustExec("insert into t values (3, 3), (4, 4)")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 1", "2 2", "3 3", "4 4"))
+
+	// The rows written by the transaction are found in its memory buffer.
+	tk.MustExec("begin")
+	tk.MustExec("insert into t values (5, 5)")
+	_, err = tk.Exec("insert into t values (6, 6), (7, 5)")
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "[kv:1062]Duplicate entry '5' for key 'uk'")
+	tk.MustExec("commit")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 1", "2 2", "3 3", "4 4", "5 5"))
+}
+
 func (s *testSuite4) TestReplace(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("use test")
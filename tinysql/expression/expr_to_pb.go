@@ -64,6 +64,17 @@ func ExpressionsToPB(sc *stmtctx.StatementContext, exprs []Expression, client kv
 	return
 }
 
+// CanExprsPushDown returns if all of the expressions can be converted to tipb.Expr and evaluated by the coprocessor.
+func CanExprsPushDown(sc *stmtctx.StatementContext, exprs []Expression, client kv.Client) bool {
+	pc := PbConverter{client: client, sc: sc}
+	for _, expr := range exprs {
+		if pc.ExprToPB(expr) == nil {
+			return false
+		}
+	}
+	return true
+}
+
 // ExpressionsToPBList converts expressions to tipb.Expr list for new plan.
 func ExpressionsToPBList(sc *stmtctx.StatementContext, exprs []Expression, client kv.Client) (pbExpr []*tipb.Expr) {
 	pc := PbConverter{client: client, sc: sc}
@@ -49,3 +49,5 @@ go 1.13
 replace github.com/pingcap/check => github.com/tiancaiamao/check v0.0.0-20191119042138-8e73d07b629d
 
 replace github.com/pingcap-incubator/tinykv v0.0.0-20200320061650-f660f803e910 => ../tinykv
+
+replace github.com/pingcap/tipb => ../tipb
@@ -47,6 +47,14 @@ const (
 	SnapshotTS
 	// Set replica read
 	ReplicaRead
+	// Pessimistic is defined for pessimistic transactions, which lock the keys with LockKeys during
+	// execution instead of finding conflicts when they commit.
+	Pessimistic
+	// EnableAsyncCommit indicates whether the transaction is committed once all its keys are prewritten, without
+	// waiting for its primary key to be committed.
+	EnableAsyncCommit
+	// Enable1PC indicates whether the transaction is committed by the prewrite if all its keys are in one region.
+	Enable1PC
 )
 
 // Priority value for transaction priority.
@@ -153,6 +161,9 @@ type MemBuffer interface {
 // This is not thread safe.
 type Transaction interface {
 	MemBuffer
+	// BatchGet gets kv from the memory buffer of the transaction and the kv storage.
+	// Keys which don't exist are left out of the returned map.
+	BatchGet(ctx context.Context, keys []Key) (map[string][]byte, error)
 	// Commit commits the transaction operations to KV store.
 	Commit(context.Context) error
 	// Rollback undoes the transaction operations to KV store.
@@ -233,6 +244,15 @@ type Request struct {
 	SyncLog bool
 	// ReplicaRead is used for reading data from replicas, only follower is supported at this time.
 	ReplicaRead ReplicaReadType
+	// StaleRead reads from any replica which has applied all the data before StartTs, it is set for
+	// the reads at a timestamp in the past, see "AS OF TIMESTAMP".
+	StaleRead bool
+	// Paging is true if the rows of a region should be scanned page by page, which bounds the memory a scan takes and
+	// lets a query which stops early, like one with LIMIT, skip the rest of the region.
+	Paging bool
+	// Cacheable is true if the responses of the request may be served from the coprocessor cache, which holds the
+	// responses read from the regions whose data hasn't changed since.
+	Cacheable bool
 }
 
 // ResultSubset represents a result subset from a single storage unit.
@@ -258,6 +278,8 @@ type Response interface {
 // Snapshot defines the interface for the snapshot fetched from KV store.
 type Snapshot interface {
 	Retriever
+	// BatchGet gets a batch of values from the snapshot, keys which don't exist are left out of the returned map.
+	BatchGet(ctx context.Context, keys []Key) (map[string][]byte, error)
 }
 
 // Driver is the interface that must be implemented by a KV storage.
@@ -193,6 +193,14 @@ func (lmb *lazyMemBuffer) SetCap(cap int) {
 func (us *unionStore) Get(ctx context.Context, k Key) ([]byte, error) {
 	v, err := us.MemBuffer.Get(ctx, k)
 	if IsErrNotFound(err) {
+		if _, ok := us.opts.Get(PresumeKeyNotExists); ok {
+			// Check lazily: the key is presumed not to exist, the prewrite checks it and returns the saved error
+			// if it does.
+			if e, ok := us.opts.Get(PresumeKeyNotExistsError); ok {
+				us.keyExistErrs[string(k)] = e.(*existErrInfo)
+			}
+			return nil, ErrNotExist
+		}
 		v, err = us.BufferStore.r.Get(ctx, k)
 	}
 	e, ok := us.opts.Get(PresumeKeyNotExistsError)
@@ -149,3 +149,30 @@ func (s *testUnionStoreSuite) TestBasic(c *C) {
 	s.us.DelOption(1)
 	c.Assert(s.us.GetOption(1), IsNil)
 }
+
+func (s *testUnionStoreSuite) TestLazyConditionCheck(c *C) {
+	defer testleak.AfterTest(c)()
+	err := s.store.Set([]byte("1"), []byte("1"))
+	c.Assert(err, IsNil)
+
+	// The snapshot isn't read, the error is saved for the prewrite.
+	existErrInfo := NewExistErrInfo("idx", "1")
+	s.us.SetOption(PresumeKeyNotExists, nil)
+	s.us.SetOption(PresumeKeyNotExistsError, existErrInfo)
+	_, err = s.us.Get(context.TODO(), []byte("1"))
+	c.Assert(IsErrNotFound(err), IsTrue)
+	c.Assert(s.us.GetKeyExistErrInfo([]byte("1")), Equals, existErrInfo)
+
+	// Keys in the buffer are still checked at once.
+	err = s.us.Set([]byte("2"), []byte("2"))
+	c.Assert(err, IsNil)
+	_, err = s.us.Get(context.TODO(), []byte("2"))
+	c.Assert(ErrKeyExists.Equal(err), IsTrue)
+	c.Assert(s.us.GetKeyExistErrInfo([]byte("2")), IsNil)
+
+	s.us.DelOption(PresumeKeyNotExists)
+	s.us.DelOption(PresumeKeyNotExistsError)
+	v, err := s.us.Get(context.TODO(), []byte("1"))
+	c.Assert(err, IsNil)
+	c.Assert(v, BytesEquals, []byte("1"))
+}
@@ -107,6 +107,31 @@ type TableName struct {
 
 	IndexHints     []*IndexHint
 	PartitionNames []model.CIStr
+	// AsOf is set if the table is read at a timestamp in the past.
+	AsOf *AsOfClause
+}
+
+// AsOfClause is the "AS OF TIMESTAMP expr" clause of a table, the table is read at the
+// timestamp expr evaluates to.
+type AsOfClause struct {
+	node
+
+	TsExpr ExprNode
+}
+
+// Accept implements Node Accept interface.
+func (n *AsOfClause) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AsOfClause)
+	node, ok := n.TsExpr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.TsExpr = node.(ExprNode)
+	return v.Leave(n)
 }
 
 // IndexHintType is the type for index hint use, ignore or force.
@@ -144,6 +169,13 @@ func (n *TableName) Accept(v Visitor) (Node, bool) {
 		return v.Leave(newNode)
 	}
 	n = newNode.(*TableName)
+	if n.AsOf != nil {
+		node, ok := n.AsOf.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.AsOf = node.(*AsOfClause)
+	}
 	return v.Leave(n)
 }
 
@@ -23,6 +23,7 @@ import (
 var (
 	_ StmtNode = &AdminStmt{}
 	_ StmtNode = &BeginStmt{}
+	_ StmtNode = &BRIEStmt{}
 	_ StmtNode = &CommitStmt{}
 	_ StmtNode = &ExplainStmt{}
 	_ StmtNode = &RollbackStmt{}
@@ -235,6 +236,50 @@ func (n *AdminStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// BRIEKind is the kind of a BRIEStmt.
+type BRIEKind uint8
+
+// BRIE statement kinds.
+const (
+	BRIEKindBackup BRIEKind = iota
+	BRIEKindRestore
+)
+
+// String implements fmt.Stringer.
+func (kind BRIEKind) String() string {
+	switch kind {
+	case BRIEKindBackup:
+		return "BACKUP"
+	case BRIEKindRestore:
+		return "RESTORE"
+	default:
+		return ""
+	}
+}
+
+// BRIEStmt is a statement to back up databases to a storage or to restore them from it.
+type BRIEStmt struct {
+	stmtNode
+
+	Kind BRIEKind
+	// Schemas is the list of databases, it is empty for all the databases.
+	Schemas []string
+	// Storage is the URL of the backup, like 'local:///tmp/backup'.
+	Storage string
+	// Snapshot is the timestamp to back up at, it is 0 for the current timestamp.
+	Snapshot uint64
+}
+
+// Accept implements Node Accept interface.
+func (n *BRIEStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*BRIEStmt)
+	return v.Leave(n)
+}
+
 // Ident is the table identifier composed of schema name and table name.
 type Ident struct {
 	Schema model.CIStr
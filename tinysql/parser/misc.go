@@ -185,6 +185,7 @@ var tokenMap = map[string]int{
 	"AUTO_RANDOM":              autoRandom,
 	"AVG":                      avg,
 	"AVG_ROW_LENGTH":           avgRowLength,
+	"BACKUP":                   backup,
 	"BEGIN":                    begin,
 	"BETWEEN":                  between,
 	"BIGINT":                   bigIntType,
@@ -472,6 +473,7 @@ var tokenMap = map[string]int{
 	"NUMERIC":                  numericType,
 	"NCHAR":                    ncharType,
 	"NVARCHAR":                 nvarcharType,
+	"OF":                       of,
 	"OFFSET":                   offset,
 	"OLAP":                     hintOLAP,
 	"OLTP":                     hintOLTP,
@@ -538,6 +540,7 @@ var tokenMap = map[string]int{
 	"REPEATABLE":               repeatable,
 	"REPLACE":                  replace,
 	"RESPECT":                  respect,
+	"RESTORE":                  restore,
 	"REPLICA":                  replica,
 	"REPLICATION":              replication,
 	"REQUIRE":                  require,
@@ -181,6 +181,7 @@ import (
 	null			"NULL"
 	numericType		"NUMERIC"
 	nvarcharType		"NVARCHAR"
+	of			"OF"
 	on			"ON"
 	optimize		"OPTIMIZE"
 	option			"OPTION"
@@ -276,6 +277,7 @@ import (
 	autoRandom	"AUTO_RANDOM"
 	avgRowLength	"AVG_ROW_LENGTH"
 	avg		"AVG"
+	backup		"BACKUP"
 	begin		"BEGIN"
 	binlog		"BINLOG"
 	bitType		"BIT"
@@ -435,6 +437,7 @@ import (
 	repair		"REPAIR"
 	repeatable	"REPEATABLE"
 	respect		"RESPECT"
+	restore		"RESTORE"
 	replica		"REPLICA"
 	replication	"REPLICATION"
 	reverse		"REVERSE"
@@ -715,6 +718,7 @@ import (
 	AlterTableStmt			"Alter table statement"
 	AnalyzeTableStmt		"Analyze table statement"
 	BeginTransactionStmt		"BEGIN TRANSACTION statement"
+	BRIEStmt			"BACKUP or RESTORE statement"
 
 	CommitStmt			"COMMIT statement"
 	CreateTableStmt			"CREATE TABLE statement"
@@ -742,9 +746,12 @@ import (
 	AlterTableSpecList		"Alter table specification list"
 	AlterTableSpecListOpt		"Alter table specification list optional"
 	AnyOrAll			"Any or All for subquery"
+	AsOfClause			"AS OF clause"
 	Assignment			"assignment"
 	AssignmentList			"assignment list"
 	AssignmentListOpt		"assignment list opt"
+	BRIEDatabases			"databases of a BACKUP or RESTORE statement"
+	BRIESnapshotOpt			"optional SNAPSHOT of a BACKUP statement"
 	OptionalBraces			"optional braces"
 	CastType			"Cast function target type"
 	CharsetName			"Character set name"
@@ -769,6 +776,7 @@ import (
 	DatabaseOptionList		"CREATE Database specification list"
 	DatabaseOptionListOpt		"CREATE Database specification list opt"
 	DBName				"Database Name"
+	DBNameList			"Database Name list"
 	DistinctOpt			"Explicit distinct option"
 	DefaultFalseDistinctOpt		"Distinct option which defaults to false"
 	DefaultTrueDistinctOpt		"Distinct option which defaults to true"
@@ -1947,6 +1955,16 @@ DBName:
 		$$ = $1
 	}
 
+DBNameList:
+	DBName
+	{
+		$$ = []string{$1.(string)}
+	}
+|	DBNameList ',' DBName
+	{
+		$$ = append($1.([]string), $3.(string))
+	}
+
 DatabaseOption:
 	DefaultKwdOpt CharsetKw EqOpt CharsetName
 	{
@@ -2637,7 +2655,7 @@ Identifier:
 identifier | UnReservedKeyword | NotKeywordToken | TiDBKeyword
 
 UnReservedKeyword:
- "ACTION" | "ADVISE" |"ASCII" | "AUTO_INCREMENT" | "AFTER" | "ALWAYS" | "AVG" | "BEGIN" | "BIT" | "BOOL" | "BOOLEAN" | "BTREE" | "BYTE" | "CAPTURE" |"CLEANUP" | "CHARSET"
+ "ACTION" | "ADVISE" |"ASCII" | "AUTO_INCREMENT" | "AFTER" | "ALWAYS" | "AVG" | "BACKUP" | "BEGIN" | "BIT" | "BOOL" | "BOOLEAN" | "BTREE" | "BYTE" | "CAPTURE" |"CLEANUP" | "CHARSET"
 | "COLUMNS" | "COMMIT" | "COMPACT" | "COMPRESSED" | "CONSISTENT" | "CURRENT" | "DATA" | "DATE" %prec lowerThanStringLitToken| "DATETIME" | "DAY" | "DEALLOCATE" | "DO" | "DUPLICATE"
 | "DYNAMIC" | "ENCRYPTION" | "END" | "ENFORCED" | "ENGINE" | "ENGINES" | "ENUM" | "ERRORS" | "ESCAPE" | "EVOLVE" | "EXECUTE" | "EXTENDED" | "FIELDS" | "FIRST" | "FIXED" | "FLUSH" | "FOLLOWING" | "FORMAT" | "FULL" |"GLOBAL"
 | "HASH" | "HOUR" | "INSERT_METHOD" | "LESS" | "LOCAL" | "LAST" | "NAMES" | "OFFSET" | "PASSWORD" %prec lowerThanEq | "PREPARE" | "QUICK" | "REBUILD" | "REDUNDANT" | "REORGANIZE"
@@ -2645,7 +2663,7 @@ UnReservedKeyword:
 | "TIMESTAMP" %prec lowerThanStringLitToken | "TRACE" | "TRANSACTION" | "TRUNCATE" | "UNBOUNDED" | "UNKNOWN" | "VALUE" | "WARNINGS" | "YEAR" | "MODE"  | "WEEK"  | "ANY" | "SOME" | "USER" | "IDENTIFIED"
 | "COLLATION" | "COMMENT" | "AVG_ROW_LENGTH" | "CONNECTION" | "CHECKSUM" | "COMPRESSION" | "KEY_BLOCK_SIZE" | "MASTER" | "MAX_ROWS"
 | "MIN_ROWS" | "NATIONAL" | "NCHAR" | "ROW_FORMAT" | "QUARTER" | "GRANTS" | "TRIGGERS" | "DELAY_KEY_WRITE" | "ISOLATION" | "JSON"
-| "REPEATABLE" | "RESPECT" | "COMMITTED" | "UNCOMMITTED" | "ONLY" | "SERIAL" | "SERIALIZABLE" | "LEVEL" | "VARIABLES" | "SQL_CACHE" | "INDEXES" | "PROCESSLIST"
+| "REPEATABLE" | "RESPECT" | "RESTORE" | "COMMITTED" | "UNCOMMITTED" | "ONLY" | "SERIAL" | "SERIALIZABLE" | "LEVEL" | "VARIABLES" | "SQL_CACHE" | "INDEXES" | "PROCESSLIST"
 | "SQL_NO_CACHE" | "DISABLE"  | "ENABLE" | "REVERSE" | "PRIVILEGES" | "NO" | "BINLOG" | "FUNCTION" | "VIEW" | "BINDING" | "BINDINGS" | "MODIFY" | "EVENTS" | "PARTITIONS"
 | "NONE" | "NULLS" | "SUPER" | "EXCLUSIVE" | "STATS_PERSISTENT" | "STATS_AUTO_RECALC" | "ROW_COUNT" | "COALESCE" | "MONTH" | "PROCESS" | "PROFILE" | "PROFILES"
 | "MICROSECOND" | "MINUTE" | "PLUGINS" | "PRECEDING" | "QUERY" | "QUERIES" | "SECOND" | "SEPARATOR" | "SHARE" | "SHARED" | "SLOW" | "MAX_CONNECTIONS_PER_HOUR" | "MAX_QUERIES_PER_HOUR" | "MAX_UPDATES_PER_HOUR"
@@ -3688,6 +3706,13 @@ TableFactor:
 		tn.IndexHints = $3.([]*ast.IndexHint)
 		$$ = &ast.TableSource{Source: tn, AsName: $2.(model.CIStr)}
 	}
+|	TableName AsOfClause TableAsNameOpt IndexHintListOpt
+	{
+		tn := $1.(*ast.TableName)
+		tn.AsOf = $2.(*ast.AsOfClause)
+		tn.IndexHints = $4.([]*ast.IndexHint)
+		$$ = &ast.TableSource{Source: tn, AsName: $3.(model.CIStr)}
+	}
 |	'(' SelectStmt ')' TableAsName
 	{
 		st := $2.(*ast.SelectStmt)
@@ -3700,6 +3725,12 @@ TableFactor:
 		$$ = $2
 	}
 
+AsOfClause:
+	"AS" "OF" "TIMESTAMP" Expression
+	{
+		$$ = &ast.AsOfClause{TsExpr: $4}
+	}
+
 TableAsNameOpt:
 	{
 		$$ = model.CIStr{}
@@ -4374,6 +4405,49 @@ UserVariable:
 		$$ = &ast.VariableExpr{Name: v, IsGlobal: false, IsSystem: false}
 	}
 
+/*******************************************************************
+ *
+ *  Backup and Restore Statements
+ *
+ *  Examples:
+ *	BACKUP DATABASE db1, db2 TO 'local:///tmp/backup' SNAPSHOT = 412345678901234567
+ *	RESTORE DATABASE * FROM 'local:///tmp/backup'
+ *******************************************************************/
+BRIEStmt:
+	"BACKUP" DatabaseSym BRIEDatabases "TO" stringLit BRIESnapshotOpt
+	{
+		$$ = &ast.BRIEStmt{
+			Kind:     ast.BRIEKindBackup,
+			Schemas:  $3.([]string),
+			Storage:  $5,
+			Snapshot: $6.(uint64),
+		}
+	}
+|	"RESTORE" DatabaseSym BRIEDatabases "FROM" stringLit
+	{
+		$$ = &ast.BRIEStmt{
+			Kind:    ast.BRIEKindRestore,
+			Schemas: $3.([]string),
+			Storage: $5,
+		}
+	}
+
+BRIEDatabases:
+	'*'
+	{
+		$$ = []string(nil)
+	}
+|	DBNameList
+
+BRIESnapshotOpt:
+	{
+		$$ = uint64(0)
+	}
+|	"SNAPSHOT" EqOpt LengthNum
+	{
+		$$ = $3
+	}
+
 /****************************Admin Statement*******************************/
 AdminStmt:
 	"ADMIN" "SHOW" "DDL"
@@ -4533,6 +4607,7 @@ Statement:
 |	AlterTableStmt
 |	AnalyzeTableStmt
 |	BeginTransactionStmt
+|	BRIEStmt
 |	CommitStmt
 |	DeleteFromStmt
 |	ExplainStmt
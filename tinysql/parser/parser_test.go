@@ -406,6 +406,14 @@ func (s *testParserSuite) TestDMLStmt(c *C) {
 		// for select with where clause
 		{"SELECT * FROM t WHERE 1 = 1", true, "SELECT * FROM `t` WHERE 1=1"},
 
+		// for as of timestamp
+		{"select * from t as of timestamp '2020-01-01 00:00:00'", true, ""},
+		{"select * from t as of timestamp '2020-01-01 00:00:00' as t1 use index(a) where id = 1", true, ""},
+		{"select * from t as of timestamp 418002131243991041 t1 join t2 on t1.id = t2.id", true, ""},
+		{"select * from t as t1 as of timestamp '2020-01-01 00:00:00'", false, ""},
+		{"select * from t as of '2020-01-01 00:00:00'", false, ""},
+		{"select * from t as of", false, ""},
+
 		// for dual
 		{"select 1 from dual", true, "SELECT 1"},
 		{"select 1 from dual limit 1", true, "SELECT 1 LIMIT 1"},
@@ -1390,6 +1398,37 @@ func (s *testParserSuite) TestAnalyze(c *C) {
 	s.RunTest(c, table)
 }
 
+func (s *testParserSuite) TestBRIE(c *C) {
+	table := []testCase{
+		{"backup database a to 'local:///tmp/backup'", true, ""},
+		{"backup schema a, b to 'local:///tmp/backup' snapshot = 400036290571534337", true, ""},
+		{"backup database * to 'local:///tmp/backup' snapshot 400036290571534337", true, ""},
+		{"backup database to 'local:///tmp/backup'", false, ""},
+		{"backup database a to 'local:///tmp/backup' snapshot = -1", false, ""},
+		{"restore database * from 'local:///tmp/backup'", true, ""},
+		{"restore database a, b from 'local:///tmp/backup'", true, ""},
+		{"restore database a from 'local:///tmp/backup' snapshot = 1", false, ""},
+		{"restore database a to 'local:///tmp/backup'", false, ""},
+		// BACKUP and RESTORE are unreserved keywords.
+		{"create table backup (restore int)", true, ""},
+	}
+	s.RunTest(c, table)
+
+	parser := parser.New()
+	stmt, err := parser.ParseOneStmt("backup database a, b to 'local:///tmp/backup' snapshot = 42", "", "")
+	c.Assert(err, IsNil)
+	brie := stmt.(*ast.BRIEStmt)
+	c.Assert(brie.Kind, Equals, ast.BRIEKindBackup)
+	c.Assert(brie.Schemas, DeepEquals, []string{"a", "b"})
+	c.Assert(brie.Storage, Equals, "local:///tmp/backup")
+	c.Assert(brie.Snapshot, Equals, uint64(42))
+	stmt, err = parser.ParseOneStmt("restore database * from 'local:///tmp/backup'", "", "")
+	c.Assert(err, IsNil)
+	brie = stmt.(*ast.BRIEStmt)
+	c.Assert(brie.Kind, Equals, ast.BRIEKindRestore)
+	c.Assert(brie.Schemas, HasLen, 0)
+}
+
 func (s *testParserSuite) TestSideEffect(c *C) {
 	// This test cover a bug that parse an error SQL doesn't leave the parser in a
 	// clean state, cause the following SQL parse fail.
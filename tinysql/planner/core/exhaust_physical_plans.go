@@ -318,11 +318,23 @@ func (p *LogicalProjection) exhaustPhysicalPlans(prop *property.PhysicalProperty
 	if !ok {
 		return nil
 	}
-	proj := PhysicalProjection{
-		Exprs: p.Exprs,
-	}.Init(p.ctx, p.stats.ScaleByExpectCnt(prop.ExpectedCnt), newProp)
-	proj.SetSchema(p.schema)
-	return []PhysicalPlan{proj}
+	childProps := []*property.PhysicalProperty{newProp}
+	// A projection computing pushable expressions can also be pushed down to a table reader. It outputs a root task
+	// either way.
+	if prop.TaskTp == property.RootTaskType && canProjectionPushDown(p.ctx, p.Exprs) {
+		copProp := newProp.Clone()
+		copProp.TaskTp = property.CopSingleReadTaskType
+		childProps = append(childProps, copProp)
+	}
+	ret := make([]PhysicalPlan, 0, len(childProps))
+	for _, childProp := range childProps {
+		proj := PhysicalProjection{
+			Exprs: p.Exprs,
+		}.Init(p.ctx, p.stats.ScaleByExpectCnt(prop.ExpectedCnt), childProp)
+		proj.SetSchema(p.schema)
+		ret = append(ret, proj)
+	}
+	return ret
 }
 
 func (lt *LogicalTopN) getPhysTopN() []PhysicalPlan {
@@ -20,6 +20,7 @@ import (
 	"math/bits"
 	"sort"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/pingcap/errors"
@@ -33,6 +34,7 @@ import (
 	"github.com/pingcap/tidb/parser/opcode"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/statistics"
+	"github.com/pingcap/tidb/store/tikv/oracle"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/types"
 	driver "github.com/pingcap/tidb/types/parser_driver"
@@ -1348,7 +1350,50 @@ func getStatsTable(ctx sessionctx.Context, tblInfo *model.TableInfo, pid int64)
 	return statsTbl
 }
 
+// setStaleReadTS sets the read ts of the statement to the ts of the "AS OF TIMESTAMP" clause, the statement reads
+// the data committed before it from any replica which has caught up. Only autocommit SELECTs may read in the past,
+// and all the tables of a statement are read at the same ts. The tables are read with the current schema.
+func (b *PlanBuilder) setStaleReadTS(asOf *ast.AsOfClause) error {
+	sessVars := b.ctx.GetSessionVars()
+	if !(sessVars.StmtCtx.InSelectStmt || sessVars.StmtCtx.InExplainStmt) || sessVars.InTxn() {
+		return ErrNotSupportedYet.GenWithStackByArgs("AS OF TIMESTAMP in a transaction or a non-SELECT statement")
+	}
+	val, err := evalAstExpr(b.ctx, asOf.TsExpr)
+	if err != nil {
+		return err
+	}
+	var ts uint64
+	switch val.Kind() {
+	case types.KindInt64:
+		if val.GetInt64() > 0 {
+			ts = uint64(val.GetInt64())
+		}
+	case types.KindUint64:
+		ts = val.GetUint64()
+	case types.KindString:
+		for _, layout := range []string{"2006-01-02 15:04:05.999999", "2006-01-02"} {
+			if t, err := time.ParseInLocation(layout, val.GetString(), sessVars.Location()); err == nil {
+				ts = oracle.ComposeTS(oracle.GetPhysical(t), 0)
+				break
+			}
+		}
+	}
+	if ts == 0 || oracle.ExtractPhysical(ts) > oracle.GetPhysical(time.Now()) {
+		return ErrWrongArguments.GenWithStackByArgs("AS OF TIMESTAMP")
+	}
+	if sessVars.StmtCtx.StaleReadTS != 0 && sessVars.StmtCtx.StaleReadTS != ts {
+		return ErrNotSupportedYet.GenWithStackByArgs("reading tables at different timestamps")
+	}
+	sessVars.StmtCtx.StaleReadTS = ts
+	return nil
+}
+
 func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, asName *model.CIStr) (LogicalPlan, error) {
+	if tn.AsOf != nil {
+		if err := b.setStaleReadTS(tn.AsOf); err != nil {
+			return nil, err
+		}
+	}
 	dbName := tn.Schema
 	if dbName.L == "" {
 		dbName = model.NewCIStr(b.ctx.GetSessionVars().CurrentDB)
@@ -53,6 +53,16 @@ func (p *PhysicalSelection) ToPB(ctx sessionctx.Context) (*tipb.Executor, error)
 	return &tipb.Executor{Tp: tipb.ExecType_TypeSelection, Selection: selExec}, nil
 }
 
+// ToPB implements PhysicalPlan ToPB interface.
+func (p *PhysicalProjection) ToPB(ctx sessionctx.Context) (*tipb.Executor, error) {
+	sc := ctx.GetSessionVars().StmtCtx
+	client := ctx.GetClient()
+	projExec := &tipb.Projection{
+		Exprs: expression.ExpressionsToPBList(sc, p.Exprs, client),
+	}
+	return &tipb.Executor{Tp: tipb.ExecType_TypeProjection, Projection: projExec}, nil
+}
+
 // ToPB implements PhysicalPlan ToPB interface.
 func (p *PhysicalTopN) ToPB(ctx sessionctx.Context) (*tipb.Executor, error) {
 	sc := ctx.GetSessionVars().StmtCtx
@@ -259,7 +259,7 @@ func (b *PlanBuilder) Build(ctx context.Context, node ast.Node) (Plan, error) {
 		return b.buildSet(ctx, x)
 	case *ast.AnalyzeTableStmt:
 		return b.buildAnalyze(x)
-	case *ast.UseStmt, *ast.BeginStmt, *ast.CommitStmt, *ast.RollbackStmt:
+	case *ast.UseStmt, *ast.BeginStmt, *ast.CommitStmt, *ast.RollbackStmt, *ast.BRIEStmt:
 		return b.buildSimple(node.(ast.StmtNode))
 	case ast.DDLNode:
 		return b.buildDDL(ctx, x)
@@ -514,6 +514,17 @@ func buildShowDDLFields() (*expression.Schema, types.NameSlice) {
 	return schema.col2Schema(), schema.names
 }
 
+// buildBRIEFields builds the fields of the result of BACKUP and RESTORE, a row with the checksum of each table.
+func buildBRIEFields() (*expression.Schema, types.NameSlice) {
+	schema := newColumnsWithNames(5)
+	schema.Append(buildColumnWithName("", "Database", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "Table", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "Checksum_crc64_xor", mysql.TypeVarchar, 16))
+	schema.Append(buildColumnWithName("", "Total_kvs", mysql.TypeLonglong, 4))
+	schema.Append(buildColumnWithName("", "Total_bytes", mysql.TypeLonglong, 4))
+	return schema.col2Schema(), schema.names
+}
+
 func buildShowDDLJobsFields() (*expression.Schema, types.NameSlice) {
 	schema := newColumnsWithNames(11)
 	schema.Append(buildColumnWithName("", "JOB_ID", mysql.TypeLonglong, 4))
@@ -654,6 +665,8 @@ func (b *PlanBuilder) buildSimple(node ast.StmtNode) (Plan, error) {
 		if raw.DBName == "" {
 			return nil, ErrNoDB
 		}
+	case *ast.BRIEStmt:
+		p.setSchemaAndNames(buildBRIEFields())
 	}
 	return p, nil
 }
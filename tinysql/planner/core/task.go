@@ -438,8 +438,7 @@ func (p *PhysicalTopN) canPushDown() bool {
 	for _, item := range p.ByItems {
 		exprs = append(exprs, item.Expr)
 	}
-	_, _, remained := expression.ExpressionsToPB(p.ctx.GetSessionVars().StmtCtx, exprs, p.ctx.GetClient())
-	return len(remained) == 0
+	return expression.CanExprsPushDown(p.ctx.GetSessionVars().StmtCtx, exprs, p.ctx.GetClient())
 }
 
 func (p *PhysicalTopN) allColsFromSchema(schema *expression.Schema) bool {
@@ -512,8 +511,11 @@ func (p *PhysicalTopN) attach2Task(tasks ...task) task {
 }
 
 // GetCost computes the cost of projection operator itself.
-func (p *PhysicalProjection) GetCost(count float64) float64 {
+func (p *PhysicalProjection) GetCost(count float64, isRoot bool) float64 {
 	sessVars := p.ctx.GetSessionVars()
+	if !isRoot {
+		return count * sessVars.CopCPUFactor
+	}
 	cpuCost := count * sessVars.CPUFactor
 	concurrency := float64(sessVars.ProjectionConcurrency)
 	if concurrency <= 0 {
@@ -527,14 +529,44 @@ func (p *PhysicalProjection) GetCost(count float64) float64 {
 func (p *PhysicalProjection) attach2Task(tasks ...task) task {
 	t := tasks[0].copy()
 	if copTask, ok := t.(*copTask); ok {
-		// TODO: support projection push down.
+		if p.canPushDown(copTask) {
+			// The projection changes the schema of the table plan, so nothing can be pushed down above it.
+			copTask.addCost(p.GetCost(copTask.count(), false))
+			return finishCopTask(p.ctx, attachPlan2Task(p, copTask))
+		}
 		t = finishCopTask(p.ctx, copTask)
 	}
 	t = attachPlan2Task(p, t)
-	t.addCost(p.GetCost(t.count()))
+	t.addCost(p.GetCost(t.count(), true))
 	return t
 }
 
+// canPushDown checks if this projection can be pushed down to the table plan of a table reader. No condition may be
+// left to be evaluated on the schema of the table plan.
+func (p *PhysicalProjection) canPushDown(t *copTask) bool {
+	if t.indexPlan != nil || len(t.rootTaskConds) > 0 {
+		return false
+	}
+	return canProjectionPushDown(p.ctx, p.Exprs)
+}
+
+// canProjectionPushDown checks if a projection can be pushed down to the coprocessor. It must be enabled by
+// tidb_opt_projection_push_down, it must compute some expression, a projection of columns only is left to TiDB where it
+// is mostly eliminated, and each of the expressions must be convertible to pb.
+func canProjectionPushDown(ctx sessionctx.Context, exprs []expression.Expression) bool {
+	if !ctx.GetSessionVars().AllowProjectionPushDown {
+		return false
+	}
+	computed := false
+	for _, expr := range exprs {
+		if _, ok := expr.(*expression.Column); !ok {
+			computed = true
+			break
+		}
+	}
+	return computed && expression.CanExprsPushDown(ctx.GetSessionVars().StmtCtx, exprs, ctx.GetClient())
+}
+
 func (sel *PhysicalSelection) attach2Task(tasks ...task) task {
 	sessVars := sel.ctx.GetSessionVars()
 	t := finishCopTask(sel.ctx, tasks[0].copy())
@@ -0,0 +1,139 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/session"
+	"github.com/pingcap/tidb/store/mockstore"
+	"github.com/pingcap/tidb/store/mockstore/mocktikv"
+	"github.com/pingcap/tidb/util/testkit"
+	"github.com/pingcap/tidb/util/testleak"
+)
+
+var _ = Suite(&testPessimisticSuite{})
+
+type testPessimisticSuite struct {
+	cluster   *mocktikv.Cluster
+	mvccStore mocktikv.MVCCStore
+	store     kv.Storage
+	dom       *domain.Domain
+}
+
+func (s *testPessimisticSuite) SetUpSuite(c *C) {
+	testleak.BeforeTest()
+	s.cluster = mocktikv.NewCluster()
+	mocktikv.BootstrapWithSingleStore(s.cluster)
+	s.mvccStore = mocktikv.MustNewMVCCStore()
+	store, err := mockstore.NewMockTikvStore(
+		mockstore.WithCluster(s.cluster),
+		mockstore.WithMVCCStore(s.mvccStore),
+	)
+	c.Assert(err, IsNil)
+	s.store = store
+	session.SetSchemaLease(0)
+	session.DisableStats4Test()
+	s.dom, err = session.BootstrapSession(s.store)
+	c.Assert(err, IsNil)
+}
+
+func (s *testPessimisticSuite) TearDownSuite(c *C) {
+	s.dom.Close()
+	s.store.Close()
+	testleak.AfterTest(c)()
+}
+
+func (s *testPessimisticSuite) TestTxnMode(c *C) {
+	tk := testkit.NewTestKitWithInit(c, s.store)
+	tk.MustQuery("select @@tidb_txn_mode").Check(testkit.Rows(""))
+	tk.MustExec("set @@tidb_txn_mode = 'PESSIMISTIC'")
+	tk.MustQuery("select @@tidb_txn_mode").Check(testkit.Rows("pessimistic"))
+	tk.MustExec("set @@tidb_txn_mode = 'optimistic'")
+	tk.MustQuery("select @@tidb_txn_mode").Check(testkit.Rows("optimistic"))
+	_, err := tk.Exec("set @@tidb_txn_mode = 'foo'")
+	c.Assert(err, NotNil)
+
+	tk.MustExec("set @@tidb_txn_mode = 'pessimistic'")
+	tk.MustExec("begin")
+	c.Assert(tk.Se.GetSessionVars().TxnCtx.IsPessimistic, IsTrue)
+	tk.MustExec("commit")
+	tk.MustExec("set @@autocommit = 0")
+	tk.MustExec("drop table if exists txn_mode")
+	tk.MustExec("create table txn_mode (id int primary key)")
+	tk.MustExec("insert into txn_mode values (1)")
+	c.Assert(tk.Se.GetSessionVars().TxnCtx.IsPessimistic, IsTrue)
+	tk.MustExec("commit")
+	tk.MustExec("set @@autocommit = 1")
+	// A statement out of an explicit transaction commits on its own and isn't pessimistic.
+	tk.MustExec("insert into txn_mode values (2)")
+	c.Assert(tk.Se.GetSessionVars().TxnCtx.IsPessimistic, IsFalse)
+	tk.MustExec("set @@tidb_txn_mode = 'optimistic'")
+	tk.MustExec("begin")
+	c.Assert(tk.Se.GetSessionVars().TxnCtx.IsPessimistic, IsFalse)
+	tk.MustExec("rollback")
+}
+
+// TestConflict tests that a statement of a pessimistic transaction waits for the locks of the statements of other
+// transactions, and is retried on the rows they write instead of failing the transaction when it commits.
+func (s *testPessimisticSuite) TestConflict(c *C) {
+	tk := testkit.NewTestKitWithInit(c, s.store)
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (id int primary key, v int)")
+	tk.MustExec("insert into t values (1, 1)")
+
+	tk1 := testkit.NewTestKitWithInit(c, s.store)
+	tk2 := testkit.NewTestKitWithInit(c, s.store)
+	tk1.MustExec("set @@tidb_txn_mode = 'pessimistic'")
+	tk2.MustExec("set @@tidb_txn_mode = 'pessimistic'")
+	tk1.MustExec("begin")
+	tk2.MustExec("begin")
+	tk1.MustExec("delete from t where id = 1")
+
+	done := make(chan error, 1)
+	go func() {
+		// The row is locked by tk1, the statement waits for it and then reads the row tk1 writes.
+		_, err := tk2.Exec("delete from t where id = 1")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		c.Fatalf("the statement doesn't wait for the lock, err: %v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+	tk1.MustExec("insert into t values (1, 2)")
+	tk1.MustExec("commit")
+	c.Assert(<-done, IsNil)
+	c.Assert(tk2.Se.AffectedRows(), Equals, uint64(1))
+	tk2.MustExec("insert into t values (1, 3)")
+	tk2.MustExec("commit")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 3"))
+
+	// The same transactions fail when they are optimistic.
+	tk1.MustExec("set @@tidb_txn_mode = 'optimistic'")
+	tk2.MustExec("set @@tidb_txn_mode = 'optimistic'")
+	tk1.MustExec("begin")
+	tk2.MustExec("begin")
+	tk1.MustExec("delete from t where id = 1")
+	tk2.MustExec("delete from t where id = 1")
+	tk1.MustExec("insert into t values (1, 4)")
+	tk1.MustExec("commit")
+	tk2.MustExec("insert into t values (1, 5)")
+	_, err := tk2.Exec("commit")
+	c.Assert(kv.ErrWriteConflict.Equal(err), IsTrue, Commentf("err: %v", err))
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 4"))
+}
@@ -45,6 +45,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/store/tikv"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
@@ -232,6 +233,12 @@ func (s *session) doCommit(ctx context.Context) error {
 	}
 	// Set this option for 2 phase commit to validate schema lease.
 	s.txn.SetOption(kv.SchemaChecker, domain.NewSchemaChecker(domain.GetDomain(s), s.sessionVars.TxnCtx.SchemaVersion, tableIDs))
+	if s.sessionVars.EnableAsyncCommit {
+		s.txn.SetOption(kv.EnableAsyncCommit, true)
+	}
+	if s.sessionVars.Enable1PC {
+		s.txn.SetOption(kv.Enable1PC, true)
+	}
 
 	return s.txn.Commit(sessionctx.SetCommitCtx(ctx, s))
 }
@@ -664,6 +671,9 @@ func (s *session) Txn(active bool) (kv.Transaction, error) {
 		if s.sessionVars.GetReplicaRead().IsFollowerRead() {
 			s.txn.SetOption(kv.ReplicaRead, kv.ReplicaReadFollower)
 		}
+		if s.sessionVars.TxnCtx.IsPessimistic {
+			s.txn.SetOption(kv.Pessimistic, true)
+		}
 	}
 	return &s.txn, nil
 }
@@ -775,6 +785,13 @@ func BootstrapSession(store kv.Storage) (*domain.Domain, error) {
 		return nil, err
 	}
 
+	if raw, ok := store.(tikv.Storage); ok {
+		err = raw.StartGCWorker()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return dom, err
 }
 
@@ -952,6 +969,10 @@ var builtinGlobalVariable = []string{
 	variable.TiDBEnableCascadesPlanner,
 	variable.TiDBEnableVectorizedExpression,
 	variable.TiDBEnableNoopFuncs,
+	variable.TiDBEnableAsyncCommit,
+	variable.TiDBEnable1PC,
+	variable.TiDBEnablePaging,
+	variable.TiDBTxnMode,
 	variable.TiDBMaxDeltaSchemaCount,
 }
 
@@ -1033,6 +1054,10 @@ func (s *session) PrepareTxnCtx(ctx context.Context) {
 		SchemaVersion: is.SchemaMetaVersion(),
 		CreateTime:    time.Now(),
 	}
+	if !s.sessionVars.IsAutocommit() {
+		// The statements run in an explicit transaction, which is pessimistic in the pessimistic mode.
+		s.sessionVars.TxnCtx.IsPessimistic = s.sessionVars.TxnMode == variable.TxnModePessimistic
+	}
 }
 
 // PrepareTxnFuture uses to try to get txn future.
@@ -216,6 +216,33 @@ func (st *TxnState) Get(ctx context.Context, k kv.Key) ([]byte, error) {
 	return val, nil
 }
 
+// BatchGet overrides the Transaction interface.
+func (st *TxnState) BatchGet(ctx context.Context, keys []kv.Key) (map[string][]byte, error) {
+	m := make(map[string][]byte, len(keys))
+	pending := make([]kv.Key, 0, len(keys))
+	for _, k := range keys {
+		val, err := st.buf.Get(ctx, k)
+		if kv.IsErrNotFound(err) {
+			pending = append(pending, k)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(val) > 0 {
+			m[string(k)] = val
+		}
+	}
+	storageValues, err := st.Transaction.BatchGet(ctx, pending)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range storageValues {
+		m[k] = v
+	}
+	return m, nil
+}
+
 // Set overrides the Transaction interface.
 func (st *TxnState) Set(k kv.Key, v []byte) error {
 	return st.buf.Set(k, v)
@@ -67,6 +67,9 @@ type StatementContext struct {
 	// then cast the float string to int string. Otherwise, we cast string to integer
 	// prefix in a strict way, only extract 0-9 and (+ or - in first bit).
 	CastStrToIntStrict bool
+	// StaleReadTS is the timestamp of "AS OF TIMESTAMP", the statement reads the data at it from
+	// any replica which has applied it, without a transaction.
+	StaleReadTS uint64
 
 	// mu struct holds variables that change during execution.
 	mu struct {
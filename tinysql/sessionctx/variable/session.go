@@ -52,6 +52,8 @@ type TransactionContext struct {
 
 	CreateTime     time.Time
 	StatementCount int
+	// IsPessimistic is true if the DML statements of the transaction lock the keys they write, see TiDBTxnMode.
+	IsPessimistic bool
 }
 
 // UpdateDeltaForTable updates the delta info for some table.
@@ -196,6 +198,9 @@ type SessionVars struct {
 	// AllowAggPushDown can be set to false to forbid aggregation push down.
 	AllowAggPushDown bool
 
+	// AllowProjectionPushDown can be set to true to push projections down to the coprocessor.
+	AllowProjectionPushDown bool
+
 	// AllowWriteRowID can be set to false to forbid write data to _tidb_rowid.
 	// This variable is currently not recommended to be turned on.
 	AllowWriteRowID bool
@@ -268,6 +273,9 @@ type SessionVars struct {
 	// ConstraintCheckInPlace indicates whether to check the constraint when the SQL executing.
 	ConstraintCheckInPlace bool
 
+	// TxnMode is the mode of the explicit transactions, see TiDBTxnMode.
+	TxnMode string
+
 	// CommandValue indicates which command current session is doing.
 	CommandValue uint32
 
@@ -292,6 +300,18 @@ type SessionVars struct {
 	// use noop funcs or not
 	EnableNoopFuncs bool
 
+	// EnableAsyncCommit indicates whether to use async commit for transactions.
+	EnableAsyncCommit bool
+
+	// Enable1PC indicates whether to use one-phase commit for transactions whose keys are all in one region.
+	Enable1PC bool
+
+	// EnablePaging indicates whether coprocessor scans return the rows of a region page by page.
+	EnablePaging bool
+
+	// EnableCoprCache indicates whether coprocessor responses may be served from the coprocessor cache.
+	EnableCoprCache bool
+
 	// StartTime is the start time of the last query.
 	StartTime time.Time
 
@@ -370,6 +390,10 @@ func NewSessionVars() *SessionVars {
 		WaitSplitRegionFinish:       DefTiDBWaitSplitRegionFinish,
 		WaitSplitRegionTimeout:      DefWaitSplitRegionTimeout,
 		EnableNoopFuncs:             DefTiDBEnableNoopFuncs,
+		EnableAsyncCommit:           DefTiDBEnableAsyncCommit,
+		Enable1PC:                   DefTiDBEnable1PC,
+		EnablePaging:                DefTiDBEnablePaging,
+		EnableCoprCache:             DefTiDBEnableCoprCache,
 		replicaRead:                 kv.ReplicaReadLeader,
 		AllowRemoveAutoInc:          DefTiDBAllowRemoveAutoInc,
 	}
@@ -566,6 +590,8 @@ func (s *SessionVars) SetSystemVar(name string, val string) error {
 		s.SkipUTF8Check = TiDBOptOn(val)
 	case TiDBOptAggPushDown:
 		s.AllowAggPushDown = TiDBOptOn(val)
+	case TiDBOptProjectionPushDown:
+		s.AllowProjectionPushDown = TiDBOptOn(val)
 	case TiDBOptWriteRowID:
 		s.AllowWriteRowID = TiDBOptOn(val)
 	case TiDBOptInSubqToJoinAndAgg:
@@ -642,12 +668,22 @@ func (s *SessionVars) SetSystemVar(name string, val string) error {
 		s.WaitSplitRegionTimeout = uint64(tidbOptPositiveInt32(val, DefWaitSplitRegionTimeout))
 	case TiDBEnableNoopFuncs:
 		s.EnableNoopFuncs = TiDBOptOn(val)
+	case TiDBEnableAsyncCommit:
+		s.EnableAsyncCommit = TiDBOptOn(val)
+	case TiDBEnable1PC:
+		s.Enable1PC = TiDBOptOn(val)
+	case TiDBEnablePaging:
+		s.EnablePaging = TiDBOptOn(val)
+	case TiDBEnableCoprCache:
+		s.EnableCoprCache = TiDBOptOn(val)
 	case TiDBReplicaRead:
 		if strings.EqualFold(val, "follower") {
 			s.SetReplicaRead(kv.ReplicaReadFollower)
 		} else if strings.EqualFold(val, "leader") || len(val) == 0 {
 			s.SetReplicaRead(kv.ReplicaReadLeader)
 		}
+	case TiDBTxnMode:
+		s.TxnMode = strings.ToLower(val)
 	case TiDBAllowRemoveAutoInc:
 		s.AllowRemoveAutoInc = TiDBOptOn(val)
 	// It's a global variable, but it also wants to be cached in server.
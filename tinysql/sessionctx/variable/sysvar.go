@@ -626,6 +626,7 @@ var defaultSysVars = []*SysVar{
 	/* TiDB specific variables */
 	{ScopeSession, TiDBSnapshot, ""},
 	{ScopeSession, TiDBOptAggPushDown, BoolToIntStr(DefOptAggPushDown)},
+	{ScopeSession, TiDBOptProjectionPushDown, BoolToIntStr(DefOptProjectionPushDown)},
 	{ScopeSession, TiDBOptWriteRowID, BoolToIntStr(DefOptWriteRowID)},
 	{ScopeGlobal | ScopeSession, TiDBBuildStatsConcurrency, strconv.Itoa(DefBuildStatsConcurrency)},
 	{ScopeGlobal | ScopeSession, TiDBDistSQLScanConcurrency, strconv.Itoa(DefDistSQLScanConcurrency)},
@@ -675,7 +676,12 @@ var defaultSysVars = []*SysVar{
 	{ScopeSession, TiDBWaitSplitRegionFinish, BoolToIntStr(DefTiDBWaitSplitRegionFinish)},
 	{ScopeSession, TiDBWaitSplitRegionTimeout, strconv.Itoa(DefWaitSplitRegionTimeout)},
 	{ScopeGlobal | ScopeSession, TiDBEnableNoopFuncs, BoolToIntStr(DefTiDBEnableNoopFuncs)},
+	{ScopeGlobal | ScopeSession, TiDBEnableAsyncCommit, BoolToIntStr(DefTiDBEnableAsyncCommit)},
+	{ScopeGlobal | ScopeSession, TiDBEnable1PC, BoolToIntStr(DefTiDBEnable1PC)},
+	{ScopeGlobal | ScopeSession, TiDBEnablePaging, BoolToIntStr(DefTiDBEnablePaging)},
+	{ScopeGlobal | ScopeSession, TiDBEnableCoprCache, BoolToIntStr(DefTiDBEnableCoprCache)},
 	{ScopeSession, TiDBReplicaRead, "leader"},
+	{ScopeGlobal | ScopeSession, TiDBTxnMode, ""},
 	{ScopeSession, TiDBAllowRemoveAutoInc, BoolToIntStr(DefTiDBAllowRemoveAutoInc)},
 }
 
@@ -40,6 +40,11 @@ const (
 	// tidb_opt_agg_push_down is used to enable/disable the optimizer rule of aggregation push down.
 	TiDBOptAggPushDown = "tidb_opt_agg_push_down"
 
+	// tidb_opt_projection_push_down is used to enable/disable pushing projections down to the coprocessor.
+	// It is disabled by default: the plans of the labs, like `insert into t select id + 10, val from t`, keep the
+	// projection in TiDB, and a store which does not know the projection executor rejects the whole request.
+	TiDBOptProjectionPushDown = "tidb_opt_projection_push_down"
+
 	// tidb_opt_write_row_id is used to enable/disable the operations of insert、replace and update to _tidb_rowid.
 	TiDBOptWriteRowID = "tidb_opt_write_row_id"
 
@@ -211,6 +216,29 @@ const (
 
 	// TiDBEnableNoopFuncs set true will enable using fake funcs(like get_lock release_lock)
 	TiDBEnableNoopFuncs = "tidb_enable_noop_functions"
+
+	// TiDBEnableAsyncCommit indicates whether transactions are committed as soon as all their keys are prewritten,
+	// which saves the latency of committing the primary key.
+	TiDBEnableAsyncCommit = "tidb_enable_async_commit"
+
+	// TiDBEnable1PC indicates whether transactions whose keys are all in one region are committed by the prewrite.
+	TiDBEnable1PC = "tidb_enable_1pc"
+
+	// TiDBEnablePaging indicates whether coprocessor scans return the rows of a region page by page.
+	TiDBEnablePaging = "tidb_enable_paging"
+
+	// TiDBEnableCoprCache indicates whether coprocessor responses may be served from the coprocessor cache.
+	TiDBEnableCoprCache = "tidb_enable_copr_cache"
+
+	// TiDBTxnMode is the mode of the transactions begun by BEGIN or with autocommit off, it can be "pessimistic" or
+	// "optimistic". An empty value means optimistic.
+	TiDBTxnMode = "tidb_txn_mode"
+)
+
+// The values of TiDBTxnMode.
+const (
+	TxnModeOptimistic  = "optimistic"
+	TxnModePessimistic = "pessimistic"
 )
 
 // Default TiDB system variable values.
@@ -224,6 +252,7 @@ const (
 	DefBuildStatsConcurrency         = 4
 	DefSkipUTF8Check                 = false
 	DefOptAggPushDown                = false
+	DefOptProjectionPushDown         = false
 	DefOptWriteRowID                 = false
 	DefOptCorrelationThreshold       = 0.9
 	DefOptCorrelationExpFactor       = 1
@@ -262,6 +291,10 @@ const (
 	DefTiDBWaitSplitRegionFinish     = true
 	DefWaitSplitRegionTimeout        = 300 // 300s
 	DefTiDBEnableNoopFuncs           = false
+	DefTiDBEnableAsyncCommit         = false
+	DefTiDBEnable1PC                 = false
+	DefTiDBEnablePaging              = true
+	DefTiDBEnableCoprCache           = true
 	DefTiDBAllowRemoveAutoInc        = false
 	DefInnodbLockWaitTimeout         = 50 // 50s
 )
@@ -362,9 +362,9 @@ func ValidateSetSystemVar(vars *SessionVars, name string, value string) (string,
 			return "1", nil
 		}
 		return value, ErrWrongValueForVar.GenWithStackByArgs(name, value)
-	case TiDBSkipUTF8Check, TiDBOptAggPushDown, TiDBOptInSubqToJoinAndAgg,
-		TiDBEnableCascadesPlanner, TiDBEnableNoopFuncs,
-		TiDBScatterRegion, TiDBGeneralLog, TiDBConstraintCheckInPlace, TiDBEnableVectorizedExpression:
+	case TiDBSkipUTF8Check, TiDBOptAggPushDown, TiDBOptProjectionPushDown, TiDBOptInSubqToJoinAndAgg,
+		TiDBEnableCascadesPlanner, TiDBEnableNoopFuncs, TiDBEnableAsyncCommit, TiDBEnable1PC, TiDBEnablePaging,
+		TiDBEnableCoprCache, TiDBScatterRegion, TiDBGeneralLog, TiDBConstraintCheckInPlace, TiDBEnableVectorizedExpression:
 		fallthrough
 	case GeneralLog, AvoidTemporalUpgrade, BigTables, CheckProxyUsers, LogBin,
 		CoreFile, EndMakersInJSON, SQLLogBin, OfflineMode, PseudoSlaveMode, LowPriorityUpdates,
@@ -541,6 +541,12 @@ func ValidateSetSystemVar(vars *SessionVars, name string, value string) (string,
 			return "leader", nil
 		}
 		return value, ErrWrongValueForVar.GenWithStackByArgs(name, value)
+	case TiDBTxnMode:
+		switch strings.ToLower(value) {
+		case TxnModePessimistic, TxnModeOptimistic, "":
+			return strings.ToLower(value), nil
+		}
+		return value, ErrWrongValueForVar.GenWithStackByArgs(name, value)
 	case TiDBAllowRemoveAutoInc:
 		switch {
 		case strings.EqualFold(value, "ON") || value == "1":
@@ -58,8 +58,15 @@ func (h *rpcHandler) handleCopDAGRequest(req *coprocessor.Request) *coprocessor.
 		return resp
 	}
 
-	var rows [][][]byte
-	ctx := context.TODO()
+	var (
+		rows      [][][]byte
+		rowsSize  int
+		scanned   *coprocessor.KeyRange
+		ctx       = context.TODO()
+		pageable  = isPageable(dagReq)
+		pageSize  = int(req.PagingSize)
+		pageBytes = int(req.PagingBytes)
+	)
 	for {
 		var row [][]byte
 		row, err = e.Next(ctx)
@@ -70,6 +77,16 @@ func (h *rpcHandler) handleCopDAGRequest(req *coprocessor.Request) *coprocessor.
 			break
 		}
 		rows = append(rows, row)
+		if !pageable || pageSize == 0 {
+			continue
+		}
+		for _, col := range row {
+			rowsSize += len(col)
+		}
+		if len(rows) >= pageSize || (pageBytes > 0 && rowsSize >= pageBytes) {
+			scanned = scannedRange(e)
+			break
+		}
 	}
 
 	selResp := h.initSelectResponse(err, dagCtx.evalCtx.sc.GetWarnings(), e.Counts())
@@ -79,7 +96,58 @@ func (h *rpcHandler) handleCopDAGRequest(req *coprocessor.Request) *coprocessor.
 	// FIXME: some err such as (overflow) will be include in Response.OtherError with calling this buildResp.
 	//  Such err should only be marshal in the data but not in OtherError.
 	//  However, we can not distinguish such err now.
-	return buildResp(selResp, err)
+	resp = buildResp(selResp, err)
+	if err == nil {
+		resp.Range = scanned
+	}
+	return resp
+}
+
+// isPageable returns if the executors output the rows as they scan them, so that the scan can be paged.
+func isPageable(dagReq *tipb.DAGRequest) bool {
+	for _, exec := range dagReq.Executors {
+		switch exec.Tp {
+		case tipb.ExecType_TypeTableScan, tipb.ExecType_TypeIndexScan, tipb.ExecType_TypeSelection, tipb.ExecType_TypeLimit,
+			tipb.ExecType_TypeProjection:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// scannedRange returns the range scanned by a page which has output its budget, or nil if the scan is done.
+func scannedRange(e executor) *coprocessor.KeyRange {
+	for ; e != nil; e = e.GetSrcExec() {
+		switch x := e.(type) {
+		case *limitExec:
+			if x.cursor >= x.limit {
+				return nil
+			}
+		case *tableScanExec:
+			return scannedRangeOf(x.kvRanges, x.cursor, x.seekKey, x.Desc)
+		case *indexScanExec:
+			return scannedRangeOf(x.kvRanges, x.cursor, x.seekKey, x.Desc)
+		}
+	}
+	return nil
+}
+
+// scannedRangeOf returns the range scanned by a scan executor at cursor and seekKey.
+func scannedRangeOf(kvRanges []kv.KeyRange, cursor int, seekKey []byte, desc bool) *coprocessor.KeyRange {
+	if cursor >= len(kvRanges) {
+		return nil
+	}
+	if desc {
+		if seekKey == nil {
+			seekKey = kvRanges[cursor].EndKey
+		}
+		return &coprocessor.KeyRange{Start: seekKey, End: kvRanges[0].EndKey}
+	}
+	if seekKey == nil {
+		seekKey = kvRanges[cursor].StartKey
+	}
+	return &coprocessor.KeyRange{Start: kvRanges[0].StartKey, End: seekKey}
 }
 
 func (h *rpcHandler) buildDAGExecutor(req *coprocessor.Request) (*dagContext, executor, *tipb.DAGRequest, error) {
@@ -138,6 +206,8 @@ func (h *rpcHandler) buildExec(ctx *dagContext, curr *tipb.Executor) (executor,
 		currExec, err = h.buildTopN(ctx, curr)
 	case tipb.ExecType_TypeLimit:
 		currExec = &limitExec{limit: curr.Limit.GetLimit()}
+	case tipb.ExecType_TypeProjection:
+		currExec, err = h.buildProjection(ctx, curr)
 	default:
 		// TODO: Support other types.
 		err = errors.Errorf("this exec type %v doesn't support yet.", curr.GetTp())
@@ -271,6 +341,29 @@ func (h *rpcHandler) buildSelection(ctx *dagContext, executor *tipb.Executor) (*
 	}, nil
 }
 
+func (h *rpcHandler) buildProjection(ctx *dagContext, executor *tipb.Executor) (*projectionExec, error) {
+	var err error
+	var relatedColOffsets []int
+	pbExprs := executor.Projection.Exprs
+	for _, expr := range pbExprs {
+		relatedColOffsets, err = extractOffsetsInExpr(expr, ctx.evalCtx.columnInfos, relatedColOffsets)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	exprs, err := convertToExprs(ctx.evalCtx.sc, ctx.evalCtx.fieldTps, pbExprs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return &projectionExec{
+		evalCtx:           ctx.evalCtx,
+		relatedColOffsets: relatedColOffsets,
+		exprs:             exprs,
+		row:               make([]types.Datum, len(ctx.evalCtx.columnInfos)),
+	}, nil
+}
+
 func (h *rpcHandler) getAggInfo(ctx *dagContext, executor *tipb.Executor) ([]aggregation.Aggregation, []expression.Expression, []int, error) {
 	length := len(executor.Aggregation.AggFunc)
 	aggs := make([]aggregation.Aggregation, 0, length)
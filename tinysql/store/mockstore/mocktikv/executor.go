@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/codec"
 	"github.com/pingcap/tidb/util/rowcodec"
 	"github.com/pingcap/tipb/go-tipb"
 )
@@ -517,6 +518,59 @@ func (e *limitExec) Next(ctx context.Context) (value [][]byte, err error) {
 	return value, nil
 }
 
+type projectionExec struct {
+	exprs             []expression.Expression
+	relatedColOffsets []int
+	row               []types.Datum
+	evalCtx           *evalContext
+	src               executor
+}
+
+func (e *projectionExec) SetSrcExec(exec executor) {
+	e.src = exec
+}
+
+func (e *projectionExec) GetSrcExec() executor {
+	return e.src
+}
+
+func (e *projectionExec) ResetCounts() {
+	e.src.ResetCounts()
+}
+
+func (e *projectionExec) Counts() []int64 {
+	return e.src.Counts()
+}
+
+func (e *projectionExec) Next(ctx context.Context) (value [][]byte, err error) {
+	value, err = e.src.Next(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if value == nil {
+		return nil, nil
+	}
+
+	err = e.evalCtx.decodeRelatedColumnVals(e.relatedColOffsets, value, e.row)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	row := chunk.MutRowFromDatums(e.row).ToRow()
+	projected := make([][]byte, 0, len(e.exprs))
+	for _, expr := range e.exprs {
+		d, err := expr.Eval(row)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		data, err := codec.EncodeValue(e.evalCtx.sc, nil, d)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		projected = append(projected, data)
+	}
+	return projected, nil
+}
+
 // getRowData decodes raw byte slice to row data.
 func getRowData(
 	columns []*tipb.ColumnInfo,
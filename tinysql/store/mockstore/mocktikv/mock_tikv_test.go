@@ -19,6 +19,7 @@ import (
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	. "github.com/pingcap/check"
+	"github.com/pingcap/errors"
 )
 
 func TestT(t *testing.T) {
@@ -94,7 +95,7 @@ func (s *testMockTiKVSuite) mustPutOK(c *C, key, value string, startTS, commitTS
 		PrimaryLock:  []byte(key),
 		StartVersion: startTS,
 	}
-	errs := s.store.Prewrite(req)
+	_, errs := s.store.Prewrite(req)
 	for _, err := range errs {
 		c.Assert(err, IsNil)
 	}
@@ -114,7 +115,7 @@ func (s *testMockTiKVSuite) mustDeleteOK(c *C, key string, startTS, commitTS uin
 		PrimaryLock:  []byte(key),
 		StartVersion: startTS,
 	}
-	errs := s.store.Prewrite(req)
+	_, errs := s.store.Prewrite(req)
 	for _, err := range errs {
 		c.Assert(err, IsNil)
 	}
@@ -161,7 +162,7 @@ func (s *testMockTiKVSuite) mustPrewriteWithTTLOK(c *C, mutations []*kvrpcpb.Mut
 		StartVersion: startTS,
 		LockTtl:      ttl,
 	}
-	errs := s.store.Prewrite(req)
+	_, errs := s.store.Prewrite(req)
 	for _, err := range errs {
 		c.Assert(err, IsNil)
 	}
@@ -239,6 +240,37 @@ func (s *testMockTiKVSuite) TestGetWithLock(c *C) {
 	s.mustGetOK(c, key, math.MaxUint64, "value")
 }
 
+func (s *testMockTiKVSuite) mustBatchGetOK(c *C, keys []string, ts uint64, expect ...string) {
+	var ks [][]byte
+	for _, k := range keys {
+		ks = append(ks, []byte(k))
+	}
+	pairs := s.store.BatchGet(ks, ts)
+	c.Assert(len(pairs)*2, Equals, len(expect))
+	for i, p := range pairs {
+		c.Assert(p.Err, IsNil)
+		c.Assert(string(p.Key), Equals, expect[i*2])
+		c.Assert(string(p.Value), Equals, expect[i*2+1])
+	}
+}
+
+func (s *testMockTiKVSuite) TestBatchGet(c *C) {
+	s.mustPutOK(c, "k1", "v1", 1, 2)
+	s.mustPutOK(c, "k2", "v2", 1, 2)
+	s.mustPutOK(c, "k3", "v3", 3, 4)
+	s.mustDeleteOK(c, "k2", 5, 6)
+	s.mustBatchGetOK(c, []string{"k1", "k2", "k3", "k4"}, 3, "k1", "v1", "k2", "v2")
+	s.mustBatchGetOK(c, []string{"k1", "k2", "k3", "k4"}, 7, "k1", "v1", "k3", "v3")
+
+	// A locked key gets a pair with the error.
+	s.mustPrewriteOK(c, putMutations("k1", "v11"), "k1", 8)
+	pairs := s.store.BatchGet([][]byte{[]byte("k1"), []byte("k3")}, 9)
+	c.Assert(pairs, HasLen, 2)
+	_, ok := errors.Cause(pairs[0].Err).(*ErrLocked)
+	c.Assert(ok, IsTrue)
+	c.Assert(string(pairs[1].Value), Equals, "v3")
+}
+
 func (s *testMockTiKVSuite) TestDelete(c *C) {
 	s.mustPutOK(c, "x", "x5-10", 5, 10)
 	s.mustDeleteOK(c, "x", 15, 20)
@@ -421,7 +453,7 @@ func (s *testMockTiKVSuite) TestCommitConflict(c *C) {
 		PrimaryLock:  []byte("x"),
 		StartVersion: 10,
 	}
-	errs := s.store.Prewrite(req)
+	_, errs := s.store.Prewrite(req)
 	c.Assert(errs[0], NotNil)
 	// B find rollback A because A exist too long.
 	s.mustRollbackOK(c, [][]byte{[]byte("x")}, 5)
@@ -529,7 +561,7 @@ func (s *testMockTiKVSuite) TestRollbackAndWriteConflict(c *C) {
 		StartVersion: 2,
 		LockTtl:      2,
 	}
-	errs := s.store.Prewrite(req)
+	_, errs := s.store.Prewrite(req)
 	s.mustWriteWriteConflict(c, errs, 1)
 
 	s.mustPutOK(c, "test", "test2", 5, 8)
@@ -543,7 +575,7 @@ func (s *testMockTiKVSuite) TestRollbackAndWriteConflict(c *C) {
 		StartVersion: 6,
 		LockTtl:      1,
 	}
-	errs = s.store.Prewrite(req)
+	_, errs = s.store.Prewrite(req)
 	s.mustWriteWriteConflict(c, errs, 0)
 }
 
@@ -631,14 +663,14 @@ func (s *testMVCCLevelDB) TestCheckTxnStatus(c *C) {
 	startTS := uint64(5 << 18)
 	s.mustPrewriteWithTTLOK(c, putMutations("pk", "val"), "pk", startTS, 666)
 
-	ttl, commitTS, _, err := s.store.CheckTxnStatus([]byte("pk"), startTS, 666)
+	ttl, commitTS, _, _, err := s.store.CheckTxnStatus([]byte("pk"), startTS, 666, false)
 	c.Assert(err, IsNil)
 	c.Assert(ttl, Equals, uint64(666))
 	c.Assert(commitTS, Equals, uint64(0))
 
 	s.mustCommitOK(c, [][]byte{[]byte("pk")}, startTS, startTS+101)
 
-	ttl, commitTS, _, err = s.store.CheckTxnStatus([]byte("pk"), startTS, 666)
+	ttl, commitTS, _, _, err = s.store.CheckTxnStatus([]byte("pk"), startTS, 666, false)
 	c.Assert(err, IsNil)
 	c.Assert(ttl, Equals, uint64(0))
 	c.Assert(commitTS, Equals, uint64(startTS+101))
@@ -646,7 +678,7 @@ func (s *testMVCCLevelDB) TestCheckTxnStatus(c *C) {
 	s.mustPrewriteWithTTLOK(c, putMutations("pk1", "val"), "pk1", startTS, 666)
 	s.mustRollbackOK(c, [][]byte{[]byte("pk1")}, startTS)
 
-	ttl, commitTS, action, err := s.store.CheckTxnStatus([]byte("pk1"), startTS, 666)
+	ttl, commitTS, action, _, err := s.store.CheckTxnStatus([]byte("pk1"), startTS, 666, false)
 	c.Assert(err, IsNil)
 	c.Assert(ttl, Equals, uint64(0))
 	c.Assert(commitTS, Equals, uint64(0))
@@ -654,7 +686,7 @@ func (s *testMVCCLevelDB) TestCheckTxnStatus(c *C) {
 
 	s.mustPrewriteWithTTLOK(c, putMutations("pk2", "val"), "pk2", startTS, 666)
 	currentTS := uint64(777 << 18)
-	ttl, commitTS, action, err = s.store.CheckTxnStatus([]byte("pk2"), startTS, currentTS)
+	ttl, commitTS, action, _, err = s.store.CheckTxnStatus([]byte("pk2"), startTS, currentTS, false)
 	c.Assert(err, IsNil)
 	c.Assert(ttl, Equals, uint64(0))
 	c.Assert(commitTS, Equals, uint64(0))
@@ -679,3 +711,90 @@ func (s *testMVCCLevelDB) TestTxnHeartBeat(c *C) {
 	_, err = s.store.TxnHeartBeat([]byte("pk"), 5, 1000)
 	c.Assert(err, NotNil)
 }
+
+func (s *testMVCCLevelDB) TestAsyncCommit(c *C) {
+	s.mustGetNone(c, "x", 20)
+	req := &kvrpcpb.PrewriteRequest{
+		Mutations:      putMutations("pk", "val", "sk", "val"),
+		PrimaryLock:    []byte("pk"),
+		StartVersion:   10,
+		LockTtl:        1,
+		UseAsyncCommit: true,
+		Secondaries:    [][]byte{[]byte("sk")},
+	}
+	minCommitTS, errs := s.store.Prewrite(req)
+	for _, err := range errs {
+		c.Assert(err, IsNil)
+	}
+	// The transaction commits after the read.
+	c.Assert(minCommitTS, Equals, uint64(21))
+	s.mustCommitErr(c, [][]byte{[]byte("pk")}, 10, 15)
+
+	// The expired primary lock isn't rolled back.
+	ttl, _, action, lockInfo, err := s.store.CheckTxnStatus([]byte("pk"), 10, 777<<18, false)
+	c.Assert(err, IsNil)
+	c.Assert(ttl, Equals, uint64(1))
+	c.Assert(action, Equals, kvrpcpb.Action_NoAction)
+	c.Assert(lockInfo.UseAsyncCommit, IsTrue)
+	c.Assert(lockInfo.Secondaries, DeepEquals, [][]byte{[]byte("sk")})
+
+	locks, commitTS, err := s.store.CheckSecondaryLocks([][]byte{[]byte("sk")}, 10)
+	c.Assert(err, IsNil)
+	c.Assert(commitTS, Equals, uint64(0))
+	c.Assert(locks, HasLen, 1)
+	c.Assert(locks[0].MinCommitTs, Equals, uint64(21))
+
+	// A key which isn't prewritten is rolled back.
+	locks, commitTS, err = s.store.CheckSecondaryLocks([][]byte{[]byte("sk2")}, 10)
+	c.Assert(err, IsNil)
+	c.Assert(locks, HasLen, 0)
+	c.Assert(commitTS, Equals, uint64(0))
+
+	s.mustCommitOK(c, [][]byte{[]byte("pk"), []byte("sk")}, 10, 21)
+	_, commitTS, err = s.store.CheckSecondaryLocks([][]byte{[]byte("sk")}, 10)
+	c.Assert(err, IsNil)
+	c.Assert(commitTS, Equals, uint64(21))
+}
+
+func (s *testMVCCLevelDB) TestOnePC(c *C) {
+	s.mustGetNone(c, "x", 20)
+	req := &kvrpcpb.PrewriteRequest{
+		Mutations:    putMutations("pk", "val", "sk", "val"),
+		PrimaryLock:  []byte("pk"),
+		StartVersion: 10,
+		TryOnePc:     true,
+	}
+	commitTS, errs := s.store.Prewrite(req)
+	for _, err := range errs {
+		c.Assert(err, IsNil)
+	}
+	c.Assert(commitTS, Equals, uint64(21))
+	s.mustGetNone(c, "sk", 20)
+	s.mustGetOK(c, "sk", 21, "val")
+}
+
+func (s *testMVCCLevelDB) TestPrewriteInsert(c *C) {
+	s.mustPutOK(c, "k1", "v1", 1, 2)
+	s.mustDeleteOK(c, "k2", 3, 4)
+
+	insert := func(key string, startTS uint64) error {
+		req := &kvrpcpb.PrewriteRequest{
+			Mutations:    []*kvrpcpb.Mutation{{Op: kvrpcpb.Op_Insert, Key: []byte(key), Value: []byte("v")}},
+			PrimaryLock:  []byte(key),
+			StartVersion: startTS,
+		}
+		_, errs := s.store.Prewrite(req)
+		return errs[0]
+	}
+	err := insert("k1", 5)
+	c.Assert(err, NotNil)
+	existErr, ok := err.(*ErrKeyAlreadyExist)
+	c.Assert(ok, IsTrue)
+	c.Assert(existErr.Key, BytesEquals, []byte("k1"))
+
+	// Deleted and missing keys can be inserted.
+	c.Assert(insert("k2", 6), IsNil)
+	c.Assert(insert("k3", 7), IsNil)
+	s.mustCommitOK(c, [][]byte{[]byte("k2")}, 6, 8)
+	s.mustGetOK(c, "k2", 9, "v")
+}
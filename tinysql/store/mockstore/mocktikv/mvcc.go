@@ -31,6 +31,8 @@ const (
 	typePut mvccValueType = iota
 	typeDelete
 	typeRollback
+	// typeLock is committed from the lock of a key which was only locked, it doesn't change the value.
+	typeLock
 )
 
 type mvccValue struct {
@@ -47,6 +49,11 @@ type mvccLock struct {
 	op          kvrpcpb.Op
 	ttl         uint64
 	forUpdateTS uint64
+	// For async commit, the transaction is committed at the largest minCommitTS of its locks once all of them are
+	// prewritten. The primary lock records the other keys of the transaction.
+	useAsyncCommit bool
+	minCommitTS    uint64
+	secondaries    [][]byte
 }
 
 type mvccEntry struct {
@@ -67,6 +74,12 @@ func (l *mvccLock) MarshalBinary() ([]byte, error) {
 	mh.WriteNumber(&buf, l.op)
 	mh.WriteNumber(&buf, l.ttl)
 	mh.WriteNumber(&buf, l.forUpdateTS)
+	mh.WriteNumber(&buf, l.useAsyncCommit)
+	mh.WriteNumber(&buf, l.minCommitTS)
+	mh.WriteNumber(&buf, uint64(len(l.secondaries)))
+	for _, secondary := range l.secondaries {
+		mh.WriteSlice(&buf, secondary)
+	}
 	return buf.Bytes(), errors.Trace(mh.err)
 }
 
@@ -80,6 +93,15 @@ func (l *mvccLock) UnmarshalBinary(data []byte) error {
 	mh.ReadNumber(buf, &l.op)
 	mh.ReadNumber(buf, &l.ttl)
 	mh.ReadNumber(buf, &l.forUpdateTS)
+	mh.ReadNumber(buf, &l.useAsyncCommit)
+	mh.ReadNumber(buf, &l.minCommitTS)
+	var count uint64
+	mh.ReadNumber(buf, &count)
+	for i := uint64(0); i < count && mh.err == nil; i++ {
+		var secondary []byte
+		mh.ReadSlice(buf, &secondary)
+		l.secondaries = append(l.secondaries, secondary)
+	}
 	return errors.Trace(mh.err)
 }
 
@@ -194,9 +216,23 @@ func (l *mvccLock) lockErr(key []byte) error {
 	}
 }
 
+// info returns the LockInfo of the lock on key.
+func (l *mvccLock) info(key []byte) *kvrpcpb.LockInfo {
+	return &kvrpcpb.LockInfo{
+		PrimaryLock:    l.primary,
+		LockVersion:    l.startTS,
+		Key:            key,
+		LockTtl:        l.ttl,
+		LockType:       l.op,
+		UseAsyncCommit: l.useAsyncCommit,
+		MinCommitTs:    l.minCommitTS,
+		Secondaries:    l.secondaries,
+	}
+}
+
 func (l *mvccLock) check(ts uint64, key []byte) (uint64, error) {
-	// ignore when ts is older than lock or lock's type is Lock.
-	if l.startTS > ts || l.op == kvrpcpb.Op_Lock {
+	// ignore when ts is older than lock or lock's type is Lock or PessimisticLock.
+	if l.startTS > ts || l.op == kvrpcpb.Op_Lock || l.op == kvrpcpb.Op_PessimisticLock {
 		return ts, nil
 	}
 	// for point get latest version.
@@ -219,7 +255,7 @@ func (e *mvccEntry) Get(ts uint64) ([]byte, error) {
 		}
 	}
 	for _, v := range e.values {
-		if v.commitTS <= ts && v.valueType != typeRollback {
+		if v.commitTS <= ts && v.valueType != typeRollback && v.valueType != typeLock {
 			return v.value, nil
 		}
 	}
@@ -229,9 +265,14 @@ func (e *mvccEntry) Get(ts uint64) ([]byte, error) {
 // MVCCStore is a mvcc key-value storage.
 type MVCCStore interface {
 	Get(key []byte, startTS uint64) ([]byte, error)
+	BatchGet(ks [][]byte, startTS uint64) []Pair
 	Scan(startKey, endKey []byte, limit int, startTS uint64) []Pair
 	ReverseScan(startKey, endKey []byte, limit int, startTS uint64) []Pair
-	Prewrite(req *kvrpcpb.PrewriteRequest) []error
+	// Prewrite returns the commit ts it calculated for an async commit or 1PC request, 0 if it fell back to two phase
+	// commit.
+	Prewrite(req *kvrpcpb.PrewriteRequest) (uint64, []error)
+	PessimisticLock(req *kvrpcpb.PessimisticLockRequest) []error
+	PessimisticRollback(keys [][]byte, startTS, forUpdateTS uint64) []error
 	Commit(keys [][]byte, startTS, commitTS uint64) error
 	Rollback(keys [][]byte, startTS uint64) error
 	Cleanup(key []byte, startTS, currentTS uint64) error
@@ -241,7 +282,10 @@ type MVCCStore interface {
 	BatchResolveLock(startKey, endKey []byte, txnInfos map[uint64]uint64) error
 	GC(startKey, endKey []byte, safePoint uint64) error
 	DeleteRange(startKey, endKey []byte) error
-	CheckTxnStatus(primaryKey []byte, lockTS uint64, currentTS uint64) (uint64, uint64, kvrpcpb.Action, error)
+	// Ingest writes pairs as committed at ts, they aren't checked against the locks and writes of their keys.
+	Ingest(pairs []Pair, ts uint64) error
+	CheckTxnStatus(primaryKey []byte, lockTS uint64, currentTS uint64, forceSyncCommit bool) (uint64, uint64, kvrpcpb.Action, *kvrpcpb.LockInfo, error)
+	CheckSecondaryLocks(keys [][]byte, startTS uint64) ([]*kvrpcpb.LockInfo, uint64, error)
 	Close() error
 }
 
@@ -251,11 +295,16 @@ type RawKV interface {
 	RawBatchGet(keys [][]byte) [][]byte
 	RawScan(startKey, endKey []byte, limit int) []Pair        // Scan the range of [startKey, endKey)
 	RawReverseScan(startKey, endKey []byte, limit int) []Pair // Scan the range of [endKey, startKey)
-	RawPut(key, value []byte)
-	RawBatchPut(keys, values [][]byte)
+	RawPut(key, value []byte, ttl uint64)                     // A ttl of 0 means the value never expires
+	RawBatchPut(keys, values [][]byte, ttl uint64)
 	RawDelete(key []byte)
 	RawBatchDelete(keys [][]byte)
 	RawDeleteRange(startKey, endKey []byte)
+	// RawCompareAndSwap writes value to key if the current value of key is previousValue, or if key doesn't exist when
+	// previousNotExist is set. It returns the current value of key and whether value was written.
+	RawCompareAndSwap(key, previousValue, value []byte, previousNotExist bool, ttl uint64) ([]byte, bool)
+	// RawGetKeyTTL returns the remaining time to live of a key in seconds and whether the key exists.
+	RawGetKeyTTL(key []byte) (uint64, bool)
 }
 
 // Pair is a KV pair read from MvccStore or an error if any occurs.
@@ -15,8 +15,11 @@ package mocktikv
 
 import (
 	"bytes"
+	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	"github.com/pingcap/errors"
@@ -61,6 +64,12 @@ type MVCCLevelDB struct {
 	// leveldb can not guarantee multiple operations to be atomic, for example, read
 	// then write, another write may happen during it, so this lock is necessory.
 	mu sync.RWMutex
+	// maxTS is the largest ts used by a read, async commit and 1PC transactions must commit after it. It is
+	// updated atomically by reads holding the read lock of mu.
+	maxTS uint64
+	// rawExpires holds the unix time in seconds at which the raw keys with a time to live expire. It is only kept in
+	// memory, so the raw values of a reopened store never expire.
+	rawExpires map[string]uint64
 }
 
 const lockVer uint64 = math.MaxUint64
@@ -121,7 +130,7 @@ func NewMVCCLevelDB(path string) (*MVCCLevelDB, error) {
 		d, err = leveldb.OpenFile(path, &opt.Options{BlockCacheCapacity: 600 * 1024 * 1024})
 	}
 
-	return &MVCCLevelDB{db: d}, errors.Trace(err)
+	return &MVCCLevelDB{db: d, rawExpires: make(map[string]uint64)}, errors.Trace(err)
 }
 
 // Iterator wraps iterator.Iterator to provide Valid() method.
@@ -263,10 +272,45 @@ func (dec *skipDecoder) Decode(iter *Iterator) (bool, error) {
 func (mvcc *MVCCLevelDB) Get(key []byte, startTS uint64) ([]byte, error) {
 	mvcc.mu.RLock()
 	defer mvcc.mu.RUnlock()
+	mvcc.updateMaxTS(startTS)
 
 	return mvcc.getValue(key, startTS)
 }
 
+// BatchGet implements the MVCCStore interface. Keys which don't exist are left out.
+func (mvcc *MVCCLevelDB) BatchGet(ks [][]byte, startTS uint64) []Pair {
+	mvcc.mu.RLock()
+	defer mvcc.mu.RUnlock()
+	mvcc.updateMaxTS(startTS)
+
+	pairs := make([]Pair, 0, len(ks))
+	for _, k := range ks {
+		v, err := mvcc.getValue(k, startTS)
+		if v == nil && err == nil {
+			continue
+		}
+		pairs = append(pairs, Pair{
+			Key:   k,
+			Value: v,
+			Err:   errors.Trace(err),
+		})
+	}
+	return pairs
+}
+
+// updateMaxTS records a read at ts. Reads at math.MaxUint64 only read committed data, so they are ignored.
+func (mvcc *MVCCLevelDB) updateMaxTS(ts uint64) {
+	if ts == math.MaxUint64 {
+		return
+	}
+	for {
+		maxTS := atomic.LoadUint64(&mvcc.maxTS)
+		if ts <= maxTS || atomic.CompareAndSwapUint64(&mvcc.maxTS, maxTS, ts) {
+			return
+		}
+	}
+}
+
 func (mvcc *MVCCLevelDB) getValue(key []byte, startTS uint64) ([]byte, error) {
 	startKey := mvccEncode(key, lockVer)
 	iter := newIterator(mvcc.db, &util.Range{
@@ -297,7 +341,7 @@ func getValue(iter *Iterator, key []byte, startTS uint64) ([]byte, error) {
 		}
 
 		value := &dec2.value
-		if value.valueType == typeRollback {
+		if value.valueType == typeRollback || value.valueType == typeLock {
 			continue
 		}
 		// Read the first committed value that can be seen at startTS.
@@ -315,6 +359,7 @@ func getValue(iter *Iterator, key []byte, startTS uint64) ([]byte, error) {
 func (mvcc *MVCCLevelDB) Scan(startKey, endKey []byte, limit int, startTS uint64) []Pair {
 	mvcc.mu.RLock()
 	defer mvcc.mu.RUnlock()
+	mvcc.updateMaxTS(startTS)
 
 	iter, currKey, err := newScanIterator(mvcc.db, startKey, endKey)
 	defer iter.Release()
@@ -355,6 +400,7 @@ func (mvcc *MVCCLevelDB) Scan(startKey, endKey []byte, limit int, startTS uint64
 func (mvcc *MVCCLevelDB) ReverseScan(startKey, endKey []byte, limit int, startTS uint64) []Pair {
 	mvcc.mu.RLock()
 	defer mvcc.mu.RUnlock()
+	mvcc.updateMaxTS(startTS)
 
 	var mvccEnd []byte
 	if len(endKey) != 0 {
@@ -439,21 +485,84 @@ func reverse(values []mvccValue) {
 }
 
 // Prewrite implements the MVCCStore interface.
-func (mvcc *MVCCLevelDB) Prewrite(req *kvrpcpb.PrewriteRequest) []error {
+func (mvcc *MVCCLevelDB) Prewrite(req *kvrpcpb.PrewriteRequest) (uint64, []error) {
 	mutations := req.Mutations
 	primary := req.PrimaryLock
 	startTS := req.StartVersion
-	ttl := req.LockTtl
 	mvcc.mu.Lock()
 	defer mvcc.mu.Unlock()
 
+	lock := mvccLock{
+		startTS: startTS,
+		primary: primary,
+		ttl:     req.LockTtl,
+	}
+	var commitTS uint64
+	if req.UseAsyncCommit || req.TryOnePc {
+		commitTS = mvcc.calculateMinCommitTS(req)
+	}
+	lock.useAsyncCommit = req.UseAsyncCommit && commitTS > 0
+	lock.minCommitTS = commitTS
+	var onePCCommitTS uint64
+	if req.TryOnePc {
+		onePCCommitTS = commitTS
+	}
+
 	anyError := false
 	batch := &leveldb.Batch{}
 	errs := make([]error, 0, len(mutations))
-	for _, m := range mutations {
+	for i, m := range mutations {
 		// If the operation is Insert, check if key is exists at first.
 		var err error
-		err = prewriteMutation(mvcc.db, batch, m, startTS, primary, ttl)
+		isPessimisticLock := i < len(req.IsPessimisticLock) && req.IsPessimisticLock[i]
+		l := lock
+		if l.useAsyncCommit && bytes.Equal(m.Key, primary) {
+			l.secondaries = req.Secondaries
+		}
+		err = prewriteMutation(mvcc.db, batch, m, l, isPessimisticLock, onePCCommitTS)
+		errs = append(errs, err)
+		if err != nil {
+			anyError = true
+		}
+	}
+	if anyError {
+		return 0, errs
+	}
+	if err := mvcc.db.Write(batch, nil); err != nil {
+		return 0, []error{err}
+	}
+
+	return commitTS, errs
+}
+
+// calculateMinCommitTS returns the smallest commit ts of an async commit or 1PC transaction, which must be larger
+// than the ts of any read which may have missed the transaction. It returns 0 if the ts would exceed the
+// max_commit_ts of the request.
+func (mvcc *MVCCLevelDB) calculateMinCommitTS(req *kvrpcpb.PrewriteRequest) uint64 {
+	minCommitTS := atomic.LoadUint64(&mvcc.maxTS)
+	if req.StartVersion > minCommitTS {
+		minCommitTS = req.StartVersion
+	}
+	if req.ForUpdateTs > minCommitTS {
+		minCommitTS = req.ForUpdateTs
+	}
+	minCommitTS++
+	if req.MaxCommitTs > 0 && minCommitTS > req.MaxCommitTs {
+		return 0
+	}
+	return minCommitTS
+}
+
+// PessimisticLock implements the MVCCStore interface.
+func (mvcc *MVCCLevelDB) PessimisticLock(req *kvrpcpb.PessimisticLockRequest) []error {
+	mvcc.mu.Lock()
+	defer mvcc.mu.Unlock()
+
+	anyError := false
+	batch := &leveldb.Batch{}
+	errs := make([]error, 0, len(req.Mutations))
+	for _, m := range req.Mutations {
+		err := pessimisticLockMutation(mvcc.db, batch, m, req.StartVersion, req.ForUpdateTs, req.PrimaryLock, req.LockTtl)
 		errs = append(errs, err)
 		if err != nil {
 			anyError = true
@@ -469,6 +578,109 @@ func (mvcc *MVCCLevelDB) Prewrite(req *kvrpcpb.PrewriteRequest) []error {
 	return errs
 }
 
+func pessimisticLockMutation(db *leveldb.DB, batch *leveldb.Batch, mutation *kvrpcpb.Mutation,
+	startTS, forUpdateTS uint64, primary []byte, ttl uint64) error {
+	startKey := mvccEncode(mutation.Key, lockVer)
+	iter := newIterator(db, &util.Range{
+		Start: startKey,
+	})
+	defer iter.Release()
+
+	dec := lockDecoder{
+		expectKey: mutation.Key,
+	}
+	ok, err := dec.Decode(iter)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if ok {
+		if dec.lock.startTS != startTS {
+			return dec.lock.lockErr(mutation.Key)
+		}
+		if dec.lock.op != kvrpcpb.Op_PessimisticLock || dec.lock.forUpdateTS >= forUpdateTS {
+			return nil
+		}
+	} else {
+		// Only the writes committed after forUpdateTS are conflicts.
+		if err = checkConflictValue(iter, mutation, forUpdateTS+1); err != nil {
+			return err
+		}
+		// The transaction may have been rolled back by another one resolving its locks.
+		valueIter := newIterator(db, &util.Range{
+			Start: startKey,
+		})
+		defer valueIter.Release()
+		_, ok, err := getTxnCommitInfo(valueIter, mutation.Key, startTS)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if ok {
+			return ErrAbort("txn has already been finished")
+		}
+	}
+
+	lock := mvccLock{
+		startTS:     startTS,
+		primary:     primary,
+		op:          kvrpcpb.Op_PessimisticLock,
+		ttl:         ttl,
+		forUpdateTS: forUpdateTS,
+	}
+	writeValue, err := lock.MarshalBinary()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	batch.Put(mvccEncode(mutation.Key, lockVer), writeValue)
+	return nil
+}
+
+// PessimisticRollback implements the MVCCStore interface.
+func (mvcc *MVCCLevelDB) PessimisticRollback(keys [][]byte, startTS, forUpdateTS uint64) []error {
+	mvcc.mu.Lock()
+	defer mvcc.mu.Unlock()
+
+	anyError := false
+	batch := &leveldb.Batch{}
+	errs := make([]error, 0, len(keys))
+	for _, key := range keys {
+		err := pessimisticRollbackKey(mvcc.db, batch, key, startTS, forUpdateTS)
+		errs = append(errs, err)
+		if err != nil {
+			anyError = true
+		}
+	}
+	if anyError {
+		return errs
+	}
+	if err := mvcc.db.Write(batch, nil); err != nil {
+		return []error{err}
+	}
+	return errs
+}
+
+func pessimisticRollbackKey(db *leveldb.DB, batch *leveldb.Batch, key []byte, startTS, forUpdateTS uint64) error {
+	startKey := mvccEncode(key, lockVer)
+	iter := newIterator(db, &util.Range{
+		Start: startKey,
+	})
+	defer iter.Release()
+
+	dec := lockDecoder{
+		expectKey: key,
+	}
+	ok, err := dec.Decode(iter)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if ok {
+		lock := dec.lock
+		if lock.op == kvrpcpb.Op_PessimisticLock && lock.startTS == startTS && lock.forUpdateTS <= forUpdateTS {
+			batch.Delete(startKey)
+		}
+	}
+	return nil
+}
+
 func checkConflictValue(iter *Iterator, m *kvrpcpb.Mutation, startTS uint64) error {
 	dec := valueDecoder{
 		expectKey: m.Key,
@@ -490,9 +702,42 @@ func checkConflictValue(iter *Iterator, m *kvrpcpb.Mutation, startTS uint64) err
 	return nil
 }
 
+// checkKeyNotExists returns ErrKeyAlreadyExist if the latest committed value of key isn't deleted.
+func checkKeyNotExists(db *leveldb.DB, key []byte) error {
+	iter := newIterator(db, &util.Range{
+		Start: mvccEncode(key, lockVer),
+	})
+	defer iter.Release()
+
+	dec1 := lockDecoder{expectKey: key}
+	if _, err := dec1.Decode(iter); err != nil {
+		return errors.Trace(err)
+	}
+	dec2 := valueDecoder{expectKey: key}
+	for iter.Valid() {
+		ok, err := dec2.Decode(iter)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !ok {
+			break
+		}
+		switch dec2.value.valueType {
+		case typeRollback, typeLock:
+			continue
+		case typePut:
+			return &ErrKeyAlreadyExist{Key: key}
+		}
+		// The key is deleted.
+		return nil
+	}
+	return nil
+}
+
+// prewriteMutation locks the key of mutation with lock, or commits it at onePCCommitTS if it isn't 0.
 func prewriteMutation(db *leveldb.DB, batch *leveldb.Batch,
-	mutation *kvrpcpb.Mutation, startTS uint64,
-	primary []byte, ttl uint64) error {
+	mutation *kvrpcpb.Mutation, lock mvccLock, isPessimisticLock bool, onePCCommitTS uint64) error {
+	startTS := lock.startTS
 	startKey := mvccEncode(mutation.Key, lockVer)
 	iter := newIterator(db, &util.Range{
 		Start: startKey,
@@ -508,22 +753,29 @@ func prewriteMutation(db *leveldb.DB, batch *leveldb.Batch,
 	}
 	if ok {
 		if dec.lock.startTS != startTS {
+			if isPessimisticLock {
+				return ErrAbort("pessimistic lock not found")
+			}
 			return dec.lock.lockErr(mutation.Key)
 		}
+	} else if isPessimisticLock {
+		return ErrAbort("pessimistic lock not found")
 	} else {
 		err = checkConflictValue(iter, mutation, startTS)
 		if err != nil {
 			return err
 		}
+		if mutation.Op == kvrpcpb.Op_Insert {
+			if err = checkKeyNotExists(db, mutation.Key); err != nil {
+				return err
+			}
+		}
 	}
 
-	op := mutation.GetOp()
-	lock := mvccLock{
-		startTS: startTS,
-		primary: primary,
-		value:   mutation.Value,
-		op:      op,
-		ttl:     ttl,
+	lock.value = mutation.Value
+	lock.op = mutation.GetOp()
+	if onePCCommitTS > 0 {
+		return commitLock(batch, lock, mutation.Key, startTS, onePCCommitTS)
 	}
 
 	writeKey := mvccEncode(mutation.Key, lockVer)
@@ -567,6 +819,12 @@ func commitKey(db *leveldb.DB, batch *leveldb.Batch, key []byte, startTS, commit
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if ok && dec.lock.startTS == startTS && dec.lock.op == kvrpcpb.Op_PessimisticLock {
+		return ErrAbort("pessimistic lock is not prewritten")
+	}
+	if ok && dec.lock.startTS == startTS && commitTS < dec.lock.minCommitTS {
+		return ErrAbort(fmt.Sprintf("commit ts %d is smaller than min commit ts %d", commitTS, dec.lock.minCommitTS))
+	}
 	if !ok || dec.lock.startTS != startTS {
 		// If the lock of this transaction is not found, or the lock is replaced by
 		// another transaction, check commit information of this transaction.
@@ -588,11 +846,17 @@ func commitKey(db *leveldb.DB, batch *leveldb.Batch, key []byte, startTS, commit
 }
 
 func commitLock(batch *leveldb.Batch, lock mvccLock, key []byte, startTS, commitTS uint64) error {
-	if lock.op != kvrpcpb.Op_Lock {
+	// A pessimistic lock which is still there when the transaction commits was never prewritten, it holds no value.
+	if lock.op != kvrpcpb.Op_PessimisticLock {
 		var valueType mvccValueType
-		if lock.op == kvrpcpb.Op_Put {
+		switch lock.op {
+		case kvrpcpb.Op_Put, kvrpcpb.Op_Insert:
 			valueType = typePut
-		} else {
+		case kvrpcpb.Op_Lock:
+			// The commit record tells whether the transaction is committed, which is needed if the key
+			// is the primary one.
+			valueType = typeLock
+		default:
 			valueType = typeDelete
 		}
 		value := mvccValue{
@@ -785,8 +1049,10 @@ func (mvcc *MVCCLevelDB) Cleanup(key []byte, startTS, currentTS uint64) error {
 }
 
 // CheckTxnStatus checks the primary lock of a transaction to decide its status.
-// The return values are (ttl, commitTS, err):
+// The return values are (ttl, commitTS, action, lockInfo, err):
 // If the transaction is active, this function returns the ttl of the lock;
+// If the primary lock is an async commit lock, it is never rolled back unless
+// forceSyncCommit is set, and its lockInfo is returned as well;
 // If the transaction is committed, this function returns the commitTS;
 // If the transaction is rollbacked, this function returns (0, 0, nil)
 // Note that CheckTxnStatus may also push forward the `minCommitTS` of the
@@ -794,7 +1060,7 @@ func (mvcc *MVCCLevelDB) Cleanup(key []byte, startTS, currentTS uint64) error {
 //
 // primaryKey + lockTS together could locate the primary lock.
 // currentTS is the current ts, but it may be inaccurate. Just use it to check TTL.
-func (mvcc *MVCCLevelDB) CheckTxnStatus(primaryKey []byte, lockTS, currentTS uint64) (ttl uint64, commitTS uint64, action kvrpcpb.Action, err error) {
+func (mvcc *MVCCLevelDB) CheckTxnStatus(primaryKey []byte, lockTS, currentTS uint64, forceSyncCommit bool) (ttl uint64, commitTS uint64, action kvrpcpb.Action, lockInfo *kvrpcpb.LockInfo, err error) {
 	mvcc.mu.Lock()
 	defer mvcc.mu.Unlock()
 
@@ -821,6 +1087,10 @@ func (mvcc *MVCCLevelDB) CheckTxnStatus(primaryKey []byte, lockTS, currentTS uin
 			lock := dec.lock
 			batch := &leveldb.Batch{}
 
+			if lock.useAsyncCommit && !forceSyncCommit {
+				return lock.ttl, 0, action, lock.info(primaryKey), nil
+			}
+
 			// If the lock has already outdated, clean up it.
 			if uint64(oracle.ExtractPhysical(lock.startTS))+lock.ttl < uint64(oracle.ExtractPhysical(currentTS)) {
 				if err = rollbackLock(batch, primaryKey, lockTS); err != nil {
@@ -831,10 +1101,10 @@ func (mvcc *MVCCLevelDB) CheckTxnStatus(primaryKey []byte, lockTS, currentTS uin
 					err = errors.Trace(err)
 					return
 				}
-				return 0, 0, kvrpcpb.Action_TTLExpireRollback, nil
+				return 0, 0, kvrpcpb.Action_TTLExpireRollback, nil, nil
 			}
 
-			return lock.ttl, 0, action, nil
+			return lock.ttl, 0, action, nil, nil
 		}
 
 		// If current transaction's lock does not exist.
@@ -847,14 +1117,84 @@ func (mvcc *MVCCLevelDB) CheckTxnStatus(primaryKey []byte, lockTS, currentTS uin
 		if ok {
 			// If current transaction is already committed.
 			if c.valueType != typeRollback {
-				return 0, c.commitTS, action, nil
+				return 0, c.commitTS, action, nil, nil
 			}
 			// If current transaction is already rollback.
-			return 0, 0, kvrpcpb.Action_NoAction, nil
+			return 0, 0, kvrpcpb.Action_NoAction, nil, nil
+		}
+	}
+
+	return 0, 0, action, nil, nil
+}
+
+// CheckSecondaryLocks implements the MVCCStore interface. It returns the async commit locks of the transaction on
+// keys, or the commit ts of the transaction if any key is committed. A key which is neither locked nor committed is
+// rolled back, so that the transaction can't be committed by a late prewrite.
+func (mvcc *MVCCLevelDB) CheckSecondaryLocks(keys [][]byte, startTS uint64) ([]*kvrpcpb.LockInfo, uint64, error) {
+	mvcc.mu.Lock()
+	defer mvcc.mu.Unlock()
+
+	batch := &leveldb.Batch{}
+	var locks []*kvrpcpb.LockInfo
+	for _, key := range keys {
+		commitTS, err := checkSecondaryLock(mvcc.db, batch, key, startTS, &locks)
+		if err != nil {
+			return nil, 0, errors.Trace(err)
+		}
+		if commitTS > 0 {
+			return nil, commitTS, nil
+		}
+	}
+	return locks, 0, mvcc.db.Write(batch, nil)
+}
+
+func checkSecondaryLock(db *leveldb.DB, batch *leveldb.Batch, key []byte, startTS uint64, locks *[]*kvrpcpb.LockInfo) (uint64, error) {
+	iter := newIterator(db, &util.Range{
+		Start: mvccEncode(key, lockVer),
+	})
+	defer iter.Release()
+
+	dec := lockDecoder{
+		expectKey: key,
+	}
+	ok, err := dec.Decode(iter)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if ok && dec.lock.startTS == startTS {
+		if dec.lock.op != kvrpcpb.Op_PessimisticLock {
+			*locks = append(*locks, dec.lock.info(key))
+			return 0, nil
 		}
+		// The key was never prewritten, so the transaction can't have been committed.
+		return 0, errors.Trace(rollbackLock(batch, key, startTS))
 	}
 
-	return 0, 0, action, nil
+	c, committed, err := getTxnCommitInfo(iter, key, startTS)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if committed {
+		if c.valueType != typeRollback {
+			return c.commitTS, nil
+		}
+		return 0, nil
+	}
+	if ok {
+		// The key is locked by another transaction, only write the rollback record.
+		tomb := mvccValue{
+			valueType: typeRollback,
+			startTS:   startTS,
+			commitTS:  startTS,
+		}
+		writeValue, err := tomb.MarshalBinary()
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		batch.Put(mvccEncode(key, startTS), writeValue)
+		return 0, nil
+	}
+	return 0, errors.Trace(rollbackLock(batch, key, startTS))
 }
 
 // TxnHeartBeat implements the MVCCStore interface.
@@ -1086,7 +1426,33 @@ func (mvcc *MVCCLevelDB) GC(startKey, endKey []byte, safePoint uint64) error {
 
 // DeleteRange implements the MVCCStore interface.
 func (mvcc *MVCCLevelDB) DeleteRange(startKey, endKey []byte) error {
-	return mvcc.doRawDeleteRange(codec.EncodeBytes(nil, startKey), codec.EncodeBytes(nil, endKey))
+	var end []byte
+	if len(endKey) > 0 {
+		end = codec.EncodeBytes(nil, endKey)
+	}
+	return mvcc.doRawDeleteRange(codec.EncodeBytes(nil, startKey), end)
+}
+
+// Ingest implements the MVCCStore interface.
+func (mvcc *MVCCLevelDB) Ingest(pairs []Pair, ts uint64) error {
+	mvcc.mu.Lock()
+	defer mvcc.mu.Unlock()
+
+	batch := &leveldb.Batch{}
+	for _, pair := range pairs {
+		value := mvccValue{
+			valueType: typePut,
+			startTS:   ts,
+			commitTS:  ts,
+			value:     pair.Value,
+		}
+		writeValue, err := value.MarshalBinary()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		batch.Put(mvccEncode(pair.Key, ts), writeValue)
+	}
+	return mvcc.db.Write(batch, nil)
 }
 
 // Close calls leveldb's Close to free resources.
@@ -1095,18 +1461,19 @@ func (mvcc *MVCCLevelDB) Close() error {
 }
 
 // RawPut implements the RawKV interface.
-func (mvcc *MVCCLevelDB) RawPut(key, value []byte) {
+func (mvcc *MVCCLevelDB) RawPut(key, value []byte, ttl uint64) {
 	mvcc.mu.Lock()
 	defer mvcc.mu.Unlock()
 
 	if value == nil {
 		value = []byte{}
 	}
+	mvcc.setRawTTL(key, ttl)
 	terror.Log(mvcc.db.Put(key, value, nil))
 }
 
 // RawBatchPut implements the RawKV interface
-func (mvcc *MVCCLevelDB) RawBatchPut(keys, values [][]byte) {
+func (mvcc *MVCCLevelDB) RawBatchPut(keys, values [][]byte, ttl uint64) {
 	mvcc.mu.Lock()
 	defer mvcc.mu.Unlock()
 
@@ -1116,6 +1483,7 @@ func (mvcc *MVCCLevelDB) RawBatchPut(keys, values [][]byte) {
 		if value == nil {
 			value = []byte{}
 		}
+		mvcc.setRawTTL(key, ttl)
 		batch.Put(key, value)
 	}
 	terror.Log(mvcc.db.Write(batch, nil))
@@ -1126,9 +1494,7 @@ func (mvcc *MVCCLevelDB) RawGet(key []byte) []byte {
 	mvcc.mu.Lock()
 	defer mvcc.mu.Unlock()
 
-	ret, err := mvcc.db.Get(key, nil)
-	terror.Log(err)
-	return ret
+	return mvcc.rawGet(key)
 }
 
 // RawBatchGet implements the RawKV interface.
@@ -1138,18 +1504,49 @@ func (mvcc *MVCCLevelDB) RawBatchGet(keys [][]byte) [][]byte {
 
 	values := make([][]byte, 0, len(keys))
 	for _, key := range keys {
-		value, err := mvcc.db.Get(key, nil)
-		terror.Log(err)
-		values = append(values, value)
+		values = append(values, mvcc.rawGet(key))
 	}
 	return values
 }
 
+// RawGetKeyTTL implements the RawKV interface.
+func (mvcc *MVCCLevelDB) RawGetKeyTTL(key []byte) (uint64, bool) {
+	mvcc.mu.Lock()
+	defer mvcc.mu.Unlock()
+
+	if mvcc.rawGet(key) == nil {
+		return 0, false
+	}
+	expireTs, ok := mvcc.rawExpires[string(key)]
+	if !ok {
+		return 0, true
+	}
+	return expireTs - uint64(time.Now().Unix()), true
+}
+
+// RawCompareAndSwap implements the RawKV interface.
+func (mvcc *MVCCLevelDB) RawCompareAndSwap(key, previousValue, value []byte, previousNotExist bool, ttl uint64) ([]byte, bool) {
+	mvcc.mu.Lock()
+	defer mvcc.mu.Unlock()
+
+	current := mvcc.rawGet(key)
+	if previousNotExist != (current == nil) || current != nil && !bytes.Equal(current, previousValue) {
+		return current, false
+	}
+	if value == nil {
+		value = []byte{}
+	}
+	mvcc.setRawTTL(key, ttl)
+	terror.Log(mvcc.db.Put(key, value, nil))
+	return current, true
+}
+
 // RawDelete implements the RawKV interface.
 func (mvcc *MVCCLevelDB) RawDelete(key []byte) {
 	mvcc.mu.Lock()
 	defer mvcc.mu.Unlock()
 
+	delete(mvcc.rawExpires, string(key))
 	terror.Log(mvcc.db.Delete(key, nil))
 }
 
@@ -1160,6 +1557,7 @@ func (mvcc *MVCCLevelDB) RawBatchDelete(keys [][]byte) {
 
 	batch := &leveldb.Batch{}
 	for _, key := range keys {
+		delete(mvcc.rawExpires, string(key))
 		batch.Delete(key)
 	}
 	terror.Log(mvcc.db.Write(batch, nil))
@@ -1175,6 +1573,7 @@ func (mvcc *MVCCLevelDB) RawScan(startKey, endKey []byte, limit int) []Pair {
 	}, nil)
 
 	var pairs []Pair
+	now := uint64(time.Now().Unix())
 	for iter.Next() && len(pairs) < limit {
 		key := iter.Key()
 		value := iter.Value()
@@ -1182,6 +1581,9 @@ func (mvcc *MVCCLevelDB) RawScan(startKey, endKey []byte, limit int) []Pair {
 		if len(endKey) > 0 && bytes.Compare(key, endKey) >= 0 {
 			break
 		}
+		if mvcc.rawExpired(key, now) {
+			continue
+		}
 		pairs = append(pairs, Pair{
 			Key:   append([]byte{}, key...),
 			Value: append([]byte{}, value...),
@@ -1205,6 +1607,7 @@ func (mvcc *MVCCLevelDB) RawReverseScan(startKey, endKey []byte, limit int) []Pa
 	success := iter.Last()
 
 	var pairs []Pair
+	now := uint64(time.Now().Unix())
 	for success && len(pairs) < limit {
 		key := iter.Key()
 		value := iter.Value()
@@ -1212,16 +1615,47 @@ func (mvcc *MVCCLevelDB) RawReverseScan(startKey, endKey []byte, limit int) []Pa
 		if bytes.Compare(key, endKey) < 0 {
 			break
 		}
-		pairs = append(pairs, Pair{
-			Key:   append([]byte{}, key...),
-			Value: append([]byte{}, value...),
-			Err:   err,
-		})
+		if !mvcc.rawExpired(key, now) {
+			pairs = append(pairs, Pair{
+				Key:   append([]byte{}, key...),
+				Value: append([]byte{}, value...),
+				Err:   err,
+			})
+		}
 		success = iter.Prev()
 	}
 	return pairs
 }
 
+// rawGet reads the value of a raw key, an expired value is nil. The caller must hold mu.
+func (mvcc *MVCCLevelDB) rawGet(key []byte) []byte {
+	if mvcc.rawExpired(key, uint64(time.Now().Unix())) {
+		return nil
+	}
+	ret, err := mvcc.db.Get(key, nil)
+	if err != leveldb.ErrNotFound {
+		terror.Log(err)
+	}
+	return ret
+}
+
+// setRawTTL sets the time to live of a raw key which is being written, 0 means it never expires. The caller must hold
+// mu.
+func (mvcc *MVCCLevelDB) setRawTTL(key []byte, ttl uint64) {
+	if ttl == 0 {
+		delete(mvcc.rawExpires, string(key))
+		return
+	}
+	mvcc.rawExpires[string(key)] = uint64(time.Now().Unix()) + ttl
+}
+
+// rawExpired tells whether a raw key expired at now. Expired values are left in the store until they are overwritten
+// or deleted, reads skip them. The caller must hold mu.
+func (mvcc *MVCCLevelDB) rawExpired(key []byte, now uint64) bool {
+	expireTs, ok := mvcc.rawExpires[string(key)]
+	return ok && expireTs <= now
+}
+
 // RawDeleteRange implements the RawKV interface.
 func (mvcc *MVCCLevelDB) RawDeleteRange(startKey, endKey []byte) {
 	terror.Log(mvcc.doRawDeleteRange(startKey, endKey))
@@ -1239,6 +1673,7 @@ func (mvcc *MVCCLevelDB) doRawDeleteRange(startKey, endKey []byte) error {
 		Limit: endKey,
 	}, nil)
 	for iter.Next() {
+		delete(mvcc.rawExpires, string(iter.Key()))
 		batch.Delete(iter.Key())
 	}
 
@@ -1249,4 +1684,5 @@ var valueTypeOpMap = [...]kvrpcpb.Op{
 	typePut:      kvrpcpb.Op_Put,
 	typeDelete:   kvrpcpb.Op_Del,
 	typeRollback: kvrpcpb.Op_Rollback,
+	typeLock:     kvrpcpb.Op_Lock,
 }
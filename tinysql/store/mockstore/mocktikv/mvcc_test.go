@@ -32,3 +32,30 @@ func (s testMvccSuite) TestRegionContains(c *C) {
 	c.Check(regionContains([]byte{1, 1, 1}, []byte{2, 2, 2}, []byte{2, 2, 1}), IsTrue)
 	c.Check(regionContains([]byte{1, 1, 1}, []byte{2, 2, 2}, []byte{2, 2, 2}), IsFalse)
 }
+
+func (s testMvccSuite) TestRawExpire(c *C) {
+	store, err := NewMVCCLevelDB("")
+	c.Assert(err, IsNil)
+	defer store.Close()
+
+	store.RawBatchPut([][]byte{[]byte("a"), []byte("b"), []byte("c")}, [][]byte{[]byte("1"), []byte("2"), []byte("3")}, 100)
+	// Make b expire a second ago.
+	store.rawExpires["b"] -= 101
+
+	c.Check(store.RawBatchGet([][]byte{[]byte("a"), []byte("b"), []byte("c")}), DeepEquals, [][]byte{[]byte("1"), nil, []byte("3")})
+	pairs := store.RawScan(nil, nil, 10)
+	c.Assert(pairs, HasLen, 2)
+	c.Check(pairs[0].Key, BytesEquals, []byte("a"))
+	c.Check(pairs[1].Key, BytesEquals, []byte("c"))
+	c.Check(store.RawReverseScan(nil, nil, 10), HasLen, 2)
+	_, found := store.RawGetKeyTTL([]byte("b"))
+	c.Check(found, IsFalse)
+
+	// An expired key doesn't exist for a compare and swap, which gives it a value that never expires.
+	previous, succeed := store.RawCompareAndSwap([]byte("b"), nil, []byte("4"), true, 0)
+	c.Check(succeed, IsTrue)
+	c.Check(previous, IsNil)
+	ttl, found := store.RawGetKeyTTL([]byte("b"))
+	c.Check(found, IsTrue)
+	c.Check(ttl, Equals, uint64(0))
+}
@@ -14,12 +14,17 @@
 package mocktikv
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io"
+	"math"
+	"path/filepath"
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/pingcap-incubator/tinykv/kv/backup"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
@@ -51,6 +56,7 @@ func convertToKeyError(err error) *kvrpcpb.KeyError {
 				PrimaryLock: locked.Primary,
 				LockVersion: locked.StartTS,
 				LockTtl:     locked.TTL,
+				LockType:    locked.LockType,
 			},
 		}
 	}
@@ -63,6 +69,13 @@ func convertToKeyError(err error) *kvrpcpb.KeyError {
 			},
 		}
 	}
+	if alreadyExist, ok := errors.Cause(err).(*ErrKeyAlreadyExist); ok {
+		return &kvrpcpb.KeyError{
+			AlreadyExist: &kvrpcpb.AlreadyExist{
+				Key: alreadyExist.Key,
+			},
+		}
+	}
 	if retryable, ok := errors.Cause(err).(ErrRetryable); ok {
 		return &kvrpcpb.KeyError{
 			Retryable: retryable.Error(),
@@ -163,8 +176,9 @@ func (h *rpcHandler) checkRequestContext(ctx *kvrpcpb.Context) *errorpb.Error {
 			},
 		}
 	}
-	// The Peer on the Store is not leader.
-	if storePeer.GetId() != leaderPeer.GetId() {
+	// The Peer on the Store is not leader, followers serve replica reads and stale reads. The mock cluster
+	// applies everything at once, so the followers have always caught up.
+	if storePeer.GetId() != leaderPeer.GetId() && !ctx.GetReplicaRead() && !ctx.GetStaleRead() {
 		return &errorpb.Error{
 			Message: *proto.String("not leader"),
 			NotLeader: &errorpb.NotLeader{
@@ -215,12 +229,36 @@ func (h *rpcHandler) handleKvGet(req *kvrpcpb.GetRequest) *kvrpcpb.GetResponse {
 	}
 }
 
+func (h *rpcHandler) handleKvBatchGet(req *kvrpcpb.BatchGetRequest) *kvrpcpb.BatchGetResponse {
+	for _, k := range req.Keys {
+		if !h.checkKeyInRegion(k) {
+			panic("KvBatchGet: key not in region")
+		}
+	}
+	pairs := h.mvccStore.BatchGet(req.Keys, req.GetVersion())
+	return &kvrpcpb.BatchGetResponse{
+		Pairs: convertToPbPairs(pairs),
+	}
+}
+
 func (h *rpcHandler) handleKvScan(req *kvrpcpb.ScanRequest) *kvrpcpb.ScanResponse {
 	endKey := MvccKey(h.endKey).Raw()
-	if !h.checkKeyInRegion(req.GetStartKey()) {
-		panic("KvScan: startKey not in region")
+	var pairs []Pair
+	if !req.GetReverse() {
+		if !h.checkKeyInRegion(req.GetStartKey()) {
+			panic("KvScan: startKey not in region")
+		}
+		pairs = h.mvccStore.Scan(req.GetStartKey(), endKey, int(req.GetLimit()), req.GetVersion())
+	} else {
+		// A reverse scan reads [EndKey, StartKey), so EndKey is the key that must be in the region.
+		if !h.checkKeyInRegion(req.GetEndKey()) {
+			panic("KvScan: endKey not in region")
+		}
+		if len(req.GetStartKey()) > 0 && (len(endKey) == 0 || bytes.Compare(req.GetStartKey(), endKey) < 0) {
+			endKey = req.GetStartKey()
+		}
+		pairs = h.mvccStore.ReverseScan(req.GetEndKey(), endKey, int(req.GetLimit()), req.GetVersion())
 	}
-	pairs := h.mvccStore.Scan(req.GetStartKey(), endKey, int(req.GetLimit()), req.GetVersion())
 
 	return &kvrpcpb.ScanResponse{
 		Pairs: convertToPbPairs(pairs),
@@ -233,8 +271,38 @@ func (h *rpcHandler) handleKvPrewrite(req *kvrpcpb.PrewriteRequest) *kvrpcpb.Pre
 			panic("KvPrewrite: key not in region")
 		}
 	}
-	errs := h.mvccStore.Prewrite(req)
-	return &kvrpcpb.PrewriteResponse{
+	commitTS, errs := h.mvccStore.Prewrite(req)
+	resp := &kvrpcpb.PrewriteResponse{
+		Errors: convertToKeyErrors(errs),
+	}
+	if req.TryOnePc {
+		resp.OnePcCommitTs = commitTS
+	} else if req.UseAsyncCommit {
+		resp.MinCommitTs = commitTS
+	}
+	return resp
+}
+
+func (h *rpcHandler) handleKvPessimisticLock(req *kvrpcpb.PessimisticLockRequest) *kvrpcpb.PessimisticLockResponse {
+	for _, m := range req.Mutations {
+		if !h.checkKeyInRegion(m.Key) {
+			panic("KvPessimisticLock: key not in region")
+		}
+	}
+	errs := h.mvccStore.PessimisticLock(req)
+	return &kvrpcpb.PessimisticLockResponse{
+		Errors: convertToKeyErrors(errs),
+	}
+}
+
+func (h *rpcHandler) handleKvPessimisticRollback(req *kvrpcpb.PessimisticRollbackRequest) *kvrpcpb.PessimisticRollbackResponse {
+	for _, k := range req.Keys {
+		if !h.checkKeyInRegion(k) {
+			panic("KvPessimisticRollback: key not in region")
+		}
+	}
+	errs := h.mvccStore.PessimisticRollback(req.Keys, req.StartVersion, req.ForUpdateTs)
+	return &kvrpcpb.PessimisticRollbackResponse{
 		Errors: convertToKeyErrors(errs),
 	}
 }
@@ -258,14 +326,42 @@ func (h *rpcHandler) handleKvCheckTxnStatus(req *kvrpcpb.CheckTxnStatusRequest)
 		panic("KvCheckTxnStatus: key not in region")
 	}
 	var resp kvrpcpb.CheckTxnStatusResponse
-	ttl, commitTS, action, err := h.mvccStore.CheckTxnStatus(req.GetPrimaryKey(), req.GetLockTs(), req.GetCurrentTs())
+	ttl, commitTS, action, lockInfo, err := h.mvccStore.CheckTxnStatus(req.GetPrimaryKey(), req.GetLockTs(), req.GetCurrentTs(), req.GetForceSyncCommit())
 	if err != nil {
 		return nil, err
 	}
-	resp.LockTtl, resp.CommitVersion, resp.Action = ttl, commitTS, action
+	resp.LockTtl, resp.CommitVersion, resp.Action, resp.LockInfo = ttl, commitTS, action, lockInfo
 	return &resp, nil
 }
 
+func (h *rpcHandler) handleKvCheckSecondaryLocks(req *kvrpcpb.CheckSecondaryLocksRequest) *kvrpcpb.CheckSecondaryLocksResponse {
+	for _, k := range req.Keys {
+		if !h.checkKeyInRegion(k) {
+			panic("KvCheckSecondaryLocks: key not in region")
+		}
+	}
+	var resp kvrpcpb.CheckSecondaryLocksResponse
+	locks, commitTS, err := h.mvccStore.CheckSecondaryLocks(req.Keys, req.StartVersion)
+	if err != nil {
+		resp.Error = convertToKeyError(err)
+	}
+	resp.Locks, resp.CommitTs = locks, commitTS
+	return &resp
+}
+
+func (h *rpcHandler) handleTxnHeartBeat(req *kvrpcpb.TxnHeartBeatRequest) *kvrpcpb.TxnHeartBeatResponse {
+	if !h.checkKeyInRegion(req.PrimaryLock) {
+		panic("KvTxnHeartBeat: key not in region")
+	}
+	var resp kvrpcpb.TxnHeartBeatResponse
+	ttl, err := h.mvccStore.TxnHeartBeat(req.PrimaryLock, req.StartVersion, req.AdviseLockTtl)
+	if err != nil {
+		resp.Error = convertToKeyError(err)
+	}
+	resp.LockTtl = ttl
+	return &resp
+}
+
 func (h *rpcHandler) handleKvBatchRollback(req *kvrpcpb.BatchRollbackRequest) *kvrpcpb.BatchRollbackResponse {
 	err := h.mvccStore.Rollback(req.Keys, req.StartVersion)
 	if err != nil {
@@ -288,6 +384,152 @@ func (h *rpcHandler) handleKvResolveLock(req *kvrpcpb.ResolveLockRequest) *kvrpc
 	return &kvrpcpb.ResolveLockResponse{}
 }
 
+func (h *rpcHandler) handleKvScanLock(req *kvrpcpb.ScanLockRequest) *kvrpcpb.ScanLockResponse {
+	startKey := MvccKey(h.startKey).Raw()
+	if bytes.Compare(req.GetStartKey(), startKey) > 0 {
+		startKey = req.GetStartKey()
+	}
+	endKey := MvccKey(h.endKey).Raw()
+	locks, err := h.mvccStore.ScanLock(startKey, endKey, req.GetMaxVersion())
+	if err != nil {
+		return &kvrpcpb.ScanLockResponse{
+			Error: convertToKeyError(err),
+		}
+	}
+	if limit := int(req.GetLimit()); limit > 0 && len(locks) > limit {
+		locks = locks[:limit]
+	}
+	return &kvrpcpb.ScanLockResponse{
+		Locks: locks,
+	}
+}
+
+func (h *rpcHandler) handleKvGC(req *kvrpcpb.GCRequest) *kvrpcpb.GCResponse {
+	startKey := MvccKey(h.startKey).Raw()
+	if bytes.Compare(req.GetStartKey(), startKey) > 0 {
+		startKey = req.GetStartKey()
+	}
+	endKey := MvccKey(h.endKey).Raw()
+	// The whole region is collected at once, so there is never a next key.
+	err := h.mvccStore.GC(startKey, endKey, req.GetSafePoint())
+	if err != nil {
+		return &kvrpcpb.GCResponse{
+			Error: convertToKeyError(err),
+		}
+	}
+	return &kvrpcpb.GCResponse{}
+}
+
+func (h *rpcHandler) handleKvDeleteRange(req *kvrpcpb.DeleteRangeRequest) *kvrpcpb.DeleteRangeResponse {
+	startKey := MvccKey(h.startKey).Raw()
+	if bytes.Compare(req.GetStartKey(), startKey) > 0 {
+		startKey = req.GetStartKey()
+	}
+	endKey := MvccKey(h.endKey).Raw()
+	if len(req.GetEndKey()) > 0 && (len(endKey) == 0 || bytes.Compare(req.GetEndKey(), endKey) < 0) {
+		endKey = req.GetEndKey()
+	}
+	err := h.mvccStore.DeleteRange(startKey, endKey)
+	if err != nil {
+		return &kvrpcpb.DeleteRangeResponse{
+			Error: err.Error(),
+		}
+	}
+	return &kvrpcpb.DeleteRangeResponse{}
+}
+
+func (h *rpcHandler) handleBackup(req *kvrpcpb.BackupRequest) *kvrpcpb.BackupResponse {
+	if !h.checkKeyInRegion(req.GetStartKey()) {
+		panic("Backup: startKey not in region")
+	}
+	endKey := MvccKey(h.endKey).Raw()
+	if len(req.GetEndKey()) > 0 && (len(endKey) == 0 || bytes.Compare(req.GetEndKey(), endKey) < 0) {
+		endKey = req.GetEndKey()
+	}
+	pairs := h.mvccStore.Scan(req.GetStartKey(), endKey, math.MaxInt64, req.GetBackupTs())
+	if len(pairs) == 0 {
+		return &kvrpcpb.BackupResponse{}
+	}
+	name := fmt.Sprintf("%d_%x.sst", req.GetContext().GetRegionId(), sha256.Sum256(req.GetStartKey()))
+	w, err := backup.CreateFile(filepath.Join(req.GetPath(), name))
+	if err != nil {
+		return &kvrpcpb.BackupResponse{OtherError: err.Error()}
+	}
+	var checksum backup.Checksum
+	for _, pair := range pairs {
+		if pair.Err == nil {
+			err = w.Add(pair.Key, pair.Value)
+		} else if keyError := convertToKeyError(pair.Err); keyError.Locked != nil {
+			w.Abort()
+			return &kvrpcpb.BackupResponse{Locked: keyError.Locked}
+		} else {
+			err = pair.Err
+		}
+		if err != nil {
+			w.Abort()
+			return &kvrpcpb.BackupResponse{OtherError: err.Error()}
+		}
+		checksum.Update(pair.Key, pair.Value)
+	}
+	if err = w.Finish(); err != nil {
+		w.Abort()
+		return &kvrpcpb.BackupResponse{OtherError: err.Error()}
+	}
+	return &kvrpcpb.BackupResponse{
+		Files: []*kvrpcpb.BackupFile{{
+			Name:       name,
+			StartKey:   req.GetStartKey(),
+			EndKey:     req.GetEndKey(),
+			Crc64Xor:   checksum.Crc64Xor,
+			TotalKvs:   checksum.TotalKvs,
+			TotalBytes: checksum.TotalBytes,
+		}},
+	}
+}
+
+func (h *rpcHandler) handleRestore(req *kvrpcpb.RestoreRequest) *kvrpcpb.RestoreResponse {
+	if filepath.Base(req.GetName()) != req.GetName() {
+		return &kvrpcpb.RestoreResponse{Error: fmt.Sprintf("invalid backup file name %q", req.GetName())}
+	}
+	r, err := backup.OpenFile(filepath.Join(req.GetPath(), req.GetName()))
+	if err != nil {
+		return &kvrpcpb.RestoreResponse{Error: err.Error()}
+	}
+	startKey := MvccKey(h.startKey).Raw()
+	if bytes.Compare(req.GetStartKey(), startKey) > 0 {
+		startKey = req.GetStartKey()
+	}
+	endKey := MvccKey(h.endKey).Raw()
+	if len(req.GetEndKey()) > 0 && (len(endKey) == 0 || bytes.Compare(req.GetEndKey(), endKey) < 0) {
+		endKey = req.GetEndKey()
+	}
+	var checksum backup.Checksum
+	var pairs []Pair
+	for {
+		key, value, err := r.Next()
+		if err != nil {
+			return &kvrpcpb.RestoreResponse{Error: err.Error()}
+		}
+		if key == nil {
+			break
+		}
+		newKey := backup.RewriteKey(key, req.GetRewriteRules())
+		if bytes.Compare(newKey, startKey) < 0 || (len(endKey) > 0 && bytes.Compare(newKey, endKey) >= 0) {
+			continue
+		}
+		checksum.Update(key, value)
+		pairs = append(pairs, Pair{Key: newKey, Value: value})
+	}
+	if err = h.mvccStore.Ingest(pairs, req.GetRestoreTs()); err != nil {
+		return &kvrpcpb.RestoreResponse{Error: err.Error()}
+	}
+	return &kvrpcpb.RestoreResponse{
+		Crc64Xor:   checksum.Crc64Xor,
+		TotalKvs:   checksum.TotalKvs,
+		TotalBytes: checksum.TotalBytes,
+	}
+}
+
 func (h *rpcHandler) handleKvRawGet(req *kvrpcpb.RawGetRequest) *kvrpcpb.RawGetResponse {
 	rawKV, ok := h.mvccStore.(RawKV)
 	if !ok {
@@ -300,6 +542,25 @@ func (h *rpcHandler) handleKvRawGet(req *kvrpcpb.RawGetRequest) *kvrpcpb.RawGetR
 	}
 }
 
+func (h *rpcHandler) handleKvRawBatchGet(req *kvrpcpb.RawBatchGetRequest) *kvrpcpb.RawBatchGetResponse {
+	rawKV, ok := h.mvccStore.(RawKV)
+	if !ok {
+		return &kvrpcpb.RawBatchGetResponse{
+			Error: "not implemented",
+		}
+	}
+	values := rawKV.RawBatchGet(req.GetKeys())
+	pairs := make([]*kvrpcpb.KvPair, 0, len(values))
+	for i, value := range values {
+		if value != nil {
+			pairs = append(pairs, &kvrpcpb.KvPair{Key: req.Keys[i], Value: value})
+		}
+	}
+	return &kvrpcpb.RawBatchGetResponse{
+		Pairs: pairs,
+	}
+}
+
 func (h *rpcHandler) handleKvRawPut(req *kvrpcpb.RawPutRequest) *kvrpcpb.RawPutResponse {
 	rawKV, ok := h.mvccStore.(RawKV)
 	if !ok {
@@ -307,10 +568,27 @@ func (h *rpcHandler) handleKvRawPut(req *kvrpcpb.RawPutRequest) *kvrpcpb.RawPutR
 			Error: "not implemented",
 		}
 	}
-	rawKV.RawPut(req.GetKey(), req.GetValue())
+	rawKV.RawPut(req.GetKey(), req.GetValue(), req.GetTtl())
 	return &kvrpcpb.RawPutResponse{}
 }
 
+func (h *rpcHandler) handleKvRawBatchPut(req *kvrpcpb.RawBatchPutRequest) *kvrpcpb.RawBatchPutResponse {
+	rawKV, ok := h.mvccStore.(RawKV)
+	if !ok {
+		return &kvrpcpb.RawBatchPutResponse{
+			Error: "not implemented",
+		}
+	}
+	keys := make([][]byte, 0, len(req.Pairs))
+	values := make([][]byte, 0, len(req.Pairs))
+	for _, pair := range req.Pairs {
+		keys = append(keys, pair.Key)
+		values = append(values, pair.Value)
+	}
+	rawKV.RawBatchPut(keys, values, req.GetTtl())
+	return &kvrpcpb.RawBatchPutResponse{}
+}
+
 func (h *rpcHandler) handleKvRawDelete(req *kvrpcpb.RawDeleteRequest) *kvrpcpb.RawDeleteResponse {
 	rawKV, ok := h.mvccStore.(RawKV)
 	if !ok {
@@ -322,6 +600,33 @@ func (h *rpcHandler) handleKvRawDelete(req *kvrpcpb.RawDeleteRequest) *kvrpcpb.R
 	return &kvrpcpb.RawDeleteResponse{}
 }
 
+func (h *rpcHandler) handleKvRawBatchDelete(req *kvrpcpb.RawBatchDeleteRequest) *kvrpcpb.RawBatchDeleteResponse {
+	rawKV, ok := h.mvccStore.(RawKV)
+	if !ok {
+		return &kvrpcpb.RawBatchDeleteResponse{
+			Error: "not implemented",
+		}
+	}
+	rawKV.RawBatchDelete(req.GetKeys())
+	return &kvrpcpb.RawBatchDeleteResponse{}
+}
+
+func (h *rpcHandler) handleKvRawDeleteRange(req *kvrpcpb.RawDeleteRangeRequest) *kvrpcpb.RawDeleteRangeResponse {
+	rawKV, ok := h.mvccStore.(RawKV)
+	if !ok {
+		return &kvrpcpb.RawDeleteRangeResponse{
+			Error: "not implemented",
+		}
+	}
+	// The keys of a region which serves raw requests aren't encoded.
+	endKey := h.endKey
+	if len(req.GetEndKey()) > 0 && (len(endKey) == 0 || bytes.Compare(req.GetEndKey(), endKey) < 0) {
+		endKey = req.GetEndKey()
+	}
+	rawKV.RawDeleteRange(req.GetStartKey(), endKey)
+	return &kvrpcpb.RawDeleteRangeResponse{}
+}
+
 func (h *rpcHandler) handleKvRawScan(req *kvrpcpb.RawScanRequest) *kvrpcpb.RawScanResponse {
 	rawKV, ok := h.mvccStore.(RawKV)
 	if !ok {
@@ -333,19 +638,60 @@ func (h *rpcHandler) handleKvRawScan(req *kvrpcpb.RawScanRequest) *kvrpcpb.RawSc
 		}
 	}
 
+	// The scan stays in the region, whose keys aren't encoded. A reverse scan reads [EndKey, StartKey) from StartKey
+	// down.
+	startKey, endKey := h.startKey, h.endKey
 	var pairs []Pair
-	upperBound := h.endKey
-	pairs = rawKV.RawScan(
-		req.StartKey,
-		upperBound,
-		int(req.GetLimit()),
-	)
+	if !req.GetReverse() {
+		if len(req.GetEndKey()) > 0 && (len(endKey) == 0 || bytes.Compare(req.GetEndKey(), endKey) < 0) {
+			endKey = req.GetEndKey()
+		}
+		pairs = rawKV.RawScan(req.GetStartKey(), endKey, int(req.GetLimit()))
+	} else {
+		if len(req.GetStartKey()) > 0 && (len(endKey) == 0 || bytes.Compare(req.GetStartKey(), endKey) < 0) {
+			endKey = req.GetStartKey()
+		}
+		if bytes.Compare(req.GetEndKey(), startKey) > 0 {
+			startKey = req.GetEndKey()
+		}
+		pairs = rawKV.RawReverseScan(endKey, startKey, int(req.GetLimit()))
+	}
 
 	return &kvrpcpb.RawScanResponse{
 		Kvs: convertToPbPairs(pairs),
 	}
 }
 
+func (h *rpcHandler) handleKvRawCompareAndSwap(req *kvrpcpb.RawCASRequest) *kvrpcpb.RawCASResponse {
+	rawKV, ok := h.mvccStore.(RawKV)
+	if !ok {
+		return &kvrpcpb.RawCASResponse{
+			Error: "not implemented",
+		}
+	}
+	previous, succeed := rawKV.RawCompareAndSwap(req.GetKey(), req.GetPreviousValue(), req.GetValue(),
+		req.GetPreviousNotExist(), req.GetTtl())
+	return &kvrpcpb.RawCASResponse{
+		Succeed:          succeed,
+		PreviousNotExist: previous == nil,
+		PreviousValue:    previous,
+	}
+}
+
+func (h *rpcHandler) handleKvRawGetKeyTTL(req *kvrpcpb.RawGetKeyTTLRequest) *kvrpcpb.RawGetKeyTTLResponse {
+	rawKV, ok := h.mvccStore.(RawKV)
+	if !ok {
+		return &kvrpcpb.RawGetKeyTTLResponse{
+			Error: "not implemented",
+		}
+	}
+	ttl, found := rawKV.RawGetKeyTTL(req.GetKey())
+	return &kvrpcpb.RawGetKeyTTLResponse{
+		Ttl:      ttl,
+		NotFound: !found,
+	}
+}
+
 // RPCClient sends kv RPC calls to mock cluster. RPCClient mocks the behavior of
 // a rpc client at tikv's side.
 type RPCClient struct {
@@ -422,6 +768,13 @@ func (c *RPCClient) SendRequest(ctx context.Context, addr string, req *tikvrpc.R
 			return resp, nil
 		}
 		resp.Resp = handler.handleKvScan(r)
+	case tikvrpc.CmdBatchGet:
+		r := req.BatchGet()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.BatchGetResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvBatchGet(r)
 
 	case tikvrpc.CmdPrewrite:
 		failpoint.Inject("rpcPrewriteResult", func(val failpoint.Value) {
@@ -439,6 +792,20 @@ func (c *RPCClient) SendRequest(ctx context.Context, addr string, req *tikvrpc.R
 			return resp, nil
 		}
 		resp.Resp = handler.handleKvPrewrite(r)
+	case tikvrpc.CmdPessimisticLock:
+		r := req.PessimisticLock()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.PessimisticLockResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvPessimisticLock(r)
+	case tikvrpc.CmdPessimisticRollback:
+		r := req.PessimisticRollback()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.PessimisticRollbackResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvPessimisticRollback(r)
 	case tikvrpc.CmdCommit:
 		failpoint.Inject("rpcCommitResult", func(val failpoint.Value) {
 			switch val.(string) {
@@ -474,6 +841,20 @@ func (c *RPCClient) SendRequest(ctx context.Context, addr string, req *tikvrpc.R
 		}
 		resp.Resp, err = handler.handleKvCheckTxnStatus(r)
 		return resp, err
+	case tikvrpc.CmdTxnHeartBeat:
+		r := req.TxnHeartBeat()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.TxnHeartBeatResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleTxnHeartBeat(r)
+	case tikvrpc.CmdCheckSecondaryLocks:
+		r := req.CheckSecondaryLocks()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.CheckSecondaryLocksResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvCheckSecondaryLocks(r)
 	case tikvrpc.CmdBatchRollback:
 		r := req.BatchRollback()
 		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
@@ -488,6 +869,41 @@ func (c *RPCClient) SendRequest(ctx context.Context, addr string, req *tikvrpc.R
 			return resp, nil
 		}
 		resp.Resp = handler.handleKvResolveLock(r)
+	case tikvrpc.CmdScanLock:
+		r := req.ScanLock()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.ScanLockResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvScanLock(r)
+	case tikvrpc.CmdGC:
+		r := req.GC()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.GCResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvGC(r)
+	case tikvrpc.CmdDeleteRange:
+		r := req.DeleteRange()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.DeleteRangeResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvDeleteRange(r)
+	case tikvrpc.CmdBackup:
+		r := req.Backup()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.BackupResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleBackup(r)
+	case tikvrpc.CmdRestore:
+		r := req.Restore()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.RestoreResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleRestore(r)
 	case tikvrpc.CmdRawGet:
 		r := req.RawGet()
 		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
@@ -516,6 +932,48 @@ func (c *RPCClient) SendRequest(ctx context.Context, addr string, req *tikvrpc.R
 			return resp, nil
 		}
 		resp.Resp = handler.handleKvRawScan(r)
+	case tikvrpc.CmdRawBatchGet:
+		r := req.RawBatchGet()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.RawBatchGetResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvRawBatchGet(r)
+	case tikvrpc.CmdRawBatchPut:
+		r := req.RawBatchPut()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.RawBatchPutResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvRawBatchPut(r)
+	case tikvrpc.CmdRawBatchDelete:
+		r := req.RawBatchDelete()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.RawBatchDeleteResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvRawBatchDelete(r)
+	case tikvrpc.CmdRawDeleteRange:
+		r := req.RawDeleteRange()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.RawDeleteRangeResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvRawDeleteRange(r)
+	case tikvrpc.CmdRawCompareAndSwap:
+		r := req.RawCompareAndSwap()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.RawCASResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvRawCompareAndSwap(r)
+	case tikvrpc.CmdRawGetKeyTTL:
+		r := req.RawGetKeyTTL()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.RawGetKeyTTLResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvRawGetKeyTTL(r)
 	case tikvrpc.CmdCop:
 		r := req.Cop()
 		if err := handler.checkRequestContext(reqCtx); err != nil {
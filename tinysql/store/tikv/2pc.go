@@ -19,12 +19,14 @@ import (
 	"github.com/pingcap/tidb/parser/terror"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	pb "github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/store/tikv/oracle"
 	"github.com/pingcap/tidb/store/tikv/tikvrpc"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/util/logutil"
@@ -39,11 +41,17 @@ type twoPhaseCommitAction interface {
 type actionPrewrite struct{}
 type actionCommit struct{}
 type actionCleanup struct{}
+type actionPessimisticLock struct {
+	*kv.LockCtx
+}
+type actionPessimisticRollback struct{}
 
 var (
 	_ twoPhaseCommitAction = actionPrewrite{}
 	_ twoPhaseCommitAction = actionCommit{}
 	_ twoPhaseCommitAction = actionCleanup{}
+	_ twoPhaseCommitAction = actionPessimisticLock{}
+	_ twoPhaseCommitAction = actionPessimisticRollback{}
 )
 
 // Global variable set by config file.
@@ -63,6 +71,14 @@ func (actionCleanup) String() string {
 	return "cleanup"
 }
 
+func (actionPessimisticLock) String() string {
+	return "pessimistic_lock"
+}
+
+func (actionPessimisticRollback) String() string {
+	return "pessimistic_rollback"
+}
+
 // twoPhaseCommitter executes a two-phase commit protocol.
 type twoPhaseCommitter struct {
 	store     *TinykvStore
@@ -78,12 +94,30 @@ type twoPhaseCommitter struct {
 
 	primaryKey []byte
 
+	// isPessimistic is true for pessimistic transactions, which lock keys with pessimistic locks
+	// before the prewrite. forUpdateTS is the for_update_ts of the latest pessimistic lock request.
+	isPessimistic bool
+	forUpdateTS   uint64
+
+	// useAsyncCommit and useOnePC are set before the prewrite and reset if a prewrite falls back to
+	// the normal two phase commit, they are accessed atomically. maxCommitTS is the largest commit ts
+	// TinyKV may calculate for them, the schema is checked to be valid until then.
+	useAsyncCommit uint32
+	useOnePC       uint32
+	maxCommitTS    uint64
+
 	mu struct {
 		sync.RWMutex
 		undeterminedErr error // undeterminedErr saves the rpc error we encounter when commit primary key.
 		committed       bool
+		// minCommitTS is the largest min_commit_ts of the async commit prewrite responses.
+		minCommitTS uint64
+		// onePCCommitTS is the commit ts of the 1PC prewrite.
+		onePCCommitTS uint64
 	}
 	regionTxnSize map[uint64]int // regionTxnSize stores the number of keys involved in each region
+
+	ttlManager ttlManager
 }
 
 // batchExecutor is txn controller providing rate control like utils
@@ -108,6 +142,7 @@ func newTwoPhaseCommitter(txn *tikvTxn, connID uint64) (*twoPhaseCommitter, erro
 		startTS:       txn.StartTS(),
 		connID:        connID,
 		regionTxnSize: map[uint64]int{},
+		isPessimistic: txn.IsPessimistic(),
 	}, nil
 }
 
@@ -133,6 +168,10 @@ func (c *twoPhaseCommitter) initKeysAndMutations() error {
 			// `len(v) > 0` means it's a put operation.
 			// YOUR CODE HERE (lab2). -- Done
 			mutations[string(k)] = &mutationEx{pb.Mutation{Op: pb.Op_Put, Key: k, Value: v}} // 这里不能用  k.String() 方法，会在字符串后面添加一个字节的结束符！！！！！\
+			if txn.us.GetKeyExistErrInfo(k) != nil {
+				// The key was presumed not to exist, the prewrite checks it.
+				mutations[string(k)].Op = pb.Op_Insert
+			}
 			size += len(k) + len(v)
 		} else {
 			// `len(v) == 0` means it's a delete operation.
@@ -174,6 +213,16 @@ func (c *twoPhaseCommitter) initKeysAndMutations() error {
 	if len(keys) == 0 {
 		return nil
 	}
+	// The primary key of a pessimistic transaction is chosen when the first key is locked, it has to be the
+	// first one so that it is committed before the secondary keys.
+	if len(c.primaryKey) > 0 {
+		for i, k := range keys {
+			if bytes.Equal(k, c.primaryKey) {
+				keys[0], keys[i] = keys[i], keys[0]
+				break
+			}
+		}
+	}
 	c.txnSize = size
 
 	if size > int(kv.TxnTotalSizeLimit) {
@@ -242,6 +291,130 @@ func txnLockTTL(startTime time.Time, txnSize int) uint64 {
 	return lockTTL + uint64(elapsed)
 }
 
+// ttlManagerRunThreshold is the size above which a transaction keeps its primary lock alive while it prewrites and
+// commits, smaller transactions finish well within the TTL given by txnLockTTL.
+const ttlManagerRunThreshold = 32 * 1024 * 1024
+
+type ttlManagerState uint32
+
+const (
+	stateUninitialized ttlManagerState = iota
+	stateRunning
+	stateClosed
+)
+
+// ttlManager keeps the primary lock of a long-running transaction alive by sending TxnHeartBeat requests, so that
+// other transactions don't roll it back with CheckTxnStatus. It runs from the time the primary key is locked until
+// the primary key is committed or the transaction is rolled back.
+type ttlManager struct {
+	state   ttlManagerState
+	ch      chan struct{}
+	lockCtx *kv.LockCtx
+}
+
+func (tm *ttlManager) run(c *twoPhaseCommitter, lockCtx *kv.LockCtx) {
+	// Run only once.
+	if !atomic.CompareAndSwapUint32((*uint32)(&tm.state), uint32(stateUninitialized), uint32(stateRunning)) {
+		return
+	}
+	tm.ch = make(chan struct{})
+	tm.lockCtx = lockCtx
+	go tm.keepAlive(c)
+}
+
+func (tm *ttlManager) close() {
+	if !atomic.CompareAndSwapUint32((*uint32)(&tm.state), uint32(stateRunning), uint32(stateClosed)) {
+		return
+	}
+	close(tm.ch)
+}
+
+func (tm *ttlManager) keepAlive(c *twoPhaseCommitter) {
+	// Ticker is set to 1/2 of the ManagedLockTTL.
+	ticker := time.NewTicker(time.Duration(atomic.LoadUint64(&ManagedLockTTL)) * time.Millisecond / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-tm.ch:
+			return
+		case <-ticker.C:
+			// If kill signal is received, the ttlManager should exit.
+			if tm.lockCtx != nil && tm.lockCtx.Killed != nil && atomic.LoadUint32(tm.lockCtx.Killed) != 0 {
+				return
+			}
+			bo := NewBackoffer(context.Background(), pessimisticLockMaxBackoff).WithVars(c.txn.vars)
+			now, err := c.store.GetOracle().GetTimestamp(bo.ctx)
+			if err != nil {
+				err1 := bo.Backoff(BoPDRPC, err)
+				if err1 != nil {
+					logutil.Logger(bo.ctx).Warn("keepAlive get tso fail",
+						zap.Error(err))
+					return
+				}
+				continue
+			}
+
+			uptime := uint64(oracle.ExtractPhysical(now) - oracle.ExtractPhysical(c.startTS))
+			if uptime > kv.MaxTxnTimeUse {
+				// Checks maximum lifetime for the ttlManager, so when something goes wrong
+				// the key will not be locked forever.
+				logutil.Logger(bo.ctx).Info("ttlManager live up to its lifetime",
+					zap.Uint64("txnStartTS", c.startTS),
+					zap.Uint64("uptime", uptime))
+				return
+			}
+
+			newTTL := uptime + atomic.LoadUint64(&ManagedLockTTL)
+			logutil.Logger(bo.ctx).Info("send TxnHeartBeat",
+				zap.Uint64("startTS", c.startTS), zap.Uint64("newTTL", newTTL))
+			_, err = sendTxnHeartBeat(bo, c.store, c.primary(), c.startTS, newTTL)
+			if err != nil {
+				logutil.Logger(bo.ctx).Warn("send TxnHeartBeat failed",
+					zap.Error(err),
+					zap.Uint64("txnStartTS", c.startTS))
+				return
+			}
+		}
+	}
+}
+
+func sendTxnHeartBeat(bo *Backoffer, store *TinykvStore, primary []byte, startTS, ttl uint64) (uint64, error) {
+	req := tikvrpc.NewRequest(tikvrpc.CmdTxnHeartBeat, &pb.TxnHeartBeatRequest{
+		PrimaryLock:   primary,
+		StartVersion:  startTS,
+		AdviseLockTtl: ttl,
+	})
+	for {
+		loc, err := store.GetRegionCache().LocateKey(bo, primary)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		resp, err := store.SendReq(bo, req, loc.Region, readTimeoutShort)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return 0, errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return 0, errors.Trace(ErrBodyMissing)
+		}
+		cmdResp := resp.Resp.(*pb.TxnHeartBeatResponse)
+		if keyErr := cmdResp.GetError(); keyErr != nil {
+			return 0, errors.Errorf("txn %d heartbeat fail, primary key = %v, err = %s", startTS, primary, keyErr.String())
+		}
+		return cmdResp.GetLockTtl(), nil
+	}
+}
+
 // doActionOnKeys groups keys into primary batch and secondary batches, if primary batch exists in the key,
 // it does action on primary batch first, then on secondary batches. If action is commit, secondary batches
 // is done in background goroutine.
@@ -249,6 +422,7 @@ func txnLockTTL(startTime time.Time, txnSize int) uint64 {
 // actionPrewrite prewrites a transaction
 // actionCommit commits a transaction
 // actionCleanup rollbacks a transaction
+// actionPessimisticLock and actionPessimisticRollback acquire and release the locks of a pessimistic transaction
 // This function split the keys by region and parallel execute the batches in a transaction using given action
 func (c *twoPhaseCommitter) doActionOnKeys(bo *Backoffer, action twoPhaseCommitAction, keys [][]byte) error {
 	if len(keys) == 0 {
@@ -276,12 +450,20 @@ func (c *twoPhaseCommitter) doActionOnKeys(bo *Backoffer, action twoPhaseCommitA
 	for id, g := range groups {
 		batches = appendBatchBySize(batches, id, g, sizeFunc, txnCommitBatchSize)
 	}
+	if _, ok := action.(actionPrewrite); ok && c.isOnePC() && len(batches) > 1 {
+		// 1PC needs all the keys in one request, try async commit instead.
+		atomic.StoreUint32(&c.useOnePC, 0)
+		if c.checkAsyncCommit() {
+			atomic.StoreUint32(&c.useAsyncCommit, 1)
+		}
+	}
 
 	firstIsPrimary := bytes.Equal(keys[0], c.primary())
 	_, actionIsCommit := action.(actionCommit)
 	_, actionIsCleanup := action.(actionCleanup)
-	if firstIsPrimary && (actionIsCommit || actionIsCleanup) {
-		// primary should be committed/cleanup first
+	_, actionIsPessimisticLock := action.(actionPessimisticLock)
+	if firstIsPrimary && (actionIsCommit || actionIsCleanup || actionIsPessimisticLock) {
+		// primary should be committed/cleanup/locked first
 		err = c.doActionOnBatches(bo, action, batches[:1])
 		if err != nil {
 			return errors.Trace(err)
@@ -368,6 +550,28 @@ func (c *twoPhaseCommitter) buildPrewriteRequest(batch batchKeys) *tikvrpc.Reque
 	}
 
 	req = &pb.PrewriteRequest{Mutations: mutations, PrimaryLock: c.primary(), StartVersion: c.startTS, LockTtl: c.lockTTL}
+	if c.isPessimistic {
+		// The keys locked by LockKeys hold pessimistic locks which the prewrite converts.
+		req.ForUpdateTs = c.forUpdateTS
+		req.IsPessimisticLock = make([]bool, len(batch.keys))
+		c.txn.mu.Lock()
+		for i, key := range batch.keys {
+			_, req.IsPessimisticLock[i] = c.txn.lockedMap[string(key)]
+		}
+		c.txn.mu.Unlock()
+	}
+	if c.isOnePC() {
+		req.TryOnePc = true
+		req.MaxCommitTs = c.maxCommitTS
+	} else if c.isAsyncCommit() {
+		req.UseAsyncCommit = true
+		req.MaxCommitTs = c.maxCommitTS
+		// The primary lock records the other keys, so that the status of the transaction can be
+		// decided from their locks.
+		if bytes.Equal(batch.keys[0], c.primary()) {
+			req.Secondaries = c.keys[1:]
+		}
+	}
 
 	return tikvrpc.NewRequest(tikvrpc.CmdPrewrite, req, pb.Context{})
 }
@@ -402,10 +606,23 @@ func (actionPrewrite) handleSingleBatch(c *twoPhaseCommitter, bo *Backoffer, bat
 		prewriteResp := resp.Resp.(*pb.PrewriteResponse)
 		keyErrs := prewriteResp.GetErrors()
 		if len(keyErrs) == 0 { // 这是唯一的能不出错跳出循环的地方，但是下面只处理了Locked这种错误，其他三种不处理没关系吗
+			if bytes.Equal(c.primary(), batch.keys[0]) && c.txnSize > ttlManagerRunThreshold {
+				// After writing the primary key of a large transaction, keep it alive until the
+				// transaction commits. The ttlManager is closed in execute.
+				c.ttlManager.run(c, nil)
+			}
+			if req.Prewrite().TryOnePc {
+				c.handleOnePCResponse(prewriteResp)
+			} else if req.Prewrite().UseAsyncCommit {
+				c.handleAsyncCommitResponse(prewriteResp)
+			}
 			return nil
 		}
 		var locks []*Lock
 		for _, keyErr := range keyErrs {
+			if alreadyExist := keyErr.GetAlreadyExist(); alreadyExist != nil {
+				return errors.Trace(c.keyExistsError(alreadyExist.Key))
+			}
 			// Extract lock from key error
 			lock, err1 := extractLockFromKeyErr(keyErr) // TODO: 四种错误中只考虑Locked这种错误，不会有问题吗？
 			if err1 != nil {
@@ -433,6 +650,85 @@ func (actionPrewrite) handleSingleBatch(c *twoPhaseCommitter, bo *Backoffer, bat
 	}
 }
 
+// keyExistsError returns the error of an Insert mutation whose key exists, the error saved when the key was presumed
+// not to exist.
+func (c *twoPhaseCommitter) keyExistsError(key []byte) error {
+	if e := c.txn.us.GetKeyExistErrInfo(key); e != nil {
+		return e.Err()
+	}
+	return kv.ErrKeyExists.FastGenByArgs(kv.Key(key).String(), "")
+}
+
+// handleOnePCResponse records the commit ts of a successful 1PC prewrite. TinyKV doesn't commit the
+// keys if the commit ts would exceed the max commit ts, they are locked for the normal two phase
+// commit then.
+func (c *twoPhaseCommitter) handleOnePCResponse(resp *pb.PrewriteResponse) {
+	if resp.OnePcCommitTs == 0 {
+		atomic.StoreUint32(&c.useOnePC, 0)
+		return
+	}
+	c.mu.Lock()
+	c.mu.onePCCommitTS = resp.OnePcCommitTs
+	c.mu.Unlock()
+}
+
+// handleAsyncCommitResponse records the min commit ts of a successful async commit prewrite. The
+// transaction commits at the largest of them, or with the normal two phase commit if a key couldn't
+// be prewritten for async commit.
+func (c *twoPhaseCommitter) handleAsyncCommitResponse(resp *pb.PrewriteResponse) {
+	if resp.MinCommitTs == 0 {
+		atomic.StoreUint32(&c.useAsyncCommit, 0)
+		return
+	}
+	c.mu.Lock()
+	if resp.MinCommitTs > c.mu.minCommitTS {
+		c.mu.minCommitTS = resp.MinCommitTs
+	}
+	c.mu.Unlock()
+}
+
+func (c *twoPhaseCommitter) isOnePC() bool {
+	return atomic.LoadUint32(&c.useOnePC) > 0
+}
+
+func (c *twoPhaseCommitter) isAsyncCommit() bool {
+	return atomic.LoadUint32(&c.useAsyncCommit) > 0
+}
+
+// checkOnePC checks if the transaction may be committed with 1PC. Its keys also need to fit into one
+// prewrite request, which is checked when they are grouped by region.
+func (c *twoPhaseCommitter) checkOnePC() bool {
+	if enabled, _ := c.txn.us.GetOption(kv.Enable1PC).(bool); !enabled {
+		return false
+	}
+	size := 0
+	for _, key := range c.keys {
+		size += c.keyValueSize(key)
+	}
+	return size < txnCommitBatchSize
+}
+
+// checkAsyncCommit checks if the transaction may be committed with async commit. All the keys are
+// recorded in the primary lock, so the transaction mustn't be too large.
+func (c *twoPhaseCommitter) checkAsyncCommit() bool {
+	if enabled, _ := c.txn.us.GetOption(kv.EnableAsyncCommit).(bool); !enabled {
+		return false
+	}
+	return len(c.keys) <= asyncCommitKeysLimit
+}
+
+// calculateMaxCommitTS sets the max commit ts of an async commit or 1PC transaction and checks that
+// the schema is valid until then, since the commit ts is only known once the keys are prewritten.
+func (c *twoPhaseCommitter) calculateMaxCommitTS(ctx context.Context) error {
+	currentTS, err := c.store.getTimestampWithRetry(NewBackoffer(ctx, tsoMaxBackoff).WithVars(c.txn.vars))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	safeWindow := int64(asyncCommitSafeWindow / time.Millisecond)
+	c.maxCommitTS = oracle.ComposeTS(oracle.ExtractPhysical(currentTS)+safeWindow, 0)
+	return errors.Trace(c.checkSchemaValid(c.maxCommitTS))
+}
+
 func (c *twoPhaseCommitter) setUndeterminedErr(err error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -555,6 +851,104 @@ func (actionCleanup) handleSingleBatch(c *twoPhaseCommitter, bo *Backoffer, batc
 	return nil
 }
 
+// handleSingleBatch acquires pessimistic locks on a batch of keys. Locks of other transactions are resolved
+// and waited for, a write conflict fails the statement so that it can be retried with a newer for_update_ts.
+func (action actionPessimisticLock) handleSingleBatch(c *twoPhaseCommitter, bo *Backoffer, batch batchKeys) error {
+	mutations := make([]*pb.Mutation, len(batch.keys))
+	for i, k := range batch.keys {
+		mutations[i] = &pb.Mutation{Op: pb.Op_PessimisticLock, Key: k}
+	}
+	req := tikvrpc.NewRequest(tikvrpc.CmdPessimisticLock, &pb.PessimisticLockRequest{
+		Mutations:    mutations,
+		PrimaryLock:  c.primary(),
+		StartVersion: c.startTS,
+		ForUpdateTs:  c.forUpdateTS,
+		LockTtl:      ManagedLockTTL,
+	}, pb.Context{})
+	for {
+		resp, err := c.store.SendReq(bo, req, batch.region, readTimeoutShort)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return errors.Trace(err)
+			}
+			err = c.pessimisticLockKeys(bo, action.LockCtx, batch.keys)
+			return errors.Trace(err)
+		}
+		if resp.Resp == nil {
+			return errors.Trace(ErrBodyMissing)
+		}
+		lockResp := resp.Resp.(*pb.PessimisticLockResponse)
+		keyErrs := lockResp.GetErrors()
+		if len(keyErrs) == 0 {
+			if bytes.Equal(c.primary(), batch.keys[0]) {
+				// A pessimistic transaction may stay idle between statements for longer than ManagedLockTTL,
+				// so its primary lock is kept alive until the transaction commits or rolls back.
+				c.ttlManager.run(c, action.LockCtx)
+			}
+			return nil
+		}
+		var locks []*Lock
+		for _, keyErr := range keyErrs {
+			lock, err1 := extractLockFromKeyErr(keyErr)
+			if err1 != nil {
+				return errors.Trace(err1)
+			}
+			locks = append(locks, lock)
+		}
+		// Wait for the locks of other transactions to be released, or resolve them if they are expired.
+		msBeforeExpired, _, err := c.store.lockResolver.ResolveLocks(bo, c.forUpdateTS, locks)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if action.Killed != nil && atomic.LoadUint32(action.Killed) == 1 {
+			return ErrQueryInterrupted
+		}
+		if msBeforeExpired > 0 {
+			err = bo.BackoffWithMaxSleep(BoTxnLock, int(msBeforeExpired), errors.Errorf("2PC pessimistic lockedKeys: %d", len(locks)))
+			if err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+// handleSingleBatch releases the pessimistic locks of a batch of keys.
+func (actionPessimisticRollback) handleSingleBatch(c *twoPhaseCommitter, bo *Backoffer, batch batchKeys) error {
+	req := tikvrpc.NewRequest(tikvrpc.CmdPessimisticRollback, &pb.PessimisticRollbackRequest{
+		StartVersion: c.startTS,
+		ForUpdateTs:  c.forUpdateTS,
+		Keys:         batch.keys,
+	}, pb.Context{})
+	resp, err := c.store.SendReq(bo, req, batch.region, readTimeoutShort)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if resp.Resp == nil {
+		return errors.Trace(ErrBodyMissing)
+	}
+	regionErr, err := resp.GetRegionError()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if regionErr != nil {
+		err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		err = c.pessimisticRollbackKeys(bo, batch.keys)
+		return errors.Trace(err)
+	}
+	return nil
+}
+
 func (c *twoPhaseCommitter) prewriteKeys(bo *Backoffer, keys [][]byte) error {
 	return c.doActionOnKeys(bo, actionPrewrite{}, keys)
 }
@@ -567,6 +961,14 @@ func (c *twoPhaseCommitter) cleanupKeys(bo *Backoffer, keys [][]byte) error {
 	return c.doActionOnKeys(bo, actionCleanup{}, keys)
 }
 
+func (c *twoPhaseCommitter) pessimisticLockKeys(bo *Backoffer, lockCtx *kv.LockCtx, keys [][]byte) error {
+	return c.doActionOnKeys(bo, actionPessimisticLock{lockCtx}, keys)
+}
+
+func (c *twoPhaseCommitter) pessimisticRollbackKeys(bo *Backoffer, keys [][]byte) error {
+	return c.doActionOnKeys(bo, actionPessimisticRollback{}, keys)
+}
+
 // execute executes the two-phase commit protocol.
 // Prewrite phase:
 //		1. Split keys by region -> batchKeys
@@ -584,6 +986,10 @@ func (c *twoPhaseCommitter) cleanupKeys(bo *Backoffer, keys [][]byte) error {
 // 		2. Cleanup secondary keys
 func (c *twoPhaseCommitter) execute(ctx context.Context) (err error) {
 	defer func() {
+		// Once the primary key is committed or the transaction is going to be rolled back, the
+		// primary lock needn't be kept alive any more.
+		c.ttlManager.close()
+
 		// Always clean up all written keys if the txn does not commit.
 		c.mu.RLock()
 		committed := c.mu.committed
@@ -609,10 +1015,46 @@ func (c *twoPhaseCommitter) execute(ctx context.Context) (err error) {
 	prewriteBo := NewBackoffer(ctx, PrewriteMaxBackoff).WithVars(c.txn.vars)
 	logutil.BgLogger().Debug("prewriteBo", zap.Bool("nil", prewriteBo == nil))
 	// YOUR CODE HERE (lab2). -- Done
+	if c.checkOnePC() {
+		atomic.StoreUint32(&c.useOnePC, 1)
+	} else if c.checkAsyncCommit() {
+		atomic.StoreUint32(&c.useAsyncCommit, 1)
+	}
+	if c.isOnePC() || c.isAsyncCommit() {
+		if err = c.calculateMaxCommitTS(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	err = c.prewriteKeys(prewriteBo, c.keys)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if c.isOnePC() {
+		// The keys are committed by the prewrite.
+		c.mu.Lock()
+		c.commitTS = c.mu.onePCCommitTS
+		c.mu.committed = true
+		c.mu.Unlock()
+		return nil
+	}
+	if c.isAsyncCommit() {
+		// The transaction is committed once all the keys are prewritten, the locks are resolved
+		// in background.
+		c.mu.Lock()
+		c.commitTS = c.mu.minCommitTS
+		c.mu.committed = true
+		c.mu.Unlock()
+		commitBo := NewBackoffer(context.Background(), CommitMaxBackoff).WithVars(c.txn.vars)
+		go func() {
+			if e := c.commitKeys(commitBo, c.keys); e != nil {
+				logutil.BgLogger().Debug("async commit commitKeys",
+					zap.Uint64("conn", c.connID),
+					zap.Uint64("txnStartTS", c.startTS),
+					zap.Error(e))
+			}
+		}()
+		return nil
+	}
 	// commit phase
 	commitTS, err := c.store.getTimestampWithRetry(NewBackoffer(ctx, tsoMaxBackoff).WithVars(c.txn.vars))
 	if err != nil {
@@ -630,7 +1072,7 @@ func (c *twoPhaseCommitter) execute(ctx context.Context) (err error) {
 		return errors.Trace(err)
 	}
 	c.commitTS = commitTS
-	if err = c.checkSchemaValid(); err != nil {
+	if err = c.checkSchemaValid(c.commitTS); err != nil {
 		return errors.Trace(err)
 	}
 
@@ -664,10 +1106,10 @@ type schemaLeaseChecker interface {
 
 // checkSchemaValid checks if there are schema changes during the transaction execution(from startTS to commitTS).
 // Schema change in a transaction is not allowed.
-func (c *twoPhaseCommitter) checkSchemaValid() error {
+func (c *twoPhaseCommitter) checkSchemaValid(commitTS uint64) error {
 	checker, ok := c.txn.us.GetOption(kv.SchemaChecker).(schemaLeaseChecker)
 	if ok {
-		err := checker.Check(c.commitTS)
+		err := checker.Check(commitTS)
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -679,6 +1121,15 @@ func (c *twoPhaseCommitter) checkSchemaValid() error {
 // Key+Value size below 16KB.
 const txnCommitBatchSize = 16 * 1024
 
+const (
+	// asyncCommitKeysLimit is the max number of keys of an async commit transaction, they are all
+	// recorded in its primary lock.
+	asyncCommitKeysLimit = 256
+	// asyncCommitSafeWindow is how long after the prewrite starts an async commit or 1PC transaction
+	// may commit.
+	asyncCommitSafeWindow = 2 * time.Second
+)
+
 // batchKeys is a batch of keys in the same region.
 type batchKeys struct {
 	region RegionVerID
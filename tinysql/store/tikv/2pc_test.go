@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	. "github.com/pingcap/check"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/store/mockstore/mocktikv"
 	"github.com/pingcap/tidb/store/tikv/tikvrpc"
 )
@@ -462,6 +463,32 @@ func (s *testCommitterSuite) TestPrewriteTxnSize(c *C) {
 	c.Assert(err, IsNil)
 }
 
+func (s *testCommitterSuite) TestPrewriteInsert(c *C) {
+	s.mustCommit(c, map[string]string{"a": "a0"})
+
+	// The key is presumed not to exist, so the duplicate is found by the prewrite.
+	txn := s.begin(c)
+	txn.SetOption(kv.PresumeKeyNotExists, nil)
+	txn.SetOption(kv.PresumeKeyNotExistsError, kv.NewExistErrInfo("PRIMARY", "a"))
+	_, err := txn.Get(context.TODO(), []byte("a"))
+	c.Assert(kv.IsErrNotFound(err), IsTrue)
+	txn.DelOption(kv.PresumeKeyNotExists)
+	txn.DelOption(kv.PresumeKeyNotExistsError)
+	c.Assert(txn.Set([]byte("a"), []byte("a1")), IsNil)
+	err = txn.Commit(context.Background())
+	c.Assert(kv.ErrKeyExists.Equal(err), IsTrue)
+	s.checkValues(c, map[string]string{"a": "a0"})
+
+	txn = s.begin(c)
+	txn.SetOption(kv.PresumeKeyNotExists, nil)
+	_, err = txn.Get(context.TODO(), []byte("b"))
+	c.Assert(kv.IsErrNotFound(err), IsTrue)
+	txn.DelOption(kv.PresumeKeyNotExists)
+	c.Assert(txn.Set([]byte("b"), []byte("b1")), IsNil)
+	c.Assert(txn.Commit(context.Background()), IsNil)
+	s.checkValues(c, map[string]string{"b": "b1"})
+}
+
 func (s *testCommitterSuite) getLockInfo(c *C, key []byte) *kvrpcpb.LockInfo {
 	txn := s.begin(c)
 	err := txn.Set(key, key)
@@ -485,3 +512,247 @@ func (s *testCommitterSuite) getLockInfo(c *C, key []byte) *kvrpcpb.LockInfo {
 	c.Assert(locked, NotNil)
 	return locked
 }
+
+func (s *testCommitterSuite) beginPessimistic(c *C) *tikvTxn {
+	txn := s.begin(c)
+	txn.SetOption(kv.Pessimistic, true)
+	return txn
+}
+
+func (s *testCommitterSuite) TestPessimisticLockCommit(c *C) {
+	txn := s.beginPessimistic(c)
+	lockCtx := &kv.LockCtx{ForUpdateTS: txn.startTS}
+	err := txn.LockKeys(context.Background(), lockCtx, kv.Key("a"), kv.Key("b"))
+	c.Assert(err, IsNil)
+	c.Assert(txn.committer.primaryKey, BytesEquals, []byte("a"))
+	// Pessimistic locks don't block reads.
+	c.Assert(s.isKeyLocked(c, []byte("a")), IsFalse)
+
+	// Another transaction can't prewrite a locked key.
+	txn2 := s.begin(c)
+	c.Assert(txn2.Set([]byte("b"), []byte("b2")), IsNil)
+	committer2, err := newTwoPhaseCommitterWithInit(txn2, 0)
+	c.Assert(err, IsNil)
+	loc, err := s.store.regionCache.LocateKey(NewBackoffer(context.Background(), getMaxBackoff), []byte("b"))
+	c.Assert(err, IsNil)
+	resp, err := s.store.SendReq(NewBackoffer(context.Background(), getMaxBackoff), committer2.buildPrewriteRequest(batchKeys{
+		region: loc.Region,
+		keys:   [][]byte{[]byte("b")},
+	}), loc.Region, readTimeoutShort)
+	c.Assert(err, IsNil)
+	keyErrs := resp.Resp.(*kvrpcpb.PrewriteResponse).Errors
+	c.Assert(keyErrs, HasLen, 1)
+	c.Assert(keyErrs[0].Locked.LockType, Equals, kvrpcpb.Op_PessimisticLock)
+
+	c.Assert(txn.Set([]byte("b"), []byte("b1")), IsNil)
+	c.Assert(txn.Set([]byte("c"), []byte("c1")), IsNil)
+	c.Assert(txn.Commit(context.Background()), IsNil)
+	s.checkValues(c, map[string]string{
+		"b": "b1",
+		"c": "c1",
+	})
+}
+
+func (s *testCommitterSuite) TestPessimisticLockConflict(c *C) {
+	txn := s.beginPessimistic(c)
+	s.mustCommit(c, map[string]string{
+		"a": "a1",
+	})
+
+	// The write is committed after for_update_ts.
+	lockCtx := &kv.LockCtx{ForUpdateTS: txn.startTS}
+	err := txn.LockKeys(context.Background(), lockCtx, kv.Key("a"))
+	c.Assert(err, NotNil)
+	c.Assert(txn.committer.primaryKey, IsNil)
+
+	// Retry with a newer for_update_ts.
+	ver, err := s.store.CurrentVersion()
+	c.Assert(err, IsNil)
+	lockCtx.ForUpdateTS = ver.Ver
+	err = txn.LockKeys(context.Background(), lockCtx, kv.Key("a"))
+	c.Assert(err, IsNil)
+	c.Assert(txn.Set([]byte("a"), []byte("a2")), IsNil)
+	c.Assert(txn.Commit(context.Background()), IsNil)
+	s.checkValues(c, map[string]string{
+		"a": "a2",
+	})
+}
+
+func (s *testCommitterSuite) TestPessimisticRollback(c *C) {
+	txn := s.beginPessimistic(c)
+	lockCtx := &kv.LockCtx{ForUpdateTS: txn.startTS}
+	err := txn.LockKeys(context.Background(), lockCtx, kv.Key("a"), kv.Key("b"))
+	c.Assert(err, IsNil)
+	c.Assert(txn.Rollback(), IsNil)
+
+	// The locks are released, so another transaction commits without waiting for them.
+	s.mustCommit(c, map[string]string{
+		"a": "a1",
+		"b": "b1",
+	})
+}
+
+func (s *testCommitterSuite) TestTxnHeartBeat(c *C) {
+	txn := s.begin(c)
+	c.Assert(txn.Set([]byte("a"), []byte("a1")), IsNil)
+	c.Assert(txn.Set([]byte("b"), []byte("b1")), IsNil)
+	committer, err := newTwoPhaseCommitterWithInit(txn, 0)
+	c.Assert(err, IsNil)
+	err = committer.prewriteKeys(NewBackoffer(context.Background(), PrewriteMaxBackoff), committer.keys)
+	c.Assert(err, IsNil)
+
+	bo := NewBackoffer(context.Background(), PrewriteMaxBackoff)
+	newTTL, err := sendTxnHeartBeat(bo, s.store, committer.primary(), txn.StartTS(), 666)
+	c.Assert(err, IsNil)
+	c.Assert(newTTL, Equals, uint64(666))
+	c.Assert(s.getLockInfo(c, committer.primary()).LockTtl, Equals, uint64(666))
+
+	// The TTL is never decreased.
+	newTTL, err = sendTxnHeartBeat(bo, s.store, committer.primary(), txn.StartTS(), 555)
+	c.Assert(err, IsNil)
+	c.Assert(newTTL, Equals, uint64(666))
+
+	err = committer.cleanupKeys(NewBackoffer(context.Background(), cleanupMaxBackoff), committer.keys)
+	c.Assert(err, IsNil)
+	_, err = sendTxnHeartBeat(bo, s.store, committer.primary(), txn.StartTS(), 777)
+	c.Assert(err, NotNil)
+}
+
+func (s *testCommitterSuite) TestPessimisticTTLManager(c *C) {
+	defer func(ttl uint64) { ManagedLockTTL = ttl }(ManagedLockTTL)
+	ManagedLockTTL = 100
+
+	txn := s.beginPessimistic(c)
+	lockCtx := &kv.LockCtx{ForUpdateTS: txn.startTS}
+	err := txn.LockKeys(context.Background(), lockCtx, kv.Key("a"), kv.Key("b"))
+	c.Assert(err, IsNil)
+	c.Assert(txn.committer.ttlManager.state, Equals, stateRunning)
+
+	// The primary lock is kept alive beyond its initial TTL.
+	time.Sleep(300 * time.Millisecond)
+	c.Assert(s.getLockInfo(c, []byte("a")).LockTtl, Greater, uint64(100))
+
+	c.Assert(txn.Rollback(), IsNil)
+	c.Assert(txn.committer.ttlManager.state, Equals, stateClosed)
+}
+
+func (s *testCommitterSuite) TestAsyncCommit(c *C) {
+	txn := s.begin(c)
+	txn.SetOption(kv.EnableAsyncCommit, true)
+	c.Assert(txn.Set([]byte("a"), []byte("a1")), IsNil)
+	c.Assert(txn.Set([]byte("b"), []byte("b1")), IsNil)
+	committer, err := newTwoPhaseCommitterWithInit(txn, 0)
+	c.Assert(err, IsNil)
+	err = committer.execute(context.Background())
+	c.Assert(err, IsNil)
+	c.Assert(committer.isAsyncCommit(), IsTrue)
+	c.Assert(committer.commitTS, Greater, txn.StartTS())
+	c.Assert(committer.commitTS, LessEqual, committer.maxCommitTS)
+	s.checkValues(c, map[string]string{
+		"a": "a1",
+		"b": "b1",
+	})
+}
+
+func (s *testCommitterSuite) TestOnePC(c *C) {
+	txn := s.begin(c)
+	txn.SetOption(kv.Enable1PC, true)
+	c.Assert(txn.Set([]byte("a1"), []byte("a1")), IsNil)
+	c.Assert(txn.Set([]byte("a2"), []byte("a2")), IsNil)
+	committer, err := newTwoPhaseCommitterWithInit(txn, 0)
+	c.Assert(err, IsNil)
+	err = committer.execute(context.Background())
+	c.Assert(err, IsNil)
+	c.Assert(committer.isOnePC(), IsTrue)
+	c.Assert(committer.commitTS, Greater, txn.StartTS())
+	c.Assert(s.isKeyLocked(c, []byte("a1")), IsFalse)
+	s.checkValues(c, map[string]string{
+		"a1": "a1",
+		"a2": "a2",
+	})
+
+	// The keys of the transaction are in two regions, so it is committed with async commit.
+	txn = s.begin(c)
+	txn.SetOption(kv.Enable1PC, true)
+	txn.SetOption(kv.EnableAsyncCommit, true)
+	c.Assert(txn.Set([]byte("a"), []byte("a3")), IsNil)
+	c.Assert(txn.Set([]byte("b"), []byte("b3")), IsNil)
+	committer, err = newTwoPhaseCommitterWithInit(txn, 0)
+	c.Assert(err, IsNil)
+	err = committer.execute(context.Background())
+	c.Assert(err, IsNil)
+	c.Assert(committer.isOnePC(), IsFalse)
+	c.Assert(committer.isAsyncCommit(), IsTrue)
+	s.checkValues(c, map[string]string{
+		"a": "a3",
+		"b": "b3",
+	})
+}
+
+func (s *testCommitterSuite) TestBatchGetResolveLocks(c *C) {
+	s.mustCommit(c, map[string]string{
+		"a": "a0",
+		"b": "b0",
+		"c": "c0",
+	})
+	// The keys of the batch are in three regions, b is locked by a transaction whose lock has expired.
+	txn := s.begin(c)
+	c.Assert(txn.Set([]byte("b"), []byte("b1")), IsNil)
+	committer, err := newTwoPhaseCommitterWithInit(txn, 0)
+	c.Assert(err, IsNil)
+	committer.lockTTL = 1
+	err = committer.prewriteKeys(NewBackoffer(context.Background(), PrewriteMaxBackoff), committer.keys)
+	c.Assert(err, IsNil)
+	time.Sleep(10 * time.Millisecond)
+	c.Assert(s.isKeyLocked(c, []byte("b")), IsTrue)
+
+	txn = s.begin(c)
+	m, err := txn.BatchGet(context.Background(), []kv.Key{kv.Key("a"), kv.Key("b"), kv.Key("c"), kv.Key("d")})
+	c.Assert(err, IsNil)
+	c.Assert(m, DeepEquals, map[string][]byte{
+		"a": []byte("a0"),
+		"b": []byte("b0"),
+		"c": []byte("c0"),
+	})
+	c.Assert(s.isKeyLocked(c, []byte("b")), IsFalse)
+}
+
+// prewriteAsyncCommit prewrites the first n keys of txn with async commit and a lock TTL which
+// expires at once.
+func (s *testCommitterSuite) prewriteAsyncCommit(c *C, txn *tikvTxn, n int) *twoPhaseCommitter {
+	committer, err := newTwoPhaseCommitterWithInit(txn, 0)
+	c.Assert(err, IsNil)
+	committer.useAsyncCommit = 1
+	committer.lockTTL = 1
+	c.Assert(committer.calculateMaxCommitTS(context.Background()), IsNil)
+	err = committer.prewriteKeys(NewBackoffer(context.Background(), PrewriteMaxBackoff), committer.keys[:n])
+	c.Assert(err, IsNil)
+	time.Sleep(10 * time.Millisecond)
+	return committer
+}
+
+func (s *testCommitterSuite) TestResolveAsyncCommitLocks(c *C) {
+	// All the keys are prewritten, so the transaction is committed.
+	txn := s.begin(c)
+	c.Assert(txn.Set([]byte("a"), []byte("a1")), IsNil)
+	c.Assert(txn.Set([]byte("b"), []byte("b1")), IsNil)
+	s.prewriteAsyncCommit(c, txn, 2)
+	s.checkValues(c, map[string]string{
+		"a": "a1",
+		"b": "b1",
+	})
+	c.Assert(s.isKeyLocked(c, []byte("b")), IsFalse)
+
+	// Only the primary key is prewritten, so the transaction is rolled back.
+	txn = s.begin(c)
+	c.Assert(txn.Set([]byte("a"), []byte("a2")), IsNil)
+	c.Assert(txn.Set([]byte("b"), []byte("b2")), IsNil)
+	committer := s.prewriteAsyncCommit(c, txn, 1)
+	s.checkValues(c, map[string]string{
+		"a": "a1",
+		"b": "b1",
+	})
+	// The secondary key can't be prewritten any more.
+	err := committer.prewriteKeys(NewBackoffer(context.Background(), PrewriteMaxBackoff), committer.keys[1:])
+	c.Assert(err, NotNil)
+}
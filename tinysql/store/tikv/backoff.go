@@ -178,9 +178,11 @@ const (
 	copNextMaxBackoff              = 20000
 	getMaxBackoff                  = 20000
 	cleanupMaxBackoff              = 20000
+	pessimisticLockMaxBackoff      = 20000
 	GcOneRegionMaxBackoff          = 20000
 	GcResolveLockMaxBackoff        = 100000
 	deleteRangeOneRegionMaxBackoff = 100000
+	BackupOneRegionMaxBackoff      = 100000
 	rawkvMaxBackoff                = 20000
 	splitRegionBackoff             = 20000
 	maxSplitRegionsBackoff         = 120000
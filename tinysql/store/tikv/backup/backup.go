@@ -0,0 +1,173 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backup backs up key ranges to the backup files of a directory with the Backup requests of TinyKV, and
+// restores them with its Restore requests.
+package backup
+
+import (
+	"bytes"
+	"context"
+
+	kvbackup "github.com/pingcap-incubator/tinykv/kv/backup"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/store/tikv"
+	"github.com/pingcap/tidb/store/tikv/tikvrpc"
+)
+
+// BackupRange backs up the values of [startKey, endKey) which are visible at backupTs to files in dir, one file for
+// each region with data. The locks of the transactions which may commit before backupTs are resolved on the way.
+func BackupRange(ctx context.Context, store tikv.Storage, dir string, startKey, endKey []byte, backupTs uint64) ([]*kvrpcpb.BackupFile, error) {
+	var files []*kvrpcpb.BackupFile
+	key := startKey
+	bo := tikv.NewBackoffer(ctx, tikv.BackupOneRegionMaxBackoff)
+	for {
+		loc, err := store.GetRegionCache().LocateKey(bo, key)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		req := tikvrpc.NewRequest(tikvrpc.CmdBackup, &kvrpcpb.BackupRequest{
+			StartKey: key,
+			EndKey:   clipEndKey(endKey, loc),
+			BackupTs: backupTs,
+			Path:     dir,
+		})
+		resp, err := store.SendReq(bo, req, loc.Region, tikv.BackupTimeout)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(tikv.BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return nil, errors.Trace(tikv.ErrBodyMissing)
+		}
+		backupResp := resp.Resp.(*kvrpcpb.BackupResponse)
+		if lockInfo := backupResp.GetLocked(); lockInfo != nil {
+			lock := tikv.NewLock(lockInfo)
+			msBeforeExpired, _, err := store.GetLockResolver().ResolveLocks(bo, backupTs, []*tikv.Lock{lock})
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			if msBeforeExpired > 0 {
+				err = bo.BackoffWithMaxSleep(tikv.BoTxnLock, int(msBeforeExpired), errors.Errorf("backup: %s", lock))
+				if err != nil {
+					return nil, errors.Trace(err)
+				}
+			}
+			continue
+		}
+		if backupResp.GetOtherError() != "" {
+			return nil, errors.Errorf("backup region %d: %s", loc.Region.GetID(), backupResp.GetOtherError())
+		}
+		files = append(files, backupResp.GetFiles()...)
+
+		key = loc.EndKey
+		if len(key) == 0 || (len(endKey) != 0 && bytes.Compare(key, endKey) >= 0) {
+			break
+		}
+		bo = tikv.NewBackoffer(ctx, tikv.BackupOneRegionMaxBackoff)
+	}
+	return files, nil
+}
+
+// RestoreFile restores a backup file of dir at restoreTs, under the keys rewritten by rules. The file is sent to each
+// region its rewritten range covers now, and each of them ingests its part. It returns the checksum of the restored
+// pairs as they were backed up, which matches the checksum of the file if no pair was lost.
+func RestoreFile(ctx context.Context, store tikv.Storage, dir string, file *kvrpcpb.BackupFile, rules []*kvrpcpb.RewriteRule, restoreTs uint64) (*kvbackup.Checksum, error) {
+	checksum := new(kvbackup.Checksum)
+	key := kvbackup.RewriteKey(file.StartKey, rules)
+	endKey := rewriteEndKey(file.EndKey, rules)
+	bo := tikv.NewBackoffer(ctx, tikv.BackupOneRegionMaxBackoff)
+	for {
+		loc, err := store.GetRegionCache().LocateKey(bo, key)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		req := tikvrpc.NewRequest(tikvrpc.CmdRestore, &kvrpcpb.RestoreRequest{
+			Path:         dir,
+			Name:         file.Name,
+			StartKey:     key,
+			EndKey:       clipEndKey(endKey, loc),
+			RewriteRules: rules,
+			RestoreTs:    restoreTs,
+		})
+		resp, err := store.SendReq(bo, req, loc.Region, tikv.BackupTimeout)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(tikv.BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return nil, errors.Trace(tikv.ErrBodyMissing)
+		}
+		restoreResp := resp.Resp.(*kvrpcpb.RestoreResponse)
+		if restoreResp.GetError() != "" {
+			return nil, errors.Errorf("restore %s to region %d: %s", file.Name, loc.Region.GetID(), restoreResp.GetError())
+		}
+		checksum.Merge(&kvbackup.Checksum{
+			Crc64Xor:   restoreResp.GetCrc64Xor(),
+			TotalKvs:   restoreResp.GetTotalKvs(),
+			TotalBytes: restoreResp.GetTotalBytes(),
+		})
+
+		key = loc.EndKey
+		if len(key) == 0 || (len(endKey) != 0 && bytes.Compare(key, endKey) >= 0) {
+			break
+		}
+		bo = tikv.NewBackoffer(ctx, tikv.BackupOneRegionMaxBackoff)
+	}
+	return checksum, nil
+}
+
+// rewriteEndKey rewrites the end of a range like kvbackup.RewriteKey rewrites a key. The range of a whole table ends
+// after all the keys of its prefix, so an end which is the end of the old prefix of a rule becomes the end of the new
+// prefix.
+func rewriteEndKey(endKey []byte, rules []*kvrpcpb.RewriteRule) []byte {
+	if len(endKey) == 0 {
+		return nil
+	}
+	for _, rule := range rules {
+		if bytes.Equal(endKey, kv.Key(rule.OldKeyPrefix).PrefixNext()) {
+			return kv.Key(rule.NewKeyPrefix).PrefixNext()
+		}
+	}
+	return kvbackup.RewriteKey(endKey, rules)
+}
+
+// clipEndKey returns the end of the part of a range ending at endKey which is in the region of loc.
+func clipEndKey(endKey []byte, loc *tikv.KeyLocation) []byte {
+	if len(loc.EndKey) > 0 && (len(endKey) == 0 || bytes.Compare(loc.EndKey, endKey) < 0) {
+		return loc.EndKey
+	}
+	return endKey
+}
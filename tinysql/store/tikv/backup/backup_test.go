@@ -0,0 +1,39 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/tablecodec"
+)
+
+type testBackupSuite struct{}
+
+var _ = Suite(&testBackupSuite{})
+
+func (s *testBackupSuite) TestRewriteEndKey(c *C) {
+	rules := []*kvrpcpb.RewriteRule{{
+		OldKeyPrefix: tablecodec.GenTablePrefix(5),
+		NewKeyPrefix: tablecodec.GenTablePrefix(42),
+	}}
+	c.Assert(rewriteEndKey(nil, rules), IsNil)
+	// The end of the range of a whole table.
+	c.Assert(rewriteEndKey(tablecodec.GenTablePrefix(6), rules), BytesEquals, []byte(tablecodec.GenTablePrefix(43)))
+	// The end of the range of a region in the table.
+	c.Assert(rewriteEndKey(tablecodec.EncodeRowKeyWithHandle(5, 10), rules), BytesEquals,
+		[]byte(tablecodec.EncodeRowKeyWithHandle(42, 10)))
+	// An end which no rule matches is kept.
+	c.Assert(rewriteEndKey(tablecodec.GenTablePrefix(7), rules), BytesEquals, []byte(tablecodec.GenTablePrefix(7)))
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	kvbackup "github.com/pingcap-incubator/tinykv/kv/backup"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/model"
+)
+
+// metaFile is the name of the file which describes a backup in its directory.
+const metaFile = "backupmeta"
+
+// Meta describes a backup: the schemas of the backed up tables and the files which hold their data.
+type Meta struct {
+	BackupTs uint64    `json:"backup_ts"`
+	Schemas  []*Schema `json:"schemas"`
+}
+
+// Schema is a backed up database.
+type Schema struct {
+	Info   *model.DBInfo `json:"info"`
+	Tables []*Table      `json:"tables"`
+}
+
+// Table is a backed up table.
+type Table struct {
+	Info *model.TableInfo `json:"info"`
+	// AutoID is the base of the auto ID allocator of the table.
+	AutoID int64                 `json:"auto_id"`
+	Files  []*kvrpcpb.BackupFile `json:"files"`
+	// Checksum sums up the pairs of all the files.
+	Checksum kvbackup.Checksum `json:"checksum"`
+}
+
+// NewTable creates the Table of the files of a backed up table.
+func NewTable(info *model.TableInfo, autoID int64, files []*kvrpcpb.BackupFile) *Table {
+	table := &Table{Info: info, AutoID: autoID, Files: files}
+	for _, file := range files {
+		table.Checksum.Merge(&kvbackup.Checksum{
+			Crc64Xor:   file.Crc64Xor,
+			TotalKvs:   file.TotalKvs,
+			TotalBytes: file.TotalBytes,
+		})
+	}
+	return table
+}
+
+// ParseStorage returns the directory of a storage URL. Only local directories are supported, like
+// 'local:///tmp/backup'. The directory is on the local file system of every TinyKV server and of the TinySQL server,
+// so it should be a shared one.
+func ParseStorage(storage string) (string, error) {
+	u, err := url.Parse(storage)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if u.Scheme != "local" {
+		return "", errors.Errorf("storage %s is not supported, use local://", storage)
+	}
+	dir := u.Host + u.Path
+	if dir == "" {
+		return "", errors.Errorf("storage %s has no directory", storage)
+	}
+	return dir, nil
+}
+
+// CreateDir creates the directory of a new backup. It fails if the directory already holds one.
+func CreateDir(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, metaFile)); err == nil {
+		return errors.Errorf("directory %s already holds a backup", dir)
+	} else if !os.IsNotExist(err) {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// WriteMeta writes the meta of the backup of dir. It is written last, so a directory with a meta holds a complete
+// backup.
+func WriteMeta(dir string, meta *Meta) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	path := filepath.Join(dir, metaFile)
+	if err = ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(os.Rename(path+".tmp", path))
+}
+
+// ReadMeta reads the meta of the backup of dir.
+func ReadMeta(dir string) (*Meta, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, metaFile))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	meta := new(Meta)
+	if err = json.Unmarshal(data, meta); err != nil {
+		return nil, errors.Annotatef(err, "backup meta of %s", dir)
+	}
+	return meta, nil
+}
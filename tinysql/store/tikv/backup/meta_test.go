@@ -0,0 +1,73 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser/model"
+)
+
+func TestT(t *testing.T) {
+	TestingT(t)
+}
+
+type testMetaSuite struct{}
+
+var _ = Suite(&testMetaSuite{})
+
+func (s *testMetaSuite) TestParseStorage(c *C) {
+	dir, err := ParseStorage("local:///tmp/backup")
+	c.Assert(err, IsNil)
+	c.Assert(dir, Equals, "/tmp/backup")
+	dir, err = ParseStorage("local://backup/1")
+	c.Assert(err, IsNil)
+	c.Assert(dir, Equals, "backup/1")
+
+	for _, storage := range []string{"s3://bucket/backup", "/tmp/backup", "local://"} {
+		_, err = ParseStorage(storage)
+		c.Assert(err, NotNil, Commentf("%s", storage))
+	}
+}
+
+func (s *testMetaSuite) TestMeta(c *C) {
+	dir := filepath.Join(c.MkDir(), "backup")
+	c.Assert(CreateDir(dir), IsNil)
+	files := []*kvrpcpb.BackupFile{
+		{Name: "1.sst", StartKey: []byte("a"), EndKey: []byte("b"), Crc64Xor: 0x3, TotalKvs: 2, TotalBytes: 10},
+		{Name: "2.sst", StartKey: []byte("b"), EndKey: []byte("c"), Crc64Xor: 0x5, TotalKvs: 1, TotalBytes: 4},
+	}
+	table := NewTable(&model.TableInfo{ID: 42, Name: model.NewCIStr("t")}, 100, files)
+	c.Assert(table.Checksum.Crc64Xor, Equals, uint64(0x6))
+	c.Assert(table.Checksum.TotalKvs, Equals, uint64(3))
+	c.Assert(table.Checksum.TotalBytes, Equals, uint64(14))
+
+	meta := &Meta{
+		BackupTs: 7,
+		Schemas: []*Schema{{
+			Info:   &model.DBInfo{ID: 1, Name: model.NewCIStr("db")},
+			Tables: []*Table{table},
+		}},
+	}
+	c.Assert(WriteMeta(dir, meta), IsNil)
+	read, err := ReadMeta(dir)
+	c.Assert(err, IsNil)
+	c.Assert(read, DeepEquals, meta)
+
+	// A directory which holds a backup can't hold another one.
+	c.Assert(CreateDir(dir), ErrorMatches, ".*already holds a backup.*")
+}
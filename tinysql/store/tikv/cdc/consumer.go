@@ -0,0 +1,186 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+)
+
+// RowEventType is the type of a RowEvent.
+type RowEventType int
+
+const (
+	// RowInsert is an insert of a row. An update is sent as the insert of the new row, since the stream does not
+	// carry the old one.
+	RowInsert RowEventType = iota
+	// RowDelete is a delete of a row.
+	RowDelete
+)
+
+// RowEvent is a committed change of a table row.
+type RowEvent struct {
+	Type     RowEventType
+	TableID  int64
+	Handle   int64
+	CommitTs uint64
+	// Columns are the columns of an inserted row by column id, nil for a delete.
+	Columns map[int64]types.Datum
+}
+
+// ColumnTypes returns the types of the columns to decode for a table by column id. It returns nil if the changes of
+// the table are not wanted.
+type ColumnTypes func(tableID int64) map[int64]*types.FieldType
+
+// Consumer turns the events of a TinyKV ChangeData stream into row events. Committed changes are held back until the
+// resolved ts passes their commit ts, so that the row events come out in commit ts order and no earlier change
+// follows them. Changes of index keys, prewrites and rollbacks are left out.
+type Consumer struct {
+	colTypes   ColumnTypes
+	loc        *time.Location
+	resolvedTs uint64
+	commits    map[commitKey]*kvrpcpb.ChangeDataEntry
+}
+
+type commitKey struct {
+	key      string
+	commitTs uint64
+}
+
+// NewConsumer creates a Consumer for a stream subscribed from startTs.
+func NewConsumer(startTs uint64, colTypes ColumnTypes, loc *time.Location) *Consumer {
+	return &Consumer{
+		colTypes:   colTypes,
+		loc:        loc,
+		resolvedTs: startTs,
+		commits:    make(map[commitKey]*kvrpcpb.ChangeDataEntry),
+	}
+}
+
+// ResolvedTs returns the ts up to which all the changes have been returned. A stream which fails should be subscribed
+// again from it.
+func (c *Consumer) ResolvedTs() uint64 {
+	return c.resolvedTs
+}
+
+// Consume handles an event of the stream and returns the row events released by it.
+func (c *Consumer) Consume(event *kvrpcpb.ChangeDataEvent) ([]RowEvent, error) {
+	if event.RegionError != nil {
+		return nil, errors.Errorf("change data region error: %s", event.RegionError)
+	}
+	if event.Error != "" {
+		return nil, errors.Errorf("change data error: %s", event.Error)
+	}
+	for _, entry := range event.Entries {
+		// A change may be sent again after a resubscription.
+		if entry.Type != kvrpcpb.ChangeDataEntry_Commit || entry.CommitTs <= c.resolvedTs {
+			continue
+		}
+		if !tablecodec.IsRecordKey(entry.Key) {
+			continue
+		}
+		c.commits[commitKey{string(entry.Key), entry.CommitTs}] = entry
+	}
+	if event.ResolvedTs <= c.resolvedTs {
+		return nil, nil
+	}
+	c.resolvedTs = event.ResolvedTs
+
+	var entries []*kvrpcpb.ChangeDataEntry
+	for key, entry := range c.commits {
+		if entry.CommitTs <= c.resolvedTs {
+			entries = append(entries, entry)
+			delete(c.commits, key)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].CommitTs != entries[j].CommitTs {
+			return entries[i].CommitTs < entries[j].CommitTs
+		}
+		return bytes.Compare(entries[i].Key, entries[j].Key) < 0
+	})
+	rows := make([]RowEvent, 0, len(entries))
+	for _, entry := range entries {
+		row, ok, err := c.decode(entry)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (c *Consumer) decode(entry *kvrpcpb.ChangeDataEntry) (RowEvent, bool, error) {
+	tableID, handle, err := tablecodec.DecodeRecordKey(entry.Key)
+	if err != nil {
+		return RowEvent{}, false, errors.Trace(err)
+	}
+	cols := c.colTypes(tableID)
+	if cols == nil {
+		return RowEvent{}, false, nil
+	}
+	row := RowEvent{TableID: tableID, Handle: handle, CommitTs: entry.CommitTs}
+	switch entry.Op {
+	case kvrpcpb.Op_Put:
+		row.Type = RowInsert
+		row.Columns, err = tablecodec.DecodeRow(entry.Value, cols, c.loc)
+		if err != nil {
+			return RowEvent{}, false, errors.Trace(err)
+		}
+	case kvrpcpb.Op_Del:
+		row.Type = RowDelete
+	default:
+		return RowEvent{}, false, nil
+	}
+	return row, true, nil
+}
+
+// Run subscribes to the changes of a range from the consumer's resolved ts and passes the row events to handle until
+// the stream or handle fails.
+func (c *Consumer) Run(ctx context.Context, client tinykvpb.TinyKvClient, reqCtx *kvrpcpb.Context, startKey, endKey []byte, handle func(RowEvent) error) error {
+	stream, err := client.ChangeData(ctx, &kvrpcpb.ChangeDataRequest{
+		Context:  reqCtx,
+		StartKey: startKey,
+		EndKey:   endKey,
+		StartTs:  c.resolvedTs,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		rows, err := c.Consume(event)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err = handle(row); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/rowcodec"
+)
+
+func TestT(t *testing.T) {
+	TestingT(t)
+}
+
+type testConsumerSuite struct{}
+
+var _ = Suite(&testConsumerSuite{})
+
+func (s *testConsumerSuite) encodeRow(c *C, id int64, name string) []byte {
+	sc := &stmtctx.StatementContext{TimeZone: time.UTC}
+	value, err := tablecodec.EncodeRow(sc, types.MakeDatums(id, name), []int64{1, 2}, nil, nil, &rowcodec.Encoder{})
+	c.Assert(err, IsNil)
+	return value
+}
+
+func (s *testConsumerSuite) TestConsume(c *C) {
+	cols := map[int64]*types.FieldType{
+		1: types.NewFieldType(mysql.TypeLonglong),
+		2: types.NewFieldType(mysql.TypeVarchar),
+	}
+	consumer := NewConsumer(10, func(tableID int64) map[int64]*types.FieldType {
+		if tableID == 1 {
+			return cols
+		}
+		return nil
+	}, time.UTC)
+
+	key1 := tablecodec.EncodeRowKeyWithHandle(1, 1)
+	key2 := tablecodec.EncodeRowKeyWithHandle(1, 2)
+	rows, err := consumer.Consume(&kvrpcpb.ChangeDataEvent{Entries: []*kvrpcpb.ChangeDataEntry{
+		// Committed before the start ts, so it has been consumed already.
+		{Type: kvrpcpb.ChangeDataEntry_Commit, Key: key1, Value: s.encodeRow(c, 1, "a"), Op: kvrpcpb.Op_Put, StartTs: 5, CommitTs: 8},
+		{Type: kvrpcpb.ChangeDataEntry_Commit, Key: key2, Value: s.encodeRow(c, 2, "b"), Op: kvrpcpb.Op_Put, StartTs: 20, CommitTs: 25},
+		{Type: kvrpcpb.ChangeDataEntry_Prewrite, Key: key1, Value: s.encodeRow(c, 1, "c"), Op: kvrpcpb.Op_Put, StartTs: 15},
+		{Type: kvrpcpb.ChangeDataEntry_Initialized},
+	}, ResolvedTs: 14})
+	c.Assert(err, IsNil)
+	c.Assert(rows, HasLen, 0)
+	c.Assert(consumer.ResolvedTs(), Equals, uint64(14))
+
+	rows, err = consumer.Consume(&kvrpcpb.ChangeDataEvent{Entries: []*kvrpcpb.ChangeDataEntry{
+		{Type: kvrpcpb.ChangeDataEntry_Commit, Key: key1, Value: s.encodeRow(c, 1, "c"), Op: kvrpcpb.Op_Put, StartTs: 15, CommitTs: 18},
+		// Index keys and other tables are left out.
+		{Type: kvrpcpb.ChangeDataEntry_Commit, Key: tablecodec.EncodeIndexSeekKey(1, 1, []byte{1}), Value: []byte{'0'}, Op: kvrpcpb.Op_Put, StartTs: 15, CommitTs: 18},
+		{Type: kvrpcpb.ChangeDataEntry_Commit, Key: tablecodec.EncodeRowKeyWithHandle(2, 1), Value: s.encodeRow(c, 1, "x"), Op: kvrpcpb.Op_Put, StartTs: 15, CommitTs: 18},
+	}})
+	c.Assert(err, IsNil)
+	c.Assert(rows, HasLen, 0)
+
+	rows, err = consumer.Consume(&kvrpcpb.ChangeDataEvent{Entries: []*kvrpcpb.ChangeDataEntry{
+		{Type: kvrpcpb.ChangeDataEntry_Commit, Key: key1, Op: kvrpcpb.Op_Del, StartTs: 30, CommitTs: 31},
+		// A change sent again is returned once.
+		{Type: kvrpcpb.ChangeDataEntry_Commit, Key: key2, Value: s.encodeRow(c, 2, "b"), Op: kvrpcpb.Op_Put, StartTs: 20, CommitTs: 25},
+	}, ResolvedTs: 30})
+	c.Assert(err, IsNil)
+	c.Assert(rows, HasLen, 2)
+	c.Assert(rows[0].Type, Equals, RowInsert)
+	c.Assert(rows[0].TableID, Equals, int64(1))
+	c.Assert(rows[0].Handle, Equals, int64(1))
+	c.Assert(rows[0].CommitTs, Equals, uint64(18))
+	c.Assert(rows[0].Columns, DeepEquals, map[int64]types.Datum{1: types.NewIntDatum(1), 2: types.NewBytesDatum([]byte("c"))})
+	c.Assert(rows[1].Type, Equals, RowInsert)
+	c.Assert(rows[1].Handle, Equals, int64(2))
+	c.Assert(rows[1].CommitTs, Equals, uint64(25))
+	c.Assert(rows[1].Columns, DeepEquals, map[int64]types.Datum{1: types.NewIntDatum(2), 2: types.NewBytesDatum([]byte("b"))})
+
+	rows, err = consumer.Consume(&kvrpcpb.ChangeDataEvent{ResolvedTs: 40})
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, []RowEvent{{Type: RowDelete, TableID: 1, Handle: 1, CommitTs: 31}})
+
+	_, err = consumer.Consume(&kvrpcpb.ChangeDataEvent{RegionError: &errorpb.Error{Message: "not leader"}})
+	c.Assert(err, NotNil)
+	c.Assert(consumer.ResolvedTs(), Equals, uint64(40))
+}
@@ -81,8 +81,20 @@ type copTask struct {
 	respChan  chan *copResponse
 	storeAddr string
 	cmdType   tikvrpc.CmdType
+
+	// pagingSize is the number of rows of the next page of a paged task, see kv.Request.Paging.
+	pagingSize uint64
 }
 
+// The budget of the pages of a paged task. The pages of a task start small, so that a query which stops early reads
+// little, and grow to save the round trips of a full scan.
+const (
+	minPagingSize = 128
+	maxPagingSize = 8192
+	// pagingBytes bounds the size of a page whose rows are large.
+	pagingBytes = 4 * 1024 * 1024
+)
+
 func (r *copTask) String() string {
 	return fmt.Sprintf("region(%d %d %d) ranges(%d) store(%s)",
 		r.region.id, r.region.confVer, r.region.ver, r.ranges.len(), r.storeAddr)
@@ -206,6 +218,10 @@ func buildCopTasks(bo *Backoffer, cache *RegionCache, ranges *copRanges, req *kv
 	cmdType := tikvrpc.CmdCop
 
 	rangesLen := ranges.len()
+	var pagingSize uint64
+	if req.Paging {
+		pagingSize = minPagingSize
+	}
 	var tasks []*copTask
 	appendTask := func(regionWithRangeInfo *KeyLocation, ranges *copRanges) {
 		// TiKV will return gRPC error if the message is too large. So we need to limit the length of the ranges slice
@@ -218,8 +234,9 @@ func buildCopTasks(bo *Backoffer, cache *RegionCache, ranges *copRanges, req *kv
 				ranges: ranges.slice(i, nextI),
 				// Channel buffer is 2 for handling region split.
 				// In a common case, two region split tasks will not be blocked.
-				respChan: make(chan *copResponse, 2),
-				cmdType:  cmdType,
+				respChan:   make(chan *copResponse, 2),
+				cmdType:    cmdType,
+				pagingSize: pagingSize,
 			})
 			i = nextI
 		}
@@ -603,12 +620,30 @@ func (worker *copIteratorWorker) handleTaskOnce(bo *Backoffer, task *copTask, ch
 		}
 	})
 
-	req := tikvrpc.NewRequest(task.cmdType, &coprocessor.Request{
+	copReq := &coprocessor.Request{
 		Tp:      worker.req.Tp,
 		StartTs: worker.req.StartTs,
 		Data:    worker.req.Data,
 		Ranges:  task.ranges.toPBRanges(),
-	}, kvrpcpb.Context{})
+	}
+	if task.pagingSize > 0 {
+		copReq.PagingSize, copReq.PagingBytes = task.pagingSize, pagingBytes
+	}
+	var cacheKey []byte
+	var cacheValue *coprCacheValue
+	if worker.req.Cacheable && worker.store.coprCache != nil {
+		cacheKey = coprCacheBuildKey(task.region.id, copReq)
+		cacheValue = worker.store.coprCache.Get(cacheKey, worker.req.StartTs)
+		copReq.IsCacheEnabled = true
+		if cacheValue != nil {
+			copReq.CacheIfMatchVersion = cacheValue.dataVersion
+		}
+	}
+	req := tikvrpc.NewRequest(task.cmdType, copReq, kvrpcpb.Context{
+		ReplicaRead: worker.req.ReplicaRead.IsFollowerRead(),
+		StaleRead:   worker.req.StaleRead,
+		ReadTs:      worker.req.StartTs,
+	})
 	startTime := time.Now()
 	resp, rpcCtx, storeAddr, err := worker.SendReqCtx(bo, req, task.region, ReadTimeoutMedium, task.storeAddr)
 	if err != nil {
@@ -621,7 +656,7 @@ func (worker *copIteratorWorker) handleTaskOnce(bo *Backoffer, task *copTask, ch
 		worker.logTimeCopTask(costTime, task, bo, resp)
 	}
 
-	return worker.handleCopResponse(bo, rpcCtx, &copResponse{pbResp: resp.Resp.(*coprocessor.Response)}, task, ch)
+	return worker.handleCopResponse(bo, rpcCtx, &copResponse{pbResp: resp.Resp.(*coprocessor.Response)}, task, ch, cacheKey, cacheValue)
 }
 
 type minCommitTSPushed struct {
@@ -703,7 +738,9 @@ func (worker *copIteratorWorker) logTimeCopTask(costTime time.Duration, task *co
 
 // handleCopResponse checks coprocessor Response for region split and lock,
 // returns more tasks when that happens, or handles the response if no error.
-func (worker *copIteratorWorker) handleCopResponse(bo *Backoffer, rpcCtx *RPCContext, resp *copResponse, task *copTask, ch chan<- *copResponse) ([]*copTask, error) {
+// The task of the next page is returned after a page of a paged task. The response is cached under cacheKey if it can
+// be, a cache hit takes the data of cacheValue.
+func (worker *copIteratorWorker) handleCopResponse(bo *Backoffer, rpcCtx *RPCContext, resp *copResponse, task *copTask, ch chan<- *copResponse, cacheKey []byte, cacheValue *coprCacheValue) ([]*copTask, error) {
 	if regionErr := resp.pbResp.GetRegionError(); regionErr != nil {
 		if err := bo.Backoff(BoRegionMiss, errors.New(regionErr.String())); err != nil {
 			return nil, errors.Trace(err)
@@ -734,8 +771,42 @@ func (worker *copIteratorWorker) handleCopResponse(bo *Backoffer, rpcCtx *RPCCon
 			zap.Error(err))
 		return nil, errors.Trace(err)
 	}
-	worker.sendToRespCh(resp, ch, true)
-	return nil, nil
+	if resp.pbResp.IsCacheHit {
+		if cacheValue == nil {
+			return nil, errors.Errorf("unexpected coprocessor cache hit of region %d", task.region.id)
+		}
+		resp.pbResp.Data, resp.pbResp.Range = cacheValue.data, cacheValue.scanned
+	} else if cacheKey != nil && resp.pbResp.CanBeCached {
+		worker.store.coprCache.Set(cacheKey, resp.pbResp, worker.req.StartTs)
+	}
+	if exit := worker.sendToRespCh(resp, ch, true); exit {
+		return nil, nil
+	}
+	return worker.nextPage(task, resp.pbResp.Range), nil
+}
+
+// nextPage returns the task of the rows left by a page which has scanned the range scanned, or nil if the task is
+// done. The response of the page is sent first, so the next page waits while the responses aren't taken, and isn't
+// read once the iterator is closed.
+func (worker *copIteratorWorker) nextPage(task *copTask, scanned *coprocessor.KeyRange) []*copTask {
+	if task.pagingSize == 0 || scanned == nil {
+		return nil
+	}
+	var ranges *copRanges
+	if worker.req.Desc {
+		ranges, _ = task.ranges.split(scanned.Start)
+	} else {
+		_, ranges = task.ranges.split(scanned.End)
+	}
+	if ranges.len() == 0 {
+		return nil
+	}
+	next := *task
+	next.ranges = ranges
+	if next.pagingSize *= 2; next.pagingSize > maxPagingSize {
+		next.pagingSize = maxPagingSize
+	}
+	return []*copTask{&next}
 }
 
 func (worker *copIteratorWorker) buildCopTasksFromRemain(bo *Backoffer, task *copTask) ([]*copTask, error) {
@@ -0,0 +1,128 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tikv
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
+	"github.com/pingcap/tidb/util/codec"
+)
+
+const (
+	// coprCacheCapacity bounds the size of the data held by the coprocessor cache of a store.
+	coprCacheCapacity = 64 * 1024 * 1024
+	// coprCacheMaxValueSize bounds the size of a cached response, larger ones aren't worth the room they take.
+	coprCacheMaxValueSize = 1024 * 1024
+)
+
+// coprCache caches the responses of the coprocessor requests of a store. A response is cached together with the data
+// version of the region it was read at, and TinyKV answers a request whose region still has that data version with a
+// cache hit instead of the data. The least recently used responses are evicted once the cache is full.
+type coprCache struct {
+	mu       sync.Mutex
+	capacity int
+	size     int
+	values   map[string]*list.Element
+	lru      *list.List
+}
+
+// coprCacheValue is a cached response.
+type coprCacheValue struct {
+	key  string
+	data []byte
+	// scanned is the range scanned by a page, see coprocessor.Response.Range.
+	scanned *coprocessor.KeyRange
+	// startTs is the start ts the response was read at, it is valid for a later start ts only.
+	startTs     uint64
+	dataVersion uint64
+}
+
+func newCoprCache(capacity int) *coprCache {
+	return &coprCache{
+		capacity: capacity,
+		values:   make(map[string]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// coprCacheBuildKey builds the cache key of a request sent to a region, which is made of the region id, the request
+// plan and the ranges it reads.
+func coprCacheBuildKey(regionID uint64, req *coprocessor.Request) []byte {
+	key := codec.EncodeUint(nil, regionID)
+	key = codec.EncodeInt(key, req.Tp)
+	key = codec.EncodeUint(key, req.PagingSize)
+	key = codec.EncodeUint(key, req.PagingBytes)
+	key = codec.EncodeCompactBytes(key, req.Data)
+	for _, r := range req.Ranges {
+		key = codec.EncodeCompactBytes(key, r.Start)
+		key = codec.EncodeCompactBytes(key, r.End)
+	}
+	return key
+}
+
+// Get returns the cached response of key which is valid at startTs, or nil if there is none.
+func (c *coprCache) Get(key []byte, startTs uint64) *coprCacheValue {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.values[string(key)]
+	if !ok {
+		return nil
+	}
+	value := elem.Value.(*coprCacheValue)
+	if value.startTs > startTs {
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+	return value
+}
+
+// Set caches the response of key, replacing the cached one, unless the response is too large to be cached.
+func (c *coprCache) Set(key []byte, resp *coprocessor.Response, startTs uint64) {
+	if len(resp.Data) > coprCacheMaxValueSize {
+		return
+	}
+	value := &coprCacheValue{
+		key:         string(key),
+		data:        resp.Data,
+		scanned:     resp.Range,
+		startTs:     startTs,
+		dataVersion: resp.CacheLastVersion,
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.values[value.key]; ok {
+		c.remove(elem)
+	}
+	c.values[value.key] = c.lru.PushFront(value)
+	c.size += value.memSize()
+	for c.size > c.capacity {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *coprCache) remove(elem *list.Element) {
+	value := c.lru.Remove(elem).(*coprCacheValue)
+	delete(c.values, value.key)
+	c.size -= value.memSize()
+}
+
+func (v *coprCacheValue) memSize() int {
+	size := len(v.key) + len(v.data)
+	if v.scanned != nil {
+		size += v.scanned.Size()
+	}
+	return size
+}
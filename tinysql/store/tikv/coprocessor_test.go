@@ -15,11 +15,14 @@ package tikv
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
 	. "github.com/pingcap/check"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/store/mockstore/mocktikv"
+	"github.com/pingcap/tidb/store/tikv/tikvrpc"
 )
 
 type testCoprocessorSuite struct {
@@ -215,3 +218,150 @@ func (s *testCoprocessorSuite) testSplit(c *C, ranges *copRanges, checkLeft bool
 		}
 	}
 }
+
+func (s *testCoprocessorSuite) TestNextPage(c *C) {
+	worker := &copIteratorWorker{req: &kv.Request{Paging: true}}
+	task := &copTask{ranges: buildCopRanges("a", "c", "e", "g"), pagingSize: minPagingSize}
+
+	next := worker.nextPage(task, &coprocessor.KeyRange{Start: []byte("a"), End: []byte("b")})
+	c.Assert(next, HasLen, 1)
+	s.taskEqual(c, next[0], 0, "b", "c", "e", "g")
+	c.Assert(next[0].ranges.len(), Equals, 2)
+	c.Assert(next[0].pagingSize, Equals, uint64(2*minPagingSize))
+
+	// The ranges scanned are left out.
+	next = worker.nextPage(next[0], &coprocessor.KeyRange{Start: []byte("b"), End: []byte("f")})
+	c.Assert(next, HasLen, 1)
+	s.taskEqual(c, next[0], 0, "f", "g")
+	c.Assert(next[0].ranges.len(), Equals, 1)
+
+	// A page which didn't stop at its budget ends the task, and so does one which stopped at the end of the ranges.
+	c.Assert(worker.nextPage(next[0], nil), HasLen, 0)
+	c.Assert(worker.nextPage(next[0], &coprocessor.KeyRange{Start: []byte("f"), End: []byte("g")}), HasLen, 0)
+
+	// A desc scan leaves the ranges before the start of the range scanned.
+	worker.req.Desc = true
+	task.pagingSize = maxPagingSize
+	next = worker.nextPage(task, &coprocessor.KeyRange{Start: []byte("f"), End: []byte("g")})
+	c.Assert(next, HasLen, 1)
+	s.taskEqual(c, next[0], 0, "a", "c", "e", "f")
+	c.Assert(next[0].ranges.len(), Equals, 2)
+	c.Assert(next[0].pagingSize, Equals, uint64(maxPagingSize))
+
+	// A task which isn't paged is done with its first response.
+	task.pagingSize = 0
+	c.Assert(worker.nextPage(task, &coprocessor.KeyRange{Start: []byte("f"), End: []byte("g")}), HasLen, 0)
+}
+
+func (s *testCoprocessorSuite) TestCoprCache(c *C) {
+	cache := newCoprCache(150)
+	req := &coprocessor.Request{Data: []byte("plan"), Ranges: []*coprocessor.KeyRange{{Start: []byte("a"), End: []byte("b")}}}
+	key1 := coprCacheBuildKey(1, req)
+	key2 := coprCacheBuildKey(2, req)
+	c.Assert(key1, Not(DeepEquals), key2)
+	req.Ranges[0].End = []byte("c")
+	c.Assert(coprCacheBuildKey(1, req), Not(DeepEquals), key1)
+
+	cache.Set(key1, &coprocessor.Response{Data: []byte("data1"), CacheLastVersion: 5}, 10)
+	value := cache.Get(key1, 10)
+	c.Assert(value, NotNil)
+	c.Assert(value.data, DeepEquals, []byte("data1"))
+	c.Assert(value.dataVersion, Equals, uint64(5))
+	// A response read at a later start ts may have missed the changes committed before the start ts.
+	c.Assert(cache.Get(key1, 9), IsNil)
+	c.Assert(cache.Get(key2, 10), IsNil)
+
+	// The least recently used response is evicted once the cache is full.
+	cache.Set(key2, &coprocessor.Response{Data: make([]byte, 60)}, 10)
+	c.Assert(cache.Get(key1, 10), NotNil)
+	key3 := coprCacheBuildKey(3, req)
+	cache.Set(key3, &coprocessor.Response{Data: make([]byte, 60)}, 10)
+	c.Assert(cache.Get(key2, 10), IsNil)
+	c.Assert(cache.Get(key1, 10), NotNil)
+	c.Assert(cache.Get(key3, 10), NotNil)
+	c.Assert(cache.size, Equals, len(key1)+len("data1")+len(key3)+60)
+
+	// A response too large isn't cached.
+	cache.Set(key2, &coprocessor.Response{Data: make([]byte, coprCacheMaxValueSize+1)}, 10)
+	c.Assert(cache.Get(key2, 10), IsNil)
+}
+
+// cacheClient answers the coprocessor requests like TinyKV does for a region whose data version is version.
+type cacheClient struct {
+	Client
+	version uint64
+	reqs    []*coprocessor.Request
+}
+
+func (c *cacheClient) SendRequest(ctx context.Context, addr string, req *tikvrpc.Request, timeout time.Duration) (*tikvrpc.Response, error) {
+	if req.Type != tikvrpc.CmdCop {
+		return c.Client.SendRequest(ctx, addr, req, timeout)
+	}
+	copReq := req.Cop()
+	c.reqs = append(c.reqs, copReq)
+	if !copReq.IsCacheEnabled {
+		return &tikvrpc.Response{Resp: &coprocessor.Response{Data: []byte(fmt.Sprintf("data%d", c.version))}}, nil
+	}
+	if copReq.CacheIfMatchVersion == c.version {
+		return &tikvrpc.Response{Resp: &coprocessor.Response{IsCacheHit: true, CacheLastVersion: c.version}}, nil
+	}
+	return &tikvrpc.Response{Resp: &coprocessor.Response{
+		Data:             []byte(fmt.Sprintf("data%d", c.version)),
+		CacheLastVersion: c.version,
+		CanBeCached:      true,
+	}}, nil
+}
+
+func (s *testCoprocessorSuite) TestCoprCacheHit(c *C) {
+	rpcClient, pdClient, err := mocktikv.NewTiKVAndPDClient(nil, nil, "")
+	c.Assert(err, IsNil)
+	client := &cacheClient{version: 1}
+	store, err := NewTestTiKVStore(rpcClient, pdClient, func(inner Client) Client {
+		client.Client = inner
+		return client
+	}, nil)
+	c.Assert(err, IsNil)
+	defer store.Close()
+
+	send := func(startTs uint64, cacheable bool) string {
+		req := &kv.Request{
+			Tp:          kv.ReqTypeDAG,
+			StartTs:     startTs,
+			Data:        []byte("plan"),
+			KeyRanges:   buildKeyRanges("a", "z"),
+			Concurrency: 1,
+			Cacheable:   cacheable,
+		}
+		resp := store.GetClient().Send(context.Background(), req, kv.DefaultVars)
+		defer resp.Close()
+		result, err := resp.Next(context.Background())
+		c.Assert(err, IsNil)
+		c.Assert(result, NotNil)
+		return string(result.GetData())
+	}
+	lastReq := func() *coprocessor.Request {
+		return client.reqs[len(client.reqs)-1]
+	}
+
+	c.Assert(send(10, true), Equals, "data1")
+	c.Assert(lastReq().IsCacheEnabled, IsTrue)
+	c.Assert(lastReq().CacheIfMatchVersion, Equals, uint64(0))
+
+	// The data version of the region hasn't changed, the data is taken from the cache.
+	c.Assert(send(20, true), Equals, "data1")
+	c.Assert(lastReq().CacheIfMatchVersion, Equals, uint64(1))
+
+	// The cached response isn't valid at an earlier start ts.
+	c.Assert(send(5, true), Equals, "data1")
+	c.Assert(lastReq().CacheIfMatchVersion, Equals, uint64(0))
+
+	// The data of the region has changed.
+	client.version = 2
+	c.Assert(send(30, true), Equals, "data2")
+	c.Assert(lastReq().CacheIfMatchVersion, Equals, uint64(1))
+	c.Assert(send(40, true), Equals, "data2")
+	c.Assert(lastReq().CacheIfMatchVersion, Equals, uint64(2))
+
+	c.Assert(send(50, false), Equals, "data2")
+	c.Assert(lastReq().IsCacheEnabled, IsFalse)
+}
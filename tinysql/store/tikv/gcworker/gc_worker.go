@@ -0,0 +1,812 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcworker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	pd "github.com/pingcap-incubator/tinykv/scheduler/client"
+	"github.com/pingcap/errors"
+	ddlutil "github.com/pingcap/tidb/ddl/util"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/session"
+	"github.com/pingcap/tidb/store/tikv"
+	"github.com/pingcap/tidb/store/tikv/oracle"
+	"github.com/pingcap/tidb/store/tikv/tikvrpc"
+	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+// GCWorker periodically triggers GC process on tikv server.
+type GCWorker struct {
+	uuid        string
+	desc        string
+	store       tikv.Storage
+	pdClient    pd.Client
+	gcIsRunning bool
+	lastFinish  time.Time
+	cancel      context.CancelFunc
+	done        chan error
+}
+
+// NewGCWorker creates a GCWorker instance.
+func NewGCWorker(store tikv.Storage, pdClient pd.Client) (tikv.GCHandler, error) {
+	ver, err := store.CurrentVersion()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	hostName, err := os.Hostname()
+	if err != nil {
+		hostName = "unknown"
+	}
+	worker := &GCWorker{
+		uuid:       strconv.FormatUint(ver.Ver, 16),
+		desc:       fmt.Sprintf("host:%s, pid:%d, start at %s", hostName, os.Getpid(), time.Now()),
+		store:      store,
+		pdClient:   pdClient,
+		lastFinish: time.Now(),
+		// Buffered so that a job finishing after the worker is closed doesn't block forever.
+		done: make(chan error, 1),
+	}
+	return worker, nil
+}
+
+// Start starts the worker.
+func (w *GCWorker) Start() {
+	var ctx context.Context
+	ctx, w.cancel = context.WithCancel(context.Background())
+	go w.start(ctx)
+}
+
+// Close stops background goroutines.
+func (w *GCWorker) Close() {
+	w.cancel()
+}
+
+const (
+	booleanTrue  = "true"
+	booleanFalse = "false"
+
+	gcWorkerTickInterval = time.Minute
+	gcWorkerLease        = time.Minute * 2
+	gcLeaderUUIDKey      = "tikv_gc_leader_uuid"
+	gcLeaderDescKey      = "tikv_gc_leader_desc"
+	gcLeaderLeaseKey     = "tikv_gc_leader_lease"
+
+	gcLastRunTimeKey     = "tikv_gc_last_run_time"
+	gcRunIntervalKey     = "tikv_gc_run_interval"
+	gcDefaultRunInterval = time.Minute * 10
+	gcWaitTime           = time.Minute * 1
+
+	gcLifeTimeKey        = "tikv_gc_life_time"
+	gcDefaultLifeTime    = time.Minute * 10
+	gcSafePointKey       = "tikv_gc_safe_point"
+	gcEnableKey          = "tikv_gc_enable"
+	gcDefaultEnableValue = true
+
+	gcConcurrency    = 2
+	gcScanLockLimit  = tikv.ResolvedCacheSize / 2
+	gcKeysPerRequest = 1024
+)
+
+// gcMinLifeTime is a var so that tests can shorten it.
+var gcMinLifeTime = time.Minute * 10
+
+var gcVariableComments = map[string]string{
+	gcLeaderUUIDKey:  "Current GC worker leader UUID. (DO NOT EDIT)",
+	gcLeaderDescKey:  "Host name and pid of current GC leader. (DO NOT EDIT)",
+	gcLeaderLeaseKey: "Current GC worker leader lease. (DO NOT EDIT)",
+	gcLastRunTimeKey: "The time when last GC starts. (DO NOT EDIT)",
+	gcRunIntervalKey: "GC run interval, at least 10m, in Go format.",
+	gcLifeTimeKey:    "All versions within life time will not be collected by GC, at least 10m, in Go format.",
+	gcSafePointKey:   "All versions after safe point can be accessed. (DO NOT EDIT)",
+	gcEnableKey:      "Current GC enable status",
+}
+
+func (w *GCWorker) start(ctx context.Context) {
+	logutil.Logger(ctx).Info("[gc worker] start", zap.String("uuid", w.uuid))
+	ticker := time.NewTicker(gcWorkerTickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.tick(ctx)
+		case err := <-w.done:
+			w.gcIsRunning = false
+			w.lastFinish = time.Now()
+			if err != nil {
+				logutil.Logger(ctx).Error("[gc worker] runGCJob", zap.Error(err))
+			}
+		case <-ctx.Done():
+			logutil.Logger(ctx).Info("[gc worker] quit", zap.String("uuid", w.uuid))
+			return
+		}
+	}
+}
+
+func (w *GCWorker) tick(ctx context.Context) {
+	isLeader, err := w.checkLeader()
+	if err != nil {
+		logutil.Logger(ctx).Warn("[gc worker] check leader", zap.Error(err))
+		return
+	}
+	if isLeader {
+		err = w.leaderTick(ctx)
+		if err != nil {
+			logutil.Logger(ctx).Warn("[gc worker] leader tick", zap.Error(err))
+		}
+	}
+}
+
+// leaderTick of GC worker checks if it should start a GC job every tick.
+func (w *GCWorker) leaderTick(ctx context.Context) error {
+	if w.gcIsRunning {
+		logutil.Logger(ctx).Info("[gc worker] there's already a gc job running, skipped",
+			zap.String("leaderTick on", w.uuid))
+		return nil
+	}
+	// When the worker is just started, or an old GC job has just finished,
+	// wait a while before starting a new job.
+	if time.Since(w.lastFinish) < gcWaitTime {
+		logutil.Logger(ctx).Info("[gc worker] another gc job has just finished, skipped.",
+			zap.String("leaderTick on ", w.uuid))
+		return nil
+	}
+
+	ok, safePoint, err := w.prepare()
+	if err != nil || !ok {
+		return errors.Trace(err)
+	}
+
+	w.gcIsRunning = true
+	logutil.Logger(ctx).Info("[gc worker] starts the whole job",
+		zap.String("uuid", w.uuid),
+		zap.Uint64("safePoint", safePoint))
+	go func() {
+		w.done <- w.runGCJob(ctx, safePoint)
+	}()
+	return nil
+}
+
+// prepare checks preconditions for starting a GC job. It returns a bool
+// that indicates whether the GC job should start and the new safePoint.
+func (w *GCWorker) prepare() (bool, uint64, error) {
+	// The checks and the update of the safe point run in one transaction, so that a user who disables GC or reads
+	// `tikv_gc_safe_point` concurrently sees a consistent state.
+	ctx := context.Background()
+	se := createSession(w.store)
+	defer se.Close()
+	_, err := se.Execute(ctx, "BEGIN")
+	if err != nil {
+		return false, 0, errors.Trace(err)
+	}
+	doGC, safePoint, err := w.checkPrepare(ctx, se)
+	if doGC {
+		_, err = se.Execute(ctx, "COMMIT")
+		if err != nil {
+			return false, 0, errors.Trace(err)
+		}
+	} else {
+		se.RollbackTxn(ctx)
+	}
+	return doGC, safePoint, errors.Trace(err)
+}
+
+func (w *GCWorker) checkPrepare(ctx context.Context, se session.Session) (bool, uint64, error) {
+	enable, err := w.loadBooleanWithDefault(se, gcEnableKey, gcDefaultEnableValue)
+	if err != nil {
+		return false, 0, errors.Trace(err)
+	}
+	if !enable {
+		logutil.Logger(ctx).Warn("[gc worker] gc status is disabled.")
+		return false, 0, nil
+	}
+	now, err := w.getOracleTime()
+	if err != nil {
+		return false, 0, errors.Trace(err)
+	}
+	ok, err := w.checkGCInterval(se, now)
+	if err != nil || !ok {
+		return false, 0, errors.Trace(err)
+	}
+	newSafePoint, err := w.calculateNewSafePoint(se, now)
+	if err != nil || newSafePoint == nil {
+		return false, 0, errors.Trace(err)
+	}
+	err = w.saveTime(se, gcLastRunTimeKey, now)
+	if err != nil {
+		return false, 0, errors.Trace(err)
+	}
+	err = w.saveTime(se, gcSafePointKey, *newSafePoint)
+	if err != nil {
+		return false, 0, errors.Trace(err)
+	}
+	return true, oracle.ComposeTS(oracle.GetPhysical(*newSafePoint), 0), nil
+}
+
+func (w *GCWorker) getOracleTime() (time.Time, error) {
+	currentVer, err := w.store.CurrentVersion()
+	if err != nil {
+		return time.Time{}, errors.Trace(err)
+	}
+	return oracle.GetTimeFromTS(currentVer.Ver), nil
+}
+
+func (w *GCWorker) checkGCInterval(se session.Session, now time.Time) (bool, error) {
+	runInterval, err := w.loadDurationWithDefault(se, gcRunIntervalKey, gcDefaultRunInterval)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	lastRun, err := w.loadTime(se, gcLastRunTimeKey)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	if lastRun != nil && lastRun.Add(runInterval).After(now) {
+		logutil.BgLogger().Debug("[gc worker] skipping garbage collection because gc interval hasn't elapsed since last run",
+			zap.String("leaderTick on", w.uuid),
+			zap.Duration("interval", runInterval),
+			zap.Time("last run", *lastRun))
+		return false, nil
+	}
+	return true, nil
+}
+
+// calculateNewSafePoint returns the new safe point, which is `tikv_gc_life_time` before now, or nil if it would not
+// advance the last safe point.
+func (w *GCWorker) calculateNewSafePoint(se session.Session, now time.Time) (*time.Time, error) {
+	lifeTime, err := w.loadDurationWithDefault(se, gcLifeTimeKey, gcDefaultLifeTime)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if lifeTime < gcMinLifeTime {
+		lifeTime = gcMinLifeTime
+	}
+	lastSafePoint, err := w.loadTime(se, gcSafePointKey)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	safePoint := now.Add(-lifeTime)
+	// We should never decrease safePoint.
+	if lastSafePoint != nil && !safePoint.After(*lastSafePoint) {
+		logutil.BgLogger().Info("[gc worker] last safe point is later than current one. "+
+			"No need to gc. This might be caused by manually enlarging gc lifetime",
+			zap.String("leaderTick on", w.uuid),
+			zap.Time("last safe point", *lastSafePoint),
+			zap.Time("current safe point", safePoint))
+		return nil, nil
+	}
+	return &safePoint, nil
+}
+
+// runGCJob resolves the locks older than safePoint, publishes safePoint and then removes the versions it makes
+// unreachable.
+func (w *GCWorker) runGCJob(ctx context.Context, safePoint uint64) error {
+	err := w.resolveLocks(ctx, safePoint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = w.deleteRanges(ctx, safePoint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// Publish the safe point before removing any version, so that reads older than it fail instead of missing
+	// collected versions.
+	err = w.saveSafePoint(w.store.GetSafePointKV(), safePoint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = w.uploadSafePointToPD(ctx, safePoint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = w.doGC(ctx, safePoint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	logutil.Logger(ctx).Info("[gc worker] finish gc job",
+		zap.String("uuid", w.uuid),
+		zap.Uint64("safePoint", safePoint))
+	return nil
+}
+
+func (w *GCWorker) resolveLocks(ctx context.Context, safePoint uint64) error {
+	handler := func(ctx context.Context, r kv.KeyRange) (tikv.RangeTaskStat, error) {
+		return w.resolveLocksForRange(ctx, safePoint, r.StartKey, r.EndKey)
+	}
+
+	runner := tikv.NewRangeTaskRunner("resolve-locks-runner", w.store, gcConcurrency, handler)
+	// Run resolve lock on the whole TiKV cluster. Empty keys means the range is unbounded.
+	err := runner.RunOnRange(ctx, []byte(""), []byte(""))
+	if err != nil {
+		logutil.Logger(ctx).Error("[gc worker] resolve locks failed",
+			zap.String("uuid", w.uuid),
+			zap.Uint64("safePoint", safePoint),
+			zap.Error(err))
+		return errors.Trace(err)
+	}
+
+	logutil.Logger(ctx).Info("[gc worker] finish resolve locks",
+		zap.String("uuid", w.uuid),
+		zap.Uint64("safePoint", safePoint),
+		zap.Int("regions", runner.CompletedRegions()))
+	return nil
+}
+
+func (w *GCWorker) resolveLocksForRange(ctx context.Context, safePoint uint64, startKey []byte, endKey []byte) (tikv.RangeTaskStat, error) {
+	var stat tikv.RangeTaskStat
+	key := startKey
+	bo := tikv.NewBackoffer(ctx, tikv.GcResolveLockMaxBackoff)
+	for {
+		select {
+		case <-ctx.Done():
+			return stat, errors.New("[gc worker] gc job canceled")
+		default:
+		}
+
+		req := tikvrpc.NewRequest(tikvrpc.CmdScanLock, &kvrpcpb.ScanLockRequest{
+			MaxVersion: safePoint,
+			StartKey:   key,
+			Limit:      gcScanLockLimit,
+		})
+		loc, err := w.store.GetRegionCache().LocateKey(bo, key)
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		resp, err := w.store.SendReq(bo, req, loc.Region, tikv.ReadTimeoutMedium)
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(tikv.BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return stat, errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return stat, errors.Trace(tikv.ErrBodyMissing)
+		}
+		locksResp := resp.Resp.(*kvrpcpb.ScanLockResponse)
+		if locksResp.GetError() != nil {
+			return stat, errors.Errorf("unexpected scanlock error: %s", locksResp)
+		}
+		locksInfo := locksResp.GetLocks()
+		locks := make([]*tikv.Lock, len(locksInfo))
+		for i := range locksInfo {
+			locks[i] = tikv.NewLock(locksInfo[i])
+		}
+
+		msBeforeExpired, _, err := w.store.GetLockResolver().ResolveLocks(bo, 0, locks)
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		if msBeforeExpired > 0 {
+			// Some transactions older than the safe point are still alive, wait for them and scan again.
+			err = bo.BackoffWithMaxSleep(tikv.BoTxnLock, int(msBeforeExpired),
+				errors.Errorf("[gc worker] %d locks are not expired", len(locks)))
+			if err != nil {
+				return stat, errors.Trace(err)
+			}
+			continue
+		}
+
+		if len(locks) < gcScanLockLimit {
+			stat.CompletedRegions++
+			key = loc.EndKey
+		} else {
+			// The locks have been resolved, so the last one won't be scanned again.
+			key = locks[len(locks)-1].Key
+		}
+		if len(key) == 0 || (len(endKey) != 0 && bytes.Compare(key, endKey) >= 0) {
+			break
+		}
+		bo = tikv.NewBackoffer(ctx, tikv.GcResolveLockMaxBackoff)
+	}
+	return stat, nil
+}
+
+func (w *GCWorker) saveSafePoint(kv tikv.SafePointKV, t uint64) error {
+	s := strconv.FormatUint(t, 10)
+	err := kv.Put(tikv.GcSavedSafePoint, s)
+	if err != nil {
+		logutil.BgLogger().Error("save safepoint failed", zap.Error(err))
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+func (w *GCWorker) uploadSafePointToPD(ctx context.Context, safePoint uint64) error {
+	var newSafePoint uint64
+	var err error
+
+	bo := tikv.NewBackoffer(ctx, tikv.GcOneRegionMaxBackoff)
+	for {
+		newSafePoint, err = w.pdClient.UpdateGCSafePoint(ctx, safePoint)
+		if err != nil {
+			if errors.Cause(err) == context.Canceled {
+				return errors.Trace(err)
+			}
+			err = bo.Backoff(tikv.BoPDRPC, errors.Errorf("failed to upload safe point to PD, err: %v", err))
+			if err != nil {
+				return errors.Trace(err)
+			}
+			continue
+		}
+		break
+	}
+
+	if newSafePoint != safePoint {
+		logutil.Logger(ctx).Warn("[gc worker] PD rejected safe point",
+			zap.String("uuid", w.uuid),
+			zap.Uint64("our safe point", safePoint),
+			zap.Uint64("using another safe point", newSafePoint))
+		return errors.Errorf("PD rejected our safe point %v but is using another safe point %v", safePoint, newSafePoint)
+	}
+	logutil.Logger(ctx).Info("[gc worker] sent safe point to PD",
+		zap.String("uuid", w.uuid),
+		zap.Uint64("safe point", safePoint))
+	return nil
+}
+
+func (w *GCWorker) doGC(ctx context.Context, safePoint uint64) error {
+	handler := func(ctx context.Context, r kv.KeyRange) (tikv.RangeTaskStat, error) {
+		return w.doGCForRange(ctx, safePoint, r.StartKey, r.EndKey)
+	}
+
+	runner := tikv.NewRangeTaskRunner("gc-runner", w.store, gcConcurrency, handler)
+	// Run GC on the whole TiKV cluster. Empty keys means the range is unbounded.
+	err := runner.RunOnRange(ctx, []byte(""), []byte(""))
+	if err != nil {
+		logutil.Logger(ctx).Error("[gc worker] gc failed",
+			zap.String("uuid", w.uuid),
+			zap.Uint64("safePoint", safePoint),
+			zap.Error(err))
+		return errors.Trace(err)
+	}
+
+	logutil.Logger(ctx).Info("[gc worker] finish gc",
+		zap.String("uuid", w.uuid),
+		zap.Uint64("safePoint", safePoint),
+		zap.Int("regions", runner.CompletedRegions()))
+	return nil
+}
+
+func (w *GCWorker) doGCForRange(ctx context.Context, safePoint uint64, startKey []byte, endKey []byte) (tikv.RangeTaskStat, error) {
+	var stat tikv.RangeTaskStat
+	key := startKey
+	bo := tikv.NewBackoffer(ctx, tikv.GcOneRegionMaxBackoff)
+	for {
+		select {
+		case <-ctx.Done():
+			return stat, errors.New("[gc worker] gc job canceled")
+		default:
+		}
+
+		req := tikvrpc.NewRequest(tikvrpc.CmdGC, &kvrpcpb.GCRequest{
+			SafePoint: safePoint,
+			StartKey:  key,
+			Limit:     gcKeysPerRequest,
+		})
+		loc, err := w.store.GetRegionCache().LocateKey(bo, key)
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		resp, err := w.store.SendReq(bo, req, loc.Region, tikv.ReadTimeoutLong)
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(tikv.BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return stat, errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return stat, errors.Trace(tikv.ErrBodyMissing)
+		}
+		gcResp := resp.Resp.(*kvrpcpb.GCResponse)
+		if gcResp.GetError() != nil {
+			return stat, errors.Errorf("unexpected gc error: %s", gcResp.GetError())
+		}
+
+		if len(gcResp.GetNextKey()) > 0 {
+			key = gcResp.GetNextKey()
+		} else {
+			stat.CompletedRegions++
+			key = loc.EndKey
+		}
+		if len(key) == 0 || (len(endKey) != 0 && bytes.Compare(key, endKey) >= 0) {
+			break
+		}
+		bo = tikv.NewBackoffer(ctx, tikv.GcOneRegionMaxBackoff)
+	}
+	return stat, nil
+}
+
+// deleteRanges deletes the ranges of the tables and indexes which were dropped before safePoint, see the
+// mysql.gc_delete_range table.
+func (w *GCWorker) deleteRanges(ctx context.Context, safePoint uint64) error {
+	se := createSession(w.store)
+	ranges, err := ddlutil.LoadDeleteRanges(se, safePoint)
+	se.Close()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	handler := func(ctx context.Context, r kv.KeyRange) (tikv.RangeTaskStat, error) {
+		return w.deleteRangesForRange(ctx, r.StartKey, r.EndKey)
+	}
+	for _, r := range ranges {
+		startKey, endKey := r.Range()
+		runner := tikv.NewRangeTaskRunner("delete-range-runner", w.store, gcConcurrency, handler)
+		err = runner.RunOnRange(ctx, startKey, endKey)
+		if err != nil {
+			logutil.Logger(ctx).Error("[gc worker] delete range failed",
+				zap.String("uuid", w.uuid),
+				zap.Int64("jobID", r.JobID),
+				zap.Int64("elementID", r.ElementID),
+				zap.Error(err))
+			return errors.Trace(err)
+		}
+
+		se := createSession(w.store)
+		err = ddlutil.CompleteDeleteRange(se, r)
+		se.Close()
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	logutil.Logger(ctx).Info("[gc worker] finish delete ranges",
+		zap.String("uuid", w.uuid),
+		zap.Uint64("safePoint", safePoint),
+		zap.Int("ranges", len(ranges)))
+	return nil
+}
+
+func (w *GCWorker) deleteRangesForRange(ctx context.Context, startKey []byte, endKey []byte) (tikv.RangeTaskStat, error) {
+	var stat tikv.RangeTaskStat
+	key := startKey
+	bo := tikv.NewBackoffer(ctx, tikv.GcOneRegionMaxBackoff)
+	for {
+		select {
+		case <-ctx.Done():
+			return stat, errors.New("[gc worker] gc job canceled")
+		default:
+		}
+
+		req := tikvrpc.NewRequest(tikvrpc.CmdDeleteRange, &kvrpcpb.DeleteRangeRequest{
+			StartKey: key,
+			EndKey:   endKey,
+		})
+		loc, err := w.store.GetRegionCache().LocateKey(bo, key)
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		resp, err := w.store.SendReq(bo, req, loc.Region, tikv.UnsafeDestroyRangeTimeout)
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(tikv.BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return stat, errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return stat, errors.Trace(tikv.ErrBodyMissing)
+		}
+		deleteRangeResp := resp.Resp.(*kvrpcpb.DeleteRangeResponse)
+		if deleteRangeResp.GetError() != "" {
+			return stat, errors.Errorf("unexpected delete range error: %s", deleteRangeResp.GetError())
+		}
+
+		stat.CompletedRegions++
+		key = loc.EndKey
+		if len(key) == 0 || (len(endKey) != 0 && bytes.Compare(key, endKey) >= 0) {
+			break
+		}
+		bo = tikv.NewBackoffer(ctx, tikv.GcOneRegionMaxBackoff)
+	}
+	return stat, nil
+}
+
+// checkLeader campaigns for the leadership of GC workers, only the leader runs GC jobs. The leader renews its lease
+// every tick, another worker takes over once the lease expires.
+func (w *GCWorker) checkLeader() (bool, error) {
+	se := createSession(w.store)
+	defer se.Close()
+
+	ctx := context.Background()
+	_, err := se.Execute(ctx, "BEGIN")
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	leader, err := w.loadValueFromSysTable(se, gcLeaderUUIDKey)
+	if err != nil {
+		se.RollbackTxn(ctx)
+		return false, errors.Trace(err)
+	}
+	logutil.BgLogger().Debug("[gc worker] got leader", zap.String("uuid", leader))
+	if leader == w.uuid {
+		err = w.saveTime(se, gcLeaderLeaseKey, time.Now().Add(gcWorkerLease))
+		if err != nil {
+			se.RollbackTxn(ctx)
+			return false, errors.Trace(err)
+		}
+		_, err = se.Execute(ctx, "COMMIT")
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		return true, nil
+	}
+
+	lease, err := w.loadTime(se, gcLeaderLeaseKey)
+	if err != nil {
+		se.RollbackTxn(ctx)
+		return false, errors.Trace(err)
+	}
+	if lease != nil && !lease.Before(time.Now()) {
+		se.RollbackTxn(ctx)
+		return false, nil
+	}
+
+	logutil.BgLogger().Debug("[gc worker] register as leader", zap.String("uuid", w.uuid))
+	err = w.saveValueToSysTable(se, gcLeaderUUIDKey, w.uuid)
+	if err != nil {
+		se.RollbackTxn(ctx)
+		return false, errors.Trace(err)
+	}
+	err = w.saveValueToSysTable(se, gcLeaderDescKey, w.desc)
+	if err != nil {
+		se.RollbackTxn(ctx)
+		return false, errors.Trace(err)
+	}
+	err = w.saveTime(se, gcLeaderLeaseKey, time.Now().Add(gcWorkerLease))
+	if err != nil {
+		se.RollbackTxn(ctx)
+		return false, errors.Trace(err)
+	}
+	// Another worker campaigning at the same time makes the commit fail with a write conflict.
+	_, err = se.Execute(ctx, "COMMIT")
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
+func (w *GCWorker) saveTime(se session.Session, key string, t time.Time) error {
+	return errors.Trace(w.saveValueToSysTable(se, key, t.Format(util.GCTimeFormat)))
+}
+
+func (w *GCWorker) loadTime(se session.Session, key string) (*time.Time, error) {
+	str, err := w.loadValueFromSysTable(se, key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if str == "" {
+		return nil, nil
+	}
+	t, err := util.CompatibleParseGCTime(str)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &t, nil
+}
+
+// loadDurationWithDefault loads a duration, saving def if it isn't set so that users can find and change it.
+func (w *GCWorker) loadDurationWithDefault(se session.Session, key string, def time.Duration) (time.Duration, error) {
+	str, err := w.loadValueFromSysTable(se, key)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if str == "" {
+		return def, errors.Trace(w.saveValueToSysTable(se, key, def.String()))
+	}
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return d, nil
+}
+
+// loadBooleanWithDefault loads a boolean, saving defaultValue if it isn't set so that users can find and change it.
+func (w *GCWorker) loadBooleanWithDefault(se session.Session, key string, defaultValue bool) (bool, error) {
+	str, err := w.loadValueFromSysTable(se, key)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	if str == "" {
+		value := booleanFalse
+		if defaultValue {
+			value = booleanTrue
+		}
+		return defaultValue, errors.Trace(w.saveValueToSysTable(se, key, value))
+	}
+	return strings.EqualFold(str, booleanTrue), nil
+}
+
+func (w *GCWorker) loadValueFromSysTable(se session.Session, key string) (string, error) {
+	ctx := context.Background()
+	stmt := fmt.Sprintf(`SELECT HIGH_PRIORITY VARIABLE_VALUE FROM mysql.tidb WHERE VARIABLE_NAME="%s"`, key)
+	rs, err := se.Execute(ctx, stmt)
+	if len(rs) > 0 {
+		defer terror.Call(rs[0].Close)
+	}
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	req := rs[0].NewChunk()
+	err = rs[0].Next(ctx, req)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if req.NumRows() == 0 {
+		logutil.BgLogger().Debug("[gc worker] load kv",
+			zap.String("key", key))
+		return "", nil
+	}
+	value := req.GetRow(0).GetString(0)
+	logutil.BgLogger().Debug("[gc worker] load kv",
+		zap.String("key", key),
+		zap.String("value", value))
+	return value, nil
+}
+
+func (w *GCWorker) saveValueToSysTable(se session.Session, key, value string) error {
+	stmt := fmt.Sprintf(`REPLACE HIGH_PRIORITY INTO mysql.tidb VALUES ("%s", "%s", "%s")`,
+		key, value, gcVariableComments[key])
+	_, err := se.Execute(context.Background(), stmt)
+	logutil.BgLogger().Debug("[gc worker] save kv",
+		zap.String("key", key),
+		zap.String("value", value),
+		zap.Error(err))
+	return errors.Trace(err)
+}
+
+func createSession(store kv.Storage) session.Session {
+	for {
+		se, err := session.CreateSession(store)
+		if err != nil {
+			logutil.BgLogger().Warn("[gc worker] create session", zap.Error(err))
+			continue
+		}
+		se.GetSessionVars().InRestrictedSQL = true
+		return se
+	}
+}
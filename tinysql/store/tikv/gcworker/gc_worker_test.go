@@ -0,0 +1,245 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcworker
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/session"
+	"github.com/pingcap/tidb/store/mockoracle"
+	"github.com/pingcap/tidb/store/mockstore"
+	"github.com/pingcap/tidb/store/mockstore/mocktikv"
+	"github.com/pingcap/tidb/store/tikv"
+	"github.com/pingcap/tidb/util/testkit"
+)
+
+func TestT(t *testing.T) {
+	TestingT(t)
+}
+
+type testGCWorkerSuite struct {
+	store     tikv.Storage
+	cluster   *mocktikv.Cluster
+	mvccStore mocktikv.MVCCStore
+	oracle    *mockoracle.MockOracle
+	gcWorker  *GCWorker
+	dom       *domain.Domain
+}
+
+var _ = Suite(&testGCWorkerSuite{})
+
+func (s *testGCWorkerSuite) SetUpTest(c *C) {
+	s.cluster = mocktikv.NewCluster()
+	mocktikv.BootstrapWithSingleStore(s.cluster)
+	s.mvccStore = mocktikv.MustNewMVCCStore()
+	store, err := mockstore.NewMockTikvStore(
+		mockstore.WithCluster(s.cluster),
+		mockstore.WithMVCCStore(s.mvccStore),
+	)
+	c.Assert(err, IsNil)
+	s.store = store.(tikv.Storage)
+	s.oracle = &mockoracle.MockOracle{}
+	s.store.SetOracle(s.oracle)
+
+	s.dom, err = session.BootstrapSession(s.store)
+	c.Assert(err, IsNil)
+
+	gcWorker, err := NewGCWorker(s.store, mocktikv.NewPDClient(s.cluster))
+	c.Assert(err, IsNil)
+	s.gcWorker = gcWorker.(*GCWorker)
+}
+
+func (s *testGCWorkerSuite) TearDownTest(c *C) {
+	s.dom.Close()
+	s.store.Close()
+}
+
+func (s *testGCWorkerSuite) timeEqual(c *C, t1, t2 time.Time, epsilon time.Duration) {
+	c.Assert(math.Abs(float64(t1.Sub(t2))), Less, float64(epsilon))
+}
+
+func (s *testGCWorkerSuite) mustPut(c *C, key, value string) {
+	txn, err := s.store.Begin()
+	c.Assert(err, IsNil)
+	err = txn.Set([]byte(key), []byte(value))
+	c.Assert(err, IsNil)
+	err = txn.Commit(context.Background())
+	c.Assert(err, IsNil)
+}
+
+func (s *testGCWorkerSuite) TestPrepareGC(c *C) {
+	now, err := s.gcWorker.getOracleTime()
+	c.Assert(err, IsNil)
+	ok, _, err := s.gcWorker.prepare()
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsTrue)
+
+	se := createSession(s.store)
+	defer se.Close()
+	lastRun, err := s.gcWorker.loadTime(se, gcLastRunTimeKey)
+	c.Assert(err, IsNil)
+	c.Assert(lastRun, NotNil)
+	safePoint, err := s.gcWorker.loadTime(se, gcSafePointKey)
+	c.Assert(err, IsNil)
+	s.timeEqual(c, safePoint.Add(gcDefaultLifeTime), now, 2*time.Second)
+
+	// The default values are saved so that users can change them.
+	runInterval, err := s.gcWorker.loadValueFromSysTable(se, gcRunIntervalKey)
+	c.Assert(err, IsNil)
+	c.Assert(runInterval, Equals, gcDefaultRunInterval.String())
+	enable, err := s.gcWorker.loadValueFromSysTable(se, gcEnableKey)
+	c.Assert(err, IsNil)
+	c.Assert(enable, Equals, booleanTrue)
+
+	// Run interval hasn't elapsed.
+	ok, _, err = s.gcWorker.prepare()
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+
+	// A longer life time doesn't move the safe point backwards.
+	s.oracle.AddOffset(time.Minute * 20)
+	err = s.gcWorker.saveValueToSysTable(se, gcLifeTimeKey, (time.Minute * 40).String())
+	c.Assert(err, IsNil)
+	ok, _, err = s.gcWorker.prepare()
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+
+	// GC can be disabled.
+	s.oracle.AddOffset(time.Minute * 40)
+	err = s.gcWorker.saveValueToSysTable(se, gcEnableKey, booleanFalse)
+	c.Assert(err, IsNil)
+	ok, _, err = s.gcWorker.prepare()
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+	err = s.gcWorker.saveValueToSysTable(se, gcEnableKey, booleanTrue)
+	c.Assert(err, IsNil)
+	ok, _, err = s.gcWorker.prepare()
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsTrue)
+}
+
+func (s *testGCWorkerSuite) TestCheckLeader(c *C) {
+	isLeader, err := s.gcWorker.checkLeader()
+	c.Assert(err, IsNil)
+	c.Assert(isLeader, IsTrue)
+	// The leader renews its lease.
+	isLeader, err = s.gcWorker.checkLeader()
+	c.Assert(err, IsNil)
+	c.Assert(isLeader, IsTrue)
+
+	other, err := NewGCWorker(s.store, mocktikv.NewPDClient(s.cluster))
+	c.Assert(err, IsNil)
+	otherWorker := other.(*GCWorker)
+	otherWorker.uuid = s.gcWorker.uuid + "-other"
+	isLeader, err = otherWorker.checkLeader()
+	c.Assert(err, IsNil)
+	c.Assert(isLeader, IsFalse)
+
+	// Another worker takes over once the lease expires.
+	se := createSession(s.store)
+	defer se.Close()
+	err = s.gcWorker.saveTime(se, gcLeaderLeaseKey, time.Now().Add(-time.Minute))
+	c.Assert(err, IsNil)
+	isLeader, err = otherWorker.checkLeader()
+	c.Assert(err, IsNil)
+	c.Assert(isLeader, IsTrue)
+	isLeader, err = s.gcWorker.checkLeader()
+	c.Assert(err, IsNil)
+	c.Assert(isLeader, IsFalse)
+}
+
+func (s *testGCWorkerSuite) TestRunGCJob(c *C) {
+	ctx := context.Background()
+	s.mustPut(c, "k1", "v1")
+	ver, err := s.store.CurrentVersion()
+	c.Assert(err, IsNil)
+	oldTS := ver.Ver
+	s.mustPut(c, "k1", "v2")
+
+	// A lock left by a transaction which has died.
+	lockTS, err := s.oracle.GetTimestamp(ctx)
+	c.Assert(err, IsNil)
+	_, errs := s.mvccStore.Prewrite(&kvrpcpb.PrewriteRequest{
+		Mutations:    []*kvrpcpb.Mutation{{Op: kvrpcpb.Op_Put, Key: []byte("k2"), Value: []byte("v")}},
+		PrimaryLock:  []byte("k2"),
+		StartVersion: lockTS,
+		LockTtl:      0,
+	})
+	for _, err := range errs {
+		c.Assert(err, IsNil)
+	}
+
+	safePoint, err := s.oracle.GetTimestamp(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(s.gcWorker.runGCJob(ctx, safePoint), IsNil)
+
+	locks, err := s.mvccStore.ScanLock(nil, nil, safePoint)
+	c.Assert(err, IsNil)
+	c.Assert(locks, HasLen, 0)
+
+	saved, err := s.store.GetSafePointKV().Get(tikv.GcSavedSafePoint)
+	c.Assert(err, IsNil)
+	c.Assert(saved, Equals, strconv.FormatUint(safePoint, 10))
+
+	// The newest version before the safe point is kept, older versions are removed.
+	val, err := s.mvccStore.Get([]byte("k1"), safePoint)
+	c.Assert(err, IsNil)
+	c.Assert(val, BytesEquals, []byte("v2"))
+	val, err = s.mvccStore.Get([]byte("k1"), oldTS)
+	c.Assert(err, IsNil)
+	c.Assert(val, IsNil)
+}
+
+func (s *testGCWorkerSuite) TestDeleteRanges(c *C) {
+	ctx := context.Background()
+	s.mustPut(c, "a1", "v")
+	s.mustPut(c, "a2", "v")
+	s.mustPut(c, "b1", "v")
+	s.cluster.SplitKeys(s.mvccStore, []byte("a"), []byte("b"), 4)
+
+	tk := testkit.NewTestKit(c, s.store)
+	insertRange := func(jobID int64, startKey, endKey string, ts uint64) {
+		tk.MustExec(fmt.Sprintf(`INSERT INTO mysql.gc_delete_range VALUES (%d, 1, "%s", "%s", %d)`,
+			jobID, hex.EncodeToString([]byte(startKey)), hex.EncodeToString([]byte(endKey)), ts))
+	}
+	ts, err := s.oracle.GetTimestamp(ctx)
+	c.Assert(err, IsNil)
+	insertRange(1, "a", "b", ts)
+	safePoint, err := s.oracle.GetTimestamp(ctx)
+	c.Assert(err, IsNil)
+	// Dropped after the safe point, so it must be kept.
+	insertRange(2, "b", "c", safePoint+1)
+
+	c.Assert(s.gcWorker.deleteRanges(ctx, safePoint), IsNil)
+
+	for _, key := range []string{"a1", "a2"} {
+		val, err := s.mvccStore.Get([]byte(key), math.MaxUint64)
+		c.Assert(err, IsNil)
+		c.Assert(val, IsNil)
+	}
+	val, err := s.mvccStore.Get([]byte("b1"), math.MaxUint64)
+	c.Assert(err, IsNil)
+	c.Assert(val, BytesEquals, []byte("v"))
+	tk.MustQuery("SELECT job_id FROM mysql.gc_delete_range").Check(testkit.Rows("2"))
+	tk.MustQuery("SELECT job_id FROM mysql.gc_delete_range_done").Check(testkit.Rows("1"))
+}
@@ -102,6 +102,19 @@ type EtcdBackend interface {
 	TLSConfig() *tls.Config
 }
 
+// GCHandler runs garbage collection job.
+type GCHandler interface {
+	// Start GC worker background goroutine.
+	Start()
+
+	// Close closes the GCWorker.
+	Close()
+}
+
+// NewGCHandlerFunc creates a new GCHandler.
+// To enable real GC, we should assign the function to `gcworker.NewGCWorker`.
+var NewGCHandlerFunc func(storage Storage, pdClient pd.Client) (GCHandler, error)
+
 // update oracle's lastTS every 2000ms.
 var oracleUpdateInterval = 2000
 
@@ -116,6 +129,7 @@ type TinykvStore struct {
 	etcdAddrs    []string
 	mock         bool
 	enableGC     bool
+	gcWorker     GCHandler
 
 	kv        SafePointKV
 	safePoint uint64
@@ -124,6 +138,8 @@ type TinykvStore struct {
 	closed    chan struct{} // this is used to nofity when the store is closed
 
 	replicaReadSeed uint32 // this is used to load balance followers / learners when replica read is enabled
+
+	coprCache *coprCache
 }
 
 func GetRegionCacheFromStore(store Storage) *RegionCache {
@@ -178,6 +194,7 @@ func newTikvStore(uuid string, pdClient pd.Client, spkv SafePointKV, client Clie
 		spTime:          time.Now(),
 		closed:          make(chan struct{}),
 		replicaReadSeed: rand.Uint32(),
+		coprCache:       newCoprCache(coprCacheCapacity),
 	}
 	store.lockResolver = newLockResolver(store)
 	store.enableGC = enableGC
@@ -191,6 +208,21 @@ func (s *TinykvStore) EtcdAddrs() []string {
 	return s.etcdAddrs
 }
 
+// StartGCWorker starts a GC worker for the store, unless GC is disabled or no GC worker is registered.
+func (s *TinykvStore) StartGCWorker() error {
+	if !s.enableGC || NewGCHandlerFunc == nil {
+		return nil
+	}
+
+	gcWorker, err := NewGCHandlerFunc(s, s.PdClient)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	gcWorker.Start()
+	s.gcWorker = gcWorker
+	return nil
+}
+
 func (s *TinykvStore) runSafePointChecker() {
 	d := gcSafePointUpdateInterval
 	for {
@@ -242,6 +274,9 @@ func (s *TinykvStore) Close() error {
 
 	delete(mc.cache, s.uuid)
 	s.oracle.Close()
+	if s.gcWorker != nil {
+		s.gcWorker.Close()
+	}
 	s.PdClient.Close()
 
 	close(s.closed)
@@ -86,6 +86,8 @@ type TxnStatus struct {
 	ttl      uint64
 	commitTS uint64
 	action   kvrpcpb.Action
+	// primaryLock is the primary lock of an async commit transaction which isn't rolled back yet.
+	primaryLock *kvrpcpb.LockInfo
 }
 
 // IsCommitted returns true if the txn's final status is Commit.
@@ -186,6 +188,23 @@ func (lr *LockResolver) ResolveLocks(bo *Backoffer, callerStartTS uint64, locks
 			return msBeforeTxnExpired.value(), nil, err
 		}
 
+		if status.ttl != 0 && status.primaryLock != nil && status.primaryLock.UseAsyncCommit &&
+			lr.store.GetOracle().UntilExpired(l.TxnID, status.ttl) <= 0 {
+			// The primary lock of an async commit transaction isn't rolled back when it expires, the
+			// transaction may be committed already.
+			cleanRegions, exists := cleanTxns[l.TxnID]
+			if !exists {
+				cleanRegions = make(map[RegionVerID]struct{})
+				cleanTxns[l.TxnID] = cleanRegions
+			}
+			status, err = lr.resolveAsyncCommitLock(bo, l, status, cleanRegions)
+			if err != nil {
+				msBeforeTxnExpired.update(0)
+				err = errors.Trace(err)
+				return msBeforeTxnExpired.value(), nil, err
+			}
+		}
+
 		if status.ttl == 0 {
 			// If the lock is committed or rollbacked, resolve lock.
 			cleanRegions, exists := cleanTxns[l.TxnID]
@@ -256,7 +275,7 @@ func (lr *LockResolver) GetTxnStatus(txnID uint64, callerStartTS uint64, primary
 	if err != nil {
 		return status, err
 	}
-	return lr.getTxnStatus(bo, txnID, primary, callerStartTS, currentTS, true)
+	return lr.getTxnStatus(bo, txnID, primary, callerStartTS, currentTS, true, false)
 }
 
 // getTxnStatusFromLock gets transaction status from given lock
@@ -271,7 +290,7 @@ func (lr *LockResolver) getTxnStatusFromLock(bo *Backoffer, l *Lock, callerStart
 
 	rollbackIfNotExist := false
 	for {
-		status, err = lr.getTxnStatus(bo, l.TxnID, l.Primary, callerStartTS, currentTS, rollbackIfNotExist)
+		status, err = lr.getTxnStatus(bo, l.TxnID, l.Primary, callerStartTS, currentTS, rollbackIfNotExist, false)
 		if err == nil {
 			return status, nil
 		}
@@ -281,7 +300,8 @@ func (lr *LockResolver) getTxnStatusFromLock(bo *Backoffer, l *Lock, callerStart
 
 // getTxnStatus sends the CheckTxnStatus request to the TiKV server.
 // When rollbackIfNotExist is false, the caller should be careful with the txnNotFoundErr error.
-func (lr *LockResolver) getTxnStatus(bo *Backoffer, txnID uint64, primary []byte, callerStartTS, currentTS uint64, rollbackIfNotExist bool) (TxnStatus, error) {
+// When forceSyncCommit is true, an expired async commit primary lock is rolled back like other locks.
+func (lr *LockResolver) getTxnStatus(bo *Backoffer, txnID uint64, primary []byte, callerStartTS, currentTS uint64, rollbackIfNotExist, forceSyncCommit bool) (TxnStatus, error) {
 	if s, ok := lr.getResolved(txnID); ok {
 		return s, nil
 	}
@@ -299,7 +319,7 @@ func (lr *LockResolver) getTxnStatus(bo *Backoffer, txnID uint64, primary []byte
 	var req *tikvrpc.Request
 	// build the request
 	// YOUR CODE HERE (lab2). -- Done
-	request := &kvrpcpb.CheckTxnStatusRequest{Context: &kvrpcpb.Context{}, PrimaryKey: primary, LockTs: txnID, CurrentTs: currentTS}
+	request := &kvrpcpb.CheckTxnStatusRequest{Context: &kvrpcpb.Context{}, PrimaryKey: primary, LockTs: txnID, CurrentTs: currentTS, ForceSyncCommit: forceSyncCommit}
 	req = tikvrpc.NewRequest(tikvrpc.CmdCheckTxnStatus, request, kvrpcpb.Context{})
 	for {
 		loc, err := lr.store.GetRegionCache().LocateKey(bo, primary)
@@ -328,11 +348,122 @@ func (lr *LockResolver) getTxnStatus(bo *Backoffer, txnID uint64, primary []byte
 		logutil.BgLogger().Debug("cmdResp", zap.Bool("nil", cmdResp == nil))
 		// Assign status with response
 		// YOUR CODE HERE (lab2).
-		status = TxnStatus{ttl: cmdResp.LockTtl, commitTS: cmdResp.CommitVersion, action: cmdResp.Action}
+		status = TxnStatus{ttl: cmdResp.LockTtl, commitTS: cmdResp.CommitVersion, action: cmdResp.Action, primaryLock: cmdResp.LockInfo}
 		return status, nil
 	}
 }
 
+// asyncResolveData is the status of the secondary keys of an async commit transaction.
+type asyncResolveData struct {
+	// commitTS is the commit ts of the transaction if a key is committed, or the largest min commit ts
+	// of the locks otherwise.
+	commitTS  uint64
+	committed bool
+	// missingLock is true if a key isn't locked by the transaction, which means it is rolled back.
+	missingLock bool
+	// fallback is true if a key is locked for the normal two phase commit.
+	fallback bool
+}
+
+// resolveAsyncCommitLock decides the status of an async commit transaction whose primary lock has
+// expired from the locks of its secondary keys, and resolves all its keys. The transaction is
+// committed iff all its keys are prewritten for async commit, at the largest min commit ts of their
+// locks.
+func (lr *LockResolver) resolveAsyncCommitLock(bo *Backoffer, l *Lock, status TxnStatus, cleanRegions map[RegionVerID]struct{}) (TxnStatus, error) {
+	primaryLock := status.primaryLock
+	data := asyncResolveData{commitTS: primaryLock.MinCommitTs}
+	if err := lr.checkSecondaries(bo, l.TxnID, primaryLock.Secondaries, &data); err != nil {
+		return TxnStatus{}, errors.Trace(err)
+	}
+
+	switch {
+	case data.committed:
+		status = TxnStatus{commitTS: data.commitTS, action: kvrpcpb.Action_NoAction}
+	case data.missingLock:
+		status = TxnStatus{action: kvrpcpb.Action_NoAction}
+	case data.fallback:
+		// The transaction is committed with the normal two phase commit, so its status is decided
+		// by the primary lock.
+		currentTS, err := lr.store.GetOracle().GetTimestamp(bo.ctx)
+		if err != nil {
+			return TxnStatus{}, errors.Trace(err)
+		}
+		return lr.getTxnStatus(bo, l.TxnID, l.Primary, 0, currentTS, false, true)
+	default:
+		status = TxnStatus{commitTS: data.commitTS, action: kvrpcpb.Action_NoAction}
+	}
+
+	logutil.BgLogger().Info("resolve async commit locks",
+		zap.Uint64("txnStartTS", l.TxnID),
+		zap.Uint64("commitTS", status.commitTS))
+	keys := append([][]byte{l.Primary}, primaryLock.Secondaries...)
+	for _, key := range keys {
+		// Resolve all the locks of the transaction in each region.
+		lock := &Lock{Key: key, Primary: l.Primary, TxnID: l.TxnID, TxnSize: bigTxnThreshold}
+		if err := lr.resolveLock(bo, lock, status, cleanRegions); err != nil {
+			return TxnStatus{}, errors.Trace(err)
+		}
+	}
+	lr.saveResolved(l.TxnID, status)
+	return status, nil
+}
+
+// checkSecondaries sends CheckSecondaryLocks requests for keys of the transaction started at txnID
+// and merges the responses into data.
+func (lr *LockResolver) checkSecondaries(bo *Backoffer, txnID uint64, keys [][]byte, data *asyncResolveData) error {
+	groups, _, err := lr.store.GetRegionCache().GroupKeysByRegion(bo, keys, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for region, keys := range groups {
+		request := &kvrpcpb.CheckSecondaryLocksRequest{Context: &kvrpcpb.Context{}, Keys: keys, StartVersion: txnID}
+		req := tikvrpc.NewRequest(tikvrpc.CmdCheckSecondaryLocks, request, kvrpcpb.Context{})
+		resp, err := lr.store.SendReq(bo, req, region, readTimeoutShort)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return errors.Trace(err)
+			}
+			// re-split keys and check again.
+			if err = lr.checkSecondaries(bo, txnID, keys, data); err != nil {
+				return errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return errors.Trace(ErrBodyMissing)
+		}
+		cmdResp := resp.Resp.(*kvrpcpb.CheckSecondaryLocksResponse)
+		if keyErr := cmdResp.GetError(); keyErr != nil {
+			return errors.Errorf("unexpected check secondary locks err: %s, txnStartTS: %d", keyErr, txnID)
+		}
+		if cmdResp.CommitTs != 0 {
+			data.committed = true
+			data.commitTS = cmdResp.CommitTs
+			return nil
+		}
+		if len(cmdResp.Locks) < len(keys) {
+			data.missingLock = true
+		}
+		for _, lock := range cmdResp.Locks {
+			if !lock.UseAsyncCommit {
+				data.fallback = true
+			}
+			if lock.MinCommitTs > data.commitTS {
+				data.commitTS = lock.MinCommitTs
+			}
+		}
+	}
+	return nil
+}
+
 // resolveLock resolve the lock for the given transaction status which is checked from primary key.
 // If status is committed, the secondary should also be committed.
 // If status is not committed and the
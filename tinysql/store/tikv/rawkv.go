@@ -14,7 +14,9 @@
 package tikv
 
 import (
+	"bytes"
 	"context"
+	"sync"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/client"
@@ -29,8 +31,16 @@ var (
 	ErrMaxScanLimitExceeded = errors.New("limit should be less than MaxRawKVScanLimit")
 )
 
-// RawKVClient is a client of TiKV server which is used as a key-value storage,
-// only GET/PUT/DELETE commands are supported.
+const (
+	// rawBatchPutSize is the maximum size of the pairs of one batch put request.
+	rawBatchPutSize = 16 * 1024
+	// rawBatchPairCount is the maximum number of keys of one batch get or delete request.
+	rawBatchPairCount = 512
+)
+
+// RawKVClient is a client of TiKV server which is used as a key-value storage. Besides GET/PUT/DELETE and scans, it
+// supports batch commands, deleting ranges, compare and swap and values with a time to live. The keys of a batch or a
+// range may span many regions, each of them gets its own request.
 type RawKVClient struct {
 	clusterID   uint64
 	regionCache *RegionCache
@@ -38,6 +48,20 @@ type RawKVClient struct {
 	rpcClient   Client
 }
 
+// NewRawKVClient creates a client with the addresses of the PD cluster.
+func NewRawKVClient(pdAddrs []string) (*RawKVClient, error) {
+	pdCli, err := pd.NewClient(pdAddrs, pd.SecurityOption{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &RawKVClient{
+		clusterID:   pdCli.GetClusterID(context.TODO()),
+		regionCache: NewRegionCache(pdCli),
+		pdClient:    pdCli,
+		rpcClient:   newRPCClient(),
+	}, nil
+}
+
 // Close closes the client.
 func (c *RawKVClient) Close() error {
 	if c.pdClient != nil {
@@ -77,8 +101,68 @@ func (c *RawKVClient) Get(key []byte) ([]byte, error) {
 	return cmdResp.Value, nil
 }
 
+// BatchGet queries values with the keys. The values are in the order of the keys, the value of a key which does not
+// exist is nil.
+func (c *RawKVClient) BatchGet(keys [][]byte) ([][]byte, error) {
+	var mu sync.Mutex
+	m := make(map[string][]byte, len(keys))
+	bo := NewBackoffer(context.Background(), rawkvMaxBackoff)
+	err := c.sendBatchReq(bo, keys, func(batch batchKeys) *tikvrpc.Request {
+		return tikvrpc.NewRequest(tikvrpc.CmdRawBatchGet, &kvrpcpb.RawBatchGetRequest{Keys: batch.keys})
+	}, func(resp *tikvrpc.Response) error {
+		cmdResp := resp.Resp.(*kvrpcpb.RawBatchGetResponse)
+		if cmdResp.GetError() != "" {
+			return errors.New(cmdResp.GetError())
+		}
+		mu.Lock()
+		for _, pair := range cmdResp.Pairs {
+			m[string(pair.Key)] = pair.Value
+		}
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		if v := m[string(key)]; len(v) > 0 {
+			values[i] = v
+		}
+	}
+	return values, nil
+}
+
+// GetKeyTTL returns the remaining time to live of a key in seconds, 0 if the key never expires. When the key does not
+// exist, it returns `nil, nil`.
+func (c *RawKVClient) GetKeyTTL(key []byte) (*uint64, error) {
+	req := tikvrpc.NewRequest(tikvrpc.CmdRawGetKeyTTL, &kvrpcpb.RawGetKeyTTLRequest{Key: key})
+	resp, _, err := c.sendReq(key, req, false)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if resp.Resp == nil {
+		return nil, errors.Trace(ErrBodyMissing)
+	}
+	cmdResp := resp.Resp.(*kvrpcpb.RawGetKeyTTLResponse)
+	if cmdResp.GetError() != "" {
+		return nil, errors.New(cmdResp.GetError())
+	}
+	if cmdResp.GetNotFound() {
+		return nil, nil
+	}
+	ttl := cmdResp.GetTtl()
+	return &ttl, nil
+}
+
 // Put stores a key-value pair to TiKV.
 func (c *RawKVClient) Put(key, value []byte) error {
+	return c.PutWithTTL(key, value, 0)
+}
+
+// PutWithTTL stores a key-value pair to TiKV which expires after ttl seconds, 0 means it never expires.
+func (c *RawKVClient) PutWithTTL(key, value []byte, ttl uint64) error {
 	if len(value) == 0 {
 		return errors.New("empty value is not supported")
 	}
@@ -86,6 +170,7 @@ func (c *RawKVClient) Put(key, value []byte) error {
 	req := tikvrpc.NewRequest(tikvrpc.CmdRawPut, &kvrpcpb.RawPutRequest{
 		Key:   key,
 		Value: value,
+		Ttl:   ttl,
 	})
 	resp, _, err := c.sendReq(key, req, false)
 	if err != nil {
@@ -101,6 +186,40 @@ func (c *RawKVClient) Put(key, value []byte) error {
 	return nil
 }
 
+// BatchPut stores key-value pairs to TiKV. It isn't atomic across regions: if it fails, some of the pairs may have
+// been stored.
+func (c *RawKVClient) BatchPut(keys, values [][]byte) error {
+	return c.BatchPutWithTTL(keys, values, 0)
+}
+
+// BatchPutWithTTL stores key-value pairs to TiKV which expire after ttl seconds, 0 means they never expire.
+func (c *RawKVClient) BatchPutWithTTL(keys, values [][]byte, ttl uint64) error {
+	if len(keys) != len(values) {
+		return errors.New("the len of keys is not equal to the len of values")
+	}
+	m := make(map[string][]byte, len(keys))
+	for i, value := range values {
+		if len(value) == 0 {
+			return errors.New("empty value is not supported")
+		}
+		m[string(keys[i])] = value
+	}
+	bo := NewBackoffer(context.Background(), rawkvMaxBackoff)
+	sizeFn := func(key []byte) int { return len(key) + len(m[string(key)]) }
+	return c.sendBatchReqBySize(bo, keys, sizeFn, rawBatchPutSize, func(batch batchKeys) *tikvrpc.Request {
+		pairs := make([]*kvrpcpb.KvPair, 0, len(batch.keys))
+		for _, key := range batch.keys {
+			pairs = append(pairs, &kvrpcpb.KvPair{Key: key, Value: m[string(key)]})
+		}
+		return tikvrpc.NewRequest(tikvrpc.CmdRawBatchPut, &kvrpcpb.RawBatchPutRequest{Pairs: pairs, Ttl: ttl})
+	}, func(resp *tikvrpc.Response) error {
+		if cmdResp := resp.Resp.(*kvrpcpb.RawBatchPutResponse); cmdResp.GetError() != "" {
+			return errors.New(cmdResp.GetError())
+		}
+		return nil
+	})
+}
+
 // Delete deletes a key-value pair from TiKV.
 func (c *RawKVClient) Delete(key []byte) error {
 	req := tikvrpc.NewRequest(tikvrpc.CmdRawDelete, &kvrpcpb.RawDeleteRequest{
@@ -120,19 +239,97 @@ func (c *RawKVClient) Delete(key []byte) error {
 	return nil
 }
 
+// BatchDelete deletes key-value pairs from TiKV.
+func (c *RawKVClient) BatchDelete(keys [][]byte) error {
+	bo := NewBackoffer(context.Background(), rawkvMaxBackoff)
+	return c.sendBatchReq(bo, keys, func(batch batchKeys) *tikvrpc.Request {
+		return tikvrpc.NewRequest(tikvrpc.CmdRawBatchDelete, &kvrpcpb.RawBatchDeleteRequest{Keys: batch.keys})
+	}, func(resp *tikvrpc.Response) error {
+		if cmdResp := resp.Resp.(*kvrpcpb.RawBatchDeleteResponse); cmdResp.GetError() != "" {
+			return errors.New(cmdResp.GetError())
+		}
+		return nil
+	})
+}
+
+// DeleteRange deletes all key-value pairs in range [startKey, endKey) from TiKV, region by region.
+// If endKey is empty, it means unbounded.
+func (c *RawKVClient) DeleteRange(startKey, endKey []byte) error {
+	for len(endKey) == 0 || bytes.Compare(startKey, endKey) < 0 {
+		req := tikvrpc.NewRequest(tikvrpc.CmdRawDeleteRange, &kvrpcpb.RawDeleteRangeRequest{
+			StartKey: startKey,
+			EndKey:   endKey,
+		})
+		resp, loc, err := c.sendReq(startKey, req, false)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if resp.Resp == nil {
+			return errors.Trace(ErrBodyMissing)
+		}
+		cmdResp := resp.Resp.(*kvrpcpb.RawDeleteRangeResponse)
+		if cmdResp.GetError() != "" {
+			return errors.New(cmdResp.GetError())
+		}
+		startKey = loc.EndKey
+		if len(startKey) == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+// CompareAndSwap stores newValue to key if the current value of key is previousValue, a nil previousValue means the
+// key must not exist. It returns the current value of key, which newValue replaced if it was stored, and whether
+// newValue was stored.
+func (c *RawKVClient) CompareAndSwap(key, previousValue, newValue []byte) ([]byte, bool, error) {
+	return c.CompareAndSwapWithTTL(key, previousValue, newValue, 0)
+}
+
+// CompareAndSwapWithTTL is CompareAndSwap, but newValue expires after ttl seconds, 0 means it never expires.
+func (c *RawKVClient) CompareAndSwapWithTTL(key, previousValue, newValue []byte, ttl uint64) ([]byte, bool, error) {
+	if len(newValue) == 0 {
+		return nil, false, errors.New("empty value is not supported")
+	}
+
+	req := tikvrpc.NewRequest(tikvrpc.CmdRawCompareAndSwap, &kvrpcpb.RawCASRequest{
+		Key:              key,
+		Value:            newValue,
+		PreviousNotExist: previousValue == nil,
+		PreviousValue:    previousValue,
+		Ttl:              ttl,
+	})
+	resp, _, err := c.sendReq(key, req, false)
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+	if resp.Resp == nil {
+		return nil, false, errors.Trace(ErrBodyMissing)
+	}
+	cmdResp := resp.Resp.(*kvrpcpb.RawCASResponse)
+	if cmdResp.GetError() != "" {
+		return nil, false, errors.New(cmdResp.GetError())
+	}
+	if cmdResp.GetPreviousNotExist() {
+		return nil, cmdResp.GetSucceed(), nil
+	}
+	return cmdResp.GetPreviousValue(), cmdResp.GetSucceed(), nil
+}
+
 // Scan queries continuous kv pairs in range [startKey, endKey), up to limit pairs.
 // If endKey is empty, it means unbounded.
 // If you want to exclude the startKey or include the endKey, append a '\0' to the key. For example, to scan
 // (startKey, endKey], you can write:
 // `Scan(append(startKey, '\0'), append(endKey, '\0'), limit)`.
-func (c *RawKVClient) Scan(startKey []byte, limit int) (keys [][]byte, values [][]byte, err error) {
+func (c *RawKVClient) Scan(startKey, endKey []byte, limit int) (keys [][]byte, values [][]byte, err error) {
 	if limit > MaxRawKVScanLimit {
 		return nil, nil, errors.Trace(ErrMaxScanLimitExceeded)
 	}
 
-	for len(keys) < limit {
+	for len(keys) < limit && (len(endKey) == 0 || bytes.Compare(startKey, endKey) < 0) {
 		req := tikvrpc.NewRequest(tikvrpc.CmdRawScan, &kvrpcpb.RawScanRequest{
 			StartKey: startKey,
+			EndKey:   endKey,
 			Limit:    uint32(limit - len(keys)),
 		})
 		resp, loc, err := c.sendReq(startKey, req, false)
@@ -143,6 +340,9 @@ func (c *RawKVClient) Scan(startKey []byte, limit int) (keys [][]byte, values []
 			return nil, nil, errors.Trace(ErrBodyMissing)
 		}
 		cmdResp := resp.Resp.(*kvrpcpb.RawScanResponse)
+		if cmdResp.GetError() != "" {
+			return nil, nil, errors.New(cmdResp.GetError())
+		}
 		for _, pair := range cmdResp.Kvs {
 			keys = append(keys, pair.Key)
 			values = append(values, pair.Value)
@@ -155,6 +355,50 @@ func (c *RawKVClient) Scan(startKey []byte, limit int) (keys [][]byte, values []
 	return
 }
 
+// ReverseScan queries continuous kv pairs in range [endKey, startKey), from startKey down, up to limit pairs.
+// If endKey is empty, it means unbounded. The startKey can't be empty, because locating the last region is not
+// supported.
+// If you want to include the startKey or exclude the endKey, append a '\0' to the key. For example, to scan
+// (endKey, startKey], you can write:
+// `ReverseScan(append(startKey, '\0'), append(endKey, '\0'), limit)`.
+func (c *RawKVClient) ReverseScan(startKey, endKey []byte, limit int) (keys [][]byte, values [][]byte, err error) {
+	if limit > MaxRawKVScanLimit {
+		return nil, nil, errors.Trace(ErrMaxScanLimitExceeded)
+	}
+	if len(startKey) == 0 {
+		return nil, nil, errors.New("reverse scan needs a start key")
+	}
+
+	for len(keys) < limit && bytes.Compare(startKey, endKey) > 0 {
+		req := tikvrpc.NewRequest(tikvrpc.CmdRawScan, &kvrpcpb.RawScanRequest{
+			StartKey: startKey,
+			EndKey:   endKey,
+			Limit:    uint32(limit - len(keys)),
+			Reverse:  true,
+		})
+		resp, loc, err := c.sendReq(startKey, req, true)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		if resp.Resp == nil {
+			return nil, nil, errors.Trace(ErrBodyMissing)
+		}
+		cmdResp := resp.Resp.(*kvrpcpb.RawScanResponse)
+		if cmdResp.GetError() != "" {
+			return nil, nil, errors.New(cmdResp.GetError())
+		}
+		for _, pair := range cmdResp.Kvs {
+			keys = append(keys, pair.Key)
+			values = append(values, pair.Value)
+		}
+		startKey = loc.StartKey
+		if len(startKey) == 0 {
+			break
+		}
+	}
+	return
+}
+
 func (c *RawKVClient) sendReq(key []byte, req *tikvrpc.Request, reverse bool) (*tikvrpc.Response, *KeyLocation, error) {
 	bo := NewBackoffer(context.Background(), rawkvMaxBackoff)
 	sender := NewRegionRequestSender(c.regionCache, c.rpcClient)
@@ -187,3 +431,67 @@ func (c *RawKVClient) sendReq(key []byte, req *tikvrpc.Request, reverse bool) (*
 		return resp, loc, nil
 	}
 }
+
+// sendBatchReq sends a request for each region of keys, with up to rawBatchPairCount keys in a request.
+func (c *RawKVClient) sendBatchReq(bo *Backoffer, keys [][]byte, newReq func(batchKeys) *tikvrpc.Request,
+	handleResp func(*tikvrpc.Response) error) error {
+	return c.sendBatchReqBySize(bo, keys, func([]byte) int { return 1 }, rawBatchPairCount, newReq, handleResp)
+}
+
+// sendBatchReqBySize groups keys by their regions and splits the groups into batches whose size is up to limit. The
+// request newReq builds for each batch is sent concurrently, and handleResp handles their responses concurrently. The
+// keys of a batch whose region has changed are grouped again.
+func (c *RawKVClient) sendBatchReqBySize(bo *Backoffer, keys [][]byte, sizeFn func([]byte) int, limit int,
+	newReq func(batchKeys) *tikvrpc.Request, handleResp func(*tikvrpc.Response) error) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	groups, _, err := c.regionCache.GroupKeysByRegion(bo, keys, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var batches []batchKeys
+	for id, g := range groups {
+		batches = appendBatchBySize(batches, id, g, sizeFn, limit)
+	}
+	ch := make(chan error, len(batches))
+	for _, batch1 := range batches {
+		batch := batch1
+		go func() {
+			backoffer, cancel := bo.Fork()
+			defer cancel()
+			ch <- c.doBatchReq(backoffer, batch, sizeFn, limit, newReq, handleResp)
+		}()
+	}
+	for i := 0; i < len(batches); i++ {
+		if e := <-ch; e != nil {
+			err = e
+		}
+	}
+	return errors.Trace(err)
+}
+
+func (c *RawKVClient) doBatchReq(bo *Backoffer, batch batchKeys, sizeFn func([]byte) int, limit int,
+	newReq func(batchKeys) *tikvrpc.Request, handleResp func(*tikvrpc.Response) error) error {
+	sender := NewRegionRequestSender(c.regionCache, c.rpcClient)
+	resp, err := sender.SendReq(bo, newReq(batch), batch.region, readTimeoutShort)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	regionErr, err := resp.GetRegionError()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if regionErr != nil {
+		err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return c.sendBatchReqBySize(bo, batch.keys, sizeFn, limit, newReq, handleResp)
+	}
+	if resp.Resp == nil {
+		return errors.Trace(ErrBodyMissing)
+	}
+	return handleResp(resp)
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tikv
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/store/mockstore/mocktikv"
+)
+
+type testRawKVSuite struct {
+	OneByOneSuite
+	cluster *mocktikv.Cluster
+	client  *RawKVClient
+	bo      *Backoffer
+}
+
+var _ = Suite(&testRawKVSuite{})
+
+func (s *testRawKVSuite) SetUpTest(c *C) {
+	s.cluster = mocktikv.NewCluster()
+	mocktikv.BootstrapWithSingleStore(s.cluster)
+	pdClient := mocktikv.NewPDClient(s.cluster)
+	s.client = &RawKVClient{
+		clusterID:   0,
+		regionCache: NewRegionCache(pdClient),
+		pdClient:    pdClient,
+		rpcClient:   mocktikv.NewRPCClient(s.cluster, mocktikv.MustNewMVCCStore()),
+	}
+	s.bo = NewBackoffer(context.Background(), 5000)
+}
+
+func (s *testRawKVSuite) TearDownTest(c *C) {
+	s.client.Close()
+}
+
+// split splits the region of regionKey at splitKey. Raw keys aren't encoded, so neither are the region keys.
+func (s *testRawKVSuite) split(c *C, regionKey, splitKey string) {
+	loc, err := s.client.regionCache.LocateKey(s.bo, []byte(regionKey))
+	c.Assert(err, IsNil)
+	newRegionID, peerID := s.cluster.AllocID(), s.cluster.AllocID()
+	s.cluster.SplitRaw(loc.Region.id, newRegionID, []byte(splitKey), []uint64{peerID}, peerID)
+}
+
+// putKeys puts the keys "k0" to "k<n-1>" with the values "v0" to "v<n-1>".
+func (s *testRawKVSuite) putKeys(c *C, n int) ([][]byte, [][]byte) {
+	var keys, values [][]byte
+	for i := 0; i < n; i++ {
+		keys = append(keys, []byte(fmt.Sprintf("k%d", i)))
+		values = append(values, []byte(fmt.Sprintf("v%d", i)))
+	}
+	c.Assert(s.client.BatchPut(keys, values), IsNil)
+	return keys, values
+}
+
+func (s *testRawKVSuite) mustScan(c *C, startKey, endKey string, limit int, expected ...string) {
+	keys, values, err := s.client.Scan([]byte(startKey), []byte(endKey), limit)
+	c.Assert(err, IsNil)
+	s.checkPairs(c, keys, values, expected)
+}
+
+func (s *testRawKVSuite) mustReverseScan(c *C, startKey, endKey string, limit int, expected ...string) {
+	keys, values, err := s.client.ReverseScan([]byte(startKey), []byte(endKey), limit)
+	c.Assert(err, IsNil)
+	s.checkPairs(c, keys, values, expected)
+}
+
+// checkPairs checks keys and values against expected, a list of keys followed by their values.
+func (s *testRawKVSuite) checkPairs(c *C, keys, values [][]byte, expected []string) {
+	c.Assert(keys, HasLen, len(expected)/2)
+	for i := range keys {
+		c.Assert(string(keys[i]), Equals, expected[2*i])
+		c.Assert(string(values[i]), Equals, expected[2*i+1])
+	}
+}
+
+func (s *testRawKVSuite) TestBatch(c *C) {
+	s.split(c, "", "k3")
+	s.split(c, "k3", "k6")
+	keys, values := s.putKeys(c, 9)
+
+	got, err := s.client.BatchGet(append(keys, []byte("k9")))
+	c.Assert(err, IsNil)
+	c.Assert(got, DeepEquals, append(values, nil))
+
+	c.Assert(s.client.BatchDelete([][]byte{keys[1], keys[4], keys[7]}), IsNil)
+	got, err = s.client.BatchGet(keys)
+	c.Assert(err, IsNil)
+	for i := range keys {
+		if i%3 == 1 {
+			c.Assert(got[i], IsNil)
+		} else {
+			c.Assert(got[i], BytesEquals, values[i])
+		}
+	}
+
+	err = s.client.BatchPut(keys, values[:1])
+	c.Assert(err, NotNil)
+	err = s.client.BatchPut(keys[:1], [][]byte{{}})
+	c.Assert(err, NotNil)
+}
+
+func (s *testRawKVSuite) TestScan(c *C) {
+	s.split(c, "", "k3")
+	s.split(c, "k3", "k6")
+	s.putKeys(c, 9)
+
+	s.mustScan(c, "k2", "k5", 10, "k2", "v2", "k3", "v3", "k4", "v4")
+	s.mustScan(c, "k5", "", 2, "k5", "v5", "k6", "v6")
+	s.mustScan(c, "k7", "", 10, "k7", "v7", "k8", "v8")
+	s.mustReverseScan(c, "k5", "k2", 10, "k4", "v4", "k3", "v3", "k2", "v2")
+	s.mustReverseScan(c, "k7", "", 2, "k6", "v6", "k5", "v5")
+	s.mustReverseScan(c, "k2", "", 10, "k1", "v1", "k0", "v0")
+	s.mustReverseScan(c, "k9", "k8", 10, "k8", "v8")
+
+	_, _, err := s.client.ReverseScan(nil, nil, 10)
+	c.Assert(err, NotNil)
+}
+
+func (s *testRawKVSuite) TestDeleteRange(c *C) {
+	s.split(c, "", "k3")
+	s.split(c, "k3", "k6")
+	s.putKeys(c, 9)
+
+	c.Assert(s.client.DeleteRange([]byte("k2"), []byte("k7")), IsNil)
+	s.mustScan(c, "", "", 10, "k0", "v0", "k1", "v1", "k7", "v7", "k8", "v8")
+	c.Assert(s.client.DeleteRange([]byte("k1"), nil), IsNil)
+	s.mustScan(c, "", "", 10, "k0", "v0")
+}
+
+func (s *testRawKVSuite) TestCompareAndSwap(c *C) {
+	key := []byte("key")
+	previous, succeed, err := s.client.CompareAndSwap(key, nil, []byte("v1"))
+	c.Assert(err, IsNil)
+	c.Assert(succeed, IsTrue)
+	c.Assert(previous, IsNil)
+
+	// The key exists now.
+	previous, succeed, err = s.client.CompareAndSwap(key, nil, []byte("v2"))
+	c.Assert(err, IsNil)
+	c.Assert(succeed, IsFalse)
+	c.Assert(previous, BytesEquals, []byte("v1"))
+
+	previous, succeed, err = s.client.CompareAndSwap(key, []byte("v0"), []byte("v2"))
+	c.Assert(err, IsNil)
+	c.Assert(succeed, IsFalse)
+	c.Assert(previous, BytesEquals, []byte("v1"))
+
+	previous, succeed, err = s.client.CompareAndSwap(key, []byte("v1"), []byte("v2"))
+	c.Assert(err, IsNil)
+	c.Assert(succeed, IsTrue)
+	c.Assert(previous, BytesEquals, []byte("v1"))
+	value, err := s.client.Get(key)
+	c.Assert(err, IsNil)
+	c.Assert(value, BytesEquals, []byte("v2"))
+}
+
+func (s *testRawKVSuite) TestTTL(c *C) {
+	c.Assert(s.client.PutWithTTL([]byte("k1"), []byte("v1"), 100), IsNil)
+	c.Assert(s.client.Put([]byte("k2"), []byte("v2")), IsNil)
+
+	ttl, err := s.client.GetKeyTTL([]byte("k1"))
+	c.Assert(err, IsNil)
+	c.Assert(*ttl > 0 && *ttl <= 100, IsTrue)
+	ttl, err = s.client.GetKeyTTL([]byte("k2"))
+	c.Assert(err, IsNil)
+	c.Assert(*ttl, Equals, uint64(0))
+	ttl, err = s.client.GetKeyTTL([]byte("k3"))
+	c.Assert(err, IsNil)
+	c.Assert(ttl, IsNil)
+
+	// Overwriting a value without a ttl makes it never expire.
+	c.Assert(s.client.Put([]byte("k1"), []byte("v1")), IsNil)
+	ttl, err = s.client.GetKeyTTL([]byte("k1"))
+	c.Assert(err, IsNil)
+	c.Assert(*ttl, Equals, uint64(0))
+}
@@ -464,18 +464,18 @@ func (c *RegionCache) GroupKeysByRegion(bo *Backoffer, keys [][]byte, filter fun
 	// 先找到pk
 	pk := keys[0]
 	keyLocation, err := c.LocateKey(bo, pk)
-	pkRegionVerID := keyLocation.Region
-	resMap[pkRegionVerID] = append(resMap[pkRegionVerID], pk)
 	if err != nil {
 		return nil, RegionVerID{0, 0, 0}, err
 	}
+	pkRegionVerID := keyLocation.Region
+	resMap[pkRegionVerID] = append(resMap[pkRegionVerID], pk)
 
 	// 处理剩下的secondary keys
 	for i := 1; i < len(keys); i++ {
 		secondKey := keys[i]
 		secondKeyLocation, err := c.LocateKey(bo, secondKey)
 		if err != nil {
-			return nil, RegionVerID{}, nil
+			return nil, RegionVerID{}, err
 		}
 
 		secondRegionVerID := secondKeyLocation.Region
@@ -23,10 +23,12 @@ import (
 
 	"github.com/google/btree"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	. "github.com/pingcap/check"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/store/mockstore/mocktikv"
+	"github.com/pingcap/tidb/store/tikv/tikvrpc"
 )
 
 type testRegionCacheSuite struct {
@@ -961,6 +963,65 @@ func (s *testRegionCacheSuite) TestFollowerMeetEpochNotMatch(c *C) {
 	c.Assert(followReqSeed, Equals, uint32(1))
 }
 
+// TestServerIsBusy tests that `ServerIsBusy` backs off and retries without dropping the region from the cache.
+func (s *testRegionCacheSuite) TestServerIsBusy(c *C) {
+	loc, err := s.cache.LocateKey(s.bo, []byte("a"))
+	c.Assert(err, IsNil)
+	ctx, err := s.cache.GetTiKVRPCContext(s.bo, loc.Region, kv.ReplicaReadLeader, 0)
+	c.Assert(err, IsNil)
+
+	reqSend := NewRegionRequestSender(s.cache, nil)
+	regionErr := &errorpb.Error{ServerIsBusy: &errorpb.ServerIsBusy{Reason: "too many writes"}}
+	retry, err := reqSend.onRegionError(s.bo, ctx, nil, regionErr)
+	c.Assert(err, IsNil)
+	c.Assert(retry, IsTrue)
+	c.Assert(s.bo.backoffTimes[boServerBusy], Equals, 1)
+	s.checkCache(c, 1)
+}
+
+// notReadyClient records the stores the requests are sent to, the followers report `DataIsNotReady` for stale reads.
+type notReadyClient struct {
+	Client
+	leaderAddr string
+	addrs      []string
+}
+
+func (c *notReadyClient) SendRequest(ctx context.Context, addr string, req *tikvrpc.Request, timeout time.Duration) (*tikvrpc.Response, error) {
+	c.addrs = append(c.addrs, addr)
+	if req.StaleRead && addr != c.leaderAddr {
+		return tikvrpc.GenRegionErrorResp(req, &errorpb.Error{DataIsNotReady: &errorpb.DataIsNotReady{}})
+	}
+	return c.Client.SendRequest(ctx, addr, req, timeout)
+}
+
+func (s *testRegionCacheSuite) TestReplicaRead(c *C) {
+	mvccStore := mocktikv.MustNewMVCCStore()
+	defer mvccStore.Close()
+	client := &notReadyClient{
+		Client:     mocktikv.NewRPCClient(s.cluster, mvccStore),
+		leaderAddr: s.storeAddr(s.store1),
+	}
+	sender := NewRegionRequestSender(s.cache, client)
+	loc, err := s.cache.LocateKey(s.bo, []byte("a"))
+	c.Assert(err, IsNil)
+
+	// A replica read is served by the follower.
+	req := tikvrpc.NewRequest(tikvrpc.CmdGet, &kvrpcpb.GetRequest{Key: []byte("a"), Version: 1}, kvrpcpb.Context{ReplicaRead: true})
+	resp, err := sender.SendReq(s.bo, req, loc.Region, time.Second)
+	c.Assert(err, IsNil)
+	c.Assert(resp.Resp.(*kvrpcpb.GetResponse).RegionError, IsNil)
+	c.Assert(client.addrs, DeepEquals, []string{s.storeAddr(s.store2)})
+
+	// A stale read which the follower can't serve yet falls back to the leader.
+	client.addrs = nil
+	req = tikvrpc.NewRequest(tikvrpc.CmdGet, &kvrpcpb.GetRequest{Key: []byte("a"), Version: 1}, kvrpcpb.Context{StaleRead: true, ReadTs: 1})
+	resp, err = sender.SendReq(s.bo, req, loc.Region, time.Second)
+	c.Assert(err, IsNil)
+	c.Assert(resp.Resp.(*kvrpcpb.GetResponse).RegionError, IsNil)
+	c.Assert(client.addrs, DeepEquals, []string{s.storeAddr(s.store2), s.storeAddr(s.store1)})
+	c.Assert(req.StaleRead, IsFalse)
+}
+
 func createSampleRegion(startKey, endKey []byte) *Region {
 	return &Region{
 		meta: &metapb.Region{
@@ -90,6 +90,9 @@ func (s *RegionRequestSender) SendReqCtx(
 	})
 
 	replicaRead := kv.ReplicaReadLeader
+	if req.ReplicaRead || req.StaleRead {
+		replicaRead = kv.ReplicaReadFollower
+	}
 	seed := req.ReplicaReadSeed
 	for {
 		rpcCtx, err = s.regionCache.GetTiKVRPCContext(bo, regionID, replicaRead, seed)
@@ -123,6 +126,17 @@ func (s *RegionRequestSender) SendReqCtx(
 			return nil, nil, errors.Trace(err)
 		}
 		if regionErr != nil {
+			if regionErr.GetDataIsNotReady() != nil && req.StaleRead {
+				// The replica hasn't caught up with the read ts, the leader can always serve the read.
+				logutil.BgLogger().Debug("tikv reports `DataIsNotReady`, retry on the leader",
+					zap.Stringer("DataIsNotReady", regionErr.GetDataIsNotReady()),
+					zap.Stringer("ctx", rpcCtx))
+				req.StaleRead = false
+				if !req.ReplicaRead {
+					replicaRead = kv.ReplicaReadLeader
+				}
+				continue
+			}
 			retry, err = s.onRegionError(bo, rpcCtx, &seed, regionErr)
 			if err != nil {
 				return nil, nil, errors.Trace(err)
@@ -235,6 +249,16 @@ func (s *RegionRequestSender) onRegionError(bo *Backoffer, ctx *RPCContext, seed
 		err = s.regionCache.OnRegionEpochNotMatch(bo, ctx, epochNotMatch.CurrentRegions)
 		return false, errors.Trace(err)
 	}
+	if serverIsBusy := regionErr.GetServerIsBusy(); serverIsBusy != nil {
+		// The region is fine, the store is just overloaded, so keep the cache and retry the same store later.
+		logutil.BgLogger().Warn("tikv reports `ServerIsBusy` retry later",
+			zap.String("reason", serverIsBusy.GetReason()),
+			zap.Stringer("ctx", ctx))
+		if err = bo.Backoff(boServerBusy, errors.Errorf("server is busy, ctx: %v", ctx)); err != nil {
+			return false, errors.Trace(err)
+		}
+		return true, nil
+	}
 	if regionErr.GetStaleCommand() != nil {
 		logutil.BgLogger().Debug("tikv reports `StaleCommand`", zap.Stringer("ctx", ctx))
 		return true, nil
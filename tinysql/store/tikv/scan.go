@@ -187,9 +187,12 @@ func (s *Scanner) getData(bo *Backoffer) error {
 			Version:  s.startTS(),
 		}
 		if s.reverse {
+			// A reverse scan reads [EndKey, StartKey) of the region.
 			sreq.StartKey = s.nextEndKey
+			sreq.EndKey = reqStartKey
+			sreq.Reverse = true
 		}
-		req := tikvrpc.NewRequest(tikvrpc.CmdScan, sreq, pb.Context{})
+		req := tikvrpc.NewRequest(tikvrpc.CmdScan, sreq, s.snapshot.pbContext())
 		resp, err := sender.SendReq(bo, req, loc.Region, ReadTimeoutMedium)
 		if err != nil {
 			return errors.Trace(err)
@@ -18,6 +18,7 @@ import (
 
 	. "github.com/pingcap/check"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/store/mockstore/mocktikv"
 )
 
 type testScanMockSuite struct {
@@ -58,3 +59,44 @@ func (s *testScanMockSuite) TestScanMultipleRegions(c *C) {
 	}
 	c.Assert(scanner.Valid(), IsFalse)
 }
+
+func (s *testScanMockSuite) TestReverseScanMultipleRegions(c *C) {
+	cluster := mocktikv.NewCluster()
+	mocktikv.BootstrapWithMultiRegions(cluster, []byte("f"), []byte("m"), []byte("t"))
+	mvccStore, err := mocktikv.NewMVCCLevelDB("")
+	c.Assert(err, IsNil)
+	client := mocktikv.NewRPCClient(cluster, mvccStore)
+	pdCli := &codecPDClient{mocktikv.NewPDClient(cluster)}
+	store, err := newTikvStore("mocktikv-store", pdCli, NewMockSafePointKV(), client, false)
+	c.Assert(err, IsNil)
+	defer store.Close()
+
+	txn, err := store.Begin()
+	c.Assert(err, IsNil)
+	for ch := byte('a'); ch <= byte('z'); ch++ {
+		err = txn.Set([]byte{ch}, []byte{ch})
+		c.Assert(err, IsNil)
+	}
+	err = txn.Commit(context.Background())
+	c.Assert(err, IsNil)
+
+	txn, err = store.Begin()
+	c.Assert(err, IsNil)
+	snapshot := newTiKVSnapshot(store, kv.Version{Ver: txn.StartTS()})
+	scanner, err := newScanner(snapshot, []byte("c"), []byte("w"), 3, true)
+	c.Assert(err, IsNil)
+	for ch := byte('v'); ch >= byte('c'); ch-- {
+		c.Assert([]byte{ch}, BytesEquals, []byte(scanner.Key()))
+		c.Assert([]byte{ch}, BytesEquals, scanner.Value())
+		c.Assert(scanner.Next(), IsNil)
+	}
+	c.Assert(scanner.Valid(), IsFalse)
+
+	iter, err := snapshot.IterReverse([]byte("p"))
+	c.Assert(err, IsNil)
+	for ch := byte('o'); ch >= byte('a'); ch-- {
+		c.Assert([]byte{ch}, BytesEquals, []byte(iter.Key()))
+		c.Assert(iter.Next(), IsNil)
+	}
+	c.Assert(iter.Valid(), IsFalse)
+}
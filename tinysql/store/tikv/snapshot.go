@@ -27,6 +27,7 @@ import (
 	"github.com/pingcap/tidb/util/logutil"
 	"go.uber.org/zap"
 	"strings"
+	"sync"
 )
 
 var (
@@ -35,6 +36,7 @@ var (
 
 const (
 	scanBatchSize = 256
+	batchGetSize  = 5120
 )
 
 // tikvSnapshot implements the kv.Snapshot interface.
@@ -44,6 +46,8 @@ type tikvSnapshot struct {
 	syncLog bool
 	keyOnly bool
 	vars    *kv.Variables
+	// replicaRead reads from the followers, see kvrpcpb.Context.
+	replicaRead kv.ReplicaReadType
 	minCommitTSPushed
 
 	// Cache the result of BatchGet.
@@ -68,6 +72,14 @@ func newTiKVSnapshot(store *TinykvStore, ver kv.Version) *tikvSnapshot {
 	}
 }
 
+// pbContext returns the context of the requests sent by the snapshot.
+func (s *tikvSnapshot) pbContext() pb.Context {
+	return pb.Context{
+		ReplicaRead: s.replicaRead.IsFollowerRead(),
+		ReadTs:      s.version.Ver,
+	}
+}
+
 func (s *tikvSnapshot) setSnapshotTS(ts uint64) {
 	// Invalidate cache if the snapshotTS change!
 	s.version.Ver = ts
@@ -89,6 +101,167 @@ func (s *tikvSnapshot) Get(ctx context.Context, k kv.Key) ([]byte, error) {
 	return val, nil
 }
 
+// BatchGet gets the values of keys from snapshot, keys which don't exist are left out of the returned map. The keys
+// are grouped by region and the regions are read concurrently, the values are cached so that Get doesn't read them
+// again.
+func (s *tikvSnapshot) BatchGet(ctx context.Context, keys []kv.Key) (map[string][]byte, error) {
+	m := make(map[string][]byte, len(keys))
+	// Check the cached values first.
+	pending := make([][]byte, 0, len(keys))
+	for _, k := range keys {
+		if s.cached != nil {
+			if value, ok := s.cached[string(k)]; ok {
+				if len(value) > 0 {
+					m[string(k)] = value
+				}
+				continue
+			}
+		}
+		pending = append(pending, k)
+	}
+	if len(pending) == 0 {
+		return m, nil
+	}
+
+	ctx = context.WithValue(ctx, txnStartKey, s.version.Ver)
+	bo := NewBackoffer(ctx, batchGetMaxBackoff).WithVars(s.vars)
+	var mu sync.Mutex
+	err := s.batchGetKeysByRegions(bo, pending, func(k, v []byte) {
+		if len(v) == 0 {
+			return
+		}
+		mu.Lock()
+		m[string(k)] = v
+		mu.Unlock()
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	err = s.store.CheckVisibility(s.version.Ver)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// Cache the values, a key which doesn't exist is cached as an empty value.
+	if s.cached == nil {
+		s.cached = make(map[string][]byte, len(pending))
+	}
+	for _, k := range pending {
+		s.cached[string(k)] = m[string(k)]
+	}
+	return m, nil
+}
+
+// batchGetKeysByRegions reads keys by the regions they belong to and calls collectF for every key-value pair it reads.
+// The regions are read concurrently, so collectF has to be thread safe.
+func (s *tikvSnapshot) batchGetKeysByRegions(bo *Backoffer, keys [][]byte, collectF func(k, v []byte)) error {
+	groups, _, err := s.store.regionCache.GroupKeysByRegion(bo, keys, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var batches []batchKeys
+	for id, g := range groups {
+		batches = appendBatchBySize(batches, id, g, func([]byte) int { return 1 }, batchGetSize)
+	}
+	if len(batches) == 0 {
+		return nil
+	}
+	if len(batches) == 1 {
+		return errors.Trace(s.batchGetSingleRegion(bo, batches[0], collectF))
+	}
+	ch := make(chan error)
+	for _, batch1 := range batches {
+		batch := batch1
+		go func() {
+			backoffer, cancel := bo.Fork()
+			defer cancel()
+			ch <- s.batchGetSingleRegion(backoffer, batch, collectF)
+		}()
+	}
+	for i := 0; i < len(batches); i++ {
+		if e := <-ch; e != nil {
+			logutil.BgLogger().Debug("snapshot batchGet failed",
+				zap.Error(e),
+				zap.Uint64("txnStartTS", s.version.Ver))
+			err = e
+		}
+	}
+	return errors.Trace(err)
+}
+
+// batchGetSingleRegion reads the keys of batch from its region. Locked keys are read again once their locks are
+// resolved, and all the keys are regrouped if the region has changed.
+func (s *tikvSnapshot) batchGetSingleRegion(bo *Backoffer, batch batchKeys, collectF func(k, v []byte)) error {
+	cli := clientHelper{
+		LockResolver:      s.store.lockResolver,
+		RegionCache:       s.store.regionCache,
+		minCommitTSPushed: &s.minCommitTSPushed,
+		Client:            s.store.client,
+	}
+
+	pending := batch.keys
+	for {
+		req := tikvrpc.NewRequest(tikvrpc.CmdBatchGet,
+			&pb.BatchGetRequest{
+				Keys:    pending,
+				Version: s.version.Ver,
+			}, s.pbContext())
+		resp, _, _, err := cli.SendReqCtx(bo, req, batch.region, ReadTimeoutMedium, "")
+		if err != nil {
+			return errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return errors.Trace(err)
+			}
+			return errors.Trace(s.batchGetKeysByRegions(bo, pending, collectF))
+		}
+		if resp.Resp == nil {
+			return errors.Trace(ErrBodyMissing)
+		}
+		batchGetResp := resp.Resp.(*pb.BatchGetResponse)
+		var (
+			lockedKeys [][]byte
+			locks      []*Lock
+		)
+		for _, pair := range batchGetResp.Pairs {
+			keyErr := pair.GetError()
+			if keyErr == nil {
+				collectF(pair.GetKey(), pair.GetValue())
+				continue
+			}
+			lock, err := extractLockFromKeyErr(keyErr)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			lockedKeys = append(lockedKeys, lock.Key)
+			locks = append(locks, lock)
+		}
+		if len(lockedKeys) == 0 {
+			return nil
+		}
+		msBeforeExpired, err := cli.ResolveLocks(bo, s.version.Ver, locks)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if msBeforeExpired > 0 {
+			err = bo.BackoffWithMaxSleep(BoTxnLock, int(msBeforeExpired), errors.Errorf("batchGet lockedKeys: %d", len(lockedKeys)))
+			if err != nil {
+				return errors.Trace(err)
+			}
+		}
+		// Only the locked keys are read again.
+		pending = lockedKeys
+	}
+}
+
 func (s *tikvSnapshot) get(bo *Backoffer, k kv.Key) ([]byte, error) {
 	// Check the cached values first.
 	if s.cached != nil {
@@ -114,7 +287,7 @@ func (s *tikvSnapshot) get(bo *Backoffer, k kv.Key) ([]byte, error) {
 		&pb.GetRequest{
 			Key:     k,
 			Version: s.version.Ver,
-		}, pb.Context{})
+		}, s.pbContext())
 	for {
 		loc, err := s.store.regionCache.LocateKey(bo, k)
 		if err != nil {
@@ -198,6 +371,14 @@ func extractKeyErr(keyErr *pb.KeyError) error {
 		notFoundDetail := prettyLockNotFoundKey(keyErr.GetRetryable())
 		return kv.ErrTxnRetryable.GenWithStackByArgs(keyErr.GetRetryable() + " " + notFoundDetail)
 	}
+	if deadlock := keyErr.GetDeadlock(); deadlock != nil {
+		logutil.BgLogger().Info("deadlock detected",
+			zap.Uint64("lockTS", deadlock.LockTs),
+			zap.Stringer("lockKey", kv.Key(deadlock.LockKey)),
+			zap.Uint64("deadlockKeyHash", deadlock.DeadlockKeyHash),
+			zap.Int("waitChainLen", len(deadlock.WaitChain)))
+		return errors.Trace(ErrDeadlock)
+	}
 	if keyErr.Abort != "" {
 		err := errors.Errorf("tikv aborts txn: %s", keyErr.GetAbort())
 		logutil.BgLogger().Warn("2PC failed", zap.Error(err))
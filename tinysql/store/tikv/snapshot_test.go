@@ -18,7 +18,12 @@ import (
 	"fmt"
 	"time"
 
+	pb "github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	. "github.com/pingcap/check"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
 )
 
 type testSnapshotSuite struct {
@@ -67,3 +72,47 @@ func (s *testSnapshotSuite) TestLockNotFoundPrint(c *C) {
 	key := prettyLockNotFoundKey(msg)
 	c.Assert(key, Equals, "{tableID=12937, indexID=1, indexValues={C19092900000048625523, }}")
 }
+
+func (s *testSnapshotSuite) TestDeadlockKeyErr(c *C) {
+	err := extractKeyErr(&pb.KeyError{Deadlock: &pb.Deadlock{LockTs: 1, LockKey: []byte("a")}})
+	c.Assert(terror.ErrorEqual(err, ErrDeadlock), IsTrue)
+	sqlErr := errors.Cause(err).(*terror.Error).ToSQLError()
+	c.Assert(sqlErr.Code, Equals, uint16(mysql.ErrLockDeadlock))
+}
+
+func (s *testSnapshotSuite) TestBatchGet(c *C) {
+	for _, rowNum := range s.rowNums {
+		txn := s.beginTxn(c)
+		keys := make([]kv.Key, 0, rowNum+1)
+		for i := 0; i < rowNum; i++ {
+			k := encodeKey(s.prefix, s08d("key", i))
+			c.Assert(txn.Set(k, valueBytes(i)), IsNil)
+			keys = append(keys, k)
+		}
+		c.Assert(txn.Commit(context.Background()), IsNil)
+		keys = append(keys, encodeKey(s.prefix, "missing"))
+
+		txn = s.beginTxn(c)
+		m, err := txn.snapshot.BatchGet(context.Background(), keys)
+		c.Assert(err, IsNil)
+		c.Assert(m, HasLen, rowNum)
+		for i := 0; i < rowNum; i++ {
+			c.Assert(m[string(keys[i])], BytesEquals, valueBytes(i))
+		}
+		// The missing key is cached too.
+		c.Assert(txn.snapshot.cached, HasLen, rowNum+1)
+		_, err = txn.Get(context.Background(), keys[rowNum])
+		c.Assert(kv.IsErrNotFound(err), IsTrue)
+
+		// Values in the membuffer take precedence.
+		c.Assert(txn.Set(keys[0], []byte("new")), IsNil)
+		m, err = txn.BatchGet(context.Background(), keys[:1])
+		c.Assert(err, IsNil)
+		c.Assert(m[string(keys[0])], BytesEquals, []byte("new"))
+		c.Assert(txn.Delete(keys[0]), IsNil)
+		m, err = txn.BatchGet(context.Background(), keys[:1])
+		c.Assert(err, IsNil)
+		c.Assert(m, HasLen, 0)
+		c.Assert(txn.Rollback(), IsNil)
+	}
+}
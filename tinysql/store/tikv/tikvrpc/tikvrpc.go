@@ -37,11 +37,27 @@ const (
 	CmdBatchRollback
 	CmdResolveLock
 	CmdCheckTxnStatus
+	CmdPessimisticLock
+	CmdPessimisticRollback
+	CmdScanLock
+	CmdGC
+	CmdTxnHeartBeat
+	CmdCheckSecondaryLocks
+	CmdBatchGet
+	CmdDeleteRange
+	CmdBackup
+	CmdRestore
 
 	CmdRawGet CmdType = 256 + iota
 	CmdRawPut
 	CmdRawDelete
 	CmdRawScan
+	CmdRawBatchGet
+	CmdRawBatchPut
+	CmdRawBatchDelete
+	CmdRawDeleteRange
+	CmdRawCompareAndSwap
+	CmdRawGetKeyTTL
 
 	CmdCop CmdType = 512 + iota
 )
@@ -52,6 +68,8 @@ func (t CmdType) String() string {
 		return "Get"
 	case CmdScan:
 		return "Scan"
+	case CmdBatchGet:
+		return "BatchGet"
 	case CmdPrewrite:
 		return "Prewrite"
 	case CmdCommit:
@@ -68,10 +86,40 @@ func (t CmdType) String() string {
 		return "RawDelete"
 	case CmdRawScan:
 		return "RawScan"
+	case CmdRawBatchGet:
+		return "RawBatchGet"
+	case CmdRawBatchPut:
+		return "RawBatchPut"
+	case CmdRawBatchDelete:
+		return "RawBatchDelete"
+	case CmdRawDeleteRange:
+		return "RawDeleteRange"
+	case CmdRawCompareAndSwap:
+		return "RawCompareAndSwap"
+	case CmdRawGetKeyTTL:
+		return "RawGetKeyTTL"
 	case CmdCop:
 		return "Cop"
 	case CmdCheckTxnStatus:
 		return "CheckTxnStatus"
+	case CmdTxnHeartBeat:
+		return "TxnHeartBeat"
+	case CmdCheckSecondaryLocks:
+		return "CheckSecondaryLocks"
+	case CmdPessimisticLock:
+		return "PessimisticLock"
+	case CmdPessimisticRollback:
+		return "PessimisticRollback"
+	case CmdScanLock:
+		return "ScanLock"
+	case CmdGC:
+		return "GC"
+	case CmdDeleteRange:
+		return "DeleteRange"
+	case CmdBackup:
+		return "Backup"
+	case CmdRestore:
+		return "Restore"
 	}
 	return "Unknown"
 }
@@ -104,6 +152,11 @@ func (req *Request) Get() *kvrpcpb.GetRequest {
 	return req.req.(*kvrpcpb.GetRequest)
 }
 
+// BatchGet returns BatchGetRequest in request.
+func (req *Request) BatchGet() *kvrpcpb.BatchGetRequest {
+	return req.req.(*kvrpcpb.BatchGetRequest)
+}
+
 // Scan returns ScanRequest in request.
 func (req *Request) Scan() *kvrpcpb.ScanRequest {
 	return req.req.(*kvrpcpb.ScanRequest)
@@ -149,6 +202,36 @@ func (req *Request) RawScan() *kvrpcpb.RawScanRequest {
 	return req.req.(*kvrpcpb.RawScanRequest)
 }
 
+// RawBatchGet returns RawBatchGetRequest in request.
+func (req *Request) RawBatchGet() *kvrpcpb.RawBatchGetRequest {
+	return req.req.(*kvrpcpb.RawBatchGetRequest)
+}
+
+// RawBatchPut returns RawBatchPutRequest in request.
+func (req *Request) RawBatchPut() *kvrpcpb.RawBatchPutRequest {
+	return req.req.(*kvrpcpb.RawBatchPutRequest)
+}
+
+// RawBatchDelete returns RawBatchDeleteRequest in request.
+func (req *Request) RawBatchDelete() *kvrpcpb.RawBatchDeleteRequest {
+	return req.req.(*kvrpcpb.RawBatchDeleteRequest)
+}
+
+// RawDeleteRange returns RawDeleteRangeRequest in request.
+func (req *Request) RawDeleteRange() *kvrpcpb.RawDeleteRangeRequest {
+	return req.req.(*kvrpcpb.RawDeleteRangeRequest)
+}
+
+// RawCompareAndSwap returns RawCASRequest in request.
+func (req *Request) RawCompareAndSwap() *kvrpcpb.RawCASRequest {
+	return req.req.(*kvrpcpb.RawCASRequest)
+}
+
+// RawGetKeyTTL returns RawGetKeyTTLRequest in request.
+func (req *Request) RawGetKeyTTL() *kvrpcpb.RawGetKeyTTLRequest {
+	return req.req.(*kvrpcpb.RawGetKeyTTLRequest)
+}
+
 // Cop returns coprocessor request in request.
 func (req *Request) Cop() *coprocessor.Request {
 	return req.req.(*coprocessor.Request)
@@ -159,6 +242,51 @@ func (req *Request) CheckTxnStatus() *kvrpcpb.CheckTxnStatusRequest {
 	return req.req.(*kvrpcpb.CheckTxnStatusRequest)
 }
 
+// TxnHeartBeat returns TxnHeartBeatRequest in request.
+func (req *Request) TxnHeartBeat() *kvrpcpb.TxnHeartBeatRequest {
+	return req.req.(*kvrpcpb.TxnHeartBeatRequest)
+}
+
+// CheckSecondaryLocks returns CheckSecondaryLocksRequest in request.
+func (req *Request) CheckSecondaryLocks() *kvrpcpb.CheckSecondaryLocksRequest {
+	return req.req.(*kvrpcpb.CheckSecondaryLocksRequest)
+}
+
+// PessimisticLock returns PessimisticLockRequest in request.
+func (req *Request) PessimisticLock() *kvrpcpb.PessimisticLockRequest {
+	return req.req.(*kvrpcpb.PessimisticLockRequest)
+}
+
+// PessimisticRollback returns PessimisticRollbackRequest in request.
+func (req *Request) PessimisticRollback() *kvrpcpb.PessimisticRollbackRequest {
+	return req.req.(*kvrpcpb.PessimisticRollbackRequest)
+}
+
+// ScanLock returns ScanLockRequest in request.
+func (req *Request) ScanLock() *kvrpcpb.ScanLockRequest {
+	return req.req.(*kvrpcpb.ScanLockRequest)
+}
+
+// GC returns GCRequest in request.
+func (req *Request) GC() *kvrpcpb.GCRequest {
+	return req.req.(*kvrpcpb.GCRequest)
+}
+
+// DeleteRange returns DeleteRangeRequest in request.
+func (req *Request) DeleteRange() *kvrpcpb.DeleteRangeRequest {
+	return req.req.(*kvrpcpb.DeleteRangeRequest)
+}
+
+// Backup returns BackupRequest in request.
+func (req *Request) Backup() *kvrpcpb.BackupRequest {
+	return req.req.(*kvrpcpb.BackupRequest)
+}
+
+// Restore returns RestoreRequest in request.
+func (req *Request) Restore() *kvrpcpb.RestoreRequest {
+	return req.req.(*kvrpcpb.RestoreRequest)
+}
+
 // Response wraps all kv/coprocessor responses.
 type Response struct {
 	Resp interface{}
@@ -178,6 +306,8 @@ func SetContext(req *Request, region *metapb.Region, peer *metapb.Peer) error {
 		req.Get().Context = ctx
 	case CmdScan:
 		req.Scan().Context = ctx
+	case CmdBatchGet:
+		req.BatchGet().Context = ctx
 	case CmdPrewrite:
 		req.Prewrite().Context = ctx
 	case CmdCommit:
@@ -194,10 +324,40 @@ func SetContext(req *Request, region *metapb.Region, peer *metapb.Peer) error {
 		req.RawDelete().Context = ctx
 	case CmdRawScan:
 		req.RawScan().Context = ctx
+	case CmdRawBatchGet:
+		req.RawBatchGet().Context = ctx
+	case CmdRawBatchPut:
+		req.RawBatchPut().Context = ctx
+	case CmdRawBatchDelete:
+		req.RawBatchDelete().Context = ctx
+	case CmdRawDeleteRange:
+		req.RawDeleteRange().Context = ctx
+	case CmdRawCompareAndSwap:
+		req.RawCompareAndSwap().Context = ctx
+	case CmdRawGetKeyTTL:
+		req.RawGetKeyTTL().Context = ctx
 	case CmdCop:
 		req.Cop().Context = ctx
 	case CmdCheckTxnStatus:
 		req.CheckTxnStatus().Context = ctx
+	case CmdTxnHeartBeat:
+		req.TxnHeartBeat().Context = ctx
+	case CmdCheckSecondaryLocks:
+		req.CheckSecondaryLocks().Context = ctx
+	case CmdPessimisticLock:
+		req.PessimisticLock().Context = ctx
+	case CmdPessimisticRollback:
+		req.PessimisticRollback().Context = ctx
+	case CmdScanLock:
+		req.ScanLock().Context = ctx
+	case CmdGC:
+		req.GC().Context = ctx
+	case CmdDeleteRange:
+		req.DeleteRange().Context = ctx
+	case CmdBackup:
+		req.Backup().Context = ctx
+	case CmdRestore:
+		req.Restore().Context = ctx
 	default:
 		return fmt.Errorf("invalid request type %v", req.Type)
 	}
@@ -218,6 +378,10 @@ func GenRegionErrorResp(req *Request, e *errorpb.Error) (*Response, error) {
 		p = &kvrpcpb.ScanResponse{
 			RegionError: e,
 		}
+	case CmdBatchGet:
+		p = &kvrpcpb.BatchGetResponse{
+			RegionError: e,
+		}
 	case CmdPrewrite:
 		p = &kvrpcpb.PrewriteResponse{
 			RegionError: e,
@@ -250,6 +414,30 @@ func GenRegionErrorResp(req *Request, e *errorpb.Error) (*Response, error) {
 		p = &kvrpcpb.RawScanResponse{
 			RegionError: e,
 		}
+	case CmdRawBatchGet:
+		p = &kvrpcpb.RawBatchGetResponse{
+			RegionError: e,
+		}
+	case CmdRawBatchPut:
+		p = &kvrpcpb.RawBatchPutResponse{
+			RegionError: e,
+		}
+	case CmdRawBatchDelete:
+		p = &kvrpcpb.RawBatchDeleteResponse{
+			RegionError: e,
+		}
+	case CmdRawDeleteRange:
+		p = &kvrpcpb.RawDeleteRangeResponse{
+			RegionError: e,
+		}
+	case CmdRawCompareAndSwap:
+		p = &kvrpcpb.RawCASResponse{
+			RegionError: e,
+		}
+	case CmdRawGetKeyTTL:
+		p = &kvrpcpb.RawGetKeyTTLResponse{
+			RegionError: e,
+		}
 	case CmdCop:
 		p = &coprocessor.Response{
 			RegionError: e,
@@ -258,6 +446,42 @@ func GenRegionErrorResp(req *Request, e *errorpb.Error) (*Response, error) {
 		p = &kvrpcpb.CheckTxnStatusResponse{
 			RegionError: e,
 		}
+	case CmdTxnHeartBeat:
+		p = &kvrpcpb.TxnHeartBeatResponse{
+			RegionError: e,
+		}
+	case CmdCheckSecondaryLocks:
+		p = &kvrpcpb.CheckSecondaryLocksResponse{
+			RegionError: e,
+		}
+	case CmdPessimisticLock:
+		p = &kvrpcpb.PessimisticLockResponse{
+			RegionError: e,
+		}
+	case CmdPessimisticRollback:
+		p = &kvrpcpb.PessimisticRollbackResponse{
+			RegionError: e,
+		}
+	case CmdScanLock:
+		p = &kvrpcpb.ScanLockResponse{
+			RegionError: e,
+		}
+	case CmdGC:
+		p = &kvrpcpb.GCResponse{
+			RegionError: e,
+		}
+	case CmdDeleteRange:
+		p = &kvrpcpb.DeleteRangeResponse{
+			RegionError: e,
+		}
+	case CmdBackup:
+		p = &kvrpcpb.BackupResponse{
+			RegionError: e,
+		}
+	case CmdRestore:
+		p = &kvrpcpb.RestoreResponse{
+			RegionError: e,
+		}
 	default:
 		return nil, fmt.Errorf("invalid request type %v", req.Type)
 	}
@@ -292,6 +516,8 @@ func CallRPC(ctx context.Context, client tinykvpb.TinyKvClient, req *Request) (*
 		resp.Resp, err = client.KvGet(ctx, req.Get())
 	case CmdScan:
 		resp.Resp, err = client.KvScan(ctx, req.Scan())
+	case CmdBatchGet:
+		resp.Resp, err = client.KvBatchGet(ctx, req.BatchGet())
 	case CmdPrewrite:
 		resp.Resp, err = client.KvPrewrite(ctx, req.Prewrite())
 	case CmdCommit:
@@ -308,10 +534,40 @@ func CallRPC(ctx context.Context, client tinykvpb.TinyKvClient, req *Request) (*
 		resp.Resp, err = client.RawDelete(ctx, req.RawDelete())
 	case CmdRawScan:
 		resp.Resp, err = client.RawScan(ctx, req.RawScan())
+	case CmdRawBatchGet:
+		resp.Resp, err = client.RawBatchGet(ctx, req.RawBatchGet())
+	case CmdRawBatchPut:
+		resp.Resp, err = client.RawBatchPut(ctx, req.RawBatchPut())
+	case CmdRawBatchDelete:
+		resp.Resp, err = client.RawBatchDelete(ctx, req.RawBatchDelete())
+	case CmdRawDeleteRange:
+		resp.Resp, err = client.RawDeleteRange(ctx, req.RawDeleteRange())
+	case CmdRawCompareAndSwap:
+		resp.Resp, err = client.RawCompareAndSwap(ctx, req.RawCompareAndSwap())
+	case CmdRawGetKeyTTL:
+		resp.Resp, err = client.RawGetKeyTTL(ctx, req.RawGetKeyTTL())
 	case CmdCop:
 		resp.Resp, err = client.Coprocessor(ctx, req.Cop())
 	case CmdCheckTxnStatus:
 		resp.Resp, err = client.KvCheckTxnStatus(ctx, req.CheckTxnStatus())
+	case CmdTxnHeartBeat:
+		resp.Resp, err = client.KvTxnHeartBeat(ctx, req.TxnHeartBeat())
+	case CmdCheckSecondaryLocks:
+		resp.Resp, err = client.KvCheckSecondaryLocks(ctx, req.CheckSecondaryLocks())
+	case CmdPessimisticLock:
+		resp.Resp, err = client.KvPessimisticLock(ctx, req.PessimisticLock())
+	case CmdPessimisticRollback:
+		resp.Resp, err = client.KvPessimisticRollback(ctx, req.PessimisticRollback())
+	case CmdScanLock:
+		resp.Resp, err = client.KvScanLock(ctx, req.ScanLock())
+	case CmdGC:
+		resp.Resp, err = client.KvGC(ctx, req.GC())
+	case CmdDeleteRange:
+		resp.Resp, err = client.KvDeleteRange(ctx, req.DeleteRange())
+	case CmdBackup:
+		resp.Resp, err = client.Backup(ctx, req.Backup())
+	case CmdRestore:
+		resp.Resp, err = client.Restore(ctx, req.Restore())
 	default:
 		return nil, errors.Errorf("invalid request type: %v", req.Type)
 	}
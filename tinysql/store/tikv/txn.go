@@ -47,6 +47,9 @@ type tikvTxn struct {
 	vars      *kv.Variables
 	committer *twoPhaseCommitter
 
+	// isPessimistic is set by the kv.Pessimistic option, see LockKeys.
+	isPessimistic bool
+
 	valid bool
 	dirty bool
 }
@@ -109,6 +112,39 @@ func (txn *tikvTxn) Get(ctx context.Context, k kv.Key) ([]byte, error) {
 	return ret, nil
 }
 
+// BatchGet implements transaction interface. The values read from TinyKV are cached by the snapshot, so a later Get
+// of the keys doesn't send a request.
+func (txn *tikvTxn) BatchGet(ctx context.Context, keys []kv.Key) (map[string][]byte, error) {
+	m := make(map[string][]byte, len(keys))
+	pending := make([]kv.Key, 0, len(keys))
+	for _, k := range keys {
+		val, err := txn.us.GetMemBuffer().Get(ctx, k)
+		if kv.IsErrNotFound(err) {
+			pending = append(pending, k)
+			continue
+		}
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		// An empty value in the buffer is a delete.
+		if len(val) > 0 {
+			m[string(k)] = val
+		}
+	}
+	if len(pending) == 0 {
+		return m, nil
+	}
+
+	storageValues, err := txn.snapshot.BatchGet(ctx, pending)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for k, v := range storageValues {
+		m[k] = v
+	}
+	return m, nil
+}
+
 func (txn *tikvTxn) Set(k kv.Key, v []byte) error {
 	txn.setCnt++
 
@@ -143,6 +179,10 @@ func (txn *tikvTxn) SetOption(opt kv.Option, val interface{}) {
 		txn.snapshot.keyOnly = val.(bool)
 	case kv.SnapshotTS:
 		txn.snapshot.setSnapshotTS(val.(uint64))
+	case kv.ReplicaRead:
+		txn.snapshot.replicaRead = val.(kv.ReplicaReadType)
+	case kv.Pessimistic:
+		txn.isPessimistic = val.(bool)
 	}
 }
 
@@ -207,10 +247,23 @@ func (txn *tikvTxn) Rollback() error {
 	}
 	txn.close()
 	logutil.BgLogger().Debug("[kv] rollback txn", zap.Uint64("txnStartTS", txn.StartTS()))
+	if txn.committer != nil {
+		txn.committer.ttlManager.close()
+	}
+	if txn.isPessimistic && txn.committer != nil && len(txn.committer.primaryKey) > 0 {
+		// Release the pessimistic locks, or other transactions would wait for them until they expire.
+		bo := NewBackoffer(context.Background(), cleanupMaxBackoff).WithVars(txn.vars)
+		if err := txn.committer.pessimisticRollbackKeys(bo, txn.lockKeys); err != nil {
+			logutil.BgLogger().Warn("[kv] pessimistic rollback failed",
+				zap.Uint64("txnStartTS", txn.StartTS()), zap.Error(err))
+		}
+	}
 	return nil
 }
 
-// lockWaitTime in ms, except that kv.LockAlwaysWait(0) means always wait lock, kv.LockNowait(-1) means nowait lock
+// LockKeys locks keys for the transaction. Optimistic transactions only prewrite the keys with the other
+// mutations when they commit, pessimistic ones lock them right away at lockCtx.ForUpdateTS, so that a
+// conflict fails the current statement instead of the whole transaction.
 func (txn *tikvTxn) LockKeys(ctx context.Context, lockCtx *kv.LockCtx, keysInput ...kv.Key) error {
 	// Exclude keys that are already locked.
 	keys := make([][]byte, 0, len(keysInput))
@@ -224,6 +277,11 @@ func (txn *tikvTxn) LockKeys(ctx context.Context, lockCtx *kv.LockCtx, keysInput
 	if len(keys) == 0 {
 		return nil
 	}
+	if txn.isPessimistic && lockCtx.ForUpdateTS > 0 {
+		if err := txn.pessimisticLockKeys(ctx, lockCtx, keys); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	txn.mu.Lock()
 	txn.lockKeys = append(txn.lockKeys, keys...)
 	for _, key := range keys {
@@ -234,6 +292,48 @@ func (txn *tikvTxn) LockKeys(ctx context.Context, lockCtx *kv.LockCtx, keysInput
 	return nil
 }
 
+func (txn *tikvTxn) pessimisticLockKeys(ctx context.Context, lockCtx *kv.LockCtx, keys [][]byte) error {
+	if txn.committer == nil {
+		// connID is used for log.
+		var connID uint64
+		if val := ctx.Value(sessionctx.ConnID); val != nil {
+			connID = val.(uint64)
+		}
+		committer, err := newTwoPhaseCommitter(txn, connID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		txn.committer = committer
+	}
+	// The first locked key becomes the primary key of the transaction.
+	var assignedPrimaryKey bool
+	if len(txn.committer.primaryKey) == 0 {
+		txn.committer.primaryKey = keys[0]
+		assignedPrimaryKey = true
+	}
+	txn.committer.forUpdateTS = lockCtx.ForUpdateTS
+	bo := NewBackoffer(ctx, pessimisticLockMaxBackoff).WithVars(txn.vars)
+	err := txn.committer.pessimisticLockKeys(bo, lockCtx, keys)
+	if err != nil {
+		// Some of the keys may be locked already, release them since the statement fails.
+		rollbackBo := NewBackoffer(context.Background(), cleanupMaxBackoff).WithVars(txn.vars)
+		if err1 := txn.committer.pessimisticRollbackKeys(rollbackBo, keys); err1 != nil {
+			logutil.Logger(ctx).Warn("[kv] pessimistic rollback failed",
+				zap.Uint64("txnStartTS", txn.StartTS()), zap.Error(err1))
+		}
+		if assignedPrimaryKey {
+			txn.committer.primaryKey = nil
+		}
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// IsPessimistic returns true if the transaction is pessimistic.
+func (txn *tikvTxn) IsPessimistic() bool {
+	return txn.isPessimistic
+}
+
 func (txn *tikvTxn) IsReadOnly() bool {
 	return !txn.dirty
 }
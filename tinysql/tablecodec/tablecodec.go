@@ -538,6 +538,11 @@ func IsIndexKey(k []byte) bool {
 	return len(k) > 11 && k[0] == 't' && k[10] == 'i'
 }
 
+// IsRecordKey is used to check whether the key is a record key.
+func IsRecordKey(k []byte) bool {
+	return len(k) == RecordRowKeyLen && k[0] == 't' && k[10] == 'r'
+}
+
 // IsUntouchedIndexKValue uses to check whether the key is index key, and the value is untouched,
 // since the untouched index key/value is no need to commit.
 func IsUntouchedIndexKValue(k, v []byte) bool {
@@ -34,6 +34,7 @@ import (
 	kvstore "github.com/pingcap/tidb/store"
 	"github.com/pingcap/tidb/store/mockstore"
 	"github.com/pingcap/tidb/store/tikv"
+	"github.com/pingcap/tidb/store/tikv/gcworker"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/signal"
 	"go.uber.org/automaxprocs/maxprocs"
@@ -122,6 +123,7 @@ func registerStores() {
 	terror.MustNil(err)
 	err = kvstore.Register("mocktikv", mockstore.MockDriver{})
 	terror.MustNil(err)
+	tikv.NewGCHandlerFunc = gcworker.NewGCWorker
 }
 
 func createStoreAndDomain() {
@@ -0,0 +1,2601 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: executor.proto
+
+package tipb
+
+import (
+	"fmt"
+
+	proto "github.com/golang/protobuf/proto"
+
+	math "math"
+
+	io "io"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type ExecType int32
+
+const (
+	ExecType_TypeTableScan   ExecType = 0
+	ExecType_TypeIndexScan   ExecType = 1
+	ExecType_TypeSelection   ExecType = 2
+	ExecType_TypeAggregation ExecType = 3
+	ExecType_TypeTopN        ExecType = 4
+	ExecType_TypeLimit       ExecType = 5
+	ExecType_TypeStreamAgg   ExecType = 6
+	ExecType_TypeProjection  ExecType = 11
+)
+
+var ExecType_name = map[int32]string{
+	0:  "TypeTableScan",
+	1:  "TypeIndexScan",
+	2:  "TypeSelection",
+	3:  "TypeAggregation",
+	4:  "TypeTopN",
+	5:  "TypeLimit",
+	6:  "TypeStreamAgg",
+	11: "TypeProjection",
+}
+var ExecType_value = map[string]int32{
+	"TypeTableScan":   0,
+	"TypeIndexScan":   1,
+	"TypeSelection":   2,
+	"TypeAggregation": 3,
+	"TypeTopN":        4,
+	"TypeLimit":       5,
+	"TypeStreamAgg":   6,
+	"TypeProjection":  11,
+}
+
+func (x ExecType) Enum() *ExecType {
+	p := new(ExecType)
+	*p = x
+	return p
+}
+func (x ExecType) String() string {
+	return proto.EnumName(ExecType_name, int32(x))
+}
+func (x *ExecType) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ExecType_value, data, "ExecType")
+	if err != nil {
+		return err
+	}
+	*x = ExecType(value)
+	return nil
+}
+func (ExecType) EnumDescriptor() ([]byte, []int) { return fileDescriptorExecutor, []int{0} }
+
+// It represents a Executor.
+type Executor struct {
+	Tp               ExecType     `protobuf:"varint,1,opt,name=tp,enum=tipb.ExecType" json:"tp"`
+	TblScan          *TableScan   `protobuf:"bytes,2,opt,name=tbl_scan,json=tblScan" json:"tbl_scan,omitempty"`
+	IdxScan          *IndexScan   `protobuf:"bytes,3,opt,name=idx_scan,json=idxScan" json:"idx_scan,omitempty"`
+	Selection        *Selection   `protobuf:"bytes,4,opt,name=selection" json:"selection,omitempty"`
+	Aggregation      *Aggregation `protobuf:"bytes,5,opt,name=aggregation" json:"aggregation,omitempty"`
+	TopN             *TopN        `protobuf:"bytes,6,opt,name=topN" json:"topN,omitempty"`
+	Limit            *Limit       `protobuf:"bytes,7,opt,name=limit" json:"limit,omitempty"`
+	StreamAgg        *Aggregation `protobuf:"bytes,8,opt,name=stream_agg,json=streamAgg" json:"stream_agg,omitempty"`
+	Projection       *Projection  `protobuf:"bytes,13,opt,name=projection" json:"projection,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *Executor) Reset()                    { *m = Executor{} }
+func (m *Executor) String() string            { return proto.CompactTextString(m) }
+func (*Executor) ProtoMessage()               {}
+func (*Executor) Descriptor() ([]byte, []int) { return fileDescriptorExecutor, []int{0} }
+
+func (m *Executor) GetTp() ExecType {
+	if m != nil {
+		return m.Tp
+	}
+	return ExecType_TypeTableScan
+}
+
+func (m *Executor) GetTblScan() *TableScan {
+	if m != nil {
+		return m.TblScan
+	}
+	return nil
+}
+
+func (m *Executor) GetIdxScan() *IndexScan {
+	if m != nil {
+		return m.IdxScan
+	}
+	return nil
+}
+
+func (m *Executor) GetSelection() *Selection {
+	if m != nil {
+		return m.Selection
+	}
+	return nil
+}
+
+func (m *Executor) GetAggregation() *Aggregation {
+	if m != nil {
+		return m.Aggregation
+	}
+	return nil
+}
+
+func (m *Executor) GetTopN() *TopN {
+	if m != nil {
+		return m.TopN
+	}
+	return nil
+}
+
+func (m *Executor) GetLimit() *Limit {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *Executor) GetStreamAgg() *Aggregation {
+	if m != nil {
+		return m.StreamAgg
+	}
+	return nil
+}
+
+func (m *Executor) GetProjection() *Projection {
+	if m != nil {
+		return m.Projection
+	}
+	return nil
+}
+
+type TableScan struct {
+	TableId          int64         `protobuf:"varint,1,opt,name=table_id,json=tableId" json:"table_id"`
+	Columns          []*ColumnInfo `protobuf:"bytes,2,rep,name=columns" json:"columns,omitempty"`
+	Desc             bool          `protobuf:"varint,3,opt,name=desc" json:"desc"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *TableScan) Reset()                    { *m = TableScan{} }
+func (m *TableScan) String() string            { return proto.CompactTextString(m) }
+func (*TableScan) ProtoMessage()               {}
+func (*TableScan) Descriptor() ([]byte, []int) { return fileDescriptorExecutor, []int{1} }
+
+func (m *TableScan) GetTableId() int64 {
+	if m != nil {
+		return m.TableId
+	}
+	return 0
+}
+
+func (m *TableScan) GetColumns() []*ColumnInfo {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *TableScan) GetDesc() bool {
+	if m != nil {
+		return m.Desc
+	}
+	return false
+}
+
+type IndexScan struct {
+	TableId          int64         `protobuf:"varint,1,opt,name=table_id,json=tableId" json:"table_id"`
+	IndexId          int64         `protobuf:"varint,2,opt,name=index_id,json=indexId" json:"index_id"`
+	Columns          []*ColumnInfo `protobuf:"bytes,3,rep,name=columns" json:"columns,omitempty"`
+	Desc             bool          `protobuf:"varint,4,opt,name=desc" json:"desc"`
+	Unique           *bool         `protobuf:"varint,5,opt,name=unique" json:"unique,omitempty"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *IndexScan) Reset()                    { *m = IndexScan{} }
+func (m *IndexScan) String() string            { return proto.CompactTextString(m) }
+func (*IndexScan) ProtoMessage()               {}
+func (*IndexScan) Descriptor() ([]byte, []int) { return fileDescriptorExecutor, []int{2} }
+
+func (m *IndexScan) GetTableId() int64 {
+	if m != nil {
+		return m.TableId
+	}
+	return 0
+}
+
+func (m *IndexScan) GetIndexId() int64 {
+	if m != nil {
+		return m.IndexId
+	}
+	return 0
+}
+
+func (m *IndexScan) GetColumns() []*ColumnInfo {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *IndexScan) GetDesc() bool {
+	if m != nil {
+		return m.Desc
+	}
+	return false
+}
+
+func (m *IndexScan) GetUnique() bool {
+	if m != nil && m.Unique != nil {
+		return *m.Unique
+	}
+	return false
+}
+
+type Selection struct {
+	// Where conditions.
+	Conditions       []*Expr    `protobuf:"bytes,1,rep,name=conditions" json:"conditions,omitempty"`
+	RpnConditions    []*RpnExpr `protobuf:"bytes,2,rep,name=rpn_conditions,json=rpnConditions" json:"rpn_conditions,omitempty"`
+	XXX_unrecognized []byte     `json:"-"`
+}
+
+func (m *Selection) Reset()                    { *m = Selection{} }
+func (m *Selection) String() string            { return proto.CompactTextString(m) }
+func (*Selection) ProtoMessage()               {}
+func (*Selection) Descriptor() ([]byte, []int) { return fileDescriptorExecutor, []int{3} }
+
+func (m *Selection) GetConditions() []*Expr {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *Selection) GetRpnConditions() []*RpnExpr {
+	if m != nil {
+		return m.RpnConditions
+	}
+	return nil
+}
+
+type Projection struct {
+	// Projection expressions.
+	Exprs            []*Expr    `protobuf:"bytes,1,rep,name=exprs" json:"exprs,omitempty"`
+	RpnExprs         []*RpnExpr `protobuf:"bytes,2,rep,name=rpn_exprs,json=rpnExprs" json:"rpn_exprs,omitempty"`
+	XXX_unrecognized []byte     `json:"-"`
+}
+
+func (m *Projection) Reset()                    { *m = Projection{} }
+func (m *Projection) String() string            { return proto.CompactTextString(m) }
+func (*Projection) ProtoMessage()               {}
+func (*Projection) Descriptor() ([]byte, []int) { return fileDescriptorExecutor, []int{4} }
+
+func (m *Projection) GetExprs() []*Expr {
+	if m != nil {
+		return m.Exprs
+	}
+	return nil
+}
+
+func (m *Projection) GetRpnExprs() []*RpnExpr {
+	if m != nil {
+		return m.RpnExprs
+	}
+	return nil
+}
+
+type Aggregation struct {
+	// Group by clause.
+	GroupBy    []*Expr    `protobuf:"bytes,1,rep,name=group_by,json=groupBy" json:"group_by,omitempty"`
+	RpnGroupBy []*RpnExpr `protobuf:"bytes,4,rep,name=rpn_group_by,json=rpnGroupBy" json:"rpn_group_by,omitempty"`
+	// Aggregate functions.
+	AggFunc    []*Expr    `protobuf:"bytes,2,rep,name=agg_func,json=aggFunc" json:"agg_func,omitempty"`
+	RpnAggFunc []*RpnExpr `protobuf:"bytes,5,rep,name=rpn_agg_func,json=rpnAggFunc" json:"rpn_agg_func,omitempty"`
+	// If it is a stream aggregation.
+	Streamed         bool   `protobuf:"varint,3,opt,name=streamed" json:"streamed"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Aggregation) Reset()                    { *m = Aggregation{} }
+func (m *Aggregation) String() string            { return proto.CompactTextString(m) }
+func (*Aggregation) ProtoMessage()               {}
+func (*Aggregation) Descriptor() ([]byte, []int) { return fileDescriptorExecutor, []int{5} }
+
+func (m *Aggregation) GetGroupBy() []*Expr {
+	if m != nil {
+		return m.GroupBy
+	}
+	return nil
+}
+
+func (m *Aggregation) GetRpnGroupBy() []*RpnExpr {
+	if m != nil {
+		return m.RpnGroupBy
+	}
+	return nil
+}
+
+func (m *Aggregation) GetAggFunc() []*Expr {
+	if m != nil {
+		return m.AggFunc
+	}
+	return nil
+}
+
+func (m *Aggregation) GetRpnAggFunc() []*RpnExpr {
+	if m != nil {
+		return m.RpnAggFunc
+	}
+	return nil
+}
+
+func (m *Aggregation) GetStreamed() bool {
+	if m != nil {
+		return m.Streamed
+	}
+	return false
+}
+
+type TopN struct {
+	// Order by clause.
+	OrderBy          []*ByItem `protobuf:"bytes,1,rep,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	Limit            uint64    `protobuf:"varint,2,opt,name=limit" json:"limit"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *TopN) Reset()                    { *m = TopN{} }
+func (m *TopN) String() string            { return proto.CompactTextString(m) }
+func (*TopN) ProtoMessage()               {}
+func (*TopN) Descriptor() ([]byte, []int) { return fileDescriptorExecutor, []int{6} }
+
+func (m *TopN) GetOrderBy() []*ByItem {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *TopN) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type Limit struct {
+	// Limit the result to be returned.
+	Limit            uint64 `protobuf:"varint,1,opt,name=limit" json:"limit"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Limit) Reset()                    { *m = Limit{} }
+func (m *Limit) String() string            { return proto.CompactTextString(m) }
+func (*Limit) ProtoMessage()               {}
+func (*Limit) Descriptor() ([]byte, []int) { return fileDescriptorExecutor, []int{7} }
+
+func (m *Limit) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ExecutorExecutionSummary struct {
+	// Total time cost in this executor. Includes self time cost and children time cost.
+	TimeProcessedNs *uint64 `protobuf:"varint,1,opt,name=time_processed_ns,json=timeProcessedNs" json:"time_processed_ns,omitempty"`
+	// How many rows this executor produced totally.
+	NumProducedRows *uint64 `protobuf:"varint,2,opt,name=num_produced_rows,json=numProducedRows" json:"num_produced_rows,omitempty"`
+	// How many times executor's `next()` is called.
+	NumIterations    *uint64 `protobuf:"varint,3,opt,name=num_iterations,json=numIterations" json:"num_iterations,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ExecutorExecutionSummary) Reset()                    { *m = ExecutorExecutionSummary{} }
+func (m *ExecutorExecutionSummary) String() string            { return proto.CompactTextString(m) }
+func (*ExecutorExecutionSummary) ProtoMessage()               {}
+func (*ExecutorExecutionSummary) Descriptor() ([]byte, []int) { return fileDescriptorExecutor, []int{8} }
+
+func (m *ExecutorExecutionSummary) GetTimeProcessedNs() uint64 {
+	if m != nil && m.TimeProcessedNs != nil {
+		return *m.TimeProcessedNs
+	}
+	return 0
+}
+
+func (m *ExecutorExecutionSummary) GetNumProducedRows() uint64 {
+	if m != nil && m.NumProducedRows != nil {
+		return *m.NumProducedRows
+	}
+	return 0
+}
+
+func (m *ExecutorExecutionSummary) GetNumIterations() uint64 {
+	if m != nil && m.NumIterations != nil {
+		return *m.NumIterations
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Executor)(nil), "tipb.Executor")
+	proto.RegisterType((*TableScan)(nil), "tipb.TableScan")
+	proto.RegisterType((*IndexScan)(nil), "tipb.IndexScan")
+	proto.RegisterType((*Selection)(nil), "tipb.Selection")
+	proto.RegisterType((*Projection)(nil), "tipb.Projection")
+	proto.RegisterType((*Aggregation)(nil), "tipb.Aggregation")
+	proto.RegisterType((*TopN)(nil), "tipb.TopN")
+	proto.RegisterType((*Limit)(nil), "tipb.Limit")
+	proto.RegisterType((*ExecutorExecutionSummary)(nil), "tipb.ExecutorExecutionSummary")
+	proto.RegisterEnum("tipb.ExecType", ExecType_name, ExecType_value)
+}
+func (m *Executor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Executor) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintExecutor(dAtA, i, uint64(m.Tp))
+	if m.TblScan != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecutor(dAtA, i, uint64(m.TblScan.Size()))
+		n1, err := m.TblScan.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.IdxScan != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintExecutor(dAtA, i, uint64(m.IdxScan.Size()))
+		n2, err := m.IdxScan.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Selection != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintExecutor(dAtA, i, uint64(m.Selection.Size()))
+		n3, err := m.Selection.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Aggregation != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintExecutor(dAtA, i, uint64(m.Aggregation.Size()))
+		n4, err := m.Aggregation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.TopN != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintExecutor(dAtA, i, uint64(m.TopN.Size()))
+		n5, err := m.TopN.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Limit != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintExecutor(dAtA, i, uint64(m.Limit.Size()))
+		n6, err := m.Limit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.StreamAgg != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintExecutor(dAtA, i, uint64(m.StreamAgg.Size()))
+		n7, err := m.StreamAgg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Projection != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintExecutor(dAtA, i, uint64(m.Projection.Size()))
+		n8, err := m.Projection.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TableScan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableScan) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintExecutor(dAtA, i, uint64(m.TableId))
+	if len(m.Columns) > 0 {
+		for _, msg := range m.Columns {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintExecutor(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x18
+	i++
+	if m.Desc {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *IndexScan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexScan) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintExecutor(dAtA, i, uint64(m.TableId))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintExecutor(dAtA, i, uint64(m.IndexId))
+	if len(m.Columns) > 0 {
+		for _, msg := range m.Columns {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintExecutor(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x20
+	i++
+	if m.Desc {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.Unique != nil {
+		dAtA[i] = 0x28
+		i++
+		if *m.Unique {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Selection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Selection) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, msg := range m.Conditions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintExecutor(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RpnConditions) > 0 {
+		for _, msg := range m.RpnConditions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintExecutor(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Projection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Projection) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exprs) > 0 {
+		for _, msg := range m.Exprs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintExecutor(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RpnExprs) > 0 {
+		for _, msg := range m.RpnExprs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintExecutor(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Aggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Aggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupBy) > 0 {
+		for _, msg := range m.GroupBy {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintExecutor(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.AggFunc) > 0 {
+		for _, msg := range m.AggFunc {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintExecutor(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x18
+	i++
+	if m.Streamed {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if len(m.RpnGroupBy) > 0 {
+		for _, msg := range m.RpnGroupBy {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintExecutor(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RpnAggFunc) > 0 {
+		for _, msg := range m.RpnAggFunc {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintExecutor(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TopN) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopN) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderBy) > 0 {
+		for _, msg := range m.OrderBy {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintExecutor(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintExecutor(dAtA, i, uint64(m.Limit))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Limit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Limit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintExecutor(dAtA, i, uint64(m.Limit))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ExecutorExecutionSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorExecutionSummary) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TimeProcessedNs != nil {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintExecutor(dAtA, i, uint64(*m.TimeProcessedNs))
+	}
+	if m.NumProducedRows != nil {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintExecutor(dAtA, i, uint64(*m.NumProducedRows))
+	}
+	if m.NumIterations != nil {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintExecutor(dAtA, i, uint64(*m.NumIterations))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintExecutor(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Executor) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovExecutor(uint64(m.Tp))
+	if m.TblScan != nil {
+		l = m.TblScan.Size()
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	if m.IdxScan != nil {
+		l = m.IdxScan.Size()
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	if m.Selection != nil {
+		l = m.Selection.Size()
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	if m.Aggregation != nil {
+		l = m.Aggregation.Size()
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	if m.TopN != nil {
+		l = m.TopN.Size()
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	if m.StreamAgg != nil {
+		l = m.StreamAgg.Size()
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	if m.Projection != nil {
+		l = m.Projection.Size()
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TableScan) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovExecutor(uint64(m.TableId))
+	if len(m.Columns) > 0 {
+		for _, e := range m.Columns {
+			l = e.Size()
+			n += 1 + l + sovExecutor(uint64(l))
+		}
+	}
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndexScan) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovExecutor(uint64(m.TableId))
+	n += 1 + sovExecutor(uint64(m.IndexId))
+	if len(m.Columns) > 0 {
+		for _, e := range m.Columns {
+			l = e.Size()
+			n += 1 + l + sovExecutor(uint64(l))
+		}
+	}
+	n += 2
+	if m.Unique != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Selection) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovExecutor(uint64(l))
+		}
+	}
+	if len(m.RpnConditions) > 0 {
+		for _, e := range m.RpnConditions {
+			l = e.Size()
+			n += 1 + l + sovExecutor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Projection) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Exprs) > 0 {
+		for _, e := range m.Exprs {
+			l = e.Size()
+			n += 1 + l + sovExecutor(uint64(l))
+		}
+	}
+	if len(m.RpnExprs) > 0 {
+		for _, e := range m.RpnExprs {
+			l = e.Size()
+			n += 1 + l + sovExecutor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Aggregation) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.GroupBy) > 0 {
+		for _, e := range m.GroupBy {
+			l = e.Size()
+			n += 1 + l + sovExecutor(uint64(l))
+		}
+	}
+	if len(m.AggFunc) > 0 {
+		for _, e := range m.AggFunc {
+			l = e.Size()
+			n += 1 + l + sovExecutor(uint64(l))
+		}
+	}
+	n += 2
+	if len(m.RpnGroupBy) > 0 {
+		for _, e := range m.RpnGroupBy {
+			l = e.Size()
+			n += 1 + l + sovExecutor(uint64(l))
+		}
+	}
+	if len(m.RpnAggFunc) > 0 {
+		for _, e := range m.RpnAggFunc {
+			l = e.Size()
+			n += 1 + l + sovExecutor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TopN) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.OrderBy) > 0 {
+		for _, e := range m.OrderBy {
+			l = e.Size()
+			n += 1 + l + sovExecutor(uint64(l))
+		}
+	}
+	n += 1 + sovExecutor(uint64(m.Limit))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Limit) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovExecutor(uint64(m.Limit))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExecutorExecutionSummary) Size() (n int) {
+	var l int
+	_ = l
+	if m.TimeProcessedNs != nil {
+		n += 1 + sovExecutor(uint64(*m.TimeProcessedNs))
+	}
+	if m.NumProducedRows != nil {
+		n += 1 + sovExecutor(uint64(*m.NumProducedRows))
+	}
+	if m.NumIterations != nil {
+		n += 1 + sovExecutor(uint64(*m.NumIterations))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovExecutor(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozExecutor(x uint64) (n int) {
+	return sovExecutor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Executor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Executor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Executor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tp", wireType)
+			}
+			m.Tp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tp |= (ExecType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TblScan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TblScan == nil {
+				m.TblScan = &TableScan{}
+			}
+			if err := m.TblScan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdxScan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IdxScan == nil {
+				m.IdxScan = &IndexScan{}
+			}
+			if err := m.IdxScan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selection == nil {
+				m.Selection = &Selection{}
+			}
+			if err := m.Selection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aggregation == nil {
+				m.Aggregation = &Aggregation{}
+			}
+			if err := m.Aggregation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TopN == nil {
+				m.TopN = &TopN{}
+			}
+			if err := m.TopN.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &Limit{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamAgg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StreamAgg == nil {
+				m.StreamAgg = &Aggregation{}
+			}
+			if err := m.StreamAgg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Projection == nil {
+				m.Projection = &Projection{}
+			}
+			if err := m.Projection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableScan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableScan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableScan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, &ColumnInfo{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexScan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexScan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexScan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexId", wireType)
+			}
+			m.IndexId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexId |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, &ColumnInfo{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Unique = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Selection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Selection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Selection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, &Expr{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpnConditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpnConditions = append(m.RpnConditions, &RpnExpr{})
+			if err := m.RpnConditions[len(m.RpnConditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Projection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Projection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Projection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exprs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exprs = append(m.Exprs, &Expr{})
+			if err := m.Exprs[len(m.Exprs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpnExprs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpnExprs = append(m.RpnExprs, &RpnExpr{})
+			if err := m.RpnExprs[len(m.RpnExprs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Aggregation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Aggregation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Aggregation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = append(m.GroupBy, &Expr{})
+			if err := m.GroupBy[len(m.GroupBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggFunc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggFunc = append(m.AggFunc, &Expr{})
+			if err := m.AggFunc[len(m.AggFunc)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streamed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Streamed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpnGroupBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpnGroupBy = append(m.RpnGroupBy, &RpnExpr{})
+			if err := m.RpnGroupBy[len(m.RpnGroupBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpnAggFunc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpnAggFunc = append(m.RpnAggFunc, &RpnExpr{})
+			if err := m.RpnAggFunc[len(m.RpnAggFunc)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopN) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopN: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopN: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = append(m.OrderBy, &ByItem{})
+			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Limit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Limit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Limit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorExecutionSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorExecutionSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorExecutionSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeProcessedNs", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeProcessedNs = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumProducedRows", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NumProducedRows = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumIterations", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NumIterations = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExecutor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthExecutor
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowExecutor
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipExecutor(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthExecutor = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExecutor   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("executor.proto", fileDescriptorExecutor) }
+
+var fileDescriptorExecutor = []byte{
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0xc7, 0xd7, 0xb1, 0xb3, 0x71, 0x4e, 0x36, 0x89, 0x33, 0x7c, 0xc8, 0xda, 0x8b, 0x6c, 0x30,
+	0x54, 0x2c, 0x91, 0x48, 0x51, 0xe1, 0x05, 0x36, 0x55, 0x41, 0x11, 0x28, 0x8a, 0xbc, 0xbd, 0xe2,
+	0xc6, 0x72, 0xec, 0xa9, 0x19, 0x14, 0xcf, 0x0c, 0xe3, 0xb1, 0x9a, 0x3c, 0x07, 0x37, 0x20, 0xf1,
+	0x06, 0xbc, 0x48, 0x2f, 0x79, 0x02, 0x40, 0xcb, 0x8b, 0xa0, 0x99, 0xf1, 0x57, 0x69, 0x2a, 0x7a,
+	0xe5, 0x99, 0xff, 0xf9, 0x9d, 0x0f, 0xfb, 0x9c, 0x63, 0x98, 0xe0, 0x23, 0x4e, 0x4a, 0xc9, 0xc4,
+	0x8a, 0x0b, 0x26, 0x19, 0x72, 0x24, 0xe1, 0xfb, 0x6b, 0x0f, 0x1f, 0xb9, 0xc0, 0x45, 0x41, 0x18,
+	0x35, 0xfa, 0xf5, 0x55, 0x91, 0xfc, 0x80, 0xf3, 0xb8, 0xba, 0xbd, 0x9f, 0xb1, 0x8c, 0xe9, 0xe3,
+	0x63, 0x75, 0x32, 0x6a, 0xf0, 0xb3, 0x0d, 0xee, 0xb3, 0x2a, 0x1c, 0xfa, 0x04, 0x7a, 0x92, 0xfb,
+	0xd6, 0xc2, 0xba, 0x9d, 0x3c, 0x99, 0xac, 0x54, 0xd4, 0x95, 0xb2, 0x3d, 0x3f, 0x71, 0xbc, 0x76,
+	0x5e, 0xfd, 0x79, 0x73, 0x11, 0xf6, 0x24, 0x47, 0x4b, 0x70, 0xe5, 0xfe, 0x10, 0x15, 0x49, 0x4c,
+	0xfd, 0xde, 0xc2, 0xba, 0x1d, 0x3d, 0x99, 0x1a, 0xf6, 0x79, 0xbc, 0x3f, 0xe0, 0xfb, 0x24, 0xa6,
+	0xe1, 0x40, 0xee, 0x0f, 0xea, 0xa0, 0x58, 0x92, 0x1e, 0x0d, 0x6b, 0x77, 0xd9, 0x0d, 0x4d, 0xf1,
+	0xd1, 0xb0, 0x24, 0xd5, 0x07, 0xf4, 0x39, 0x0c, 0x0b, 0x7c, 0xc0, 0x89, 0x24, 0x8c, 0xfa, 0x4e,
+	0x17, 0xbe, 0xaf, 0xe5, 0xb0, 0x25, 0xd0, 0x97, 0x30, 0x8a, 0xb3, 0x4c, 0xe0, 0x2c, 0xd6, 0x0e,
+	0x7d, 0xed, 0x30, 0x33, 0x0e, 0x77, 0xad, 0x21, 0xec, 0x52, 0x68, 0x0e, 0x8e, 0x64, 0x7c, 0xeb,
+	0x5f, 0x6a, 0x1a, 0xaa, 0xba, 0x19, 0xdf, 0x86, 0x5a, 0x47, 0x1f, 0x41, 0xff, 0x40, 0x72, 0x22,
+	0xfd, 0x81, 0x06, 0x46, 0x06, 0xf8, 0x4e, 0x49, 0xa1, 0xb1, 0xa0, 0x2f, 0x00, 0x0a, 0x29, 0x70,
+	0x9c, 0x47, 0x71, 0x96, 0xf9, 0xee, 0xdb, 0xd2, 0x0e, 0x0d, 0x74, 0x97, 0x65, 0xca, 0x83, 0x0b,
+	0xf6, 0x63, 0xf5, 0x66, 0x63, 0xed, 0xe1, 0x19, 0x8f, 0x5d, 0xa3, 0x87, 0x1d, 0x26, 0x10, 0x30,
+	0x6c, 0x3e, 0x26, 0xba, 0x01, 0x57, 0xaa, 0x4b, 0x44, 0x52, 0xdd, 0x1b, 0xbb, 0xea, 0xc5, 0x40,
+	0xab, 0x9b, 0x14, 0x2d, 0x61, 0x90, 0xb0, 0x43, 0x99, 0xd3, 0xc2, 0xef, 0x2d, 0xec, 0x36, 0xf8,
+	0x53, 0x2d, 0x6e, 0xe8, 0x0b, 0x16, 0xd6, 0x00, 0xf2, 0xc1, 0x49, 0x71, 0x91, 0xe8, 0x66, 0xb8,
+	0x55, 0x20, 0xad, 0x04, 0xbf, 0x5b, 0x30, 0x6c, 0xba, 0xf2, 0xff, 0x49, 0x6f, 0xc0, 0x25, 0x8a,
+	0x56, 0x40, 0xaf, 0x0b, 0x68, 0xf5, 0xf5, 0xaa, 0xec, 0x77, 0xad, 0xca, 0xf9, 0x6f, 0x55, 0xe8,
+	0x43, 0xb8, 0x2c, 0x29, 0xf9, 0xa9, 0xc4, 0xba, 0xc1, 0x6e, 0x58, 0xdd, 0x82, 0x1c, 0x86, 0xcd,
+	0x54, 0xa0, 0x25, 0x40, 0xc2, 0x68, 0x4a, 0xd4, 0xa5, 0xf0, 0xad, 0x85, 0xdd, 0xf6, 0xf6, 0xd9,
+	0x91, 0x8b, 0xb0, 0x63, 0x45, 0x5f, 0xc1, 0x44, 0x70, 0x1a, 0x75, 0x78, 0xf3, 0xcd, 0xc6, 0x86,
+	0x0f, 0x39, 0xd5, 0x2e, 0x63, 0xc1, 0xe9, 0xd3, 0x86, 0x09, 0xbe, 0x07, 0x68, 0x5b, 0x85, 0x16,
+	0xd0, 0x57, 0xcb, 0x76, 0x2e, 0x95, 0x31, 0xa0, 0x25, 0x0c, 0x55, 0x16, 0x43, 0x9d, 0x4d, 0xe0,
+	0x0a, 0x73, 0x28, 0x82, 0xbf, 0x2c, 0x18, 0x75, 0x26, 0x07, 0x3d, 0x02, 0x37, 0x13, 0xac, 0xe4,
+	0xd1, 0xfe, 0x74, 0x26, 0xc1, 0x40, 0xdb, 0xd6, 0x27, 0x85, 0xc5, 0x59, 0x16, 0xbd, 0x28, 0x69,
+	0xe2, 0xf7, 0xde, 0xc4, 0xe2, 0x2c, 0xfb, 0xba, 0xa4, 0x09, 0x5a, 0x80, 0x6b, 0x26, 0x11, 0xa7,
+	0xaf, 0x35, 0xbd, 0x51, 0xd1, 0x63, 0xb8, 0x52, 0xb5, 0x36, 0x39, 0x9d, 0x73, 0xe5, 0x82, 0xe0,
+	0xf4, 0x9b, 0x2a, 0x73, 0xe5, 0xd0, 0x64, 0xef, 0xbf, 0xcd, 0xe1, 0xce, 0xd4, 0x10, 0x7c, 0x0b,
+	0x8e, 0xda, 0x31, 0xf4, 0x29, 0xb8, 0x4c, 0xa4, 0x58, 0xb4, 0x6f, 0x76, 0x65, 0x9c, 0xd6, 0xa7,
+	0x8d, 0xc4, 0x79, 0x38, 0xd0, 0xd6, 0xf5, 0x09, 0x5d, 0xd7, 0x6b, 0xa8, 0x26, 0xcb, 0xa9, 0x2a,
+	0x36, 0x52, 0xf0, 0x31, 0xf4, 0xf5, 0x3e, 0xb6, 0x90, 0xf5, 0x26, 0xf4, 0xab, 0x05, 0x7e, 0xfd,
+	0x5b, 0x33, 0x4f, 0xc2, 0xe8, 0x7d, 0x99, 0xe7, 0xb1, 0x38, 0xa1, 0x25, 0xcc, 0x24, 0xc9, 0x71,
+	0xc4, 0x05, 0x4b, 0x70, 0x51, 0xe0, 0x34, 0xd2, 0x53, 0x63, 0xdd, 0x3a, 0xe1, 0x54, 0x19, 0x76,
+	0xb5, 0xbe, 0x55, 0x8d, 0x9c, 0xd1, 0x32, 0x57, 0x68, 0x5a, 0x26, 0x38, 0x8d, 0x04, 0x7b, 0x59,
+	0x98, 0xaa, 0xc2, 0x29, 0x2d, 0xf3, 0x5d, 0xa5, 0x87, 0xec, 0x65, 0x81, 0x1e, 0xc1, 0x44, 0xb1,
+	0x44, 0x62, 0x11, 0x9b, 0xd1, 0xb2, 0x35, 0x38, 0xa6, 0x65, 0xbe, 0x69, 0xc4, 0xe5, 0x6f, 0x16,
+	0xb8, 0xf5, 0x6f, 0x15, 0xcd, 0x60, 0xac, 0x9e, 0xcd, 0xb6, 0x7b, 0x17, 0xb5, 0xd4, 0xec, 0xa2,
+	0x67, 0xd5, 0x52, 0x33, 0xf1, 0x5e, 0x0f, 0xbd, 0x07, 0x53, 0x25, 0x75, 0x06, 0xc7, 0xb3, 0xd1,
+	0x15, 0xb8, 0x3a, 0x1a, 0xe3, 0x5b, 0xcf, 0x41, 0x63, 0x18, 0xaa, 0x9b, 0xfe, 0x5a, 0x5e, 0xbf,
+	0x09, 0x52, 0xff, 0x97, 0xbc, 0x4b, 0x84, 0x60, 0xa2, 0xa4, 0x76, 0xb4, 0xbd, 0xd1, 0xfa, 0xb3,
+	0x57, 0x0f, 0x73, 0xeb, 0x8f, 0x87, 0xb9, 0xf5, 0xf7, 0xc3, 0xdc, 0xfa, 0xe5, 0x9f, 0xf9, 0x05,
+	0x7c, 0x90, 0xb0, 0x7c, 0xc5, 0x09, 0xcd, 0x92, 0x98, 0xaf, 0x24, 0x49, 0xf7, 0xba, 0x69, 0x3b,
+	0xeb, 0xdf, 0x01, 0x00, 0x4e, 0x95, 0x7e, 0xc0, 0x89, 0x06, 0x00, 0x00,
+}
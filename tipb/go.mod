@@ -0,0 +1,5 @@
+module github.com/pingcap/tipb
+
+go 1.13
+
+require github.com/golang/protobuf v1.3.4